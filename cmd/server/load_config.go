@@ -58,6 +58,9 @@ const (
 	FGAStoreIDFlag      = "fga-store-id"
 	BaseURLFlag         = "base-url"
 	DefaultFromEmailFlag = "default-from-email"
+	StripeSecretKeyFlag  = "stripe-secret-key"
+	StripeWebhookSecretFlag = "stripe-webhook-secret"
+	StripeAPIURLFlag     = "stripe-api-url"
 )
 
 func loadDBConfigFromCLI(ctx *cli.Context) config.DatabaseConfig {
@@ -104,6 +107,11 @@ func loadConfigFromCLI(ctx *cli.Context) *config.Config {
 			Username: ctx.String(SMTPUsernameFlag),
 			Password: ctx.String(SMTPPasswordFlag),
 		},
+		Stripe: config.StripeConfig{
+			SecretKey:     ctx.String(StripeSecretKeyFlag),
+			WebhookSecret: ctx.String(StripeWebhookSecretFlag),
+			APIURL:        ctx.String(StripeAPIURLFlag),
+		},
 		BaseURL:          ctx.String(BaseURLFlag),
 		DefaultFromEmail: ctx.String(DefaultFromEmailFlag),
 	}
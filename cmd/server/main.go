@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	_ "time/tzdata" // the business timezone must load on hosts without zoneinfo
 
 	"github.com/rs/zerolog/log"
 	"github.com/urfave/cli/v2"
@@ -74,6 +75,9 @@ func run(args []string) {
 					&cli.StringFlag{Name: SMTPUsernameFlag, Value: "anything", EnvVars: []string{"DEGREES_SMTP_USERNAME"}},
 					&cli.StringFlag{Name: SMTPPasswordFlag, Value: "anypassword", EnvVars: []string{"DEGREES_SMTP_PASSWORD"}},
 					&cli.StringFlag{Name: BaseURLFlag, Value: "http://localhost:8080", Usage: "Base URL for the application (used in emails, etc.)", EnvVars: []string{"DEGREES_BASE_URL"}},
+					&cli.StringFlag{Name: StripeSecretKeyFlag, Usage: "Stripe secret API key; payments are disabled without one", EnvVars: []string{"DEGREES_STRIPE_SECRET_KEY"}},
+					&cli.StringFlag{Name: StripeWebhookSecretFlag, Usage: "Signing secret of the Stripe webhook endpoint", EnvVars: []string{"DEGREES_STRIPE_WEBHOOK_SECRET"}},
+					&cli.StringFlag{Name: StripeAPIURLFlag, Usage: "Stripe API URL if not Stripe's own, e.g. a local fake for testing", EnvVars: []string{"DEGREES_STRIPE_API_URL"}},
 					&cli.StringFlag{Name: DefaultFromEmailFlag, Value: "noreply@localhost", Usage: "Default from email address for notifications", EnvVars: []string{"DEGREES_DEFAULT_FROM_EMAIL"}},
 				},
 				Subcommands: []*cli.Command{
@@ -31,6 +31,11 @@ func seedRun(ctx *cli.Context) error {
 		return fmt.Errorf("failed to seed schedule config: %w", err)
 	}
 
+	bayID, err := seedResources(bgCtx, queries)
+	if err != nil {
+		return fmt.Errorf("failed to seed resources: %w", err)
+	}
+
 	if err := seedCatalogue(bgCtx, queries); err != nil {
 		return fmt.Errorf("failed to seed catalogue: %w", err)
 	}
@@ -49,7 +54,7 @@ func seedRun(ctx *cli.Context) error {
 		return fmt.Errorf("failed to seed demo customers: %w", err)
 	}
 
-	completed, err := seedDemoBookings(bgCtx, queries, customers)
+	completed, err := seedDemoBookings(bgCtx, queries, customers, bayID)
 	if err != nil {
 		return fmt.Errorf("failed to seed demo bookings: %w", err)
 	}
@@ -97,6 +102,51 @@ func seedScheduleConfig(ctx context.Context, q *dbpg.Queries) error {
 	return nil
 }
 
+// seedResources makes sure the demo bays and van exist (matched by name) and
+// returns the ID of the first bay, used for demo bookings.
+func seedResources(ctx context.Context, q *dbpg.Queries) (int64, error) {
+	log.Info().Msg("seeding resources...")
+
+	type resourceDef struct {
+		name         string
+		resourceType string
+	}
+	defs := []resourceDef{
+		{"Bay 1", "bay"},
+		{"Bay 2", "bay"},
+		{"Mobile Van", "van"},
+	}
+
+	existing, err := q.ListResources(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list resources: %w", err)
+	}
+	ids := make(map[string]int64)
+	for _, r := range existing {
+		ids[r.Name] = r.ID
+	}
+
+	for i, def := range defs {
+		if _, ok := ids[def.name]; ok {
+			log.Info().Str("resource", def.name).Msg("  resource already exists, skipping")
+			continue
+		}
+		created, err := q.CreateResource(ctx, dbpg.CreateResourceParams{
+			Name:         def.name,
+			ResourceType: def.resourceType,
+			IsActive:     true,
+			SortOrder:    int32(i + 1),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to create resource %s: %w", def.name, err)
+		}
+		ids[def.name] = created.ID
+		log.Info().Str("resource", def.name).Int64("id", created.ID).Msg("  resource created")
+	}
+
+	return ids["Bay 1"], nil
+}
+
 func seedCatalogue(ctx context.Context, q *dbpg.Queries) error {
 	log.Info().Msg("seeding catalogue...")
 
@@ -159,6 +209,14 @@ func seedCatalogue(ctx context.Context, q *dbpg.Queries) error {
 		{"Leather Treatment", "leather-treatment", "interior", 15000, 120},
 	}
 
+	// Lighter services can also be done on site from the van
+	vanCapable := map[string]bool{
+		"basic-wash-dry":      true,
+		"wash-protect":        true,
+		"mini-detail":         true,
+		"interior-deep-clean": true,
+	}
+
 	// Create services (skip if slug already exists)
 	serviceIDs := make(map[string]int64)
 	for i, svc := range services {
@@ -174,6 +232,11 @@ func seedCatalogue(ctx context.Context, q *dbpg.Queries) error {
 			continue
 		}
 
+		resourceTypes := []string{"bay"}
+		if vanCapable[svc.slug] {
+			resourceTypes = append(resourceTypes, "van")
+		}
+
 		created, err := q.CreateService(ctx, dbpg.CreateServiceParams{
 			CategoryID:      catID,
 			Name:            svc.name,
@@ -184,6 +247,7 @@ func seedCatalogue(ctx context.Context, q *dbpg.Queries) error {
 			DurationMinutes: svc.duration,
 			IsActive:        true,
 			SortOrder:       int32(i + 1),
+			ResourceTypes:   resourceTypes,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create service %s: %w", svc.name, err)
@@ -196,16 +260,17 @@ func seedCatalogue(ctx context.Context, q *dbpg.Queries) error {
 	type optionDef struct {
 		name     string
 		price    int64
+		duration int32    // extra minutes the option adds
 		services []string // service slugs this option applies to
 	}
 
 	options := []optionDef{
-		{"Engine Bay Clean", 8000, []string{"wash-protect", "mini-detail", "full-detail", "premium-detail"}},
-		{"Wheel Ceramic Coating", 15000, []string{"full-detail", "premium-detail", "ceramic-coating-1-year", "ceramic-coating-3-year", "ceramic-coating-5-year"}},
-		{"Headlight Restoration", 10000, []string{"mini-detail", "full-detail", "premium-detail", "stage-1-paint-correction", "stage-2-paint-correction"}},
-		{"Pet Hair Removal", 5000, []string{"basic-wash-dry", "wash-protect", "mini-detail", "full-detail", "premium-detail", "interior-deep-clean"}},
-		{"Fabric Protection", 6000, []string{"interior-deep-clean", "full-detail", "premium-detail"}},
-		{"Odour Removal", 8000, []string{"interior-deep-clean", "leather-treatment", "full-detail", "premium-detail"}},
+		{"Engine Bay Clean", 8000, 30, []string{"wash-protect", "mini-detail", "full-detail", "premium-detail"}},
+		{"Wheel Ceramic Coating", 15000, 60, []string{"full-detail", "premium-detail", "ceramic-coating-1-year", "ceramic-coating-3-year", "ceramic-coating-5-year"}},
+		{"Headlight Restoration", 10000, 45, []string{"mini-detail", "full-detail", "premium-detail", "stage-1-paint-correction", "stage-2-paint-correction"}},
+		{"Pet Hair Removal", 5000, 30, []string{"basic-wash-dry", "wash-protect", "mini-detail", "full-detail", "premium-detail", "interior-deep-clean"}},
+		{"Fabric Protection", 6000, 20, []string{"interior-deep-clean", "full-detail", "premium-detail"}},
+		{"Odour Removal", 8000, 30, []string{"interior-deep-clean", "leather-treatment", "full-detail", "premium-detail"}},
 	}
 
 	for i, opt := range options {
@@ -232,12 +297,13 @@ func seedCatalogue(ctx context.Context, q *dbpg.Queries) error {
 			}
 
 			_, err = q.CreateServiceOption(ctx, dbpg.CreateServiceOptionParams{
-				ServiceID:   svcID,
-				Name:        opt.name,
-				Description: dbpg.StringToPGString(opt.name),
-				Price:       opt.price,
-				IsActive:    true,
-				SortOrder:   int32(i + 1),
+				ServiceID:       svcID,
+				Name:            opt.name,
+				Description:     dbpg.StringToPGString(opt.name),
+				Price:           opt.price,
+				IsActive:        true,
+				SortOrder:       int32(i + 1),
+				DurationMinutes: opt.duration,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to create option %s on service %s: %w", opt.name, svcSlug, err)
@@ -644,7 +710,7 @@ type demoCompletedBooking struct {
 	daysAgo     int
 }
 
-func seedDemoBookings(ctx context.Context, q *dbpg.Queries, customers []demoCustomer) ([]demoCompletedBooking, error) {
+func seedDemoBookings(ctx context.Context, q *dbpg.Queries, customers []demoCustomer, bayID int64) ([]demoCompletedBooking, error) {
 	log.Info().Msg("seeding demo bookings...")
 
 	if len(customers) < 5 {
@@ -748,6 +814,7 @@ func seedDemoBookings(ctx context.Context, q *dbpg.Queries, customers []demoCust
 			DepositAmount:         deposit,
 			TotalAmount:           subtotal,
 			Notes:                 dbpg.StringToPGString(b.notes),
+			ResourceID:            bayID,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create booking %s for %s: %w", b.svcSlug, b.cust.email, err)
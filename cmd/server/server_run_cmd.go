@@ -33,6 +33,7 @@ import (
 	"github.com/richardbowden/degrees/internal/riverqueue"
 	"github.com/richardbowden/degrees/internal/services"
 	"github.com/richardbowden/degrees/internal/settings"
+	"github.com/richardbowden/degrees/internal/stripe"
 	"github.com/richardbowden/degrees/internal/templater"
 	thttp "github.com/richardbowden/degrees/internal/transport/http"
 	"github.com/richardbowden/degrees/internal/workers"
@@ -219,6 +220,101 @@ func serverRun(ctx *cli.Context) error {
 	if err := riverqueue.Register(rq, bookingWkrConfig, bookingConfirmationWorker); err != nil {
 		log.Fatal().Err(err).Msg("failed to register booking confirmation worker")
 	}
+	bookingRescheduledWorker := workers.NewBookingRescheduledWorker(n)
+	bookingRescheduledWkrConfig := riverqueue.WorkerConfig{
+		Name:       "booking_rescheduled",
+		Queue:      "booking",
+		MaxWorkers: 2,
+	}
+	if err := riverqueue.Register(rq, bookingRescheduledWkrConfig, bookingRescheduledWorker); err != nil {
+		log.Fatal().Err(err).Msg("failed to register booking rescheduled worker")
+	}
+	bookingRefundedWorker := workers.NewBookingRefundedWorker(n)
+	bookingRefundedWkrConfig := riverqueue.WorkerConfig{
+		Name:       "booking_refunded",
+		Queue:      "booking",
+		MaxWorkers: 2,
+	}
+	if err := riverqueue.Register(rq, bookingRefundedWkrConfig, bookingRefundedWorker); err != nil {
+		log.Fatal().Err(err).Msg("failed to register booking refunded worker")
+	}
+
+	// Booking service is built before the queue starts so the recurring
+	// series worker can use it
+	bookingRepo := repos.NewBookingRepo(ds)
+	bookingSvc := services.NewBookingService(bookingRepo, settingsService)
+	bookingSvc.Notifier = n
+	bookingSvc.Waitlist = n
+	bookingSvc.BaseURL = config.BaseURL
+
+	bookingSeriesWorker := workers.NewBookingSeriesWorker(bookingSvc)
+	bookingSeriesWkrConfig := riverqueue.WorkerConfig{
+		Name:       "booking_series",
+		Queue:      workers.QueueMaintenance,
+		MaxWorkers: 1,
+	}
+	if err := riverqueue.Register(rq, bookingSeriesWkrConfig, bookingSeriesWorker); err != nil {
+		log.Fatal().Err(err).Msg("failed to register booking series worker")
+	}
+
+	// Book recurring series occurrences hourly (and once on start)
+	riverqueue.AddPeriodicJob(rq, 1*time.Hour, workers.BookingSeriesArgs{})
+
+	waitlistCheckWorker := workers.NewWaitlistCheckWorker(bookingSvc)
+	waitlistCheckWkrConfig := riverqueue.WorkerConfig{
+		Name:       "waitlist_check",
+		Queue:      workers.QueueBooking,
+		MaxWorkers: 2,
+	}
+	if err := riverqueue.Register(rq, waitlistCheckWkrConfig, waitlistCheckWorker); err != nil {
+		log.Fatal().Err(err).Msg("failed to register waitlist check worker")
+	}
+	waitlistExpiryWorker := workers.NewWaitlistExpiryWorker(bookingSvc)
+	waitlistExpiryWkrConfig := riverqueue.WorkerConfig{
+		Name:       "waitlist_expiry",
+		Queue:      workers.QueueMaintenance,
+		MaxWorkers: 1,
+	}
+	if err := riverqueue.Register(rq, waitlistExpiryWkrConfig, waitlistExpiryWorker); err != nil {
+		log.Fatal().Err(err).Msg("failed to register waitlist expiry worker")
+	}
+
+	// Release unclaimed waitlist offers every few minutes so the next
+	// customer hears about the slot promptly
+	riverqueue.AddPeriodicJob(rq, 5*time.Minute, workers.WaitlistExpiryArgs{})
+
+	bookingReminderWorker := workers.NewBookingReminderWorker(bookingSvc)
+	bookingReminderWkrConfig := riverqueue.WorkerConfig{
+		Name:       "booking_reminder",
+		Queue:      workers.QueueBooking,
+		MaxWorkers: 2,
+	}
+	if err := riverqueue.Register(rq, bookingReminderWkrConfig, bookingReminderWorker); err != nil {
+		log.Fatal().Err(err).Msg("failed to register booking reminder worker")
+	}
+	bookingFollowUpWorker := workers.NewBookingFollowUpWorker(bookingSvc)
+	bookingFollowUpWkrConfig := riverqueue.WorkerConfig{
+		Name:       "booking_follow_up",
+		Queue:      workers.QueueBooking,
+		MaxWorkers: 2,
+	}
+	if err := riverqueue.Register(rq, bookingFollowUpWkrConfig, bookingFollowUpWorker); err != nil {
+		log.Fatal().Err(err).Msg("failed to register booking follow-up worker")
+	}
+
+	checkoutHoldExpiryWorker := workers.NewCheckoutHoldExpiryWorker(bookingSvc)
+	checkoutHoldExpiryWkrConfig := riverqueue.WorkerConfig{
+		Name:       "checkout_hold_expiry",
+		Queue:      workers.QueueMaintenance,
+		MaxWorkers: 1,
+	}
+	if err := riverqueue.Register(rq, checkoutHoldExpiryWkrConfig, checkoutHoldExpiryWorker); err != nil {
+		log.Fatal().Err(err).Msg("failed to register checkout hold expiry worker")
+	}
+
+	// Checkout holds last minutes, so free abandoned ones soon after they
+	// run out
+	riverqueue.AddPeriodicJob(rq, 1*time.Minute, workers.CheckoutHoldExpiryArgs{})
 
 	err = rq.Start(context.Background())
 	if err != nil {
@@ -284,19 +380,33 @@ func serverRun(ctx *cli.Context) error {
 
 	// Schedule service
 	scheduleRepo := repos.NewScheduleRepo(ds)
-	scheduleSvc := services.NewScheduleService(scheduleRepo)
-	scheduleGrpcSvc := grpcsvr.NewScheduleServer(scheduleSvc)
+	scheduleSvc := services.NewScheduleService(scheduleRepo, settingsService)
+	scheduleSvc.Waitlist = n
+	scheduleGrpcSvc := grpcsvr.NewScheduleServer(scheduleSvc, authzClient)
 	pb.RegisterScheduleServiceServer(grpcServer, scheduleGrpcSvc)
 
 	// Booking service
-	bookingRepo := repos.NewBookingRepo(ds)
-	bookingSvc := services.NewBookingService(bookingRepo)
-	bookingGrpcSvc := grpcsvr.NewBookingServer(bookingSvc, scheduleSvc)
+	calendarFeedRepo := repos.NewCalendarFeedRepo(ds)
+	calendarFeedSvc := services.NewCalendarFeedService(calendarFeedRepo, authzClient, settingsService)
+	calendarFeedSvc.BaseURL = config.BaseURL
+
+	bookingGrpcSvc := grpcsvr.NewBookingServer(bookingSvc, scheduleSvc, calendarFeedSvc, authzClient)
 	pb.RegisterBookingServiceServer(grpcServer, bookingGrpcSvc)
 
+	// Staff service
+	staffRepo := repos.NewStaffRepo(ds)
+	staffSvc := services.NewStaffService(staffRepo, authzClient)
+	staffGrpcSvc := grpcsvr.NewStaffServiceServer(staffSvc, bookingSvc)
+	pb.RegisterStaffServiceServer(grpcServer, staffGrpcSvc)
+
 	// Payment service
-	paymentSvc := services.NewPaymentService(bookingRepo, nil, config.BaseURL)
-	paymentGrpcSvc := grpcsvr.NewPaymentServer(paymentSvc)
+	var stripeClient services.StripeClient
+	if config.Stripe.SecretKey != "" {
+		stripeClient = stripe.NewClient(config.Stripe.SecretKey, config.Stripe.APIURL)
+	}
+	paymentSvc := services.NewPaymentService(bookingRepo, stripeClient, config.BaseURL, config.Stripe.WebhookSecret)
+	paymentSvc.Notifier = n
+	paymentGrpcSvc := grpcsvr.NewPaymentServer(paymentSvc, authzClient)
 	pb.RegisterPaymentServiceServer(grpcServer, paymentGrpcSvc)
 
 	// Enable gRPC reflection for grpcurl/grpcui
@@ -392,6 +502,11 @@ func serverRun(ctx *cli.Context) error {
 		log.Fatal().Err(err).Msg("failed to register ScheduleService gateway")
 	}
 
+	err = gw.RegisterStaffServiceHandlerFromEndpoint(gwCtx, gwmux, grpcEndpoint, opts)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to register StaffService gateway")
+	}
+
 	// ========================================
 	// HTTP Server with Gateway + Chi
 	// ========================================
@@ -400,6 +515,8 @@ func serverRun(ctx *cli.Context) error {
 	logStartupInfo(grpcServer, grpcAddr, httpAddr)
 
 	server := thttp.NewServerWithGateway(config, healthSvc, authMiddleware, gwmux)
+	server.SetCalendarFeeds(calendarFeedSvc)
+	server.SetPaymentWebhooks(paymentSvc)
 	err = server.Serve()
 
 	if err != nil {
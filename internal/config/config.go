@@ -16,6 +16,12 @@ type SMTPConfig struct {
 	Password string
 }
 
+type StripeConfig struct {
+	SecretKey     string
+	WebhookSecret string
+	APIURL        string // Stripe's own API unless set, e.g. to a local fake
+}
+
 type DatabaseConfig struct {
 	Host                 string
 	Port                 int
@@ -78,6 +84,7 @@ type Config struct {
 	CookieLifeTime   int
 	Auth             AuthConfig
 	SMTP             SMTPConfig
+	Stripe           StripeConfig
 	BaseURL          string // Base URL for the application (e.g., https://myapp.com)
 	DefaultFromEmail string // Default from email for notifications
 }
@@ -16,9 +16,11 @@ INSERT INTO bookings (
     customer_id, vehicle_id, scheduled_date, scheduled_time,
     estimated_duration_mins, status, payment_status,
     subtotal, deposit_amount, total_amount,
-    stripe_payment_intent_id, stripe_deposit_intent_id, notes
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
-RETURNING id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, stripe_payment_intent_id, stripe_deposit_intent_id, notes, created_at, updated_at
+    notes, resource_id,
+    service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge,
+    created_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+RETURNING id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, notes, created_at, updated_at, refund_amount, resource_id, series_id, service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge, sequence, forfeited_amount, created_by, amount_paid
 `
 
 type CreateBookingParams struct {
@@ -32,9 +34,14 @@ type CreateBookingParams struct {
 	Subtotal              int64
 	DepositAmount         int64
 	TotalAmount           int64
-	StripePaymentIntentID pgtype.Text
-	StripeDepositIntentID pgtype.Text
 	Notes                 pgtype.Text
+	ResourceID            int64
+	ServiceAddress        pgtype.Text
+	ServiceSuburb         pgtype.Text
+	ServicePostcode       pgtype.Text
+	TravelDistanceKm      float64
+	TravelSurcharge       int64
+	CreatedBy             pgtype.Int8
 }
 
 func (q *Queries) CreateBooking(ctx context.Context, arg CreateBookingParams) (Booking, error) {
@@ -49,9 +56,14 @@ func (q *Queries) CreateBooking(ctx context.Context, arg CreateBookingParams) (B
 		arg.Subtotal,
 		arg.DepositAmount,
 		arg.TotalAmount,
-		arg.StripePaymentIntentID,
-		arg.StripeDepositIntentID,
 		arg.Notes,
+		arg.ResourceID,
+		arg.ServiceAddress,
+		arg.ServiceSuburb,
+		arg.ServicePostcode,
+		arg.TravelDistanceKm,
+		arg.TravelSurcharge,
+		arg.CreatedBy,
 	)
 	var i Booking
 	err := row.Scan(
@@ -66,72 +78,165 @@ func (q *Queries) CreateBooking(ctx context.Context, arg CreateBookingParams) (B
 		&i.Subtotal,
 		&i.DepositAmount,
 		&i.TotalAmount,
-		&i.StripePaymentIntentID,
-		&i.StripeDepositIntentID,
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RefundAmount,
+		&i.ResourceID,
+		&i.SeriesID,
+		&i.ServiceAddress,
+		&i.ServiceSuburb,
+		&i.ServicePostcode,
+		&i.TravelDistanceKm,
+		&i.TravelSurcharge,
+		&i.Sequence,
+		&i.ForfeitedAmount,
+		&i.CreatedBy,
+		&i.AmountPaid,
 	)
 	return i, err
 }
 
 const createBookingService = `-- name: CreateBookingService :one
-INSERT INTO booking_services (booking_id, service_id, price_at_booking)
-VALUES ($1, $2, $3)
-RETURNING id, booking_id, service_id, price_at_booking
+INSERT INTO booking_services (booking_id, service_id, price_at_booking, duration_at_booking)
+VALUES ($1, $2, $3, $4)
+RETURNING id, booking_id, service_id, price_at_booking, duration_at_booking
 `
 
 type CreateBookingServiceParams struct {
-	BookingID      int64
-	ServiceID      int64
-	PriceAtBooking int64
+	BookingID         int64
+	ServiceID         int64
+	PriceAtBooking    int64
+	DurationAtBooking int32
 }
 
 func (q *Queries) CreateBookingService(ctx context.Context, arg CreateBookingServiceParams) (BookingService, error) {
-	row := q.db.QueryRow(ctx, createBookingService, arg.BookingID, arg.ServiceID, arg.PriceAtBooking)
+	row := q.db.QueryRow(ctx, createBookingService,
+		arg.BookingID,
+		arg.ServiceID,
+		arg.PriceAtBooking,
+		arg.DurationAtBooking,
+	)
 	var i BookingService
 	err := row.Scan(
 		&i.ID,
 		&i.BookingID,
 		&i.ServiceID,
 		&i.PriceAtBooking,
+		&i.DurationAtBooking,
 	)
 	return i, err
 }
 
 const createBookingServiceOption = `-- name: CreateBookingServiceOption :one
-INSERT INTO booking_service_options (booking_service_id, service_option_id, price_at_booking)
-VALUES ($1, $2, $3)
-RETURNING id, booking_service_id, service_option_id, price_at_booking
+INSERT INTO booking_service_options (booking_service_id, service_option_id, price_at_booking, duration_at_booking)
+VALUES ($1, $2, $3, $4)
+RETURNING id, booking_service_id, service_option_id, price_at_booking, duration_at_booking
 `
 
 type CreateBookingServiceOptionParams struct {
-	BookingServiceID int64
-	ServiceOptionID  int64
-	PriceAtBooking   int64
+	BookingServiceID  int64
+	ServiceOptionID   int64
+	PriceAtBooking    int64
+	DurationAtBooking int32
 }
 
 func (q *Queries) CreateBookingServiceOption(ctx context.Context, arg CreateBookingServiceOptionParams) (BookingServiceOption, error) {
-	row := q.db.QueryRow(ctx, createBookingServiceOption, arg.BookingServiceID, arg.ServiceOptionID, arg.PriceAtBooking)
+	row := q.db.QueryRow(ctx, createBookingServiceOption,
+		arg.BookingServiceID,
+		arg.ServiceOptionID,
+		arg.PriceAtBooking,
+		arg.DurationAtBooking,
+	)
 	var i BookingServiceOption
 	err := row.Scan(
 		&i.ID,
 		&i.BookingServiceID,
 		&i.ServiceOptionID,
 		&i.PriceAtBooking,
+		&i.DurationAtBooking,
+	)
+	return i, err
+}
+
+const createBookingStatusHistory = `-- name: CreateBookingStatusHistory :one
+INSERT INTO booking_status_history (booking_id, field, from_value, to_value, changed_by, reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, booking_id, field, from_value, to_value, changed_by, reason, created_at
+`
+
+type CreateBookingStatusHistoryParams struct {
+	BookingID int64
+	Field     string
+	FromValue pgtype.Text
+	ToValue   string
+	ChangedBy pgtype.Int8
+	Reason    pgtype.Text
+}
+
+func (q *Queries) CreateBookingStatusHistory(ctx context.Context, arg CreateBookingStatusHistoryParams) (BookingStatusHistory, error) {
+	row := q.db.QueryRow(ctx, createBookingStatusHistory,
+		arg.BookingID,
+		arg.Field,
+		arg.FromValue,
+		arg.ToValue,
+		arg.ChangedBy,
+		arg.Reason,
+	)
+	var i BookingStatusHistory
+	err := row.Scan(
+		&i.ID,
+		&i.BookingID,
+		&i.Field,
+		&i.FromValue,
+		&i.ToValue,
+		&i.ChangedBy,
+		&i.Reason,
+		&i.CreatedAt,
 	)
 	return i, err
 }
 
+const deleteBookingService = `-- name: DeleteBookingService :exec
+DELETE FROM booking_services
+WHERE id = $1
+`
+
+type DeleteBookingServiceParams struct {
+	ID int64
+}
+
+func (q *Queries) DeleteBookingService(ctx context.Context, arg DeleteBookingServiceParams) error {
+	_, err := q.db.Exec(ctx, deleteBookingService, arg.ID)
+	return err
+}
+
+const deleteBookingServiceOption = `-- name: DeleteBookingServiceOption :exec
+DELETE FROM booking_service_options
+WHERE id = $1
+`
+
+type DeleteBookingServiceOptionParams struct {
+	ID int64
+}
+
+func (q *Queries) DeleteBookingServiceOption(ctx context.Context, arg DeleteBookingServiceOptionParams) error {
+	_, err := q.db.Exec(ctx, deleteBookingServiceOption, arg.ID)
+	return err
+}
+
 const getBookingByID = `-- name: GetBookingByID :one
-SELECT b.id, b.customer_id, b.vehicle_id, b.scheduled_date, b.scheduled_time, b.estimated_duration_mins, b.status, b.payment_status, b.subtotal, b.deposit_amount, b.total_amount, b.stripe_payment_intent_id, b.stripe_deposit_intent_id, b.notes, b.created_at, b.updated_at,
+SELECT b.id, b.customer_id, b.vehicle_id, b.scheduled_date, b.scheduled_time, b.estimated_duration_mins, b.status, b.payment_status, b.subtotal, b.deposit_amount, b.total_amount, b.notes, b.created_at, b.updated_at, b.refund_amount, b.resource_id, b.series_id, b.service_address, b.service_suburb, b.service_postcode, b.travel_distance_km, b.travel_surcharge, b.sequence, b.forfeited_amount, b.created_by, b.amount_paid,
        cp.user_id AS customer_user_id,
        cp.phone AS customer_phone,
+       u.login_email AS customer_email,
+       trim(u.first_name || ' ' || COALESCE(u.surname, '')) AS customer_name,
        v.make AS vehicle_make,
        v.model AS vehicle_model,
        v.rego AS vehicle_rego
 FROM bookings b
 JOIN customer_profiles cp ON cp.id = b.customer_id
+JOIN users u ON u.id = cp.user_id
 LEFT JOIN vehicles v ON v.id = b.vehicle_id
 WHERE b.id = $1
 `
@@ -152,13 +257,25 @@ type GetBookingByIDRow struct {
 	Subtotal              int64
 	DepositAmount         int64
 	TotalAmount           int64
-	StripePaymentIntentID pgtype.Text
-	StripeDepositIntentID pgtype.Text
 	Notes                 pgtype.Text
 	CreatedAt             pgtype.Timestamptz
 	UpdatedAt             pgtype.Timestamptz
+	RefundAmount          int64
+	ResourceID            int64
+	SeriesID              pgtype.Int8
+	ServiceAddress        pgtype.Text
+	ServiceSuburb         pgtype.Text
+	ServicePostcode       pgtype.Text
+	TravelDistanceKm      float64
+	TravelSurcharge       int64
+	Sequence              int32
+	ForfeitedAmount       int64
+	CreatedBy             pgtype.Int8
+	AmountPaid            int64
 	CustomerUserID        int64
 	CustomerPhone         pgtype.Text
+	CustomerEmail         string
+	CustomerName          string
 	VehicleMake           pgtype.Text
 	VehicleModel          pgtype.Text
 	VehicleRego           pgtype.Text
@@ -179,13 +296,25 @@ func (q *Queries) GetBookingByID(ctx context.Context, arg GetBookingByIDParams)
 		&i.Subtotal,
 		&i.DepositAmount,
 		&i.TotalAmount,
-		&i.StripePaymentIntentID,
-		&i.StripeDepositIntentID,
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RefundAmount,
+		&i.ResourceID,
+		&i.SeriesID,
+		&i.ServiceAddress,
+		&i.ServiceSuburb,
+		&i.ServicePostcode,
+		&i.TravelDistanceKm,
+		&i.TravelSurcharge,
+		&i.Sequence,
+		&i.ForfeitedAmount,
+		&i.CreatedBy,
+		&i.AmountPaid,
 		&i.CustomerUserID,
 		&i.CustomerPhone,
+		&i.CustomerEmail,
+		&i.CustomerName,
 		&i.VehicleMake,
 		&i.VehicleModel,
 		&i.VehicleRego,
@@ -193,8 +322,127 @@ func (q *Queries) GetBookingByID(ctx context.Context, arg GetBookingByIDParams)
 	return i, err
 }
 
+const getBookingForUpdate = `-- name: GetBookingForUpdate :one
+SELECT id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, notes, created_at, updated_at, refund_amount, resource_id, series_id, service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge, sequence, forfeited_amount, created_by, amount_paid FROM bookings
+WHERE id = $1
+FOR UPDATE
+`
+
+type GetBookingForUpdateParams struct {
+	ID int64
+}
+
+func (q *Queries) GetBookingForUpdate(ctx context.Context, arg GetBookingForUpdateParams) (Booking, error) {
+	row := q.db.QueryRow(ctx, getBookingForUpdate, arg.ID)
+	var i Booking
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.VehicleID,
+		&i.ScheduledDate,
+		&i.ScheduledTime,
+		&i.EstimatedDurationMins,
+		&i.Status,
+		&i.PaymentStatus,
+		&i.Subtotal,
+		&i.DepositAmount,
+		&i.TotalAmount,
+		&i.Notes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RefundAmount,
+		&i.ResourceID,
+		&i.SeriesID,
+		&i.ServiceAddress,
+		&i.ServiceSuburb,
+		&i.ServicePostcode,
+		&i.TravelDistanceKm,
+		&i.TravelSurcharge,
+		&i.Sequence,
+		&i.ForfeitedAmount,
+		&i.CreatedBy,
+		&i.AmountPaid,
+	)
+	return i, err
+}
+
+const getBookingService = `-- name: GetBookingService :one
+SELECT id, booking_id, service_id, price_at_booking, duration_at_booking FROM booking_services
+WHERE id = $1 AND booking_id = $2
+`
+
+type GetBookingServiceParams struct {
+	ID        int64
+	BookingID int64
+}
+
+func (q *Queries) GetBookingService(ctx context.Context, arg GetBookingServiceParams) (BookingService, error) {
+	row := q.db.QueryRow(ctx, getBookingService, arg.ID, arg.BookingID)
+	var i BookingService
+	err := row.Scan(
+		&i.ID,
+		&i.BookingID,
+		&i.ServiceID,
+		&i.PriceAtBooking,
+		&i.DurationAtBooking,
+	)
+	return i, err
+}
+
+const getBookingServiceOption = `-- name: GetBookingServiceOption :one
+SELECT id, booking_service_id, service_option_id, price_at_booking, duration_at_booking FROM booking_service_options
+WHERE id = $1 AND booking_service_id = $2
+`
+
+type GetBookingServiceOptionParams struct {
+	ID               int64
+	BookingServiceID int64
+}
+
+func (q *Queries) GetBookingServiceOption(ctx context.Context, arg GetBookingServiceOptionParams) (BookingServiceOption, error) {
+	row := q.db.QueryRow(ctx, getBookingServiceOption, arg.ID, arg.BookingServiceID)
+	var i BookingServiceOption
+	err := row.Scan(
+		&i.ID,
+		&i.BookingServiceID,
+		&i.ServiceOptionID,
+		&i.PriceAtBooking,
+		&i.DurationAtBooking,
+	)
+	return i, err
+}
+
+const incrementCustomerNoShowCount = `-- name: IncrementCustomerNoShowCount :one
+UPDATE customer_profiles
+SET no_show_count = no_show_count + 1
+WHERE id = $1
+RETURNING id, user_id, phone, address, suburb, postcode, notes, created_at, updated_at, no_show_count
+`
+
+type IncrementCustomerNoShowCountParams struct {
+	ID int64
+}
+
+func (q *Queries) IncrementCustomerNoShowCount(ctx context.Context, arg IncrementCustomerNoShowCountParams) (CustomerProfile, error) {
+	row := q.db.QueryRow(ctx, incrementCustomerNoShowCount, arg.ID)
+	var i CustomerProfile
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Phone,
+		&i.Address,
+		&i.Suburb,
+		&i.Postcode,
+		&i.Notes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.NoShowCount,
+	)
+	return i, err
+}
+
 const listAllBookingsAdmin = `-- name: ListAllBookingsAdmin :many
-SELECT b.id, b.customer_id, b.vehicle_id, b.scheduled_date, b.scheduled_time, b.estimated_duration_mins, b.status, b.payment_status, b.subtotal, b.deposit_amount, b.total_amount, b.stripe_payment_intent_id, b.stripe_deposit_intent_id, b.notes, b.created_at, b.updated_at,
+SELECT b.id, b.customer_id, b.vehicle_id, b.scheduled_date, b.scheduled_time, b.estimated_duration_mins, b.status, b.payment_status, b.subtotal, b.deposit_amount, b.total_amount, b.notes, b.created_at, b.updated_at, b.refund_amount, b.resource_id, b.series_id, b.service_address, b.service_suburb, b.service_postcode, b.travel_distance_km, b.travel_surcharge, b.sequence, b.forfeited_amount, b.created_by, b.amount_paid,
        trim(u.first_name || ' ' || COALESCE(u.surname, '')) AS customer_name,
        cp.phone AS customer_phone,
        v.make AS vehicle_make,
@@ -225,11 +473,21 @@ type ListAllBookingsAdminRow struct {
 	Subtotal              int64
 	DepositAmount         int64
 	TotalAmount           int64
-	StripePaymentIntentID pgtype.Text
-	StripeDepositIntentID pgtype.Text
 	Notes                 pgtype.Text
 	CreatedAt             pgtype.Timestamptz
 	UpdatedAt             pgtype.Timestamptz
+	RefundAmount          int64
+	ResourceID            int64
+	SeriesID              pgtype.Int8
+	ServiceAddress        pgtype.Text
+	ServiceSuburb         pgtype.Text
+	ServicePostcode       pgtype.Text
+	TravelDistanceKm      float64
+	TravelSurcharge       int64
+	Sequence              int32
+	ForfeitedAmount       int64
+	CreatedBy             pgtype.Int8
+	AmountPaid            int64
 	CustomerName          string
 	CustomerPhone         pgtype.Text
 	VehicleMake           pgtype.Text
@@ -258,11 +516,21 @@ func (q *Queries) ListAllBookingsAdmin(ctx context.Context, arg ListAllBookingsA
 			&i.Subtotal,
 			&i.DepositAmount,
 			&i.TotalAmount,
-			&i.StripePaymentIntentID,
-			&i.StripeDepositIntentID,
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RefundAmount,
+			&i.ResourceID,
+			&i.SeriesID,
+			&i.ServiceAddress,
+			&i.ServiceSuburb,
+			&i.ServicePostcode,
+			&i.TravelDistanceKm,
+			&i.TravelSurcharge,
+			&i.Sequence,
+			&i.ForfeitedAmount,
+			&i.CreatedBy,
+			&i.AmountPaid,
 			&i.CustomerName,
 			&i.CustomerPhone,
 			&i.VehicleMake,
@@ -281,7 +549,7 @@ func (q *Queries) ListAllBookingsAdmin(ctx context.Context, arg ListAllBookingsA
 
 const listBookingServiceOptions = `-- name: ListBookingServiceOptions :many
 SELECT bso.id, bso.booking_service_id, bso.service_option_id,
-       bso.price_at_booking,
+       bso.price_at_booking, bso.duration_at_booking,
        so.name AS option_name
 FROM booking_service_options bso
 JOIN service_options so ON so.id = bso.service_option_id
@@ -293,11 +561,12 @@ type ListBookingServiceOptionsParams struct {
 }
 
 type ListBookingServiceOptionsRow struct {
-	ID               int64
-	BookingServiceID int64
-	ServiceOptionID  int64
-	PriceAtBooking   int64
-	OptionName       string
+	ID                int64
+	BookingServiceID  int64
+	ServiceOptionID   int64
+	PriceAtBooking    int64
+	DurationAtBooking int32
+	OptionName        string
 }
 
 func (q *Queries) ListBookingServiceOptions(ctx context.Context, arg ListBookingServiceOptionsParams) ([]ListBookingServiceOptionsRow, error) {
@@ -314,6 +583,7 @@ func (q *Queries) ListBookingServiceOptions(ctx context.Context, arg ListBooking
 			&i.BookingServiceID,
 			&i.ServiceOptionID,
 			&i.PriceAtBooking,
+			&i.DurationAtBooking,
 			&i.OptionName,
 		); err != nil {
 			return nil, err
@@ -327,7 +597,7 @@ func (q *Queries) ListBookingServiceOptions(ctx context.Context, arg ListBooking
 }
 
 const listBookingServices = `-- name: ListBookingServices :many
-SELECT bs.id, bs.booking_id, bs.service_id, bs.price_at_booking,
+SELECT bs.id, bs.booking_id, bs.service_id, bs.price_at_booking, bs.duration_at_booking,
        s.name AS service_name, s.slug AS service_slug
 FROM booking_services bs
 JOIN services s ON s.id = bs.service_id
@@ -339,12 +609,13 @@ type ListBookingServicesParams struct {
 }
 
 type ListBookingServicesRow struct {
-	ID             int64
-	BookingID      int64
-	ServiceID      int64
-	PriceAtBooking int64
-	ServiceName    string
-	ServiceSlug    string
+	ID                int64
+	BookingID         int64
+	ServiceID         int64
+	PriceAtBooking    int64
+	DurationAtBooking int32
+	ServiceName       string
+	ServiceSlug       string
 }
 
 func (q *Queries) ListBookingServices(ctx context.Context, arg ListBookingServicesParams) ([]ListBookingServicesRow, error) {
@@ -361,6 +632,7 @@ func (q *Queries) ListBookingServices(ctx context.Context, arg ListBookingServic
 			&i.BookingID,
 			&i.ServiceID,
 			&i.PriceAtBooking,
+			&i.DurationAtBooking,
 			&i.ServiceName,
 			&i.ServiceSlug,
 		); err != nil {
@@ -374,8 +646,47 @@ func (q *Queries) ListBookingServices(ctx context.Context, arg ListBookingServic
 	return items, nil
 }
 
+const listBookingStatusHistory = `-- name: ListBookingStatusHistory :many
+SELECT id, booking_id, field, from_value, to_value, changed_by, reason, created_at FROM booking_status_history
+WHERE booking_id = $1
+ORDER BY created_at, id
+`
+
+type ListBookingStatusHistoryParams struct {
+	BookingID int64
+}
+
+func (q *Queries) ListBookingStatusHistory(ctx context.Context, arg ListBookingStatusHistoryParams) ([]BookingStatusHistory, error) {
+	rows, err := q.db.Query(ctx, listBookingStatusHistory, arg.BookingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BookingStatusHistory
+	for rows.Next() {
+		var i BookingStatusHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookingID,
+			&i.Field,
+			&i.FromValue,
+			&i.ToValue,
+			&i.ChangedBy,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listBookingsByCustomer = `-- name: ListBookingsByCustomer :many
-SELECT id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, stripe_payment_intent_id, stripe_deposit_intent_id, notes, created_at, updated_at FROM bookings
+SELECT id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, notes, created_at, updated_at, refund_amount, resource_id, series_id, service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge, sequence, forfeited_amount, created_by, amount_paid FROM bookings
 WHERE customer_id = $1
 ORDER BY scheduled_date DESC, scheduled_time DESC
 `
@@ -405,11 +716,21 @@ func (q *Queries) ListBookingsByCustomer(ctx context.Context, arg ListBookingsBy
 			&i.Subtotal,
 			&i.DepositAmount,
 			&i.TotalAmount,
-			&i.StripePaymentIntentID,
-			&i.StripeDepositIntentID,
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RefundAmount,
+			&i.ResourceID,
+			&i.SeriesID,
+			&i.ServiceAddress,
+			&i.ServiceSuburb,
+			&i.ServicePostcode,
+			&i.TravelDistanceKm,
+			&i.TravelSurcharge,
+			&i.Sequence,
+			&i.ForfeitedAmount,
+			&i.CreatedBy,
+			&i.AmountPaid,
 		); err != nil {
 			return nil, err
 		}
@@ -422,7 +743,7 @@ func (q *Queries) ListBookingsByCustomer(ctx context.Context, arg ListBookingsBy
 }
 
 const listBookingsByDateRange = `-- name: ListBookingsByDateRange :many
-SELECT id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, stripe_payment_intent_id, stripe_deposit_intent_id, notes, created_at, updated_at FROM bookings
+SELECT id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, notes, created_at, updated_at, refund_amount, resource_id, series_id, service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge, sequence, forfeited_amount, created_by, amount_paid FROM bookings
 WHERE scheduled_date >= $1 AND scheduled_date <= $2
 ORDER BY scheduled_date, scheduled_time
 `
@@ -453,11 +774,21 @@ func (q *Queries) ListBookingsByDateRange(ctx context.Context, arg ListBookingsB
 			&i.Subtotal,
 			&i.DepositAmount,
 			&i.TotalAmount,
-			&i.StripePaymentIntentID,
-			&i.StripeDepositIntentID,
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RefundAmount,
+			&i.ResourceID,
+			&i.SeriesID,
+			&i.ServiceAddress,
+			&i.ServiceSuburb,
+			&i.ServicePostcode,
+			&i.TravelDistanceKm,
+			&i.TravelSurcharge,
+			&i.Sequence,
+			&i.ForfeitedAmount,
+			&i.CreatedBy,
+			&i.AmountPaid,
 		); err != nil {
 			return nil, err
 		}
@@ -470,7 +801,7 @@ func (q *Queries) ListBookingsByDateRange(ctx context.Context, arg ListBookingsB
 }
 
 const listBookingsForDate = `-- name: ListBookingsForDate :many
-SELECT id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, stripe_payment_intent_id, stripe_deposit_intent_id, notes, created_at, updated_at FROM bookings
+SELECT id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, notes, created_at, updated_at, refund_amount, resource_id, series_id, service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge, sequence, forfeited_amount, created_by, amount_paid FROM bookings
 WHERE scheduled_date = $1
   AND status NOT IN ('cancelled')
 ORDER BY scheduled_time
@@ -501,11 +832,21 @@ func (q *Queries) ListBookingsForDate(ctx context.Context, arg ListBookingsForDa
 			&i.Subtotal,
 			&i.DepositAmount,
 			&i.TotalAmount,
-			&i.StripePaymentIntentID,
-			&i.StripeDepositIntentID,
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RefundAmount,
+			&i.ResourceID,
+			&i.SeriesID,
+			&i.ServiceAddress,
+			&i.ServiceSuburb,
+			&i.ServicePostcode,
+			&i.TravelDistanceKm,
+			&i.TravelSurcharge,
+			&i.Sequence,
+			&i.ForfeitedAmount,
+			&i.CreatedBy,
+			&i.AmountPaid,
 		); err != nil {
 			return nil, err
 		}
@@ -517,11 +858,261 @@ func (q *Queries) ListBookingsForDate(ctx context.Context, arg ListBookingsForDa
 	return items, nil
 }
 
+const listBookingsForRange = `-- name: ListBookingsForRange :many
+SELECT id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, notes, created_at, updated_at, refund_amount, resource_id, series_id, service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge, sequence, forfeited_amount, created_by, amount_paid FROM bookings
+WHERE scheduled_date BETWEEN $1::date AND $2::date
+  AND status NOT IN ('cancelled')
+ORDER BY scheduled_date, scheduled_time
+`
+
+type ListBookingsForRangeParams struct {
+	DateFrom pgtype.Date
+	DateTo   pgtype.Date
+}
+
+func (q *Queries) ListBookingsForRange(ctx context.Context, arg ListBookingsForRangeParams) ([]Booking, error) {
+	rows, err := q.db.Query(ctx, listBookingsForRange, arg.DateFrom, arg.DateTo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Booking
+	for rows.Next() {
+		var i Booking
+		if err := rows.Scan(
+			&i.ID,
+			&i.CustomerID,
+			&i.VehicleID,
+			&i.ScheduledDate,
+			&i.ScheduledTime,
+			&i.EstimatedDurationMins,
+			&i.Status,
+			&i.PaymentStatus,
+			&i.Subtotal,
+			&i.DepositAmount,
+			&i.TotalAmount,
+			&i.Notes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RefundAmount,
+			&i.ResourceID,
+			&i.SeriesID,
+			&i.ServiceAddress,
+			&i.ServiceSuburb,
+			&i.ServicePostcode,
+			&i.TravelDistanceKm,
+			&i.TravelSurcharge,
+			&i.Sequence,
+			&i.ForfeitedAmount,
+			&i.CreatedBy,
+			&i.AmountPaid,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rescheduleBooking = `-- name: RescheduleBooking :one
+UPDATE bookings
+SET scheduled_date = $2, scheduled_time = $3, resource_id = $4
+WHERE id = $1
+RETURNING id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, notes, created_at, updated_at, refund_amount, resource_id, series_id, service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge, sequence, forfeited_amount, created_by, amount_paid
+`
+
+type RescheduleBookingParams struct {
+	ID            int64
+	ScheduledDate pgtype.Date
+	ScheduledTime pgtype.Time
+	ResourceID    int64
+}
+
+func (q *Queries) RescheduleBooking(ctx context.Context, arg RescheduleBookingParams) (Booking, error) {
+	row := q.db.QueryRow(ctx, rescheduleBooking,
+		arg.ID,
+		arg.ScheduledDate,
+		arg.ScheduledTime,
+		arg.ResourceID,
+	)
+	var i Booking
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.VehicleID,
+		&i.ScheduledDate,
+		&i.ScheduledTime,
+		&i.EstimatedDurationMins,
+		&i.Status,
+		&i.PaymentStatus,
+		&i.Subtotal,
+		&i.DepositAmount,
+		&i.TotalAmount,
+		&i.Notes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RefundAmount,
+		&i.ResourceID,
+		&i.SeriesID,
+		&i.ServiceAddress,
+		&i.ServiceSuburb,
+		&i.ServicePostcode,
+		&i.TravelDistanceKm,
+		&i.TravelSurcharge,
+		&i.Sequence,
+		&i.ForfeitedAmount,
+		&i.CreatedBy,
+		&i.AmountPaid,
+	)
+	return i, err
+}
+
+const setBookingAmountPaid = `-- name: SetBookingAmountPaid :one
+UPDATE bookings
+SET amount_paid = $2
+WHERE id = $1
+RETURNING id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, notes, created_at, updated_at, refund_amount, resource_id, series_id, service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge, sequence, forfeited_amount, created_by, amount_paid
+`
+
+type SetBookingAmountPaidParams struct {
+	ID         int64
+	AmountPaid int64
+}
+
+func (q *Queries) SetBookingAmountPaid(ctx context.Context, arg SetBookingAmountPaidParams) (Booking, error) {
+	row := q.db.QueryRow(ctx, setBookingAmountPaid, arg.ID, arg.AmountPaid)
+	var i Booking
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.VehicleID,
+		&i.ScheduledDate,
+		&i.ScheduledTime,
+		&i.EstimatedDurationMins,
+		&i.Status,
+		&i.PaymentStatus,
+		&i.Subtotal,
+		&i.DepositAmount,
+		&i.TotalAmount,
+		&i.Notes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RefundAmount,
+		&i.ResourceID,
+		&i.SeriesID,
+		&i.ServiceAddress,
+		&i.ServiceSuburb,
+		&i.ServicePostcode,
+		&i.TravelDistanceKm,
+		&i.TravelSurcharge,
+		&i.Sequence,
+		&i.ForfeitedAmount,
+		&i.CreatedBy,
+		&i.AmountPaid,
+	)
+	return i, err
+}
+
+const setBookingForfeitedAmount = `-- name: SetBookingForfeitedAmount :one
+UPDATE bookings
+SET forfeited_amount = $2
+WHERE id = $1
+RETURNING id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, notes, created_at, updated_at, refund_amount, resource_id, series_id, service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge, sequence, forfeited_amount, created_by, amount_paid
+`
+
+type SetBookingForfeitedAmountParams struct {
+	ID              int64
+	ForfeitedAmount int64
+}
+
+func (q *Queries) SetBookingForfeitedAmount(ctx context.Context, arg SetBookingForfeitedAmountParams) (Booking, error) {
+	row := q.db.QueryRow(ctx, setBookingForfeitedAmount, arg.ID, arg.ForfeitedAmount)
+	var i Booking
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.VehicleID,
+		&i.ScheduledDate,
+		&i.ScheduledTime,
+		&i.EstimatedDurationMins,
+		&i.Status,
+		&i.PaymentStatus,
+		&i.Subtotal,
+		&i.DepositAmount,
+		&i.TotalAmount,
+		&i.Notes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RefundAmount,
+		&i.ResourceID,
+		&i.SeriesID,
+		&i.ServiceAddress,
+		&i.ServiceSuburb,
+		&i.ServicePostcode,
+		&i.TravelDistanceKm,
+		&i.TravelSurcharge,
+		&i.Sequence,
+		&i.ForfeitedAmount,
+		&i.CreatedBy,
+		&i.AmountPaid,
+	)
+	return i, err
+}
+
+const setBookingRefundAmount = `-- name: SetBookingRefundAmount :one
+UPDATE bookings
+SET refund_amount = $2
+WHERE id = $1
+RETURNING id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, notes, created_at, updated_at, refund_amount, resource_id, series_id, service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge, sequence, forfeited_amount, created_by, amount_paid
+`
+
+type SetBookingRefundAmountParams struct {
+	ID           int64
+	RefundAmount int64
+}
+
+func (q *Queries) SetBookingRefundAmount(ctx context.Context, arg SetBookingRefundAmountParams) (Booking, error) {
+	row := q.db.QueryRow(ctx, setBookingRefundAmount, arg.ID, arg.RefundAmount)
+	var i Booking
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.VehicleID,
+		&i.ScheduledDate,
+		&i.ScheduledTime,
+		&i.EstimatedDurationMins,
+		&i.Status,
+		&i.PaymentStatus,
+		&i.Subtotal,
+		&i.DepositAmount,
+		&i.TotalAmount,
+		&i.Notes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RefundAmount,
+		&i.ResourceID,
+		&i.SeriesID,
+		&i.ServiceAddress,
+		&i.ServiceSuburb,
+		&i.ServicePostcode,
+		&i.TravelDistanceKm,
+		&i.TravelSurcharge,
+		&i.Sequence,
+		&i.ForfeitedAmount,
+		&i.CreatedBy,
+		&i.AmountPaid,
+	)
+	return i, err
+}
+
 const updateBookingPaymentStatus = `-- name: UpdateBookingPaymentStatus :one
 UPDATE bookings
 SET payment_status = $2
 WHERE id = $1
-RETURNING id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, stripe_payment_intent_id, stripe_deposit_intent_id, notes, created_at, updated_at
+RETURNING id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, notes, created_at, updated_at, refund_amount, resource_id, series_id, service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge, sequence, forfeited_amount, created_by, amount_paid
 `
 
 type UpdateBookingPaymentStatusParams struct {
@@ -544,11 +1135,76 @@ func (q *Queries) UpdateBookingPaymentStatus(ctx context.Context, arg UpdateBook
 		&i.Subtotal,
 		&i.DepositAmount,
 		&i.TotalAmount,
-		&i.StripePaymentIntentID,
-		&i.StripeDepositIntentID,
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RefundAmount,
+		&i.ResourceID,
+		&i.SeriesID,
+		&i.ServiceAddress,
+		&i.ServiceSuburb,
+		&i.ServicePostcode,
+		&i.TravelDistanceKm,
+		&i.TravelSurcharge,
+		&i.Sequence,
+		&i.ForfeitedAmount,
+		&i.CreatedBy,
+		&i.AmountPaid,
+	)
+	return i, err
+}
+
+const updateBookingPricing = `-- name: UpdateBookingPricing :one
+UPDATE bookings
+SET subtotal = $2, total_amount = $3, estimated_duration_mins = $4, resource_id = $5
+WHERE id = $1
+RETURNING id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, notes, created_at, updated_at, refund_amount, resource_id, series_id, service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge, sequence, forfeited_amount, created_by, amount_paid
+`
+
+type UpdateBookingPricingParams struct {
+	ID                    int64
+	Subtotal              int64
+	TotalAmount           int64
+	EstimatedDurationMins int32
+	ResourceID            int64
+}
+
+func (q *Queries) UpdateBookingPricing(ctx context.Context, arg UpdateBookingPricingParams) (Booking, error) {
+	row := q.db.QueryRow(ctx, updateBookingPricing,
+		arg.ID,
+		arg.Subtotal,
+		arg.TotalAmount,
+		arg.EstimatedDurationMins,
+		arg.ResourceID,
+	)
+	var i Booking
+	err := row.Scan(
+		&i.ID,
+		&i.CustomerID,
+		&i.VehicleID,
+		&i.ScheduledDate,
+		&i.ScheduledTime,
+		&i.EstimatedDurationMins,
+		&i.Status,
+		&i.PaymentStatus,
+		&i.Subtotal,
+		&i.DepositAmount,
+		&i.TotalAmount,
+		&i.Notes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RefundAmount,
+		&i.ResourceID,
+		&i.SeriesID,
+		&i.ServiceAddress,
+		&i.ServiceSuburb,
+		&i.ServicePostcode,
+		&i.TravelDistanceKm,
+		&i.TravelSurcharge,
+		&i.Sequence,
+		&i.ForfeitedAmount,
+		&i.CreatedBy,
+		&i.AmountPaid,
 	)
 	return i, err
 }
@@ -557,7 +1213,7 @@ const updateBookingStatus = `-- name: UpdateBookingStatus :one
 UPDATE bookings
 SET status = $2
 WHERE id = $1
-RETURNING id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, stripe_payment_intent_id, stripe_deposit_intent_id, notes, created_at, updated_at
+RETURNING id, customer_id, vehicle_id, scheduled_date, scheduled_time, estimated_duration_mins, status, payment_status, subtotal, deposit_amount, total_amount, notes, created_at, updated_at, refund_amount, resource_id, series_id, service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge, sequence, forfeited_amount, created_by, amount_paid
 `
 
 type UpdateBookingStatusParams struct {
@@ -580,11 +1236,21 @@ func (q *Queries) UpdateBookingStatus(ctx context.Context, arg UpdateBookingStat
 		&i.Subtotal,
 		&i.DepositAmount,
 		&i.TotalAmount,
-		&i.StripePaymentIntentID,
-		&i.StripeDepositIntentID,
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RefundAmount,
+		&i.ResourceID,
+		&i.SeriesID,
+		&i.ServiceAddress,
+		&i.ServiceSuburb,
+		&i.ServicePostcode,
+		&i.TravelDistanceKm,
+		&i.TravelSurcharge,
+		&i.Sequence,
+		&i.ForfeitedAmount,
+		&i.CreatedBy,
+		&i.AmountPaid,
 	)
 	return i, err
 }
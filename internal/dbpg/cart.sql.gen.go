@@ -148,6 +148,60 @@ func (q *Queries) GetCartByUserID(ctx context.Context, arg GetCartByUserIDParams
 	return i, err
 }
 
+const listCartItemOptionsBySession = `-- name: ListCartItemOptionsBySession :many
+SELECT cio.id, cio.cart_item_id, cio.service_option_id,
+       so.service_id, so.name AS option_name, so.price, so.duration_minutes, so.is_active
+FROM cart_item_options cio
+JOIN cart_items ci ON ci.id = cio.cart_item_id
+JOIN service_options so ON so.id = cio.service_option_id
+WHERE ci.cart_session_id = $1
+ORDER BY cio.cart_item_id, so.sort_order, so.name
+`
+
+type ListCartItemOptionsBySessionParams struct {
+	CartSessionID int64
+}
+
+type ListCartItemOptionsBySessionRow struct {
+	ID              int64
+	CartItemID      int64
+	ServiceOptionID int64
+	ServiceID       int64
+	OptionName      string
+	Price           int64
+	DurationMinutes int32
+	IsActive        bool
+}
+
+func (q *Queries) ListCartItemOptionsBySession(ctx context.Context, arg ListCartItemOptionsBySessionParams) ([]ListCartItemOptionsBySessionRow, error) {
+	rows, err := q.db.Query(ctx, listCartItemOptionsBySession, arg.CartSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCartItemOptionsBySessionRow
+	for rows.Next() {
+		var i ListCartItemOptionsBySessionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CartItemID,
+			&i.ServiceOptionID,
+			&i.ServiceID,
+			&i.OptionName,
+			&i.Price,
+			&i.DurationMinutes,
+			&i.IsActive,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listCartItems = `-- name: ListCartItems :many
 SELECT ci.id, ci.cart_session_id, ci.service_id, ci.vehicle_id,
        ci.quantity, ci.created_at,
@@ -47,9 +47,9 @@ func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams)
 const createService = `-- name: CreateService :one
 INSERT INTO services (
     category_id, name, slug, description, short_desc,
-    base_price, duration_minutes, is_active, sort_order
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-RETURNING id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at
+    base_price, duration_minutes, is_active, sort_order, resource_types
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at, resource_types
 `
 
 type CreateServiceParams struct {
@@ -62,6 +62,7 @@ type CreateServiceParams struct {
 	DurationMinutes int32
 	IsActive        bool
 	SortOrder       int32
+	ResourceTypes   []string
 }
 
 func (q *Queries) CreateService(ctx context.Context, arg CreateServiceParams) (Service, error) {
@@ -75,6 +76,7 @@ func (q *Queries) CreateService(ctx context.Context, arg CreateServiceParams) (S
 		arg.DurationMinutes,
 		arg.IsActive,
 		arg.SortOrder,
+		arg.ResourceTypes,
 	)
 	var i Service
 	err := row.Scan(
@@ -90,23 +92,25 @@ func (q *Queries) CreateService(ctx context.Context, arg CreateServiceParams) (S
 		&i.SortOrder,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ResourceTypes,
 	)
 	return i, err
 }
 
 const createServiceOption = `-- name: CreateServiceOption :one
-INSERT INTO service_options (service_id, name, description, price, is_active, sort_order)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, service_id, name, description, price, is_active, sort_order, created_at
+INSERT INTO service_options (service_id, name, description, price, is_active, sort_order, duration_minutes)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, service_id, name, description, price, is_active, sort_order, created_at, duration_minutes
 `
 
 type CreateServiceOptionParams struct {
-	ServiceID   int64
-	Name        string
-	Description pgtype.Text
-	Price       int64
-	IsActive    bool
-	SortOrder   int32
+	ServiceID       int64
+	Name            string
+	Description     pgtype.Text
+	Price           int64
+	IsActive        bool
+	SortOrder       int32
+	DurationMinutes int32
 }
 
 func (q *Queries) CreateServiceOption(ctx context.Context, arg CreateServiceOptionParams) (ServiceOption, error) {
@@ -117,6 +121,7 @@ func (q *Queries) CreateServiceOption(ctx context.Context, arg CreateServiceOpti
 		arg.Price,
 		arg.IsActive,
 		arg.SortOrder,
+		arg.DurationMinutes,
 	)
 	var i ServiceOption
 	err := row.Scan(
@@ -128,6 +133,7 @@ func (q *Queries) CreateServiceOption(ctx context.Context, arg CreateServiceOpti
 		&i.IsActive,
 		&i.SortOrder,
 		&i.CreatedAt,
+		&i.DurationMinutes,
 	)
 	return i, err
 }
@@ -198,7 +204,7 @@ const deleteService = `-- name: DeleteService :one
 UPDATE services
 SET is_active = false
 WHERE id = $1
-RETURNING id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at
+RETURNING id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at, resource_types
 `
 
 type DeleteServiceParams struct {
@@ -221,6 +227,7 @@ func (q *Queries) DeleteService(ctx context.Context, arg DeleteServiceParams) (S
 		&i.SortOrder,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ResourceTypes,
 	)
 	return i, err
 }
@@ -229,7 +236,7 @@ const deleteServiceOption = `-- name: DeleteServiceOption :one
 UPDATE service_options
 SET is_active = false
 WHERE id = $1
-RETURNING id, service_id, name, description, price, is_active, sort_order, created_at
+RETURNING id, service_id, name, description, price, is_active, sort_order, created_at, duration_minutes
 `
 
 type DeleteServiceOptionParams struct {
@@ -248,6 +255,7 @@ func (q *Queries) DeleteServiceOption(ctx context.Context, arg DeleteServiceOpti
 		&i.IsActive,
 		&i.SortOrder,
 		&i.CreatedAt,
+		&i.DurationMinutes,
 	)
 	return i, err
 }
@@ -291,7 +299,7 @@ func (q *Queries) GetCategoryBySlug(ctx context.Context, arg GetCategoryBySlugPa
 }
 
 const getPriceTier = `-- name: GetPriceTier :one
-SELECT spt.id, spt.service_id, spt.vehicle_category_id, spt.price, spt.created_at,
+SELECT spt.id, spt.service_id, spt.vehicle_category_id, spt.price, spt.duration_minutes, spt.created_at,
        vc.name AS category_name, vc.slug AS category_slug
 FROM service_price_tiers spt
 JOIN vehicle_categories vc ON vc.id = spt.vehicle_category_id
@@ -308,6 +316,7 @@ type GetPriceTierRow struct {
 	ServiceID         int64
 	VehicleCategoryID int64
 	Price             int64
+	DurationMinutes   pgtype.Int4
 	CreatedAt         pgtype.Timestamptz
 	CategoryName      string
 	CategorySlug      string
@@ -321,6 +330,7 @@ func (q *Queries) GetPriceTier(ctx context.Context, arg GetPriceTierParams) (Get
 		&i.ServiceID,
 		&i.VehicleCategoryID,
 		&i.Price,
+		&i.DurationMinutes,
 		&i.CreatedAt,
 		&i.CategoryName,
 		&i.CategorySlug,
@@ -329,7 +339,7 @@ func (q *Queries) GetPriceTier(ctx context.Context, arg GetPriceTierParams) (Get
 }
 
 const getServiceByID = `-- name: GetServiceByID :one
-SELECT id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at FROM services
+SELECT id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at, resource_types FROM services
 WHERE id = $1
 `
 
@@ -353,12 +363,13 @@ func (q *Queries) GetServiceByID(ctx context.Context, arg GetServiceByIDParams)
 		&i.SortOrder,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ResourceTypes,
 	)
 	return i, err
 }
 
 const getServiceBySlug = `-- name: GetServiceBySlug :one
-SELECT s.id, s.category_id, s.name, s.slug, s.description, s.short_desc, s.base_price, s.duration_minutes, s.is_active, s.sort_order, s.created_at, s.updated_at, sc.name AS category_name
+SELECT s.id, s.category_id, s.name, s.slug, s.description, s.short_desc, s.base_price, s.duration_minutes, s.is_active, s.sort_order, s.created_at, s.updated_at, s.resource_types, sc.name AS category_name
 FROM services s
 JOIN service_categories sc ON sc.id = s.category_id
 WHERE s.slug = $1
@@ -381,6 +392,7 @@ type GetServiceBySlugRow struct {
 	SortOrder       int32
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
+	ResourceTypes   []string
 	CategoryName    string
 }
 
@@ -400,11 +412,38 @@ func (q *Queries) GetServiceBySlug(ctx context.Context, arg GetServiceBySlugPara
 		&i.SortOrder,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ResourceTypes,
 		&i.CategoryName,
 	)
 	return i, err
 }
 
+const getServiceOptionByID = `-- name: GetServiceOptionByID :one
+SELECT id, service_id, name, description, price, is_active, sort_order, created_at, duration_minutes FROM service_options
+WHERE id = $1
+`
+
+type GetServiceOptionByIDParams struct {
+	ID int64
+}
+
+func (q *Queries) GetServiceOptionByID(ctx context.Context, arg GetServiceOptionByIDParams) (ServiceOption, error) {
+	row := q.db.QueryRow(ctx, getServiceOptionByID, arg.ID)
+	var i ServiceOption
+	err := row.Scan(
+		&i.ID,
+		&i.ServiceID,
+		&i.Name,
+		&i.Description,
+		&i.Price,
+		&i.IsActive,
+		&i.SortOrder,
+		&i.CreatedAt,
+		&i.DurationMinutes,
+	)
+	return i, err
+}
+
 const getVehicleCategoryByID = `-- name: GetVehicleCategoryByID :one
 SELECT id, name, slug, description, sort_order, created_at, updated_at FROM vehicle_categories
 WHERE id = $1
@@ -430,7 +469,7 @@ func (q *Queries) GetVehicleCategoryByID(ctx context.Context, arg GetVehicleCate
 }
 
 const listAllServiceOptions = `-- name: ListAllServiceOptions :many
-SELECT id, service_id, name, description, price, is_active, sort_order, created_at FROM service_options
+SELECT id, service_id, name, description, price, is_active, sort_order, created_at, duration_minutes FROM service_options
 WHERE service_id = $1
 ORDER BY sort_order, name
 `
@@ -457,6 +496,7 @@ func (q *Queries) ListAllServiceOptions(ctx context.Context, arg ListAllServiceO
 			&i.IsActive,
 			&i.SortOrder,
 			&i.CreatedAt,
+			&i.DurationMinutes,
 		); err != nil {
 			return nil, err
 		}
@@ -469,7 +509,7 @@ func (q *Queries) ListAllServiceOptions(ctx context.Context, arg ListAllServiceO
 }
 
 const listAllServices = `-- name: ListAllServices :many
-SELECT id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at FROM services
+SELECT id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at, resource_types FROM services
 ORDER BY sort_order, name
 `
 
@@ -495,6 +535,7 @@ func (q *Queries) ListAllServices(ctx context.Context) ([]Service, error) {
 			&i.SortOrder,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ResourceTypes,
 		); err != nil {
 			return nil, err
 		}
@@ -541,7 +582,7 @@ func (q *Queries) ListCategories(ctx context.Context) ([]ServiceCategory, error)
 
 const listPriceTiersByService = `-- name: ListPriceTiersByService :many
 
-SELECT spt.id, spt.service_id, spt.vehicle_category_id, spt.price, spt.created_at,
+SELECT spt.id, spt.service_id, spt.vehicle_category_id, spt.price, spt.duration_minutes, spt.created_at,
        vc.name AS category_name, vc.slug AS category_slug
 FROM service_price_tiers spt
 JOIN vehicle_categories vc ON vc.id = spt.vehicle_category_id
@@ -558,6 +599,7 @@ type ListPriceTiersByServiceRow struct {
 	ServiceID         int64
 	VehicleCategoryID int64
 	Price             int64
+	DurationMinutes   pgtype.Int4
 	CreatedAt         pgtype.Timestamptz
 	CategoryName      string
 	CategorySlug      string
@@ -580,6 +622,7 @@ func (q *Queries) ListPriceTiersByService(ctx context.Context, arg ListPriceTier
 			&i.ServiceID,
 			&i.VehicleCategoryID,
 			&i.Price,
+			&i.DurationMinutes,
 			&i.CreatedAt,
 			&i.CategoryName,
 			&i.CategorySlug,
@@ -595,7 +638,7 @@ func (q *Queries) ListPriceTiersByService(ctx context.Context, arg ListPriceTier
 }
 
 const listServiceOptions = `-- name: ListServiceOptions :many
-SELECT id, service_id, name, description, price, is_active, sort_order, created_at FROM service_options
+SELECT id, service_id, name, description, price, is_active, sort_order, created_at, duration_minutes FROM service_options
 WHERE service_id = $1 AND is_active = true
 ORDER BY sort_order, name
 `
@@ -622,6 +665,53 @@ func (q *Queries) ListServiceOptions(ctx context.Context, arg ListServiceOptions
 			&i.IsActive,
 			&i.SortOrder,
 			&i.CreatedAt,
+			&i.DurationMinutes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listServiceRequirements = `-- name: ListServiceRequirements :many
+SELECT s.id, s.category_id, s.resource_types,
+       COALESCE(spt.duration_minutes, s.duration_minutes)::int AS duration_minutes
+FROM services s
+LEFT JOIN service_price_tiers spt ON spt.service_id = s.id AND spt.vehicle_category_id = $1
+WHERE s.id = ANY($2::bigint[])
+`
+
+type ListServiceRequirementsParams struct {
+	VehicleCategoryID int64
+	Ids               []int64
+}
+
+type ListServiceRequirementsRow struct {
+	ID              int64
+	CategoryID      int64
+	ResourceTypes   []string
+	DurationMinutes int32
+}
+
+// Durations are those for the vehicle category where a tier sets one.
+func (q *Queries) ListServiceRequirements(ctx context.Context, arg ListServiceRequirementsParams) ([]ListServiceRequirementsRow, error) {
+	rows, err := q.db.Query(ctx, listServiceRequirements, arg.VehicleCategoryID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListServiceRequirementsRow
+	for rows.Next() {
+		var i ListServiceRequirementsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CategoryID,
+			&i.ResourceTypes,
+			&i.DurationMinutes,
 		); err != nil {
 			return nil, err
 		}
@@ -634,7 +724,7 @@ func (q *Queries) ListServiceOptions(ctx context.Context, arg ListServiceOptions
 }
 
 const listServices = `-- name: ListServices :many
-SELECT id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at FROM services
+SELECT id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at, resource_types FROM services
 WHERE is_active = true
 ORDER BY sort_order, name
 `
@@ -661,6 +751,7 @@ func (q *Queries) ListServices(ctx context.Context) ([]Service, error) {
 			&i.SortOrder,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ResourceTypes,
 		); err != nil {
 			return nil, err
 		}
@@ -673,7 +764,7 @@ func (q *Queries) ListServices(ctx context.Context) ([]Service, error) {
 }
 
 const listServicesByCategory = `-- name: ListServicesByCategory :many
-SELECT id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at FROM services
+SELECT id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at, resource_types FROM services
 WHERE category_id = $1 AND is_active = true
 ORDER BY sort_order, name
 `
@@ -704,6 +795,7 @@ func (q *Queries) ListServicesByCategory(ctx context.Context, arg ListServicesBy
 			&i.SortOrder,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ResourceTypes,
 		); err != nil {
 			return nil, err
 		}
@@ -792,9 +884,9 @@ const updateService = `-- name: UpdateService :one
 UPDATE services
 SET category_id = $2, name = $3, slug = $4, description = $5,
     short_desc = $6, base_price = $7, duration_minutes = $8,
-    is_active = $9, sort_order = $10
+    is_active = $9, sort_order = $10, resource_types = $11
 WHERE id = $1
-RETURNING id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at
+RETURNING id, category_id, name, slug, description, short_desc, base_price, duration_minutes, is_active, sort_order, created_at, updated_at, resource_types
 `
 
 type UpdateServiceParams struct {
@@ -808,6 +900,7 @@ type UpdateServiceParams struct {
 	DurationMinutes int32
 	IsActive        bool
 	SortOrder       int32
+	ResourceTypes   []string
 }
 
 func (q *Queries) UpdateService(ctx context.Context, arg UpdateServiceParams) (Service, error) {
@@ -822,6 +915,7 @@ func (q *Queries) UpdateService(ctx context.Context, arg UpdateServiceParams) (S
 		arg.DurationMinutes,
 		arg.IsActive,
 		arg.SortOrder,
+		arg.ResourceTypes,
 	)
 	var i Service
 	err := row.Scan(
@@ -837,24 +931,26 @@ func (q *Queries) UpdateService(ctx context.Context, arg UpdateServiceParams) (S
 		&i.SortOrder,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ResourceTypes,
 	)
 	return i, err
 }
 
 const updateServiceOption = `-- name: UpdateServiceOption :one
 UPDATE service_options
-SET name = $2, description = $3, price = $4, is_active = $5, sort_order = $6
+SET name = $2, description = $3, price = $4, is_active = $5, sort_order = $6, duration_minutes = $7
 WHERE id = $1
-RETURNING id, service_id, name, description, price, is_active, sort_order, created_at
+RETURNING id, service_id, name, description, price, is_active, sort_order, created_at, duration_minutes
 `
 
 type UpdateServiceOptionParams struct {
-	ID          int64
-	Name        string
-	Description pgtype.Text
-	Price       int64
-	IsActive    bool
-	SortOrder   int32
+	ID              int64
+	Name            string
+	Description     pgtype.Text
+	Price           int64
+	IsActive        bool
+	SortOrder       int32
+	DurationMinutes int32
 }
 
 func (q *Queries) UpdateServiceOption(ctx context.Context, arg UpdateServiceOptionParams) (ServiceOption, error) {
@@ -865,6 +961,7 @@ func (q *Queries) UpdateServiceOption(ctx context.Context, arg UpdateServiceOpti
 		arg.Price,
 		arg.IsActive,
 		arg.SortOrder,
+		arg.DurationMinutes,
 	)
 	var i ServiceOption
 	err := row.Scan(
@@ -876,6 +973,7 @@ func (q *Queries) UpdateServiceOption(ctx context.Context, arg UpdateServiceOpti
 		&i.IsActive,
 		&i.SortOrder,
 		&i.CreatedAt,
+		&i.DurationMinutes,
 	)
 	return i, err
 }
@@ -917,21 +1015,27 @@ func (q *Queries) UpdateVehicleCategory(ctx context.Context, arg UpdateVehicleCa
 }
 
 const upsertPriceTier = `-- name: UpsertPriceTier :one
-INSERT INTO service_price_tiers (service_id, vehicle_category_id, price)
-VALUES ($1, $2, $3)
+INSERT INTO service_price_tiers (service_id, vehicle_category_id, price, duration_minutes)
+VALUES ($1, $2, $3, $4)
 ON CONFLICT (service_id, vehicle_category_id)
-DO UPDATE SET price = EXCLUDED.price
-RETURNING id, service_id, vehicle_category_id, price, created_at
+DO UPDATE SET price = EXCLUDED.price, duration_minutes = EXCLUDED.duration_minutes
+RETURNING id, service_id, vehicle_category_id, price, created_at, duration_minutes
 `
 
 type UpsertPriceTierParams struct {
 	ServiceID         int64
 	VehicleCategoryID int64
 	Price             int64
+	DurationMinutes   pgtype.Int4
 }
 
 func (q *Queries) UpsertPriceTier(ctx context.Context, arg UpsertPriceTierParams) (ServicePriceTier, error) {
-	row := q.db.QueryRow(ctx, upsertPriceTier, arg.ServiceID, arg.VehicleCategoryID, arg.Price)
+	row := q.db.QueryRow(ctx, upsertPriceTier,
+		arg.ServiceID,
+		arg.VehicleCategoryID,
+		arg.Price,
+		arg.DurationMinutes,
+	)
 	var i ServicePriceTier
 	err := row.Scan(
 		&i.ID,
@@ -939,6 +1043,7 @@ func (q *Queries) UpsertPriceTier(ctx context.Context, arg UpsertPriceTierParams
 		&i.VehicleCategoryID,
 		&i.Price,
 		&i.CreatedAt,
+		&i.DurationMinutes,
 	)
 	return i, err
 }
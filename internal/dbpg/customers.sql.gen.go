@@ -14,7 +14,7 @@ import (
 const createCustomerProfile = `-- name: CreateCustomerProfile :one
 INSERT INTO customer_profiles (user_id, phone, address, suburb, postcode, notes)
 VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, user_id, phone, address, suburb, postcode, notes, created_at, updated_at
+RETURNING id, user_id, phone, address, suburb, postcode, notes, created_at, updated_at, no_show_count
 `
 
 type CreateCustomerProfileParams struct {
@@ -46,6 +46,7 @@ func (q *Queries) CreateCustomerProfile(ctx context.Context, arg CreateCustomerP
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.NoShowCount,
 	)
 	return i, err
 }
@@ -117,8 +118,35 @@ func (q *Queries) DeleteVehicle(ctx context.Context, arg DeleteVehicleParams) er
 	return err
 }
 
+const getCustomerProfileByID = `-- name: GetCustomerProfileByID :one
+SELECT id, user_id, phone, address, suburb, postcode, notes, created_at, updated_at, no_show_count FROM customer_profiles
+WHERE id = $1
+`
+
+type GetCustomerProfileByIDParams struct {
+	ID int64
+}
+
+func (q *Queries) GetCustomerProfileByID(ctx context.Context, arg GetCustomerProfileByIDParams) (CustomerProfile, error) {
+	row := q.db.QueryRow(ctx, getCustomerProfileByID, arg.ID)
+	var i CustomerProfile
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Phone,
+		&i.Address,
+		&i.Suburb,
+		&i.Postcode,
+		&i.Notes,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.NoShowCount,
+	)
+	return i, err
+}
+
 const getCustomerProfileByUserID = `-- name: GetCustomerProfileByUserID :one
-SELECT id, user_id, phone, address, suburb, postcode, notes, created_at, updated_at FROM customer_profiles
+SELECT id, user_id, phone, address, suburb, postcode, notes, created_at, updated_at, no_show_count FROM customer_profiles
 WHERE user_id = $1
 `
 
@@ -139,6 +167,7 @@ func (q *Queries) GetCustomerProfileByUserID(ctx context.Context, arg GetCustome
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.NoShowCount,
 	)
 	return i, err
 }
@@ -174,7 +203,7 @@ func (q *Queries) GetVehicleByID(ctx context.Context, arg GetVehicleByIDParams)
 }
 
 const listCustomers = `-- name: ListCustomers :many
-SELECT id, user_id, phone, address, suburb, postcode, notes, created_at, updated_at FROM customer_profiles
+SELECT id, user_id, phone, address, suburb, postcode, notes, created_at, updated_at, no_show_count FROM customer_profiles
 ORDER BY created_at DESC
 LIMIT $1 OFFSET $2
 `
@@ -203,6 +232,7 @@ func (q *Queries) ListCustomers(ctx context.Context, arg ListCustomersParams) ([
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.NoShowCount,
 		); err != nil {
 			return nil, err
 		}
@@ -262,7 +292,7 @@ const updateCustomerProfile = `-- name: UpdateCustomerProfile :one
 UPDATE customer_profiles
 SET phone = $2, address = $3, suburb = $4, postcode = $5, notes = $6
 WHERE id = $1
-RETURNING id, user_id, phone, address, suburb, postcode, notes, created_at, updated_at
+RETURNING id, user_id, phone, address, suburb, postcode, notes, created_at, updated_at, no_show_count
 `
 
 type UpdateCustomerProfileParams struct {
@@ -294,6 +324,7 @@ func (q *Queries) UpdateCustomerProfile(ctx context.Context, arg UpdateCustomerP
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.NoShowCount,
 	)
 	return i, err
 }
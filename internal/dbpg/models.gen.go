@@ -20,6 +20,7 @@ const (
 	BookingStatusInProgress     BookingStatus = "in_progress"
 	BookingStatusCompleted      BookingStatus = "completed"
 	BookingStatusCancelled      BookingStatus = "cancelled"
+	BookingStatusNoShow         BookingStatus = "no_show"
 )
 
 func (e *BookingStatus) Scan(src interface{}) error {
@@ -57,6 +58,94 @@ func (ns NullBookingStatus) Value() (driver.Value, error) {
 	return string(ns.BookingStatus), nil
 }
 
+type PaymentKind string
+
+const (
+	PaymentKindCharge     PaymentKind = "charge"
+	PaymentKindRefund     PaymentKind = "refund"
+	PaymentKindAdjustment PaymentKind = "adjustment"
+)
+
+func (e *PaymentKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PaymentKind(s)
+	case string:
+		*e = PaymentKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PaymentKind: %T", src)
+	}
+	return nil
+}
+
+type NullPaymentKind struct {
+	PaymentKind PaymentKind
+	Valid       bool // Valid is true if PaymentKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPaymentKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.PaymentKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PaymentKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPaymentKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PaymentKind), nil
+}
+
+type PaymentMethod string
+
+const (
+	PaymentMethodCard         PaymentMethod = "card"
+	PaymentMethodCash         PaymentMethod = "cash"
+	PaymentMethodEftpos       PaymentMethod = "eftpos"
+	PaymentMethodBankTransfer PaymentMethod = "bank_transfer"
+	PaymentMethodOther        PaymentMethod = "other"
+)
+
+func (e *PaymentMethod) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PaymentMethod(s)
+	case string:
+		*e = PaymentMethod(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PaymentMethod: %T", src)
+	}
+	return nil
+}
+
+type NullPaymentMethod struct {
+	PaymentMethod PaymentMethod
+	Valid         bool // Valid is true if PaymentMethod is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPaymentMethod) Scan(value interface{}) error {
+	if value == nil {
+		ns.PaymentMethod, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PaymentMethod.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPaymentMethod) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PaymentMethod), nil
+}
+
 type PaymentStatus string
 
 const (
@@ -65,6 +154,7 @@ const (
 	PaymentStatusFullyPaid         PaymentStatus = "fully_paid"
 	PaymentStatusRefunded          PaymentStatus = "refunded"
 	PaymentStatusPartiallyRefunded PaymentStatus = "partially_refunded"
+	PaymentStatusForfeited         PaymentStatus = "forfeited"
 )
 
 func (e *PaymentStatus) Scan(src interface{}) error {
@@ -114,25 +204,85 @@ type Booking struct {
 	Subtotal              int64
 	DepositAmount         int64
 	TotalAmount           int64
-	StripePaymentIntentID pgtype.Text
-	StripeDepositIntentID pgtype.Text
 	Notes                 pgtype.Text
 	CreatedAt             pgtype.Timestamptz
 	UpdatedAt             pgtype.Timestamptz
+	RefundAmount          int64
+	ResourceID            int64
+	SeriesID              pgtype.Int8
+	ServiceAddress        pgtype.Text
+	ServiceSuburb         pgtype.Text
+	ServicePostcode       pgtype.Text
+	TravelDistanceKm      float64
+	TravelSurcharge       int64
+	Sequence              int32
+	ForfeitedAmount       int64
+	CreatedBy             pgtype.Int8
+	AmountPaid            int64
+}
+
+type BookingSeries struct {
+	ID                int64
+	CustomerID        int64
+	TemplateBookingID int64
+	Rrule             string
+	StartDate         pgtype.Date
+	ScheduledTime     pgtype.Time
+	Status            string
+	CreatedAt         pgtype.Timestamptz
+	UpdatedAt         pgtype.Timestamptz
 }
 
-type BookingService struct {
+type BookingSeriesOccurrence struct {
 	ID             int64
-	BookingID      int64
-	ServiceID      int64
-	PriceAtBooking int64
+	SeriesID       int64
+	OccurrenceDate pgtype.Date
+	BookingID      pgtype.Int8
+	Status         string
+	Reason         pgtype.Text
+	CreatedAt      pgtype.Timestamptz
+}
+
+type BookingService struct {
+	ID                int64
+	BookingID         int64
+	ServiceID         int64
+	PriceAtBooking    int64
+	DurationAtBooking int32
 }
 
 type BookingServiceOption struct {
-	ID               int64
-	BookingServiceID int64
-	ServiceOptionID  int64
-	PriceAtBooking   int64
+	ID                int64
+	BookingServiceID  int64
+	ServiceOptionID   int64
+	PriceAtBooking    int64
+	DurationAtBooking int32
+}
+
+type BookingStaff struct {
+	BookingID  int64
+	StaffID    int64
+	AssignedBy pgtype.Int8
+	CreatedAt  pgtype.Timestamptz
+}
+
+type BookingStatusHistory struct {
+	ID        int64
+	BookingID int64
+	Field     string
+	FromValue pgtype.Text
+	ToValue   string
+	ChangedBy pgtype.Int8
+	Reason    pgtype.Text
+	CreatedAt pgtype.Timestamptz
+}
+
+type CalendarFeed struct {
+	ID        int64
+	UserID    int64
+	Scope     string
+	Token     string
+	CreatedAt pgtype.Timestamptz
 }
 
 type CartItem struct {
@@ -159,15 +309,16 @@ type CartSession struct {
 }
 
 type CustomerProfile struct {
-	ID        int64
-	UserID    int64
-	Phone     pgtype.Text
-	Address   pgtype.Text
-	Suburb    pgtype.Text
-	Postcode  pgtype.Text
-	Notes     pgtype.Text
-	CreatedAt pgtype.Timestamptz
-	UpdatedAt pgtype.Timestamptz
+	ID          int64
+	UserID      int64
+	Phone       pgtype.Text
+	Address     pgtype.Text
+	Suburb      pgtype.Text
+	Postcode    pgtype.Text
+	Notes       pgtype.Text
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+	NoShowCount int32
 }
 
 type NotificationTemplate struct {
@@ -204,6 +355,20 @@ type PasswordResetToken struct {
 	CreatedAt pgtype.Timestamptz
 }
 
+type Payment struct {
+	ID          int64
+	BookingID   int64
+	Kind        PaymentKind
+	Amount      int64
+	TipAmount   int64
+	Method      PaymentMethod
+	ProviderRef pgtype.Text
+	Note        pgtype.Text
+	CreatedBy   pgtype.Int8
+	CreatedAt   pgtype.Timestamptz
+	RefundOf    pgtype.Int8
+}
+
 type Profile struct {
 	UserID      int64
 	DisplayName pgtype.Text
@@ -230,11 +395,31 @@ type Project struct {
 	DeletedAt   pgtype.Timestamptz
 }
 
+type Resource struct {
+	ID           int64
+	Name         string
+	ResourceType string
+	IsActive     bool
+	SortOrder    int32
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+}
+
+type ResourceHour struct {
+	ID         int64
+	ResourceID int64
+	DayOfWeek  int32
+	OpenTime   pgtype.Time
+	CloseTime  pgtype.Time
+	IsOpen     bool
+}
+
 type ScheduleBlackout struct {
-	ID        int64
-	Date      pgtype.Date
-	Reason    pgtype.Text
-	CreatedAt pgtype.Timestamptz
+	ID             int64
+	Date           pgtype.Date
+	Reason         pgtype.Text
+	CreatedAt      pgtype.Timestamptz
+	RecursAnnually bool
 }
 
 type ScheduleConfig struct {
@@ -246,6 +431,22 @@ type ScheduleConfig struct {
 	BufferMinutes int32
 }
 
+type ScheduleOverride struct {
+	ID        int64
+	Date      pgtype.Date
+	OpenTime  pgtype.Time
+	CloseTime pgtype.Time
+	Reason    pgtype.Text
+	CreatedAt pgtype.Timestamptz
+}
+
+type ScheduleWindow struct {
+	ID        int64
+	DayOfWeek int32
+	OpenTime  pgtype.Time
+	CloseTime pgtype.Time
+}
+
 type Service struct {
 	ID              int64
 	CategoryID      int64
@@ -259,6 +460,7 @@ type Service struct {
 	SortOrder       int32
 	CreatedAt       pgtype.Timestamptz
 	UpdatedAt       pgtype.Timestamptz
+	ResourceTypes   []string
 }
 
 type ServiceCategory struct {
@@ -282,14 +484,15 @@ type ServiceNote struct {
 }
 
 type ServiceOption struct {
-	ID          int64
-	ServiceID   int64
-	Name        string
-	Description pgtype.Text
-	Price       int64
-	IsActive    bool
-	SortOrder   int32
-	CreatedAt   pgtype.Timestamptz
+	ID              int64
+	ServiceID       int64
+	Name            string
+	Description     pgtype.Text
+	Price           int64
+	IsActive        bool
+	SortOrder       int32
+	CreatedAt       pgtype.Timestamptz
+	DurationMinutes int32
 }
 
 type ServicePhoto struct {
@@ -307,6 +510,7 @@ type ServicePriceTier struct {
 	VehicleCategoryID int64
 	Price             int64
 	CreatedAt         pgtype.Timestamptz
+	DurationMinutes   pgtype.Int4
 }
 
 type ServiceProductsUsed struct {
@@ -352,6 +556,57 @@ type Setting struct {
 	UpdatedBy      pgtype.Int8
 }
 
+type SlotHold struct {
+	ID              int64
+	CustomerID      int64
+	ResourceID      int64
+	ScheduledDate   pgtype.Date
+	ScheduledTime   pgtype.Time
+	DurationMins    int32
+	ExpiresAt       pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+	Purpose         string
+	ServicePostcode pgtype.Text
+}
+
+type Staff struct {
+	ID          int64
+	UserID      int64
+	DisplayName string
+	IsActive    bool
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type StaffLeave struct {
+	ID        int64
+	StaffID   int64
+	StartDate pgtype.Date
+	EndDate   pgtype.Date
+	Reason    pgtype.Text
+	CreatedAt pgtype.Timestamptz
+}
+
+type StaffRoster struct {
+	ID        int64
+	StaffID   int64
+	DayOfWeek int32
+	StartTime pgtype.Time
+	EndTime   pgtype.Time
+}
+
+type StaffSkill struct {
+	StaffID    int64
+	CategoryID int64
+}
+
+type StripeEvent struct {
+	ID         string
+	Type       string
+	BookingID  pgtype.Int8
+	ReceivedAt pgtype.Timestamptz
+}
+
 type Template struct {
 	ID        int64
 	Name      string
@@ -423,3 +678,44 @@ type Verification struct {
 	Token     string
 	CreatedAt pgtype.Timestamptz
 }
+
+type WaitlistEntry struct {
+	ID                    int64
+	CustomerID            int64
+	VehicleID             pgtype.Int8
+	DateFrom              pgtype.Date
+	DateTo                pgtype.Date
+	EstimatedDurationMins int32
+	Subtotal              int64
+	DepositAmount         int64
+	TotalAmount           int64
+	Notes                 pgtype.Text
+	Status                string
+	HoldID                pgtype.Int8
+	ClaimToken            pgtype.Text
+	OfferedAt             pgtype.Timestamptz
+	BookingID             pgtype.Int8
+	CreatedAt             pgtype.Timestamptz
+	UpdatedAt             pgtype.Timestamptz
+	ServiceAddress        pgtype.Text
+	ServiceSuburb         pgtype.Text
+	ServicePostcode       pgtype.Text
+	TravelDistanceKm      float64
+	TravelSurcharge       int64
+}
+
+type WaitlistEntryService struct {
+	ID                int64
+	WaitlistEntryID   int64
+	ServiceID         int64
+	PriceAtBooking    int64
+	DurationAtBooking int32
+}
+
+type WaitlistEntryServiceOption struct {
+	ID                     int64
+	WaitlistEntryServiceID int64
+	ServiceOptionID        int64
+	PriceAtBooking         int64
+	DurationAtBooking      int32
+}
@@ -11,15 +11,21 @@ import (
 type Querier interface {
 	AddCartItem(ctx context.Context, arg AddCartItemParams) (CartItem, error)
 	AddCartItemOption(ctx context.Context, arg AddCartItemOptionParams) (CartItemOption, error)
+	ClaimWaitlistEntry(ctx context.Context, arg ClaimWaitlistEntryParams) (WaitlistEntry, error)
 	ClearCart(ctx context.Context, arg ClearCartParams) error
 	CreateBlackout(ctx context.Context, arg CreateBlackoutParams) (ScheduleBlackout, error)
 	CreateBooking(ctx context.Context, arg CreateBookingParams) (Booking, error)
+	CreateBookingSeries(ctx context.Context, arg CreateBookingSeriesParams) (BookingSeries, error)
 	CreateBookingService(ctx context.Context, arg CreateBookingServiceParams) (BookingService, error)
 	CreateBookingServiceOption(ctx context.Context, arg CreateBookingServiceOptionParams) (BookingServiceOption, error)
+	CreateBookingStatusHistory(ctx context.Context, arg CreateBookingStatusHistoryParams) (BookingStatusHistory, error)
 	CreateCartSession(ctx context.Context, arg CreateCartSessionParams) (CartSession, error)
 	CreateCategory(ctx context.Context, arg CreateCategoryParams) (ServiceCategory, error)
 	CreateCustomerProfile(ctx context.Context, arg CreateCustomerProfileParams) (CustomerProfile, error)
 	CreatePasswordResetToken(ctx context.Context, arg CreatePasswordResetTokenParams) error
+	// Returns no row when the provider payment was already recorded.
+	CreatePayment(ctx context.Context, arg CreatePaymentParams) (Payment, error)
+	CreateResource(ctx context.Context, arg CreateResourceParams) (Resource, error)
 	CreateService(ctx context.Context, arg CreateServiceParams) (Service, error)
 	CreateServiceNote(ctx context.Context, arg CreateServiceNoteParams) (ServiceNote, error)
 	CreateServiceOption(ctx context.Context, arg CreateServiceOptionParams) (ServiceOption, error)
@@ -28,33 +34,60 @@ type Querier interface {
 	CreateServiceRecord(ctx context.Context, arg CreateServiceRecordParams) (ServiceRecord, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateSetting(ctx context.Context, arg CreateSettingParams) error
+	CreateSlotHold(ctx context.Context, arg CreateSlotHoldParams) (SlotHold, error)
+	CreateStaff(ctx context.Context, arg CreateStaffParams) (Staff, error)
+	CreateStaffLeave(ctx context.Context, arg CreateStaffLeaveParams) (StaffLeave, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	// First create the email, then create the user in separate operations
 	CreateUserEmail(ctx context.Context, arg CreateUserEmailParams) (UserEmail, error)
 	CreateVehicle(ctx context.Context, arg CreateVehicleParams) (Vehicle, error)
 	CreateVehicleCategory(ctx context.Context, arg CreateVehicleCategoryParams) (VehicleCategory, error)
 	CreateVerificationToken(ctx context.Context, arg CreateVerificationTokenParams) error
-	DeleteBlackout(ctx context.Context, arg DeleteBlackoutParams) error
+	CreateWaitlistEntry(ctx context.Context, arg CreateWaitlistEntryParams) (WaitlistEntry, error)
+	CreateWaitlistEntryService(ctx context.Context, arg CreateWaitlistEntryServiceParams) (WaitlistEntryService, error)
+	CreateWaitlistEntryServiceOption(ctx context.Context, arg CreateWaitlistEntryServiceOptionParams) (WaitlistEntryServiceOption, error)
+	DeleteBlackout(ctx context.Context, arg DeleteBlackoutParams) (ScheduleBlackout, error)
+	DeleteBookingService(ctx context.Context, arg DeleteBookingServiceParams) error
+	DeleteBookingServiceOption(ctx context.Context, arg DeleteBookingServiceOptionParams) error
+	DeleteCheckoutHoldsForCustomer(ctx context.Context, arg DeleteCheckoutHoldsForCustomerParams) ([]SlotHold, error)
+	DeleteExpiredCheckoutHolds(ctx context.Context) ([]SlotHold, error)
 	DeleteExpiredPasswordResetTokens(ctx context.Context) error
 	DeleteExpiredSessions(ctx context.Context) error
 	DeletePasswordResetToken(ctx context.Context, arg DeletePasswordResetTokenParams) error
 	DeletePriceTier(ctx context.Context, arg DeletePriceTierParams) error
 	DeletePriceTiersByService(ctx context.Context, arg DeletePriceTiersByServiceParams) error
+	DeleteResourceHours(ctx context.Context, arg DeleteResourceHoursParams) error
+	DeleteScheduleOverride(ctx context.Context, arg DeleteScheduleOverrideParams) (int64, error)
 	DeleteService(ctx context.Context, arg DeleteServiceParams) (Service, error)
 	DeleteServiceOption(ctx context.Context, arg DeleteServiceOptionParams) (ServiceOption, error)
 	DeleteSession(ctx context.Context, arg DeleteSessionParams) error
 	// Delete a specific setting by ID
 	DeleteSetting(ctx context.Context, arg DeleteSettingParams) error
+	DeleteSlotHold(ctx context.Context, arg DeleteSlotHoldParams) error
+	DeleteStaffLeave(ctx context.Context, arg DeleteStaffLeaveParams) error
+	DeleteStaffRoster(ctx context.Context, arg DeleteStaffRosterParams) error
 	DeleteToken(ctx context.Context, arg DeleteTokenParams) error
 	DeleteUserPasswordResetTokens(ctx context.Context, arg DeleteUserPasswordResetTokensParams) error
 	DeleteUserSessions(ctx context.Context, arg DeleteUserSessionsParams) error
 	DeleteVehicle(ctx context.Context, arg DeleteVehicleParams) error
 	DeleteVehicleCategory(ctx context.Context, arg DeleteVehicleCategoryParams) error
 	EmailExists(ctx context.Context, arg EmailExistsParams) (bool, error)
+	ExpirePastWaitlistEntries(ctx context.Context) (int64, error)
+	ExpireWaitlistOffer(ctx context.Context, arg ExpireWaitlistOfferParams) error
 	GetBookingByID(ctx context.Context, arg GetBookingByIDParams) (GetBookingByIDRow, error)
+	GetBookingForUpdate(ctx context.Context, arg GetBookingForUpdateParams) (Booking, error)
+	GetBookingSeriesByID(ctx context.Context, arg GetBookingSeriesByIDParams) (BookingSeries, error)
+	GetBookingService(ctx context.Context, arg GetBookingServiceParams) (BookingService, error)
+	GetBookingServiceOption(ctx context.Context, arg GetBookingServiceOptionParams) (BookingServiceOption, error)
+	GetCalendarFeedByToken(ctx context.Context, arg GetCalendarFeedByTokenParams) (CalendarFeed, error)
+	GetCalendarFeedForUser(ctx context.Context, arg GetCalendarFeedForUserParams) (CalendarFeed, error)
 	GetCartBySessionToken(ctx context.Context, arg GetCartBySessionTokenParams) (CartSession, error)
 	GetCartByUserID(ctx context.Context, arg GetCartByUserIDParams) (CartSession, error)
 	GetCategoryBySlug(ctx context.Context, arg GetCategoryBySlugParams) (ServiceCategory, error)
+	GetChargeByProviderRef(ctx context.Context, arg GetChargeByProviderRefParams) (Payment, error)
+	// The customer's checkout hold, if it has not run out.
+	GetCheckoutHoldForCustomer(ctx context.Context, arg GetCheckoutHoldForCustomerParams) (SlotHold, error)
+	GetCustomerProfileByID(ctx context.Context, arg GetCustomerProfileByIDParams) (CustomerProfile, error)
 	GetCustomerProfileByUserID(ctx context.Context, arg GetCustomerProfileByUserIDParams) (CustomerProfile, error)
 	GetNotificationTemplateByName(ctx context.Context, arg GetNotificationTemplateByNameParams) (string, error)
 	GetPasswordResetToken(ctx context.Context, arg GetPasswordResetTokenParams) (PasswordResetToken, error)
@@ -63,6 +96,7 @@ type Querier interface {
 	GetScheduleConfigForDay(ctx context.Context, arg GetScheduleConfigForDayParams) (ScheduleConfig, error)
 	GetServiceByID(ctx context.Context, arg GetServiceByIDParams) (Service, error)
 	GetServiceBySlug(ctx context.Context, arg GetServiceBySlugParams) (GetServiceBySlugRow, error)
+	GetServiceOptionByID(ctx context.Context, arg GetServiceOptionByIDParams) (ServiceOption, error)
 	GetServiceRecordByID(ctx context.Context, arg GetServiceRecordByIDParams) (ServiceRecord, error)
 	GetSessionByToken(ctx context.Context, arg GetSessionByTokenParams) (Session, error)
 	GetSetting(ctx context.Context, arg GetSettingParams) ([]byte, error)
@@ -73,6 +107,9 @@ type Querier interface {
 	GetSettingHierarchy(ctx context.Context, arg GetSettingHierarchyParams) ([]Setting, error)
 	// Get all settings for a subsystem with hierarchy resolution
 	GetSettingsBySubsystem(ctx context.Context, arg GetSettingsBySubsystemParams) ([]Setting, error)
+	GetSlotHoldByID(ctx context.Context, arg GetSlotHoldByIDParams) (SlotHold, error)
+	GetStaffByID(ctx context.Context, arg GetStaffByIDParams) (Staff, error)
+	GetStaffByUserID(ctx context.Context, arg GetStaffByUserIDParams) (Staff, error)
 	GetTemplateByID(ctx context.Context, arg GetTemplateByIDParams) (Template, error)
 	GetTemplateByRef(ctx context.Context, arg GetTemplateByRefParams) (string, error)
 	GetToken(ctx context.Context, arg GetTokenParams) (Verification, error)
@@ -82,23 +119,47 @@ type Querier interface {
 	GetUserByUsername(ctx context.Context, arg GetUserByUsernameParams) (User, error)
 	GetVehicleByID(ctx context.Context, arg GetVehicleByIDParams) (Vehicle, error)
 	GetVehicleCategoryByID(ctx context.Context, arg GetVehicleCategoryByIDParams) (VehicleCategory, error)
+	GetWaitlistEntryByClaimToken(ctx context.Context, arg GetWaitlistEntryByClaimTokenParams) (WaitlistEntry, error)
+	GetWaitlistEntryByID(ctx context.Context, arg GetWaitlistEntryByIDParams) (WaitlistEntry, error)
+	HasActiveStaff(ctx context.Context) (bool, error)
+	IncrementCustomerNoShowCount(ctx context.Context, arg IncrementCustomerNoShowCountParams) (CustomerProfile, error)
 	IsDateBlackedOut(ctx context.Context, arg IsDateBlackedOutParams) (bool, error)
 	IsFirstUser(ctx context.Context) (bool, error)
+	ListActiveBookingSeries(ctx context.Context) ([]BookingSeries, error)
+	ListActiveResources(ctx context.Context) ([]Resource, error)
+	ListActiveSlotHoldsForDate(ctx context.Context, arg ListActiveSlotHoldsForDateParams) ([]SlotHold, error)
+	ListActiveSlotHoldsForRange(ctx context.Context, arg ListActiveSlotHoldsForRangeParams) ([]SlotHold, error)
 	ListAllBookingsAdmin(ctx context.Context, arg ListAllBookingsAdminParams) ([]ListAllBookingsAdminRow, error)
 	ListAllServiceOptions(ctx context.Context, arg ListAllServiceOptionsParams) ([]ServiceOption, error)
 	ListAllServices(ctx context.Context) ([]Service, error)
 	// List all settings (for admin interface)
 	ListAllSettings(ctx context.Context) ([]Setting, error)
+	ListAllStaffSkills(ctx context.Context) ([]StaffSkill, error)
 	ListAllUsers(ctx context.Context) ([]User, error)
 	ListBlackoutDates(ctx context.Context) ([]ScheduleBlackout, error)
+	// Blackouts in the range plus every recurring blackout that may fall in it.
+	ListBlackoutsInRange(ctx context.Context, arg ListBlackoutsInRangeParams) ([]ScheduleBlackout, error)
+	ListBookingPayments(ctx context.Context, arg ListBookingPaymentsParams) ([]ListBookingPaymentsRow, error)
+	ListBookingSeriesByCustomer(ctx context.Context, arg ListBookingSeriesByCustomerParams) ([]BookingSeries, error)
+	ListBookingServiceNames(ctx context.Context, arg ListBookingServiceNamesParams) ([]ListBookingServiceNamesRow, error)
 	ListBookingServiceOptions(ctx context.Context, arg ListBookingServiceOptionsParams) ([]ListBookingServiceOptionsRow, error)
 	ListBookingServices(ctx context.Context, arg ListBookingServicesParams) ([]ListBookingServicesRow, error)
+	ListBookingStaff(ctx context.Context, arg ListBookingStaffParams) ([]ListBookingStaffRow, error)
+	ListBookingStaffForDate(ctx context.Context, arg ListBookingStaffForDateParams) ([]ListBookingStaffForDateRow, error)
+	ListBookingStaffForRange(ctx context.Context, arg ListBookingStaffForRangeParams) ([]ListBookingStaffForRangeRow, error)
+	ListBookingStatusHistory(ctx context.Context, arg ListBookingStatusHistoryParams) ([]BookingStatusHistory, error)
 	ListBookingsByCustomer(ctx context.Context, arg ListBookingsByCustomerParams) ([]Booking, error)
 	ListBookingsByDateRange(ctx context.Context, arg ListBookingsByDateRangeParams) ([]Booking, error)
 	ListBookingsForDate(ctx context.Context, arg ListBookingsForDateParams) ([]Booking, error)
+	ListBookingsForRange(ctx context.Context, arg ListBookingsForRangeParams) ([]Booking, error)
+	// Columns match GetBookingByID so each row can be handled as one.
+	ListCalendarBookings(ctx context.Context, arg ListCalendarBookingsParams) ([]ListCalendarBookingsRow, error)
+	ListCartItemOptionsBySession(ctx context.Context, arg ListCartItemOptionsBySessionParams) ([]ListCartItemOptionsBySessionRow, error)
 	ListCartItems(ctx context.Context, arg ListCartItemsParams) ([]ListCartItemsRow, error)
 	ListCategories(ctx context.Context) ([]ServiceCategory, error)
 	ListCustomers(ctx context.Context, arg ListCustomersParams) ([]CustomerProfile, error)
+	// Offers whose hold has run out, with the date the hold was on.
+	ListExpiredWaitlistOffers(ctx context.Context) ([]ListExpiredWaitlistOffersRow, error)
 	// List settings for a specific organization (including system defaults)
 	ListOrganizationSettings(ctx context.Context, arg ListOrganizationSettingsParams) ([]Setting, error)
 	// ========================================
@@ -108,48 +169,101 @@ type Querier interface {
 	// List settings for a specific project (including org and system defaults)
 	// Note: Pass both project_id and org_id as parameters
 	ListProjectSettings(ctx context.Context, arg ListProjectSettingsParams) ([]Setting, error)
+	ListResourceHours(ctx context.Context) ([]ResourceHour, error)
+	ListResourceHoursForDay(ctx context.Context, arg ListResourceHoursForDayParams) ([]ResourceHour, error)
+	ListResources(ctx context.Context) ([]Resource, error)
+	// Active staff working on a date: rostered for its weekday and not on leave.
+	ListRosteredStaffForDate(ctx context.Context, arg ListRosteredStaffForDateParams) ([]ListRosteredStaffForDateRow, error)
+	// ListRosteredStaffForDate for every date in a range at once.
+	ListRosteredStaffForRange(ctx context.Context, arg ListRosteredStaffForRangeParams) ([]ListRosteredStaffForRangeRow, error)
+	ListScheduleOverridesForDate(ctx context.Context, arg ListScheduleOverridesForDateParams) ([]ScheduleOverride, error)
+	ListScheduleOverridesInRange(ctx context.Context, arg ListScheduleOverridesInRangeParams) ([]ScheduleOverride, error)
+	ListScheduleWindows(ctx context.Context) ([]ScheduleWindow, error)
+	ListScheduleWindowsForDay(ctx context.Context, arg ListScheduleWindowsForDayParams) ([]ScheduleWindow, error)
+	ListSeriesOccurrences(ctx context.Context, arg ListSeriesOccurrencesParams) ([]BookingSeriesOccurrence, error)
 	ListServiceNotes(ctx context.Context, arg ListServiceNotesParams) ([]ServiceNote, error)
 	ListServiceOptions(ctx context.Context, arg ListServiceOptionsParams) ([]ServiceOption, error)
 	ListServicePhotos(ctx context.Context, arg ListServicePhotosParams) ([]ServicePhoto, error)
 	ListServiceProductsUsed(ctx context.Context, arg ListServiceProductsUsedParams) ([]ServiceProductsUsed, error)
 	ListServiceRecordsByBooking(ctx context.Context, arg ListServiceRecordsByBookingParams) ([]ServiceRecord, error)
 	ListServiceRecordsByCustomer(ctx context.Context, arg ListServiceRecordsByCustomerParams) ([]ServiceRecord, error)
+	// Durations are those for the vehicle category where a tier sets one.
+	ListServiceRequirements(ctx context.Context, arg ListServiceRequirementsParams) ([]ListServiceRequirementsRow, error)
 	ListServices(ctx context.Context) ([]Service, error)
 	ListServicesByCategory(ctx context.Context, arg ListServicesByCategoryParams) ([]Service, error)
+	ListStaff(ctx context.Context) ([]ListStaffRow, error)
+	ListStaffBookingsForDate(ctx context.Context, arg ListStaffBookingsForDateParams) ([]Booking, error)
+	ListStaffRoster(ctx context.Context) ([]StaffRoster, error)
 	// -- name: ListSystemNotificationTemplates :many
 	// select name from notification_template;
 	ListSystemNotificationTemplates(ctx context.Context) ([]ListSystemNotificationTemplatesRow, error)
 	// List all system-level settings
 	ListSystemSettings(ctx context.Context) ([]Setting, error)
 	ListTemplates(ctx context.Context) ([]Template, error)
+	ListUpcomingScheduleOverrides(ctx context.Context) ([]ScheduleOverride, error)
+	ListUpcomingSeriesBookings(ctx context.Context, arg ListUpcomingSeriesBookingsParams) ([]Booking, error)
+	ListUpcomingStaffLeave(ctx context.Context) ([]StaffLeave, error)
 	// ========================================
 	// Vehicle Categories
 	// ========================================
 	ListVehicleCategories(ctx context.Context) ([]VehicleCategory, error)
 	ListVehiclesByCustomer(ctx context.Context, arg ListVehiclesByCustomerParams) ([]Vehicle, error)
+	// Waiting customers whose range covers the date, first come first served.
+	ListWaitingEntriesForDate(ctx context.Context, arg ListWaitingEntriesForDateParams) ([]ListWaitingEntriesForDateRow, error)
+	ListWaitlistEntriesByCustomer(ctx context.Context, arg ListWaitlistEntriesByCustomerParams) ([]ListWaitlistEntriesByCustomerRow, error)
+	ListWaitlistEntryServiceOptions(ctx context.Context, arg ListWaitlistEntryServiceOptionsParams) ([]WaitlistEntryServiceOption, error)
+	ListWaitlistEntryServices(ctx context.Context, arg ListWaitlistEntryServicesParams) ([]WaitlistEntryService, error)
+	// Serialises booking writes for a single day for the rest of the transaction.
+	LockScheduleDate(ctx context.Context, arg LockScheduleDateParams) error
+	OfferWaitlistEntry(ctx context.Context, arg OfferWaitlistEntryParams) (WaitlistEntry, error)
+	// Affects no rows when the event was already recorded.
+	RecordStripeEvent(ctx context.Context, arg RecordStripeEventParams) (int64, error)
 	RemoveCartItem(ctx context.Context, arg RemoveCartItemParams) error
 	RemoveCartItemOption(ctx context.Context, arg RemoveCartItemOptionParams) error
+	RescheduleBooking(ctx context.Context, arg RescheduleBookingParams) (Booking, error)
 	SaveTemplate(ctx context.Context, arg SaveTemplateParams) error
+	SetBookingAmountPaid(ctx context.Context, arg SetBookingAmountPaidParams) (Booking, error)
+	SetBookingForfeitedAmount(ctx context.Context, arg SetBookingForfeitedAmountParams) (Booking, error)
+	SetBookingRefundAmount(ctx context.Context, arg SetBookingRefundAmountParams) (Booking, error)
+	SetBookingSeriesID(ctx context.Context, arg SetBookingSeriesIDParams) error
+	// Replaces the detailers assigned to a booking with the given staff.
+	SetBookingStaff(ctx context.Context, arg SetBookingStaffParams) error
+	SetCalendarFeedToken(ctx context.Context, arg SetCalendarFeedTokenParams) (CalendarFeed, error)
+	// Replaces the hours for a date with the given windows.
+	SetScheduleOverride(ctx context.Context, arg SetScheduleOverrideParams) ([]ScheduleOverride, error)
+	// Replaces a weekday's windows. Empty arrays clear them.
+	SetScheduleWindows(ctx context.Context, arg SetScheduleWindowsParams) error
+	// Replaces a staff member's skills with the given categories.
+	SetStaffSkills(ctx context.Context, arg SetStaffSkillsParams) error
+	SumBookingPayments(ctx context.Context, arg SumBookingPaymentsParams) (SumBookingPaymentsRow, error)
 	UpdateBookingPaymentStatus(ctx context.Context, arg UpdateBookingPaymentStatusParams) (Booking, error)
+	UpdateBookingPricing(ctx context.Context, arg UpdateBookingPricingParams) (Booking, error)
+	UpdateBookingSeriesStatus(ctx context.Context, arg UpdateBookingSeriesStatusParams) (BookingSeries, error)
 	UpdateBookingStatus(ctx context.Context, arg UpdateBookingStatusParams) (Booking, error)
 	UpdateCartItemQuantity(ctx context.Context, arg UpdateCartItemQuantityParams) (CartItem, error)
 	UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (ServiceCategory, error)
 	UpdateCustomerProfile(ctx context.Context, arg UpdateCustomerProfileParams) (CustomerProfile, error)
+	UpdateResource(ctx context.Context, arg UpdateResourceParams) (Resource, error)
 	UpdateScheduleConfig(ctx context.Context, arg UpdateScheduleConfigParams) (ScheduleConfig, error)
 	UpdateService(ctx context.Context, arg UpdateServiceParams) (Service, error)
 	UpdateServiceOption(ctx context.Context, arg UpdateServiceOptionParams) (ServiceOption, error)
 	UpdateSessionActivity(ctx context.Context, arg UpdateSessionActivityParams) error
+	UpdateStaff(ctx context.Context, arg UpdateStaffParams) (Staff, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 	UpdateUserEnabled(ctx context.Context, arg UpdateUserEnabledParams) (User, error)
 	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
 	UpdateUserSignUpStage(ctx context.Context, arg UpdateUserSignUpStageParams) (User, error)
 	UpdateVehicle(ctx context.Context, arg UpdateVehicleParams) (Vehicle, error)
 	UpdateVehicleCategory(ctx context.Context, arg UpdateVehicleCategoryParams) (VehicleCategory, error)
+	UpdateWaitlistEntryStatus(ctx context.Context, arg UpdateWaitlistEntryStatusParams) (WaitlistEntry, error)
 	// Create or update an organization-level setting
 	UpsertOrganizationSetting(ctx context.Context, arg UpsertOrganizationSettingParams) (Setting, error)
 	UpsertPriceTier(ctx context.Context, arg UpsertPriceTierParams) (ServicePriceTier, error)
 	// Create or update a project-level setting
 	UpsertProjectSetting(ctx context.Context, arg UpsertProjectSettingParams) (Setting, error)
+	UpsertResourceHours(ctx context.Context, arg UpsertResourceHoursParams) (ResourceHour, error)
+	UpsertSeriesOccurrence(ctx context.Context, arg UpsertSeriesOccurrenceParams) (BookingSeriesOccurrence, error)
+	UpsertStaffRoster(ctx context.Context, arg UpsertStaffRosterParams) (StaffRoster, error)
 	// Create or update a system-level setting
 	UpsertSystemSetting(ctx context.Context, arg UpsertSystemSettingParams) (Setting, error)
 	// Create or update a user-level setting
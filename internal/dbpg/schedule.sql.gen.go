@@ -12,42 +12,118 @@ import (
 )
 
 const createBlackout = `-- name: CreateBlackout :one
-INSERT INTO schedule_blackouts (date, reason)
-VALUES ($1, $2)
-RETURNING id, date, reason, created_at
+INSERT INTO schedule_blackouts (date, reason, recurs_annually)
+VALUES ($1, $2, $3)
+RETURNING id, date, reason, created_at, recurs_annually
 `
 
 type CreateBlackoutParams struct {
-	Date   pgtype.Date
-	Reason pgtype.Text
+	Date           pgtype.Date
+	Reason         pgtype.Text
+	RecursAnnually bool
 }
 
 func (q *Queries) CreateBlackout(ctx context.Context, arg CreateBlackoutParams) (ScheduleBlackout, error) {
-	row := q.db.QueryRow(ctx, createBlackout, arg.Date, arg.Reason)
+	row := q.db.QueryRow(ctx, createBlackout, arg.Date, arg.Reason, arg.RecursAnnually)
 	var i ScheduleBlackout
 	err := row.Scan(
 		&i.ID,
 		&i.Date,
 		&i.Reason,
 		&i.CreatedAt,
+		&i.RecursAnnually,
 	)
 	return i, err
 }
 
-const deleteBlackout = `-- name: DeleteBlackout :exec
+const createResource = `-- name: CreateResource :one
+INSERT INTO resources (name, resource_type, is_active, sort_order)
+VALUES ($1, $2, $3, $4)
+RETURNING id, name, resource_type, is_active, sort_order, created_at, updated_at
+`
+
+type CreateResourceParams struct {
+	Name         string
+	ResourceType string
+	IsActive     bool
+	SortOrder    int32
+}
+
+func (q *Queries) CreateResource(ctx context.Context, arg CreateResourceParams) (Resource, error) {
+	row := q.db.QueryRow(ctx, createResource,
+		arg.Name,
+		arg.ResourceType,
+		arg.IsActive,
+		arg.SortOrder,
+	)
+	var i Resource
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ResourceType,
+		&i.IsActive,
+		&i.SortOrder,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteBlackout = `-- name: DeleteBlackout :one
 DELETE FROM schedule_blackouts
 WHERE id = $1
+RETURNING id, date, reason, created_at, recurs_annually
 `
 
 type DeleteBlackoutParams struct {
 	ID int64
 }
 
-func (q *Queries) DeleteBlackout(ctx context.Context, arg DeleteBlackoutParams) error {
-	_, err := q.db.Exec(ctx, deleteBlackout, arg.ID)
+func (q *Queries) DeleteBlackout(ctx context.Context, arg DeleteBlackoutParams) (ScheduleBlackout, error) {
+	row := q.db.QueryRow(ctx, deleteBlackout, arg.ID)
+	var i ScheduleBlackout
+	err := row.Scan(
+		&i.ID,
+		&i.Date,
+		&i.Reason,
+		&i.CreatedAt,
+		&i.RecursAnnually,
+	)
+	return i, err
+}
+
+const deleteResourceHours = `-- name: DeleteResourceHours :exec
+DELETE FROM resource_hours
+WHERE resource_id = $1 AND day_of_week = $2
+`
+
+type DeleteResourceHoursParams struct {
+	ResourceID int64
+	DayOfWeek  int32
+}
+
+func (q *Queries) DeleteResourceHours(ctx context.Context, arg DeleteResourceHoursParams) error {
+	_, err := q.db.Exec(ctx, deleteResourceHours, arg.ResourceID, arg.DayOfWeek)
 	return err
 }
 
+const deleteScheduleOverride = `-- name: DeleteScheduleOverride :execrows
+DELETE FROM schedule_overrides
+WHERE date = $1
+`
+
+type DeleteScheduleOverrideParams struct {
+	Date pgtype.Date
+}
+
+func (q *Queries) DeleteScheduleOverride(ctx context.Context, arg DeleteScheduleOverrideParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteScheduleOverride, arg.Date)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getScheduleConfig = `-- name: GetScheduleConfig :many
 SELECT id, day_of_week, open_time, close_time, is_open, buffer_minutes FROM schedule_config
 ORDER BY day_of_week
@@ -105,7 +181,12 @@ func (q *Queries) GetScheduleConfigForDay(ctx context.Context, arg GetScheduleCo
 
 const isDateBlackedOut = `-- name: IsDateBlackedOut :one
 SELECT EXISTS(
-    SELECT 1 FROM schedule_blackouts WHERE date = $1
+    SELECT 1 FROM schedule_blackouts
+    WHERE date = $1::date
+       OR (recurs_annually
+           AND date <= $1::date
+           AND EXTRACT(MONTH FROM date) = EXTRACT(MONTH FROM $1::date)
+           AND EXTRACT(DAY FROM date) = EXTRACT(DAY FROM $1::date))
 ) AS is_blacked_out
 `
 
@@ -120,8 +201,42 @@ func (q *Queries) IsDateBlackedOut(ctx context.Context, arg IsDateBlackedOutPara
 	return is_blacked_out, err
 }
 
+const listActiveResources = `-- name: ListActiveResources :many
+SELECT id, name, resource_type, is_active, sort_order, created_at, updated_at FROM resources
+WHERE is_active = true
+ORDER BY sort_order, id
+`
+
+func (q *Queries) ListActiveResources(ctx context.Context) ([]Resource, error) {
+	rows, err := q.db.Query(ctx, listActiveResources)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Resource
+	for rows.Next() {
+		var i Resource
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ResourceType,
+			&i.IsActive,
+			&i.SortOrder,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listBlackoutDates = `-- name: ListBlackoutDates :many
-SELECT id, date, reason, created_at FROM schedule_blackouts
+SELECT id, date, reason, created_at, recurs_annually FROM schedule_blackouts
 ORDER BY date
 `
 
@@ -139,6 +254,287 @@ func (q *Queries) ListBlackoutDates(ctx context.Context) ([]ScheduleBlackout, er
 			&i.Date,
 			&i.Reason,
 			&i.CreatedAt,
+			&i.RecursAnnually,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBlackoutsInRange = `-- name: ListBlackoutsInRange :many
+SELECT id, date, reason, created_at, recurs_annually FROM schedule_blackouts
+WHERE date BETWEEN $1::date AND $2::date
+   OR (recurs_annually AND date <= $2::date)
+ORDER BY date
+`
+
+type ListBlackoutsInRangeParams struct {
+	DateFrom pgtype.Date
+	DateTo   pgtype.Date
+}
+
+// Blackouts in the range plus every recurring blackout that may fall in it.
+func (q *Queries) ListBlackoutsInRange(ctx context.Context, arg ListBlackoutsInRangeParams) ([]ScheduleBlackout, error) {
+	rows, err := q.db.Query(ctx, listBlackoutsInRange, arg.DateFrom, arg.DateTo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduleBlackout
+	for rows.Next() {
+		var i ScheduleBlackout
+		if err := rows.Scan(
+			&i.ID,
+			&i.Date,
+			&i.Reason,
+			&i.CreatedAt,
+			&i.RecursAnnually,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResourceHours = `-- name: ListResourceHours :many
+SELECT id, resource_id, day_of_week, open_time, close_time, is_open FROM resource_hours
+ORDER BY resource_id, day_of_week
+`
+
+func (q *Queries) ListResourceHours(ctx context.Context) ([]ResourceHour, error) {
+	rows, err := q.db.Query(ctx, listResourceHours)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ResourceHour
+	for rows.Next() {
+		var i ResourceHour
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceID,
+			&i.DayOfWeek,
+			&i.OpenTime,
+			&i.CloseTime,
+			&i.IsOpen,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResourceHoursForDay = `-- name: ListResourceHoursForDay :many
+SELECT id, resource_id, day_of_week, open_time, close_time, is_open FROM resource_hours
+WHERE day_of_week = $1
+`
+
+type ListResourceHoursForDayParams struct {
+	DayOfWeek int32
+}
+
+func (q *Queries) ListResourceHoursForDay(ctx context.Context, arg ListResourceHoursForDayParams) ([]ResourceHour, error) {
+	rows, err := q.db.Query(ctx, listResourceHoursForDay, arg.DayOfWeek)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ResourceHour
+	for rows.Next() {
+		var i ResourceHour
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceID,
+			&i.DayOfWeek,
+			&i.OpenTime,
+			&i.CloseTime,
+			&i.IsOpen,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listResources = `-- name: ListResources :many
+SELECT id, name, resource_type, is_active, sort_order, created_at, updated_at FROM resources
+ORDER BY sort_order, id
+`
+
+func (q *Queries) ListResources(ctx context.Context) ([]Resource, error) {
+	rows, err := q.db.Query(ctx, listResources)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Resource
+	for rows.Next() {
+		var i Resource
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ResourceType,
+			&i.IsActive,
+			&i.SortOrder,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduleOverridesForDate = `-- name: ListScheduleOverridesForDate :many
+SELECT id, date, open_time, close_time, reason, created_at FROM schedule_overrides
+WHERE date = $1
+ORDER BY open_time
+`
+
+type ListScheduleOverridesForDateParams struct {
+	Date pgtype.Date
+}
+
+func (q *Queries) ListScheduleOverridesForDate(ctx context.Context, arg ListScheduleOverridesForDateParams) ([]ScheduleOverride, error) {
+	rows, err := q.db.Query(ctx, listScheduleOverridesForDate, arg.Date)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduleOverride
+	for rows.Next() {
+		var i ScheduleOverride
+		if err := rows.Scan(
+			&i.ID,
+			&i.Date,
+			&i.OpenTime,
+			&i.CloseTime,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduleOverridesInRange = `-- name: ListScheduleOverridesInRange :many
+SELECT id, date, open_time, close_time, reason, created_at FROM schedule_overrides
+WHERE date BETWEEN $1::date AND $2::date
+ORDER BY date, open_time
+`
+
+type ListScheduleOverridesInRangeParams struct {
+	DateFrom pgtype.Date
+	DateTo   pgtype.Date
+}
+
+func (q *Queries) ListScheduleOverridesInRange(ctx context.Context, arg ListScheduleOverridesInRangeParams) ([]ScheduleOverride, error) {
+	rows, err := q.db.Query(ctx, listScheduleOverridesInRange, arg.DateFrom, arg.DateTo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduleOverride
+	for rows.Next() {
+		var i ScheduleOverride
+		if err := rows.Scan(
+			&i.ID,
+			&i.Date,
+			&i.OpenTime,
+			&i.CloseTime,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduleWindows = `-- name: ListScheduleWindows :many
+SELECT id, day_of_week, open_time, close_time FROM schedule_windows
+ORDER BY day_of_week, open_time
+`
+
+func (q *Queries) ListScheduleWindows(ctx context.Context) ([]ScheduleWindow, error) {
+	rows, err := q.db.Query(ctx, listScheduleWindows)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduleWindow
+	for rows.Next() {
+		var i ScheduleWindow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DayOfWeek,
+			&i.OpenTime,
+			&i.CloseTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduleWindowsForDay = `-- name: ListScheduleWindowsForDay :many
+SELECT id, day_of_week, open_time, close_time FROM schedule_windows
+WHERE day_of_week = $1
+ORDER BY open_time
+`
+
+type ListScheduleWindowsForDayParams struct {
+	DayOfWeek int32
+}
+
+func (q *Queries) ListScheduleWindowsForDay(ctx context.Context, arg ListScheduleWindowsForDayParams) ([]ScheduleWindow, error) {
+	rows, err := q.db.Query(ctx, listScheduleWindowsForDay, arg.DayOfWeek)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduleWindow
+	for rows.Next() {
+		var i ScheduleWindow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DayOfWeek,
+			&i.OpenTime,
+			&i.CloseTime,
 		); err != nil {
 			return nil, err
 		}
@@ -150,6 +546,158 @@ func (q *Queries) ListBlackoutDates(ctx context.Context) ([]ScheduleBlackout, er
 	return items, nil
 }
 
+const listUpcomingScheduleOverrides = `-- name: ListUpcomingScheduleOverrides :many
+SELECT id, date, open_time, close_time, reason, created_at FROM schedule_overrides
+WHERE date >= CURRENT_DATE
+ORDER BY date, open_time
+`
+
+func (q *Queries) ListUpcomingScheduleOverrides(ctx context.Context) ([]ScheduleOverride, error) {
+	rows, err := q.db.Query(ctx, listUpcomingScheduleOverrides)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduleOverride
+	for rows.Next() {
+		var i ScheduleOverride
+		if err := rows.Scan(
+			&i.ID,
+			&i.Date,
+			&i.OpenTime,
+			&i.CloseTime,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockScheduleDate = `-- name: LockScheduleDate :exec
+SELECT pg_advisory_xact_lock(hashtext('schedule:' || $1::date::text))
+`
+
+type LockScheduleDateParams struct {
+	ScheduledDate pgtype.Date
+}
+
+// Serialises booking writes for a single day for the rest of the transaction.
+func (q *Queries) LockScheduleDate(ctx context.Context, arg LockScheduleDateParams) error {
+	_, err := q.db.Exec(ctx, lockScheduleDate, arg.ScheduledDate)
+	return err
+}
+
+const setScheduleOverride = `-- name: SetScheduleOverride :many
+WITH removed AS (
+    DELETE FROM schedule_overrides WHERE date = $1
+)
+INSERT INTO schedule_overrides (date, open_time, close_time, reason)
+SELECT $1, unnest($2::time[]), unnest($3::time[]), $4
+RETURNING id, date, open_time, close_time, reason, created_at
+`
+
+type SetScheduleOverrideParams struct {
+	Date       pgtype.Date
+	OpenTimes  []pgtype.Time
+	CloseTimes []pgtype.Time
+	Reason     pgtype.Text
+}
+
+// Replaces the hours for a date with the given windows.
+func (q *Queries) SetScheduleOverride(ctx context.Context, arg SetScheduleOverrideParams) ([]ScheduleOverride, error) {
+	rows, err := q.db.Query(ctx, setScheduleOverride,
+		arg.Date,
+		arg.OpenTimes,
+		arg.CloseTimes,
+		arg.Reason,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduleOverride
+	for rows.Next() {
+		var i ScheduleOverride
+		if err := rows.Scan(
+			&i.ID,
+			&i.Date,
+			&i.OpenTime,
+			&i.CloseTime,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setScheduleWindows = `-- name: SetScheduleWindows :exec
+WITH removed AS (
+    DELETE FROM schedule_windows WHERE day_of_week = $1
+)
+INSERT INTO schedule_windows (day_of_week, open_time, close_time)
+SELECT $1, unnest($2::time[]), unnest($3::time[])
+`
+
+type SetScheduleWindowsParams struct {
+	DayOfWeek  int32
+	OpenTimes  []pgtype.Time
+	CloseTimes []pgtype.Time
+}
+
+// Replaces a weekday's windows. Empty arrays clear them.
+func (q *Queries) SetScheduleWindows(ctx context.Context, arg SetScheduleWindowsParams) error {
+	_, err := q.db.Exec(ctx, setScheduleWindows, arg.DayOfWeek, arg.OpenTimes, arg.CloseTimes)
+	return err
+}
+
+const updateResource = `-- name: UpdateResource :one
+UPDATE resources
+SET name = $2, resource_type = $3, is_active = $4, sort_order = $5
+WHERE id = $1
+RETURNING id, name, resource_type, is_active, sort_order, created_at, updated_at
+`
+
+type UpdateResourceParams struct {
+	ID           int64
+	Name         string
+	ResourceType string
+	IsActive     bool
+	SortOrder    int32
+}
+
+func (q *Queries) UpdateResource(ctx context.Context, arg UpdateResourceParams) (Resource, error) {
+	row := q.db.QueryRow(ctx, updateResource,
+		arg.ID,
+		arg.Name,
+		arg.ResourceType,
+		arg.IsActive,
+		arg.SortOrder,
+	)
+	var i Resource
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ResourceType,
+		&i.IsActive,
+		&i.SortOrder,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateScheduleConfig = `-- name: UpdateScheduleConfig :one
 INSERT INTO schedule_config (day_of_week, open_time, close_time, is_open, buffer_minutes)
 VALUES ($1, $2, $3, $4, $5)
@@ -188,3 +736,41 @@ func (q *Queries) UpdateScheduleConfig(ctx context.Context, arg UpdateScheduleCo
 	)
 	return i, err
 }
+
+const upsertResourceHours = `-- name: UpsertResourceHours :one
+INSERT INTO resource_hours (resource_id, day_of_week, open_time, close_time, is_open)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (resource_id, day_of_week)
+DO UPDATE SET open_time = EXCLUDED.open_time,
+              close_time = EXCLUDED.close_time,
+              is_open = EXCLUDED.is_open
+RETURNING id, resource_id, day_of_week, open_time, close_time, is_open
+`
+
+type UpsertResourceHoursParams struct {
+	ResourceID int64
+	DayOfWeek  int32
+	OpenTime   pgtype.Time
+	CloseTime  pgtype.Time
+	IsOpen     bool
+}
+
+func (q *Queries) UpsertResourceHours(ctx context.Context, arg UpsertResourceHoursParams) (ResourceHour, error) {
+	row := q.db.QueryRow(ctx, upsertResourceHours,
+		arg.ResourceID,
+		arg.DayOfWeek,
+		arg.OpenTime,
+		arg.CloseTime,
+		arg.IsOpen,
+	)
+	var i ResourceHour
+	err := row.Scan(
+		&i.ID,
+		&i.ResourceID,
+		&i.DayOfWeek,
+		&i.OpenTime,
+		&i.CloseTime,
+		&i.IsOpen,
+	)
+	return i, err
+}
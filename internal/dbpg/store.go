@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -113,6 +114,13 @@ func IsErrNoRows(err error) bool {
 	return false
 }
 
+// IsErrExclusionViolation reports whether err was raised by an EXCLUDE
+// constraint, e.g. two bookings claiming overlapping time ranges.
+func IsErrExclusionViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23P01"
+}
+
 func StringToPGString(str string) pgtype.Text {
 	s := pgtype.Text{}
 	if str == "" {
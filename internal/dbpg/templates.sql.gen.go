@@ -78,7 +78,7 @@ const listSystemNotificationTemplates = `-- name: ListSystemNotificationTemplate
 
 select t.id, t.name, t.content,t.version, nt.name system_name
     from template t
-         join notification_template nt on t.id = nt.id
+         join notification_template nt on t.id = nt.template_id
 `
 
 type ListSystemNotificationTemplatesRow struct {
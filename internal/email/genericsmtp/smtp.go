@@ -2,9 +2,13 @@ package fastmail
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"mime"
+	"mime/multipart"
 	"net/smtp"
+	"net/textproto"
 	"strings"
 	"sync"
 
@@ -143,7 +147,14 @@ func (c *Client) SetConfig(ctx context.Context, smtpAddress string, smtpPort int
 	return c.loadConfig()
 }
 
-func (c *Client) Send(sender string, rcpt []string, subject, body string) error {
+// Attachment is a file sent with an email.
+type Attachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	Content     []byte `json:"content"`
+}
+
+func (c *Client) Send(sender string, rcpt []string, subject, body string, attachments ...Attachment) error {
 	c.mu.RLock()
 	ready := c.ready
 	smtpServer := c.config.smtpServer
@@ -158,10 +169,14 @@ func (c *Client) Send(sender string, rcpt []string, subject, body string) error
 	buf.WriteString(fmt.Sprintf("To: %s\r\n", rcpt[0]))
 	buf.WriteString(fmt.Sprintf("Subject: %s\r\n", subject))
 	buf.WriteString("MIME-Version: 1.0\r\n")
-	buf.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
-	buf.WriteString("\r\n")
-	buf.WriteString(body)
-	buf.WriteString("\r\n")
+	if len(attachments) == 0 {
+		buf.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
+		buf.WriteString("\r\n")
+		buf.WriteString(body)
+		buf.WriteString("\r\n")
+	} else if err := writeMultipart(&buf, body, attachments); err != nil {
+		return fmt.Errorf("failed to build message: %w", err)
+	}
 
 	return smtp.SendMail(
 		smtpServer,
@@ -171,3 +186,43 @@ func (c *Client) Send(sender string, rcpt []string, subject, body string) error
 		[]byte(buf.String()),
 	)
 }
+
+// writeMultipart writes a multipart/mixed message body: the HTML body
+// followed by each attachment, base64 encoded.
+func writeMultipart(buf *strings.Builder, body string, attachments []Attachment) error {
+	mw := multipart.NewWriter(buf)
+	buf.WriteString(fmt.Sprintf("Content-Type: multipart/mixed; boundary=%q\r\n", mw.Boundary()))
+	buf.WriteString("\r\n")
+
+	part, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"text/html; charset=UTF-8"},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write([]byte(body + "\r\n")); err != nil {
+		return err
+	}
+
+	for _, a := range attachments {
+		part, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {a.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+		})
+		if err != nil {
+			return err
+		}
+		encoded := base64.StdEncoding.EncodeToString(a.Content)
+		for len(encoded) > 76 {
+			if _, err := part.Write([]byte(encoded[:76] + "\r\n")); err != nil {
+				return err
+			}
+			encoded = encoded[76:]
+		}
+		if _, err := part.Write([]byte(encoded + "\r\n")); err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
@@ -0,0 +1,99 @@
+// Package geo resolves Australian postcodes to approximate coordinates and
+// measures distances between them. Centroids come from an embedded dataset
+// covering the Perth metropolitan area.
+package geo
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:embed postcodes.csv
+var postcodesCSV []byte
+
+// Point is a latitude and longitude in decimal degrees.
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Postcode is the centroid of a postcode area.
+type Postcode struct {
+	Postcode string
+	Suburb   string
+	Point    Point
+}
+
+var (
+	loadOnce  sync.Once
+	postcodes map[string]Postcode
+	loadErr   error
+)
+
+func load() {
+	records, err := csv.NewReader(bytes.NewReader(postcodesCSV)).ReadAll()
+	if err != nil {
+		loadErr = fmt.Errorf("geo: reading postcodes: %w", err)
+		return
+	}
+	postcodes = make(map[string]Postcode, len(records))
+	for i, rec := range records {
+		if i == 0 {
+			continue // header
+		}
+		if len(rec) != 4 {
+			loadErr = fmt.Errorf("geo: postcodes line %d: expected 4 fields, got %d", i+1, len(rec))
+			return
+		}
+		lat, err := strconv.ParseFloat(rec[2], 64)
+		if err != nil {
+			loadErr = fmt.Errorf("geo: postcodes line %d: %w", i+1, err)
+			return
+		}
+		lng, err := strconv.ParseFloat(rec[3], 64)
+		if err != nil {
+			loadErr = fmt.Errorf("geo: postcodes line %d: %w", i+1, err)
+			return
+		}
+		postcodes[rec[0]] = Postcode{Postcode: rec[0], Suburb: rec[1], Point: Point{Lat: lat, Lng: lng}}
+	}
+}
+
+// Lookup returns the centroid of postcode, or false if it is not in the
+// dataset.
+func Lookup(postcode string) (Postcode, bool) {
+	loadOnce.Do(load)
+	if loadErr != nil {
+		return Postcode{}, false
+	}
+	p, ok := postcodes[strings.TrimSpace(postcode)]
+	return p, ok
+}
+
+const earthRadiusKm = 6371.0
+
+// DistanceKm is the great-circle distance between a and b.
+func DistanceKm(a, b Point) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+// TravelMinutes estimates the whole minutes needed to cover km at an average
+// speed of kmh, rounding up.
+func TravelMinutes(km, kmh float64) int32 {
+	if km <= 0 || kmh <= 0 {
+		return 0
+	}
+	return int32(math.Ceil(km / kmh * 60))
+}
@@ -0,0 +1,41 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	p, ok := Lookup("6027")
+	if !ok || p.Suburb != "Joondalup" {
+		t.Fatalf("Lookup(6027) = %+v, %v", p, ok)
+	}
+	if _, ok := Lookup("2000"); ok {
+		t.Fatal("expected Sydney postcode to be missing")
+	}
+}
+
+func TestDistanceKm(t *testing.T) {
+	joondalup, _ := Lookup("6027")
+	fremantle, _ := Lookup("6160")
+	d := DistanceKm(joondalup.Point, fremantle.Point)
+	// Roughly 36km as the crow flies
+	if d < 33 || d > 39 {
+		t.Fatalf("Joondalup to Fremantle = %.1fkm", d)
+	}
+	if got := DistanceKm(joondalup.Point, joondalup.Point); math.Abs(got) > 1e-9 {
+		t.Fatalf("distance to self = %v", got)
+	}
+}
+
+func TestTravelMinutes(t *testing.T) {
+	if got := TravelMinutes(20, 40); got != 30 {
+		t.Fatalf("TravelMinutes(20, 40) = %d, want 30", got)
+	}
+	if got := TravelMinutes(0.1, 40); got != 1 {
+		t.Fatalf("TravelMinutes rounds up, got %d", got)
+	}
+	if got := TravelMinutes(10, 0); got != 0 {
+		t.Fatalf("TravelMinutes with no speed = %d, want 0", got)
+	}
+}
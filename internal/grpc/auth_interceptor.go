@@ -45,8 +45,11 @@ var publicEndpoints = map[string]bool{
 	"/degrees.v1.CartService/RemoveCartItem": true,
 	"/degrees.v1.CartService/ClearCart":      true,
 
-	// Booking public endpoint
-	"/degrees.v1.BookingService/GetAvailableSlots": true,
+	// Booking public endpoints
+	"/degrees.v1.BookingService/GetAvailableSlots":       true,
+	"/degrees.v1.BookingService/GetAvailabilityCalendar": true,
+	"/degrees.v1.BookingService/GetCancellationPolicy":   true,
+	"/degrees.v1.BookingService/CheckServiceArea":        true,
 }
 
 // AuthInterceptor creates a gRPC unary interceptor for authentication
@@ -138,3 +141,25 @@ func RequireSysop(ctx context.Context, authz *services.AuthzSvc) error {
 
 	return nil
 }
+
+// RequireAdmin checks if the user is a system admin (sysop or admin) via FGA,
+// for handlers that act on other customers' bookings
+func RequireAdmin(ctx context.Context, authz services.AdminChecker) error {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	isAdmin, err := authz.IsSystemAdmin(ctx, userID)
+	if err != nil {
+		log.Warn().Err(err).Int64("user_id", userID).Msg("failed to check admin permission")
+		return status.Error(codes.Internal, "failed to verify permissions")
+	}
+
+	if !isAdmin {
+		log.Warn().Int64("user_id", userID).Msg("user attempted admin-only action without privileges")
+		return status.Error(codes.PermissionDenied, "admin privileges required")
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/jackc/pgx/v5/pgtype"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/richardbowden/degrees/internal/dbpg"
 	pb "github.com/richardbowden/degrees/internal/pb/degrees/v1"
+	"github.com/richardbowden/degrees/internal/problems"
 	"github.com/richardbowden/degrees/internal/services"
 )
 
@@ -19,12 +21,16 @@ type BookingServiceServer struct {
 	pb.UnimplementedBookingServiceServer
 	bookingSvc  *services.BookingService
 	scheduleSvc *services.ScheduleService
+	calendarSvc *services.CalendarFeedService
+	authzSvc    services.AdminChecker
 }
 
-func NewBookingServer(bookingSvc *services.BookingService, scheduleSvc *services.ScheduleService) *BookingServiceServer {
+func NewBookingServer(bookingSvc *services.BookingService, scheduleSvc *services.ScheduleService, calendarSvc *services.CalendarFeedService, authzSvc services.AdminChecker) *BookingServiceServer {
 	return &BookingServiceServer{
 		bookingSvc:  bookingSvc,
 		scheduleSvc: scheduleSvc,
+		calendarSvc: calendarSvc,
+		authzSvc:    authzSvc,
 	}
 }
 
@@ -55,6 +61,8 @@ func (s *BookingServiceServer) CreateBookingFromCart(ctx context.Context, req *p
 		ScheduledTime:    req.ScheduledTime,
 		Notes:            req.Notes,
 		CartSessionToken: cartSessionToken,
+		Recurrence:       req.Recurrence,
+		Location:         serviceLocationFromProto(req.ServiceLocation),
 	})
 	if err != nil {
 		return nil, ToGRPCError(err)
@@ -65,12 +73,71 @@ func (s *BookingServiceServer) CreateBookingFromCart(ctx context.Context, req *p
 	}, nil
 }
 
+func (s *BookingServiceServer) HoldCheckoutSlot(ctx context.Context, req *pb.HoldCheckoutSlotRequest) (*pb.HoldCheckoutSlotResponse, error) {
+	if req.ScheduledDate == "" {
+		return nil, status.Error(codes.InvalidArgument, "scheduled_date is required")
+	}
+	if req.ScheduledTime == "" {
+		return nil, status.Error(codes.InvalidArgument, "scheduled_time is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	var cartSessionToken string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if tokens := md.Get("x-cart-session"); len(tokens) > 0 {
+			cartSessionToken = tokens[0]
+		}
+	}
+
+	hold, err := s.bookingSvc.HoldCheckoutSlot(ctx, services.HoldCheckoutSlotParams{
+		UserID:           userID,
+		VehicleID:        req.VehicleId,
+		ScheduledDate:    req.ScheduledDate,
+		ScheduledTime:    req.ScheduledTime,
+		CartSessionToken: cartSessionToken,
+		Location:         serviceLocationFromProto(req.ServiceLocation),
+	})
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.HoldCheckoutSlotResponse{
+		Hold: &pb.CheckoutHold{
+			ScheduledDate:   formatPGDate(hold.ScheduledDate),
+			ScheduledTime:   formatPGTime(hold.ScheduledTime),
+			DurationMinutes: hold.DurationMins,
+			ExpiresAt:       timestampFromPG(hold.ExpiresAt),
+		},
+	}, nil
+}
+
+func (s *BookingServiceServer) ReleaseCheckoutHold(ctx context.Context, req *pb.ReleaseCheckoutHoldRequest) (*pb.ReleaseCheckoutHoldResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := s.bookingSvc.ReleaseCheckoutHold(ctx, userID); err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.ReleaseCheckoutHoldResponse{
+		Success: true,
+	}, nil
+}
+
 func (s *BookingServiceServer) GetAvailableSlots(ctx context.Context, req *pb.GetAvailableSlotsRequest) (*pb.GetAvailableSlotsResponse, error) {
 	if req.Date == "" {
 		return nil, status.Error(codes.InvalidArgument, "date is required")
 	}
 
-	slots, err := s.scheduleSvc.GetAvailableSlots(ctx, req.Date, req.DurationMinutes)
+	// Public endpoint; a signed-in caller's own holds stay available to them
+	userID, _ := GetUserIDFromContext(ctx)
+	slots, err := s.scheduleSvc.GetAvailableSlots(ctx, req.Date, req.DurationMinutes, req.ServiceIds, req.VehicleCategoryId, req.Postcode, userID)
 	if err != nil {
 		return nil, ToGRPCError(err)
 	}
@@ -89,6 +156,63 @@ func (s *BookingServiceServer) GetAvailableSlots(ctx context.Context, req *pb.Ge
 	}, nil
 }
 
+func (s *BookingServiceServer) GetAvailabilityCalendar(ctx context.Context, req *pb.GetAvailabilityCalendarRequest) (*pb.GetAvailabilityCalendarResponse, error) {
+	if req.DateFrom == "" || req.DateTo == "" {
+		return nil, status.Error(codes.InvalidArgument, "date_from and date_to are required")
+	}
+
+	userID, _ := GetUserIDFromContext(ctx)
+	days, err := s.scheduleSvc.GetAvailabilityCalendar(ctx, services.AvailabilityCalendarParams{
+		DateFrom:          req.DateFrom,
+		DateTo:            req.DateTo,
+		ServiceIDs:        req.ServiceIds,
+		VehicleCategoryID: req.VehicleCategoryId,
+		DurationMinutes:   req.DurationMinutes,
+		Postcode:          req.Postcode,
+		UserID:            userID,
+	})
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	pbDays := make([]*pb.CalendarDay, len(days))
+	for i, d := range days {
+		pbDays[i] = &pb.CalendarDay{
+			Date:         d.Date,
+			Status:       d.Status,
+			EarliestSlot: d.EarliestSlot,
+			SlotCount:    d.SlotCount,
+		}
+	}
+
+	return &pb.GetAvailabilityCalendarResponse{
+		Days: pbDays,
+	}, nil
+}
+
+func (s *BookingServiceServer) CheckServiceArea(ctx context.Context, req *pb.CheckServiceAreaRequest) (*pb.CheckServiceAreaResponse, error) {
+	if req.Postcode == "" {
+		return nil, status.Error(codes.InvalidArgument, "postcode is required")
+	}
+
+	travel, err := s.bookingSvc.CheckServiceArea(ctx, req.Postcode)
+	if err != nil {
+		// A postcode outside the area is an answer, not a failure
+		var p problems.Problem
+		if errors.As(err, &p) && p.Kind == problems.InvalidRequest {
+			return &pb.CheckServiceAreaResponse{InArea: false, Reason: p.Detail}, nil
+		}
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.CheckServiceAreaResponse{
+		InArea:          true,
+		Suburb:          travel.Location.Suburb,
+		DistanceKm:      travel.DistanceKm,
+		TravelSurcharge: travel.Surcharge,
+	}, nil
+}
+
 func (s *BookingServiceServer) ListMyBookings(ctx context.Context, req *pb.ListMyBookingsRequest) (*pb.ListMyBookingsResponse, error) {
 	userID, ok := GetUserIDFromContext(ctx)
 	if !ok {
@@ -133,6 +257,12 @@ func (s *BookingServiceServer) GetMyBooking(ctx context.Context, req *pb.GetMyBo
 		pbBooking.Services = bookingServicesToProto(ctx, s.bookingSvc, svcs)
 	}
 
+	// Let the customer see who will be doing the work
+	staff, err := s.bookingSvc.ListBookingStaff(ctx, req.Id)
+	if err == nil {
+		pbBooking.AssignedStaff = bookingStaffToProto(staff)
+	}
+
 	return &pb.GetMyBookingResponse{
 		Booking: pbBooking,
 	}, nil
@@ -159,6 +289,253 @@ func (s *BookingServiceServer) CancelBooking(ctx context.Context, req *pb.Cancel
 	}, nil
 }
 
+func (s *BookingServiceServer) ListMyBookingSeries(ctx context.Context, req *pb.ListMyBookingSeriesRequest) (*pb.ListMyBookingSeriesResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	all, err := s.bookingSvc.ListMyBookingSeries(ctx, userID)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	pbSeries := make([]*pb.BookingSeries, len(all))
+	for i, d := range all {
+		pbSeries[i] = bookingSeriesToProto(d.Series, d.Occurrences)
+	}
+
+	return &pb.ListMyBookingSeriesResponse{Series: pbSeries}, nil
+}
+
+func (s *BookingServiceServer) SkipSeriesOccurrence(ctx context.Context, req *pb.SkipSeriesOccurrenceRequest) (*pb.SkipSeriesOccurrenceResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.Date == "" {
+		return nil, status.Error(codes.InvalidArgument, "date is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	occurrence, msg, err := s.bookingSvc.SkipSeriesOccurrence(ctx, userID, req.Id, req.Date)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.SkipSeriesOccurrenceResponse{
+		Occurrence: seriesOccurrenceToProto(*occurrence),
+		Message:    msg,
+	}, nil
+}
+
+func (s *BookingServiceServer) CancelBookingSeries(ctx context.Context, req *pb.CancelBookingSeriesRequest) (*pb.CancelBookingSeriesResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	series, cancelled, err := s.bookingSvc.CancelBookingSeries(ctx, userID, req.Id)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.CancelBookingSeriesResponse{
+		Series:            bookingSeriesToProto(*series, nil),
+		CancelledBookings: int32(cancelled),
+	}, nil
+}
+
+func (s *BookingServiceServer) JoinWaitlist(ctx context.Context, req *pb.JoinWaitlistRequest) (*pb.JoinWaitlistResponse, error) {
+	if req.DateFrom == "" {
+		return nil, status.Error(codes.InvalidArgument, "date_from is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	var cartSessionToken string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if tokens := md.Get("x-cart-session"); len(tokens) > 0 {
+			cartSessionToken = tokens[0]
+		}
+	}
+
+	entry, err := s.bookingSvc.JoinWaitlist(ctx, services.JoinWaitlistParams{
+		UserID:           userID,
+		VehicleID:        req.VehicleId,
+		DateFrom:         req.DateFrom,
+		DateTo:           req.DateTo,
+		Notes:            req.Notes,
+		CartSessionToken: cartSessionToken,
+		Location:         serviceLocationFromProto(req.ServiceLocation),
+	})
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.JoinWaitlistResponse{Entry: waitlistEntryToProto(entry)}, nil
+}
+
+func (s *BookingServiceServer) ListMyWaitlist(ctx context.Context, req *pb.ListMyWaitlistRequest) (*pb.ListMyWaitlistResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	entries, err := s.bookingSvc.ListMyWaitlist(ctx, userID)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	pbEntries := make([]*pb.WaitlistEntry, len(entries))
+	for i, e := range entries {
+		pbEntries[i] = &pb.WaitlistEntry{
+			Id:                    e.ID,
+			VehicleId:             e.VehicleID.Int64,
+			DateFrom:              formatPGDate(e.DateFrom),
+			DateTo:                formatPGDate(e.DateTo),
+			EstimatedDurationMins: e.EstimatedDurationMins,
+			TotalAmount:           e.TotalAmount,
+			Status:                e.Status,
+			OfferedDate:           formatPGDate(e.OfferedDate),
+			OfferedTime:           formatPGTime(e.OfferedTime),
+			OfferExpiresAt:        timestampFromPG(e.OfferExpiresAt),
+			BookingId:             e.BookingID.Int64,
+			CreatedAt:             timestampFromPG(e.CreatedAt),
+			ServiceLocation:       serviceLocationToProto(e.ServiceAddress, e.ServiceSuburb, e.ServicePostcode),
+			TravelSurcharge:       e.TravelSurcharge,
+		}
+	}
+
+	return &pb.ListMyWaitlistResponse{Entries: pbEntries}, nil
+}
+
+func (s *BookingServiceServer) LeaveWaitlist(ctx context.Context, req *pb.LeaveWaitlistRequest) (*pb.LeaveWaitlistResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	entry, err := s.bookingSvc.LeaveWaitlist(ctx, userID, req.Id)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.LeaveWaitlistResponse{Entry: waitlistEntryToProto(entry)}, nil
+}
+
+func (s *BookingServiceServer) ClaimWaitlistOffer(ctx context.Context, req *pb.ClaimWaitlistOfferRequest) (*pb.ClaimWaitlistOfferResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	booking, err := s.bookingSvc.ClaimWaitlistOffer(ctx, userID, req.Token)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.ClaimWaitlistOfferResponse{Booking: bookingToProto(booking)}, nil
+}
+
+func (s *BookingServiceServer) RescheduleBooking(ctx context.Context, req *pb.RescheduleBookingRequest) (*pb.RescheduleBookingResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.ScheduledDate == "" || req.ScheduledTime == "" {
+		return nil, status.Error(codes.InvalidArgument, "scheduled_date and scheduled_time are required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	booking, err := s.bookingSvc.RescheduleBooking(ctx, services.RescheduleBookingParams{
+		UserID:        userID,
+		BookingID:     req.Id,
+		ScheduledDate: req.ScheduledDate,
+		ScheduledTime: req.ScheduledTime,
+	})
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.RescheduleBookingResponse{
+		Booking: dbBookingToProto(booking),
+	}, nil
+}
+
+func (s *BookingServiceServer) GetCancellationPolicy(ctx context.Context, req *pb.GetCancellationPolicyRequest) (*pb.GetCancellationPolicyResponse, error) {
+	policy := s.bookingSvc.GetCancellationPolicy(ctx)
+	descriptions := policy.Describe()
+
+	tiers := make([]*pb.CancellationPolicyTier, len(descriptions))
+	for i, tier := range policy.SortedTiers() {
+		tiers[i] = &pb.CancellationPolicyTier{
+			MinHoursBefore: int32(tier.MinHoursBefore),
+			RefundPercent:  int32(tier.RefundPercent),
+			Description:    descriptions[i],
+		}
+	}
+
+	return &pb.GetCancellationPolicyResponse{
+		Tiers: tiers,
+	}, nil
+}
+
+func (s *BookingServiceServer) GetMyCalendarFeed(ctx context.Context, req *pb.GetMyCalendarFeedRequest) (*pb.GetMyCalendarFeedResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	url, err := s.calendarSvc.GetCalendarFeedURL(ctx, userID, calendarFeedScope(req.Scope))
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+	return &pb.GetMyCalendarFeedResponse{Url: url}, nil
+}
+
+func (s *BookingServiceServer) ResetMyCalendarFeed(ctx context.Context, req *pb.ResetMyCalendarFeedRequest) (*pb.ResetMyCalendarFeedResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	url, err := s.calendarSvc.ResetCalendarFeedURL(ctx, userID, calendarFeedScope(req.Scope))
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+	return &pb.ResetMyCalendarFeedResponse{Url: url}, nil
+}
+
+// calendarFeedScope defaults an empty scope to the customer feed.
+func calendarFeedScope(scope string) string {
+	if scope == "" {
+		return services.CalendarFeedCustomer
+	}
+	return scope
+}
+
 func (s *BookingServiceServer) ListAllBookings(ctx context.Context, req *pb.ListAllBookingsRequest) (*pb.ListAllBookingsResponse, error) {
 	bookings, err := s.bookingSvc.ListAllBookings(ctx, req.DateFrom, req.DateTo)
 	if err != nil {
@@ -188,6 +565,16 @@ func adminBookingRowToProto(b *dbpg.ListAllBookingsAdminRow) *pb.Booking {
 		Subtotal:              b.Subtotal,
 		DepositAmount:         b.DepositAmount,
 		TotalAmount:           b.TotalAmount,
+		RefundAmount:          b.RefundAmount,
+		ForfeitedAmount:       b.ForfeitedAmount,
+		CreatedBy:             b.CreatedBy.Int64,
+		AmountPaid:            b.AmountPaid,
+		BalanceDue:            b.TotalAmount - b.AmountPaid,
+		ResourceId:            b.ResourceID,
+		SeriesId:              b.SeriesID.Int64,
+		ServiceLocation:       serviceLocationToProto(b.ServiceAddress, b.ServiceSuburb, b.ServicePostcode),
+		TravelDistanceKm:      b.TravelDistanceKm,
+		TravelSurcharge:       b.TravelSurcharge,
 		Notes:                 b.Notes.String,
 		CreatedAt:             timestampFromPG(b.CreatedAt),
 		UpdatedAt:             timestampFromPG(b.UpdatedAt),
@@ -223,6 +610,18 @@ func (s *BookingServiceServer) GetBooking(ctx context.Context, req *pb.GetBookin
 		pbBooking.Services = bookingServicesToProto(ctx, s.bookingSvc, svcs)
 	}
 
+	staff, err := s.bookingSvc.ListBookingStaff(ctx, req.Id)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+	pbBooking.AssignedStaff = bookingStaffToProto(staff)
+
+	history, err := s.bookingSvc.ListStatusHistory(ctx, req.Id)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+	pbBooking.StatusHistory = statusHistoryToProto(history)
+
 	return &pb.GetBookingResponse{
 		Booking: pbBooking,
 	}, nil
@@ -236,7 +635,16 @@ func (s *BookingServiceServer) UpdateBookingStatus(ctx context.Context, req *pb.
 		return nil, status.Error(codes.InvalidArgument, "status is required")
 	}
 
-	booking, err := s.bookingSvc.UpdateBookingStatus(ctx, req.Id, req.Status)
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookingSvc.UpdateBookingStatus(ctx, userID, req.Id, req.Status, req.Reason)
 	if err != nil {
 		return nil, ToGRPCError(err)
 	}
@@ -251,7 +659,16 @@ func (s *BookingServiceServer) CompleteBooking(ctx context.Context, req *pb.Comp
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	booking, err := s.bookingSvc.CompleteBooking(ctx, req.Id, req.Notes)
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookingSvc.CompleteBooking(ctx, userID, req.Id, req.Notes, req.OverrideReason)
 	if err != nil {
 		return nil, ToGRPCError(err)
 	}
@@ -261,6 +678,255 @@ func (s *BookingServiceServer) CompleteBooking(ctx context.Context, req *pb.Comp
 	}, nil
 }
 
+func (s *BookingServiceServer) CreateBooking(ctx context.Context, req *pb.CreateBookingRequest) (*pb.CreateBookingResponse, error) {
+	if req.ScheduledDate == "" {
+		return nil, status.Error(codes.InvalidArgument, "scheduled_date is required")
+	}
+	if req.ScheduledTime == "" {
+		return nil, status.Error(codes.InvalidArgument, "scheduled_time is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	params := services.CreateAdminBookingParams{
+		ActorID:       userID,
+		CustomerID:    req.CustomerId,
+		VehicleID:     req.VehicleId,
+		ScheduledDate: req.ScheduledDate,
+		ScheduledTime: req.ScheduledTime,
+		Notes:         req.Notes,
+		Location:      serviceLocationFromProto(req.ServiceLocation),
+		SkipDeposit:   req.SkipDeposit,
+	}
+	if c := req.NewCustomer; c != nil {
+		params.NewCustomer = &services.NewCustomer{
+			FirstName: c.FirstName,
+			Surname:   c.Surname,
+			Phone:     c.Phone,
+			Email:     c.Email,
+		}
+	}
+	if v := req.NewVehicle; v != nil {
+		params.NewVehicle = &services.NewVehicle{
+			Make:              v.Make,
+			Model:             v.Model,
+			Rego:              v.Rego,
+			VehicleCategoryID: v.VehicleCategoryId,
+		}
+	}
+	for _, item := range req.Services {
+		params.Services = append(params.Services, services.AdminBookingService{
+			ServiceID: item.ServiceId,
+			Quantity:  item.Quantity,
+			OptionIDs: item.OptionIds,
+			Price:     item.PriceOverride,
+		})
+	}
+
+	booking, err := s.bookingSvc.CreateAdminBooking(ctx, params)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.CreateBookingResponse{
+		Booking: bookingToProto(booking),
+	}, nil
+}
+
+func (s *BookingServiceServer) MarkBookingNoShow(ctx context.Context, req *pb.MarkBookingNoShowRequest) (*pb.MarkBookingNoShowResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookingSvc.MarkNoShow(ctx, userID, req.Id, req.Reason)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.MarkBookingNoShowResponse{
+		Booking: dbBookingToProto(booking),
+	}, nil
+}
+
+func (s *BookingServiceServer) AddBookingService(ctx context.Context, req *pb.AddBookingServiceRequest) (*pb.AddBookingServiceResponse, error) {
+	if req.Id == 0 || req.ServiceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id and service_id are required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookingSvc.AddBookingService(ctx, userID, req.Id, req.ServiceId, req.OptionIds, req.PriceOverride)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.AddBookingServiceResponse{
+		Booking: s.bookingWithServices(ctx, booking),
+	}, nil
+}
+
+func (s *BookingServiceServer) RemoveBookingService(ctx context.Context, req *pb.RemoveBookingServiceRequest) (*pb.RemoveBookingServiceResponse, error) {
+	if req.Id == 0 || req.BookingServiceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id and booking_service_id are required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookingSvc.RemoveBookingService(ctx, userID, req.Id, req.BookingServiceId)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.RemoveBookingServiceResponse{
+		Booking: s.bookingWithServices(ctx, booking),
+	}, nil
+}
+
+func (s *BookingServiceServer) AddBookingServiceOption(ctx context.Context, req *pb.AddBookingServiceOptionRequest) (*pb.AddBookingServiceOptionResponse, error) {
+	if req.Id == 0 || req.BookingServiceId == 0 || req.ServiceOptionId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id, booking_service_id and service_option_id are required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookingSvc.AddBookingServiceOption(ctx, userID, req.Id, req.BookingServiceId, req.ServiceOptionId)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.AddBookingServiceOptionResponse{
+		Booking: s.bookingWithServices(ctx, booking),
+	}, nil
+}
+
+func (s *BookingServiceServer) RemoveBookingServiceOption(ctx context.Context, req *pb.RemoveBookingServiceOptionRequest) (*pb.RemoveBookingServiceOptionResponse, error) {
+	if req.Id == 0 || req.BookingServiceId == 0 || req.OptionId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id, booking_service_id and option_id are required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookingSvc.RemoveBookingServiceOption(ctx, userID, req.Id, req.BookingServiceId, req.OptionId)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.RemoveBookingServiceOptionResponse{
+		Booking: s.bookingWithServices(ctx, booking),
+	}, nil
+}
+
+func (s *BookingServiceServer) RecordBookingPayment(ctx context.Context, req *pb.RecordBookingPaymentRequest) (*pb.RecordBookingPaymentResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.Method == "" {
+		return nil, status.Error(codes.InvalidArgument, "method is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookingSvc.RecordInPersonPayment(ctx, userID, req.Id, services.InPersonPayment{
+		Method: dbpg.PaymentMethod(req.Method),
+		Amount: req.Amount,
+		Tip:    req.TipAmount,
+		Note:   req.Note,
+	})
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.RecordBookingPaymentResponse{
+		Booking: dbBookingToProto(booking),
+	}, nil
+}
+
+func (s *BookingServiceServer) ListBookingPayments(ctx context.Context, req *pb.ListBookingPaymentsRequest) (*pb.ListBookingPaymentsResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	ledger, err := s.bookingSvc.ListBookingPayments(ctx, req.Id)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.ListBookingPaymentsResponse{
+		Payments:    bookingPaymentsToProto(ledger.Payments),
+		TotalAmount: ledger.TotalAmount,
+		AmountPaid:  ledger.AmountPaid,
+		BalanceDue:  ledger.BalanceDue,
+		Refunded:    ledger.Refunded,
+		Tips:        ledger.Tips,
+	}, nil
+}
+
+// bookingWithServices converts a booking whose services have just changed,
+// listing them so the caller sees the new line IDs.
+func (s *BookingServiceServer) bookingWithServices(ctx context.Context, b *dbpg.Booking) *pb.Booking {
+	pbBooking := dbBookingToProto(b)
+	svcs, err := s.bookingSvc.ListBookingServices(ctx, b.ID)
+	if err == nil {
+		pbBooking.Services = bookingServicesToProto(ctx, s.bookingSvc, svcs)
+	}
+	return pbBooking
+}
+
 // Conversion helpers
 
 func bookingToProto(b *dbpg.Booking) *pb.Booking {
@@ -279,6 +945,16 @@ func bookingToProto(b *dbpg.Booking) *pb.Booking {
 		Subtotal:              b.Subtotal,
 		DepositAmount:         b.DepositAmount,
 		TotalAmount:           b.TotalAmount,
+		RefundAmount:          b.RefundAmount,
+		ForfeitedAmount:       b.ForfeitedAmount,
+		CreatedBy:             b.CreatedBy.Int64,
+		AmountPaid:            b.AmountPaid,
+		BalanceDue:            b.TotalAmount - b.AmountPaid,
+		ResourceId:            b.ResourceID,
+		SeriesId:              b.SeriesID.Int64,
+		ServiceLocation:       serviceLocationToProto(b.ServiceAddress, b.ServiceSuburb, b.ServicePostcode),
+		TravelDistanceKm:      b.TravelDistanceKm,
+		TravelSurcharge:       b.TravelSurcharge,
 		Notes:                 b.Notes.String,
 		CreatedAt:             timestampFromPG(b.CreatedAt),
 		UpdatedAt:             timestampFromPG(b.UpdatedAt),
@@ -305,12 +981,23 @@ func bookingRowToProto(row *dbpg.GetBookingByIDRow) *pb.Booking {
 		Subtotal:              row.Subtotal,
 		DepositAmount:         row.DepositAmount,
 		TotalAmount:           row.TotalAmount,
+		RefundAmount:          row.RefundAmount,
+		ForfeitedAmount:       row.ForfeitedAmount,
+		CreatedBy:             row.CreatedBy.Int64,
+		AmountPaid:            row.AmountPaid,
+		BalanceDue:            row.TotalAmount - row.AmountPaid,
+		ResourceId:            row.ResourceID,
+		SeriesId:              row.SeriesID.Int64,
+		ServiceLocation:       serviceLocationToProto(row.ServiceAddress, row.ServiceSuburb, row.ServicePostcode),
+		TravelDistanceKm:      row.TravelDistanceKm,
+		TravelSurcharge:       row.TravelSurcharge,
 		Notes:                 row.Notes.String,
 		CreatedAt:             timestampFromPG(row.CreatedAt),
 		UpdatedAt:             timestampFromPG(row.UpdatedAt),
 		Customer: &pb.BookingCustomerInfo{
 			UserId: row.CustomerUserID,
 			Phone:  row.CustomerPhone.String,
+			Name:   row.CustomerName,
 		},
 	}
 
@@ -325,6 +1012,70 @@ func bookingRowToProto(row *dbpg.GetBookingByIDRow) *pb.Booking {
 	return pbBooking
 }
 
+// serviceLocationToProto returns nil for bookings at the workshop.
+func serviceLocationToProto(address, suburb, postcode pgtype.Text) *pb.ServiceLocation {
+	if !postcode.Valid {
+		return nil
+	}
+	return &pb.ServiceLocation{
+		Address:  address.String,
+		Suburb:   suburb.String,
+		Postcode: postcode.String,
+	}
+}
+
+func serviceLocationFromProto(loc *pb.ServiceLocation) *services.ServiceLocation {
+	if loc == nil {
+		return nil
+	}
+	return &services.ServiceLocation{
+		Address:  loc.Address,
+		Suburb:   loc.Suburb,
+		Postcode: loc.Postcode,
+	}
+}
+
+func bookingSeriesToProto(series dbpg.BookingSeries, occurrences []dbpg.BookingSeriesOccurrence) *pb.BookingSeries {
+	pbSeries := &pb.BookingSeries{
+		Id:                series.ID,
+		Rrule:             series.Rrule,
+		StartDate:         formatPGDate(series.StartDate),
+		ScheduledTime:     formatPGTime(series.ScheduledTime),
+		Status:            series.Status,
+		TemplateBookingId: series.TemplateBookingID,
+		CreatedAt:         timestampFromPG(series.CreatedAt),
+	}
+	for _, o := range occurrences {
+		pbSeries.Occurrences = append(pbSeries.Occurrences, seriesOccurrenceToProto(o))
+	}
+	return pbSeries
+}
+
+func seriesOccurrenceToProto(o dbpg.BookingSeriesOccurrence) *pb.SeriesOccurrence {
+	return &pb.SeriesOccurrence{
+		Date:      formatPGDate(o.OccurrenceDate),
+		Status:    o.Status,
+		BookingId: o.BookingID.Int64,
+		Reason:    o.Reason.String,
+	}
+}
+
+func waitlistEntryToProto(e *dbpg.WaitlistEntry) *pb.WaitlistEntry {
+	return &pb.WaitlistEntry{
+		Id:                    e.ID,
+		VehicleId:             e.VehicleID.Int64,
+		DateFrom:              formatPGDate(e.DateFrom),
+		DateTo:                formatPGDate(e.DateTo),
+		EstimatedDurationMins: e.EstimatedDurationMins,
+		TotalAmount:           e.TotalAmount,
+		Status:                e.Status,
+		BookingId:             e.BookingID.Int64,
+		CreatedAt:             timestampFromPG(e.CreatedAt),
+		ServiceLocation:       serviceLocationToProto(e.ServiceAddress, e.ServiceSuburb, e.ServicePostcode),
+		TravelSurcharge:       e.TravelSurcharge,
+	}
+}
+
 func bookingServicesToProto(ctx context.Context, bookingSvc *services.BookingService, svcs []dbpg.ListBookingServicesRow) []*pb.BookingServiceItem {
 	items := make([]*pb.BookingServiceItem, len(svcs))
 	for i, svc := range svcs {
@@ -378,3 +1129,38 @@ func timestampFromPG(ts pgtype.Timestamptz) *timestamppb.Timestamp {
 	}
 	return timestamppb.New(ts.Time)
 }
+
+func statusHistoryToProto(history []dbpg.BookingStatusHistory) []*pb.BookingStatusChange {
+	result := make([]*pb.BookingStatusChange, len(history))
+	for i, h := range history {
+		result[i] = &pb.BookingStatusChange{
+			Id:        h.ID,
+			Field:     h.Field,
+			FromValue: h.FromValue.String,
+			ToValue:   h.ToValue,
+			ChangedBy: h.ChangedBy.Int64,
+			Reason:    h.Reason.String,
+			CreatedAt: timestampFromPG(h.CreatedAt),
+		}
+	}
+	return result
+}
+
+func bookingPaymentsToProto(payments []dbpg.ListBookingPaymentsRow) []*pb.BookingPayment {
+	result := make([]*pb.BookingPayment, len(payments))
+	for i, p := range payments {
+		result[i] = &pb.BookingPayment{
+			Id:            p.ID,
+			Kind:          string(p.Kind),
+			Amount:        p.Amount,
+			TipAmount:     p.TipAmount,
+			Method:        string(p.Method),
+			ProviderRef:   p.ProviderRef.String,
+			Note:          p.Note.String,
+			CreatedBy:     p.CreatedBy.Int64,
+			CreatedByName: p.CreatedByName,
+			CreatedAt:     timestampFromPG(p.CreatedAt),
+		}
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/richardbowden/degrees/internal/pb/degrees/v1"
+)
+
+// admins grants admin to the listed users.
+type admins map[int64]bool
+
+func (a admins) IsSystemAdmin(ctx context.Context, userID int64) (bool, error) {
+	return a[userID], nil
+}
+
+func TestCompleteBookingOverrideNeedsAdmin(t *testing.T) {
+	srv := NewBookingServer(nil, nil, nil, admins{1: true})
+	ctx := context.WithValue(context.Background(), UserIDKey, int64(5))
+
+	// A customer cannot complete a booking with money owing by giving a reason
+	_, err := srv.CompleteBooking(ctx, &pb.CompleteBookingRequest{Id: 7, OverrideReason: "paid cash on the day"})
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = srv.UpdateBookingStatus(ctx, &pb.UpdateBookingStatusRequest{Id: 7, Status: "no_show"})
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -103,6 +104,7 @@ func (s *CatalogueServiceServer) GetService(ctx context.Context, req *pb.GetCata
 		CategoryName:    svc.CategoryName,
 		Options:         pbOpts,
 		PriceTiers:      dbPriceTiersToPB(tiers),
+		ResourceTypes:   svc.ResourceTypes,
 	}
 	if svc.CreatedAt.Valid {
 		pbSvc.CreatedAt = timestamppb.New(svc.CreatedAt.Time)
@@ -137,6 +139,7 @@ func (s *CatalogueServiceServer) CreateService(ctx context.Context, req *pb.Crea
 		DurationMinutes: req.DurationMinutes,
 		IsActive:        req.IsActive,
 		SortOrder:       req.SortOrder,
+		ResourceTypes:   req.ResourceTypes,
 	}
 
 	svc, err := s.catalogueSvc.CreateService(ctx, userID, params)
@@ -168,6 +171,7 @@ func (s *CatalogueServiceServer) UpdateService(ctx context.Context, req *pb.Upda
 		DurationMinutes: req.DurationMinutes,
 		IsActive:        req.IsActive,
 		SortOrder:       req.SortOrder,
+		ResourceTypes:   req.ResourceTypes,
 	}
 
 	svc, err := s.catalogueSvc.UpdateService(ctx, userID, params)
@@ -210,12 +214,13 @@ func (s *CatalogueServiceServer) AddServiceOption(ctx context.Context, req *pb.A
 	}
 
 	params := dbpg.CreateServiceOptionParams{
-		ServiceID:   req.ServiceId,
-		Name:        req.Name,
-		Description: dbpg.StringToPGString(req.Description),
-		Price:       req.Price,
-		IsActive:    req.IsActive,
-		SortOrder:   req.SortOrder,
+		ServiceID:       req.ServiceId,
+		Name:            req.Name,
+		Description:     dbpg.StringToPGString(req.Description),
+		Price:           req.Price,
+		IsActive:        req.IsActive,
+		SortOrder:       req.SortOrder,
+		DurationMinutes: req.DurationMinutes,
 	}
 
 	opt, err := s.catalogueSvc.AddServiceOption(ctx, userID, params)
@@ -328,6 +333,7 @@ func (s *CatalogueServiceServer) SetServicePriceTiers(ctx context.Context, req *
 			ServiceID:         req.ServiceId,
 			VehicleCategoryID: t.VehicleCategoryId,
 			Price:             t.Price,
+			DurationMinutes:   pgtype.Int4{Int32: t.DurationMinutes, Valid: t.DurationMinutes != 0},
 		}
 	}
 
@@ -370,6 +376,7 @@ func dbServiceToPB(s dbpg.Service) *pb.DetailingService {
 		DurationMinutes: s.DurationMinutes,
 		IsActive:        s.IsActive,
 		SortOrder:       s.SortOrder,
+		ResourceTypes:   s.ResourceTypes,
 	}
 	if s.CreatedAt.Valid {
 		svc.CreatedAt = timestamppb.New(s.CreatedAt.Time)
@@ -382,13 +389,14 @@ func dbServiceToPB(s dbpg.Service) *pb.DetailingService {
 
 func dbServiceOptionToPB(o dbpg.ServiceOption) *pb.DetailingServiceOption {
 	opt := &pb.DetailingServiceOption{
-		Id:          o.ID,
-		ServiceId:   o.ServiceID,
-		Name:        o.Name,
-		Description: o.Description.String,
-		Price:       o.Price,
-		IsActive:    o.IsActive,
-		SortOrder:   o.SortOrder,
+		Id:              o.ID,
+		ServiceId:       o.ServiceID,
+		Name:            o.Name,
+		Description:     o.Description.String,
+		Price:           o.Price,
+		IsActive:        o.IsActive,
+		SortOrder:       o.SortOrder,
+		DurationMinutes: o.DurationMinutes,
 	}
 	if o.CreatedAt.Valid {
 		opt.CreatedAt = timestamppb.New(o.CreatedAt.Time)
@@ -422,6 +430,7 @@ func dbPriceTiersToPB(tiers []dbpg.ListPriceTiersByServiceRow) []*pb.ServicePric
 			CategoryName:      t.CategoryName,
 			CategorySlug:      t.CategorySlug,
 			Price:             t.Price,
+			DurationMinutes:   t.DurationMinutes.Int32,
 		}
 	}
 	return result
@@ -193,15 +193,16 @@ func (s *CustomerServiceServer) GetCustomer(ctx context.Context, req *pb.GetCust
 
 func customerProfileToPB(p *services.CustomerProfile) *pb.CustomerProfile {
 	return &pb.CustomerProfile{
-		Id:        p.ID,
-		UserId:    p.UserID,
-		Phone:     p.Phone,
-		Address:   p.Address,
-		Suburb:    p.Suburb,
-		Postcode:  p.Postcode,
-		Notes:     p.Notes,
-		CreatedAt: timestamppb.New(p.CreatedAt),
-		UpdatedAt: timestamppb.New(p.UpdatedAt),
+		Id:          p.ID,
+		UserId:      p.UserID,
+		Phone:       p.Phone,
+		Address:     p.Address,
+		Suburb:      p.Suburb,
+		Postcode:    p.Postcode,
+		Notes:       p.Notes,
+		NoShowCount: p.NoShowCount,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
 	}
 }
 
@@ -13,11 +13,13 @@ import (
 type PaymentServiceServer struct {
 	pb.UnimplementedPaymentServiceServer
 	paymentSvc *services.PaymentService
+	authzSvc   *services.AuthzSvc
 }
 
-func NewPaymentServer(paymentSvc *services.PaymentService) *PaymentServiceServer {
+func NewPaymentServer(paymentSvc *services.PaymentService, authzSvc *services.AuthzSvc) *PaymentServiceServer {
 	return &PaymentServiceServer{
 		paymentSvc: paymentSvc,
+		authzSvc:   authzSvc,
 	}
 }
 
@@ -41,3 +43,51 @@ func (s *PaymentServiceServer) CreateDepositSession(ctx context.Context, req *pb
 		DepositAmount: depositAmount,
 	}, nil
 }
+
+func (s *PaymentServiceServer) CreateBalanceSession(ctx context.Context, req *pb.CreateBalanceSessionRequest) (*pb.CreateBalanceSessionResponse, error) {
+	if req.BookingId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "booking_id is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	clientSecret, balanceAmount, err := s.paymentSvc.CreateBalanceSession(ctx, userID, req.BookingId)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.CreateBalanceSessionResponse{
+		ClientSecret:  clientSecret,
+		BalanceAmount: balanceAmount,
+	}, nil
+}
+
+func (s *PaymentServiceServer) RefundBooking(ctx context.Context, req *pb.RefundBookingRequest) (*pb.RefundBookingResponse, error) {
+	if req.BookingId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "booking_id is required")
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	refund, err := s.paymentSvc.RefundBooking(ctx, userID, req.BookingId, req.Amount, req.Reason)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.RefundBookingResponse{
+		Booking:  bookingRowToProto(&refund.Booking),
+		Refunded: refund.Refunded,
+		Pending:  refund.Pending,
+		Failed:   refund.Failed,
+	}, nil
+}
@@ -17,23 +17,31 @@ import (
 type ScheduleServiceServer struct {
 	pb.UnimplementedScheduleServiceServer
 	scheduleSvc *services.ScheduleService
+	authzSvc    services.AdminChecker
 }
 
-func NewScheduleServer(scheduleSvc *services.ScheduleService) *ScheduleServiceServer {
+func NewScheduleServer(scheduleSvc *services.ScheduleService, authzSvc services.AdminChecker) *ScheduleServiceServer {
 	return &ScheduleServiceServer{
 		scheduleSvc: scheduleSvc,
+		authzSvc:    authzSvc,
 	}
 }
 
 func (s *ScheduleServiceServer) GetScheduleConfig(ctx context.Context, req *pb.GetScheduleConfigRequest) (*pb.GetScheduleConfigResponse, error) {
-	configs, err := s.scheduleSvc.GetScheduleConfig(ctx)
+	configs, windows, err := s.scheduleSvc.GetScheduleConfig(ctx)
 	if err != nil {
 		return nil, ToGRPCError(err)
 	}
 
+	windowsByDay := make(map[int32][]*pb.TimeWindow)
+	for _, w := range windows {
+		windowsByDay[w.DayOfWeek] = append(windowsByDay[w.DayOfWeek], timeWindowToProto(w.OpenTime, w.CloseTime))
+	}
+
 	days := make([]*pb.ScheduleDay, len(configs))
 	for i, c := range configs {
 		days[i] = scheduleConfigToProto(&c)
+		days[i].Windows = windowsByDay[c.DayOfWeek]
 	}
 
 	return &pb.GetScheduleConfigResponse{
@@ -52,19 +60,28 @@ func (s *ScheduleServiceServer) UpdateScheduleConfig(ctx context.Context, req *p
 		return nil, status.Error(codes.InvalidArgument, "invalid close_time format, expected HH:MM")
 	}
 
-	cfg, err := s.scheduleSvc.UpdateScheduleConfig(ctx, dbpg.UpdateScheduleConfigParams{
+	windows, err := timeWindowsFromProto(req.Windows)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, stored, err := s.scheduleSvc.UpdateScheduleConfig(ctx, dbpg.UpdateScheduleConfigParams{
 		DayOfWeek:     req.DayOfWeek,
 		OpenTime:      openTime,
 		CloseTime:     closeTime,
 		IsOpen:        req.IsOpen,
 		BufferMinutes: req.BufferMinutes,
-	})
+	}, windows)
 	if err != nil {
 		return nil, ToGRPCError(err)
 	}
 
+	day := scheduleConfigToProto(cfg)
+	for _, w := range stored {
+		day.Windows = append(day.Windows, timeWindowToProto(w.OpenTime, w.CloseTime))
+	}
 	return &pb.UpdateScheduleConfigResponse{
-		Day: scheduleConfigToProto(cfg),
+		Day: day,
 	}, nil
 }
 
@@ -73,16 +90,17 @@ func (s *ScheduleServiceServer) AddBlackout(ctx context.Context, req *pb.AddBlac
 		return nil, status.Error(codes.InvalidArgument, "date is required")
 	}
 
-	blackout, err := s.scheduleSvc.AddBlackout(ctx, req.Date, req.Reason)
+	blackout, err := s.scheduleSvc.AddBlackout(ctx, req.Date, req.Reason, req.RecursAnnually)
 	if err != nil {
 		return nil, ToGRPCError(err)
 	}
 
 	return &pb.AddBlackoutResponse{
 		Blackout: &pb.Blackout{
-			Id:     blackout.ID,
-			Date:   formatPGDate(blackout.Date),
-			Reason: blackout.Reason.String,
+			Id:             blackout.ID,
+			Date:           formatPGDate(blackout.Date),
+			Reason:         blackout.Reason.String,
+			RecursAnnually: blackout.RecursAnnually,
 		},
 	}, nil
 }
@@ -102,6 +120,207 @@ func (s *ScheduleServiceServer) RemoveBlackout(ctx context.Context, req *pb.Remo
 	}, nil
 }
 
+func (s *ScheduleServiceServer) ListScheduleOverrides(ctx context.Context, req *pb.ListScheduleOverridesRequest) (*pb.ListScheduleOverridesResponse, error) {
+	overrides, err := s.scheduleSvc.ListScheduleOverrides(ctx)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	// Rows come ordered by date, one per window
+	var pbOverrides []*pb.ScheduleOverride
+	for _, o := range overrides {
+		date := formatPGDate(o.Date)
+		if n := len(pbOverrides); n == 0 || pbOverrides[n-1].Date != date {
+			pbOverrides = append(pbOverrides, &pb.ScheduleOverride{Date: date, Reason: o.Reason.String})
+		}
+		last := pbOverrides[len(pbOverrides)-1]
+		last.Windows = append(last.Windows, timeWindowToProto(o.OpenTime, o.CloseTime))
+	}
+
+	return &pb.ListScheduleOverridesResponse{
+		Overrides: pbOverrides,
+	}, nil
+}
+
+func (s *ScheduleServiceServer) SetScheduleOverride(ctx context.Context, req *pb.SetScheduleOverrideRequest) (*pb.SetScheduleOverrideResponse, error) {
+	if req.Date == "" {
+		return nil, status.Error(codes.InvalidArgument, "date is required")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	windows, err := timeWindowsFromProto(req.Windows)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides, err := s.scheduleSvc.SetScheduleOverride(ctx, req.Date, windows, req.Reason)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	override := &pb.ScheduleOverride{Date: req.Date, Reason: req.Reason}
+	for _, o := range overrides {
+		override.Windows = append(override.Windows, timeWindowToProto(o.OpenTime, o.CloseTime))
+	}
+	return &pb.SetScheduleOverrideResponse{
+		Override: override,
+	}, nil
+}
+
+func (s *ScheduleServiceServer) RemoveScheduleOverride(ctx context.Context, req *pb.RemoveScheduleOverrideRequest) (*pb.RemoveScheduleOverrideResponse, error) {
+	if req.Date == "" {
+		return nil, status.Error(codes.InvalidArgument, "date is required")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	if err := s.scheduleSvc.RemoveScheduleOverride(ctx, req.Date); err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.RemoveScheduleOverrideResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *ScheduleServiceServer) ListResources(ctx context.Context, req *pb.ListResourcesRequest) (*pb.ListResourcesResponse, error) {
+	resources, hours, err := s.scheduleSvc.ListResources(ctx)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	hoursByResource := make(map[int64][]*pb.ResourceHours)
+	for _, h := range hours {
+		hoursByResource[h.ResourceID] = append(hoursByResource[h.ResourceID], resourceHoursToProto(&h))
+	}
+
+	pbResources := make([]*pb.Resource, len(resources))
+	for i, r := range resources {
+		pbResources[i] = resourceToProto(&r)
+		pbResources[i].Hours = hoursByResource[r.ID]
+	}
+
+	return &pb.ListResourcesResponse{
+		Resources: pbResources,
+	}, nil
+}
+
+func (s *ScheduleServiceServer) CreateResource(ctx context.Context, req *pb.CreateResourceRequest) (*pb.CreateResourceResponse, error) {
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	res, err := s.scheduleSvc.CreateResource(ctx, dbpg.CreateResourceParams{
+		Name:         req.Name,
+		ResourceType: req.ResourceType,
+		IsActive:     req.IsActive,
+		SortOrder:    req.SortOrder,
+	})
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.CreateResourceResponse{
+		Resource: resourceToProto(res),
+	}, nil
+}
+
+func (s *ScheduleServiceServer) UpdateResource(ctx context.Context, req *pb.UpdateResourceRequest) (*pb.UpdateResourceResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	res, err := s.scheduleSvc.UpdateResource(ctx, dbpg.UpdateResourceParams{
+		ID:           req.Id,
+		Name:         req.Name,
+		ResourceType: req.ResourceType,
+		IsActive:     req.IsActive,
+		SortOrder:    req.SortOrder,
+	})
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.UpdateResourceResponse{
+		Resource: resourceToProto(res),
+	}, nil
+}
+
+func (s *ScheduleServiceServer) SetResourceHours(ctx context.Context, req *pb.SetResourceHoursRequest) (*pb.SetResourceHoursResponse, error) {
+	if req.ResourceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "resource_id is required")
+	}
+
+	if err := RequireAdmin(ctx, s.authzSvc); err != nil {
+		return nil, err
+	}
+
+	if req.UseBusinessHours {
+		if err := s.scheduleSvc.ClearResourceHours(ctx, req.ResourceId, req.DayOfWeek); err != nil {
+			return nil, ToGRPCError(err)
+		}
+		return &pb.SetResourceHoursResponse{}, nil
+	}
+
+	params := dbpg.UpsertResourceHoursParams{
+		ResourceID: req.ResourceId,
+		DayOfWeek:  req.DayOfWeek,
+		OpenTime:   pgtype.Time{Valid: true},
+		CloseTime:  pgtype.Time{Valid: true},
+		IsOpen:     req.IsOpen,
+	}
+	if req.IsOpen {
+		openTime, err := parseTimeString(req.OpenTime)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid open_time format, expected HH:MM")
+		}
+		closeTime, err := parseTimeString(req.CloseTime)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid close_time format, expected HH:MM")
+		}
+		params.OpenTime = openTime
+		params.CloseTime = closeTime
+	}
+
+	hours, err := s.scheduleSvc.SetResourceHours(ctx, params)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.SetResourceHoursResponse{
+		Hours: resourceHoursToProto(hours),
+	}, nil
+}
+
+func resourceToProto(r *dbpg.Resource) *pb.Resource {
+	return &pb.Resource{
+		Id:           r.ID,
+		Name:         r.Name,
+		ResourceType: r.ResourceType,
+		IsActive:     r.IsActive,
+		SortOrder:    r.SortOrder,
+	}
+}
+
+func resourceHoursToProto(h *dbpg.ResourceHour) *pb.ResourceHours {
+	return &pb.ResourceHours{
+		ResourceId: h.ResourceID,
+		DayOfWeek:  h.DayOfWeek,
+		OpenTime:   formatPGTime(h.OpenTime),
+		CloseTime:  formatPGTime(h.CloseTime),
+		IsOpen:     h.IsOpen,
+	}
+}
+
 func scheduleConfigToProto(c *dbpg.ScheduleConfig) *pb.ScheduleDay {
 	return &pb.ScheduleDay{
 		Id:            c.ID,
@@ -113,6 +332,29 @@ func scheduleConfigToProto(c *dbpg.ScheduleConfig) *pb.ScheduleDay {
 	}
 }
 
+func timeWindowToProto(open, close pgtype.Time) *pb.TimeWindow {
+	return &pb.TimeWindow{
+		OpenTime:  formatPGTime(open),
+		CloseTime: formatPGTime(close),
+	}
+}
+
+func timeWindowsFromProto(windows []*pb.TimeWindow) ([]services.TimeWindow, error) {
+	var out []services.TimeWindow
+	for _, w := range windows {
+		open, err := parseTimeString(w.OpenTime)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid window open_time format, expected HH:MM")
+		}
+		close, err := parseTimeString(w.CloseTime)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid window close_time format, expected HH:MM")
+		}
+		out = append(out, services.TimeWindow{Open: open, Close: close})
+	}
+	return out, nil
+}
+
 func parseTimeString(timeStr string) (pgtype.Time, error) {
 	t, err := time.Parse("15:04", timeStr)
 	if err != nil {
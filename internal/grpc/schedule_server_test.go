@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/richardbowden/degrees/internal/pb/degrees/v1"
+)
+
+func TestScheduleChangesNeedAdmin(t *testing.T) {
+	srv := NewScheduleServer(nil, admins{1: true})
+	ctx := context.WithValue(context.Background(), UserIDKey, int64(5))
+
+	calls := map[string]func() error{
+		"CreateResource": func() error {
+			_, err := srv.CreateResource(ctx, &pb.CreateResourceRequest{Name: "Bay 2", ResourceType: "bay"})
+			return err
+		},
+		"UpdateResource": func() error {
+			_, err := srv.UpdateResource(ctx, &pb.UpdateResourceRequest{Id: 1, Name: "Bay 1"})
+			return err
+		},
+		"SetScheduleOverride": func() error {
+			_, err := srv.SetScheduleOverride(ctx, &pb.SetScheduleOverrideRequest{Date: "2026-03-04"})
+			return err
+		},
+		"RemoveScheduleOverride": func() error {
+			_, err := srv.RemoveScheduleOverride(ctx, &pb.RemoveScheduleOverrideRequest{Date: "2026-03-04"})
+			return err
+		},
+		"SetResourceHours": func() error {
+			_, err := srv.SetResourceHours(ctx, &pb.SetResourceHoursRequest{ResourceId: 1, DayOfWeek: 1})
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, codes.PermissionDenied, status.Code(call()))
+		})
+	}
+}
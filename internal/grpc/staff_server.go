@@ -0,0 +1,262 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/richardbowden/degrees/internal/dbpg"
+	pb "github.com/richardbowden/degrees/internal/pb/degrees/v1"
+	"github.com/richardbowden/degrees/internal/services"
+)
+
+type StaffServiceServer struct {
+	pb.UnimplementedStaffServiceServer
+	staffSvc   *services.StaffService
+	bookingSvc *services.BookingService
+}
+
+func NewStaffServiceServer(staffSvc *services.StaffService, bookingSvc *services.BookingService) *StaffServiceServer {
+	return &StaffServiceServer{
+		staffSvc:   staffSvc,
+		bookingSvc: bookingSvc,
+	}
+}
+
+func (s *StaffServiceServer) ListStaff(ctx context.Context, req *pb.ListStaffRequest) (*pb.ListStaffResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	members, err := s.staffSvc.ListStaff(ctx, userID)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	pbStaff := make([]*pb.StaffMember, len(members))
+	for i, m := range members {
+		pbStaff[i] = staffMemberToProto(m)
+	}
+
+	return &pb.ListStaffResponse{Staff: pbStaff}, nil
+}
+
+func (s *StaffServiceServer) CreateStaff(ctx context.Context, req *pb.CreateStaffRequest) (*pb.CreateStaffResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	st, err := s.staffSvc.CreateStaff(ctx, userID, dbpg.CreateStaffParams{
+		UserID:      req.UserId,
+		DisplayName: req.DisplayName,
+		IsActive:    req.IsActive,
+	}, req.CategoryIds)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.CreateStaffResponse{Staff: staffToProto(st, req.CategoryIds)}, nil
+}
+
+func (s *StaffServiceServer) UpdateStaff(ctx context.Context, req *pb.UpdateStaffRequest) (*pb.UpdateStaffResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	st, err := s.staffSvc.UpdateStaff(ctx, userID, dbpg.UpdateStaffParams{
+		ID:          req.Id,
+		DisplayName: req.DisplayName,
+		IsActive:    req.IsActive,
+	}, req.CategoryIds)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.UpdateStaffResponse{Staff: staffToProto(st, req.CategoryIds)}, nil
+}
+
+func (s *StaffServiceServer) SetStaffRoster(ctx context.Context, req *pb.SetStaffRosterRequest) (*pb.SetStaffRosterResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if req.StaffId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "staff_id is required")
+	}
+
+	if req.Off {
+		if err := s.staffSvc.ClearRoster(ctx, userID, req.StaffId, req.DayOfWeek); err != nil {
+			return nil, ToGRPCError(err)
+		}
+		return &pb.SetStaffRosterResponse{}, nil
+	}
+
+	startTime, err := parseTimeString(req.StartTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid start_time format, expected HH:MM")
+	}
+	endTime, err := parseTimeString(req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid end_time format, expected HH:MM")
+	}
+
+	shift, err := s.staffSvc.SetRoster(ctx, userID, dbpg.UpsertStaffRosterParams{
+		StaffID:   req.StaffId,
+		DayOfWeek: req.DayOfWeek,
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.SetStaffRosterResponse{Shift: rosterShiftToProto(*shift)}, nil
+}
+
+func (s *StaffServiceServer) AddStaffLeave(ctx context.Context, req *pb.AddStaffLeaveRequest) (*pb.AddStaffLeaveResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if req.StaffId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "staff_id is required")
+	}
+
+	leave, err := s.staffSvc.AddLeave(ctx, userID, req.StaffId, req.StartDate, req.EndDate, req.Reason)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.AddStaffLeaveResponse{Leave: staffLeaveToProto(*leave)}, nil
+}
+
+func (s *StaffServiceServer) RemoveStaffLeave(ctx context.Context, req *pb.RemoveStaffLeaveRequest) (*pb.RemoveStaffLeaveResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.staffSvc.RemoveLeave(ctx, userID, req.Id); err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.RemoveStaffLeaveResponse{Success: true}, nil
+}
+
+func (s *StaffServiceServer) AssignBookingStaff(ctx context.Context, req *pb.AssignBookingStaffRequest) (*pb.AssignBookingStaffResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if req.BookingId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "booking_id is required")
+	}
+
+	assigned, err := s.staffSvc.AssignBookingStaff(ctx, userID, req.BookingId, req.StaffIds)
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	return &pb.AssignBookingStaffResponse{AssignedStaff: bookingStaffToProto(assigned)}, nil
+}
+
+func (s *StaffServiceServer) ListMyJobsToday(ctx context.Context, req *pb.ListMyJobsTodayRequest) (*pb.ListMyJobsTodayResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	jobs, err := s.staffSvc.ListMyJobs(ctx, userID, s.bookingSvc.Today(ctx))
+	if err != nil {
+		return nil, ToGRPCError(err)
+	}
+
+	// Each job carries the customer, vehicle and services the detailer needs
+	pbJobs := make([]*pb.Booking, 0, len(jobs))
+	for _, job := range jobs {
+		row, err := s.bookingSvc.GetBookingByID(ctx, job.ID)
+		if err != nil {
+			return nil, ToGRPCError(err)
+		}
+		pbJob := bookingRowToProto(row)
+
+		svcs, err := s.bookingSvc.ListBookingServices(ctx, job.ID)
+		if err == nil {
+			pbJob.Services = bookingServicesToProto(ctx, s.bookingSvc, svcs)
+		}
+		staff, err := s.bookingSvc.ListBookingStaff(ctx, job.ID)
+		if err == nil {
+			pbJob.AssignedStaff = bookingStaffToProto(staff)
+		}
+		pbJobs = append(pbJobs, pbJob)
+	}
+
+	return &pb.ListMyJobsTodayResponse{Jobs: pbJobs}, nil
+}
+
+func staffMemberToProto(m services.StaffMember) *pb.StaffMember {
+	pbMember := &pb.StaffMember{
+		Id:          m.Staff.ID,
+		UserId:      m.Staff.UserID,
+		Email:       m.Staff.Email,
+		DisplayName: m.Staff.DisplayName,
+		IsActive:    m.Staff.IsActive,
+		CategoryIds: m.CategoryIDs,
+	}
+	for _, r := range m.Roster {
+		pbMember.Roster = append(pbMember.Roster, rosterShiftToProto(r))
+	}
+	for _, l := range m.Leave {
+		pbMember.Leave = append(pbMember.Leave, staffLeaveToProto(l))
+	}
+	return pbMember
+}
+
+func staffToProto(st *dbpg.Staff, categoryIDs []int64) *pb.StaffMember {
+	return &pb.StaffMember{
+		Id:          st.ID,
+		UserId:      st.UserID,
+		DisplayName: st.DisplayName,
+		IsActive:    st.IsActive,
+		CategoryIds: categoryIDs,
+	}
+}
+
+func rosterShiftToProto(r dbpg.StaffRoster) *pb.RosterShift {
+	return &pb.RosterShift{
+		DayOfWeek: r.DayOfWeek,
+		StartTime: formatPGTime(r.StartTime),
+		EndTime:   formatPGTime(r.EndTime),
+	}
+}
+
+func staffLeaveToProto(l dbpg.StaffLeave) *pb.StaffLeave {
+	return &pb.StaffLeave{
+		Id:        l.ID,
+		StaffId:   l.StaffID,
+		StartDate: formatPGDate(l.StartDate),
+		EndDate:   formatPGDate(l.EndDate),
+		Reason:    l.Reason.String,
+	}
+}
+
+func bookingStaffToProto(staff []dbpg.ListBookingStaffRow) []*pb.BookingStaffMember {
+	items := make([]*pb.BookingStaffMember, len(staff))
+	for i, st := range staff {
+		items[i] = &pb.BookingStaffMember{
+			StaffId:     st.StaffID,
+			DisplayName: st.DisplayName,
+		}
+	}
+	return items
+}
@@ -0,0 +1,121 @@
+// Package ics writes iCalendar (RFC 5545) calendars of events, as served to
+// calendar apps subscribing to a feed or attached to an email.
+package ics
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event statuses.
+const (
+	StatusTentative = "TENTATIVE"
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Event is one VEVENT. UID must stay the same for the life of the event and
+// Sequence must go up whenever it changes, so calendars replace their copy.
+type Event struct {
+	UID         string
+	Sequence    int32
+	Stamp       time.Time // when the event was last changed
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	Status      string
+}
+
+// Calendar is a VCALENDAR of events.
+type Calendar struct {
+	Name   string
+	Method string // e.g. PUBLISH, set for a calendar attached to an email
+	Events []Event
+}
+
+const timeFormat = "20060102T150405Z"
+
+// Bytes encodes the calendar. Times are written in UTC.
+func (c Calendar) Bytes() []byte {
+	var buf bytes.Buffer
+	w := &writer{buf: &buf}
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", "-//40 Degrees Car Detailing//Bookings//EN")
+	w.line("CALSCALE", "GREGORIAN")
+	if c.Method != "" {
+		w.line("METHOD", c.Method)
+	}
+	if c.Name != "" {
+		w.line("X-WR-CALNAME", escape(c.Name))
+	}
+	for _, e := range c.Events {
+		w.line("BEGIN", "VEVENT")
+		w.line("UID", escape(e.UID))
+		w.line("SEQUENCE", strconv.Itoa(int(e.Sequence)))
+		w.line("DTSTAMP", e.Stamp.UTC().Format(timeFormat))
+		w.line("DTSTART", e.Start.UTC().Format(timeFormat))
+		w.line("DTEND", e.End.UTC().Format(timeFormat))
+		w.line("SUMMARY", escape(e.Summary))
+		if e.Description != "" {
+			w.line("DESCRIPTION", escape(e.Description))
+		}
+		if e.Location != "" {
+			w.line("LOCATION", escape(e.Location))
+		}
+		if e.Status != "" {
+			w.line("STATUS", e.Status)
+		}
+		w.line("END", "VEVENT")
+	}
+	w.line("END", "VCALENDAR")
+	return buf.Bytes()
+}
+
+type writer struct {
+	buf *bytes.Buffer
+}
+
+// maxLineOctets is the longest a content line may be before it is folded.
+const maxLineOctets = 75
+
+// line writes one content line, folding it onto continuation lines that
+// start with a space. Lines are only broken between UTF-8 characters.
+func (w *writer) line(name, value string) {
+	s := name + ":" + value
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !startsRune(s[cut]) {
+			cut--
+		}
+		w.buf.WriteString(s[:cut])
+		w.buf.WriteString("\r\n ")
+		s = s[cut:]
+		limit = maxLineOctets - 1
+	}
+	w.buf.WriteString(s)
+	w.buf.WriteString("\r\n")
+}
+
+// startsRune reports whether b is the first byte of a UTF-8 character.
+func startsRune(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+var escaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// escape escapes a TEXT value.
+func escape(s string) string {
+	return escaper.Replace(s)
+}
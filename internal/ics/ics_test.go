@@ -0,0 +1,46 @@
+package ics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarBytes(t *testing.T) {
+	start := time.Date(2030, 1, 10, 9, 0, 0, 0, time.FixedZone("AWST", 8*60*60))
+	cal := Calendar{
+		Name: "Bookings",
+		Events: []Event{{
+			UID:         "booking-1@example.com",
+			Sequence:    2,
+			Stamp:       start.Add(-time.Hour),
+			Start:       start,
+			End:         start.Add(90 * time.Minute),
+			Summary:     "Full detail; Mazda, CX-5",
+			Description: "Services: Full detail\nCustomer: Sam",
+			Status:      StatusConfirmed,
+		}},
+	}
+	out := string(cal.Bytes())
+
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+	assert.Contains(t, out, "\r\nDTSTART:20300110T010000Z\r\n")
+	assert.Contains(t, out, "\r\nDTEND:20300110T023000Z\r\n")
+	assert.Contains(t, out, "\r\nSEQUENCE:2\r\n")
+	assert.Contains(t, out, `SUMMARY:Full detail\; Mazda\, CX-5`)
+	assert.Contains(t, out, `DESCRIPTION:Services: Full detail\nCustomer: Sam`)
+}
+
+func TestLineFolding(t *testing.T) {
+	long := strings.Repeat("é", 60) // 120 octets
+	out := string(Calendar{Events: []Event{{Summary: long}}}.Bytes())
+
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+	unfolded := strings.ReplaceAll(out, "\r\n ", "")
+	assert.Contains(t, unfolded, "SUMMARY:"+long+"\r\n")
+}
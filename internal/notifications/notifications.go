@@ -2,12 +2,17 @@ package notification
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-chi/httplog"
+	fastmail "github.com/richardbowden/degrees/internal/email/genericsmtp"
 	"github.com/richardbowden/degrees/internal/riverqueue"
 	"github.com/richardbowden/degrees/internal/templater"
 	"github.com/richardbowden/degrees/internal/workers"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
 )
 
 type Notifier struct {
@@ -31,7 +36,7 @@ type EmailData struct {
 }
 
 // SendEmail sends any email notification using the specified template
-func (n *Notifier) SendEmail(ctx context.Context, templateType TemplateType, to []string, subject string, templateData any) error {
+func (n *Notifier) SendEmail(ctx context.Context, templateType TemplateType, to []string, subject string, templateData any, attachments ...fastmail.Attachment) error {
 	log := httplog.LogEntry(ctx)
 
 	var buf strings.Builder
@@ -46,10 +51,11 @@ func (n *Notifier) SendEmail(ctx context.Context, templateType TemplateType, to
 		Msg("sending email notification")
 
 	emailJobArgs := workers.EmailArgs{
-		To:      to,
-		From:    n.fromEmail,
-		Subject: subject,
-		Content: buf.String(),
+		To:          to,
+		From:        n.fromEmail,
+		Subject:     subject,
+		Content:     buf.String(),
+		Attachments: attachments,
 	}
 
 	_, err = n.q.Client().Insert(ctx, emailJobArgs, nil)
@@ -71,13 +77,203 @@ type BookingConfirmationData struct {
 	BookingTime   string
 	DepositAmount int64
 	TotalAmount   int64
+	Deposit       string // DepositAmount formatted as dollars
+	Total         string // TotalAmount formatted as dollars
 }
 
-func (n *Notifier) SendBookingConfirmation(ctx context.Context, to string, bookingDate string, bookingTime string, depositAmount int64, totalAmount int64) error {
+// SendBookingConfirmation emails the customer confirming their booking. A
+// calendar, if given, is attached so the booking can be added to theirs.
+func (n *Notifier) SendBookingConfirmation(ctx context.Context, to string, bookingDate string, bookingTime string, depositAmount int64, totalAmount int64, calendar []byte) error {
+	var attachments []fastmail.Attachment
+	if len(calendar) > 0 {
+		attachments = append(attachments, fastmail.Attachment{
+			Filename:    "booking.ics",
+			ContentType: "text/calendar; charset=UTF-8; method=PUBLISH",
+			Content:     calendar,
+		})
+	}
 	return n.SendEmail(ctx, TPL_BOOKING_CONFIRMATION, []string{to}, "Booking Confirmation - 40 Degrees Car Detailing", BookingConfirmationData{
 		BookingDate:   bookingDate,
 		BookingTime:   bookingTime,
 		DepositAmount: depositAmount,
 		TotalAmount:   totalAmount,
+		Deposit:       formatDollars(depositAmount),
+		Total:         formatDollars(totalAmount),
+	}, attachments...)
+}
+
+// formatDollars formats an amount in cents, e.g. $12.50.
+func formatDollars(cents int64) string {
+	return fmt.Sprintf("$%d.%02d", cents/100, cents%100)
+}
+
+// QueueBookingConfirmation queues a job that emails the customer confirming
+// a new booking.
+func (n *Notifier) QueueBookingConfirmation(ctx context.Context, bookingID int64, to, bookingDate, bookingTime string, depositAmount, totalAmount int64, calendar []byte) error {
+	_, err := n.q.Client().Insert(ctx, workers.BookingConfirmationArgs{
+		BookingID:     bookingID,
+		CustomerEmail: to,
+		BookingDate:   bookingDate,
+		BookingTime:   bookingTime,
+		DepositAmount: depositAmount,
+		TotalAmount:   totalAmount,
+		Calendar:      calendar,
+	}, nil)
+	return err
+}
+
+type BookingReminderData struct {
+	CustomerName string
+	BookingDate  string
+	BookingTime  string
+	Vehicle      string
+	Address      string
+}
+
+func (n *Notifier) SendBookingReminder(ctx context.Context, to string, data BookingReminderData) error {
+	return n.SendEmail(ctx, TPL_BOOKING_REMINDER, []string{to}, "Booking Reminder - 40 Degrees Car Detailing", data)
+}
+
+type BookingFollowUpData struct {
+	CustomerName string
+	BookingDate  string
+	ReviewLink   string
+}
+
+func (n *Notifier) SendBookingFollowUp(ctx context.Context, to string, data BookingFollowUpData) error {
+	return n.SendEmail(ctx, TPL_BOOKING_FOLLOW_UP, []string{to}, "Thanks For Choosing 40 Degrees Car Detailing", data)
+}
+
+// bookingJobMetadata tags a scheduled job with its booking so it can be
+// found and withdrawn if the booking changes.
+func bookingJobMetadata(bookingID int64) []byte {
+	return []byte(fmt.Sprintf(`{"booking_id":%d}`, bookingID))
+}
+
+// ScheduleBookingReminder schedules a job that reminds the customer of a
+// booking starting at bookingDate and bookingTime, run at sendAt.
+func (n *Notifier) ScheduleBookingReminder(ctx context.Context, bookingID int64, bookingDate, bookingTime string, sendAt time.Time) error {
+	_, err := n.q.Client().Insert(ctx, workers.BookingReminderArgs{
+		BookingID: bookingID,
+		Date:      bookingDate,
+		Time:      bookingTime,
+	}, &river.InsertOpts{ScheduledAt: sendAt, Metadata: bookingJobMetadata(bookingID)})
+	return err
+}
+
+// ScheduleBookingFollowUp schedules a job that thanks the customer for a
+// completed booking, run at sendAt.
+func (n *Notifier) ScheduleBookingFollowUp(ctx context.Context, bookingID int64, sendAt time.Time) error {
+	_, err := n.q.Client().Insert(ctx, workers.BookingFollowUpArgs{
+		BookingID: bookingID,
+	}, &river.InsertOpts{ScheduledAt: sendAt, Metadata: bookingJobMetadata(bookingID)})
+	return err
+}
+
+// CancelBookingReminders withdraws a booking's reminder and follow-up jobs
+// that have not run yet.
+func (n *Notifier) CancelBookingReminders(ctx context.Context, bookingID int64) error {
+	params := river.NewJobListParams().
+		Kinds(workers.BookingReminderArgs{}.Kind(), workers.BookingFollowUpArgs{}.Kind()).
+		States(rivertype.JobStateScheduled, rivertype.JobStateAvailable, rivertype.JobStateRetryable).
+		Metadata(string(bookingJobMetadata(bookingID))).
+		First(100)
+
+	res, err := n.q.Client().JobList(ctx, params)
+	if err != nil {
+		return err
+	}
+	for _, job := range res.Jobs {
+		if _, err := n.q.Client().JobCancel(ctx, job.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type BookingRescheduledData struct {
+	CustomerName string
+	OldDate      string
+	OldTime      string
+	NewDate      string
+	NewTime      string
+}
+
+func (n *Notifier) SendBookingRescheduled(ctx context.Context, to, customerName, oldDate, oldTime, newDate, newTime string) error {
+	return n.SendEmail(ctx, TPL_BOOKING_RESCHEDULED, []string{to}, "Booking Rescheduled - 40 Degrees Car Detailing", BookingRescheduledData{
+		CustomerName: customerName,
+		OldDate:      oldDate,
+		OldTime:      oldTime,
+		NewDate:      newDate,
+		NewTime:      newTime,
+	})
+}
+
+// QueueBookingRescheduled queues a job that emails the customer about a
+// booking moving to a new date/time.
+func (n *Notifier) QueueBookingRescheduled(ctx context.Context, bookingID int64, to, customerName, oldDate, oldTime, newDate, newTime string) error {
+	_, err := n.q.Client().Insert(ctx, workers.BookingRescheduledArgs{
+		BookingID:     bookingID,
+		CustomerEmail: to,
+		CustomerName:  customerName,
+		OldDate:       oldDate,
+		OldTime:       oldTime,
+		NewDate:       newDate,
+		NewTime:       newTime,
+	}, nil)
+	return err
+}
+
+type BookingRefundedData struct {
+	CustomerName string
+	BookingDate  string
+	Amount       string
+}
+
+func (n *Notifier) SendBookingRefunded(ctx context.Context, to, customerName, bookingDate string, amount int64) error {
+	return n.SendEmail(ctx, TPL_BOOKING_REFUNDED, []string{to}, "Refund Processed - 40 Degrees Car Detailing", BookingRefundedData{
+		CustomerName: customerName,
+		BookingDate:  bookingDate,
+		Amount:       formatDollars(amount),
+	})
+}
+
+// QueueBookingRefunded queues a job that emails the customer confirming a
+// refund has gone through.
+func (n *Notifier) QueueBookingRefunded(ctx context.Context, bookingID int64, to, customerName, bookingDate string, amount int64) error {
+	_, err := n.q.Client().Insert(ctx, workers.BookingRefundedArgs{
+		BookingID:     bookingID,
+		CustomerEmail: to,
+		CustomerName:  customerName,
+		BookingDate:   bookingDate,
+		Amount:        amount,
+	}, nil)
+	return err
+}
+
+type WaitlistOfferData struct {
+	CustomerName string
+	Date         string
+	Time         string
+	ExpiresAt    string
+	ClaimLink    string
+}
+
+// SendWaitlistOffer emails a waitlisted customer that a slot is being held
+// for them and how to claim it.
+func (n *Notifier) SendWaitlistOffer(ctx context.Context, to, customerName, date, time, expiresAt, claimLink string) error {
+	return n.SendEmail(ctx, TPL_WAITLIST_SLOT_AVAILABLE, []string{to}, "A Slot Has Opened Up - 40 Degrees Car Detailing", WaitlistOfferData{
+		CustomerName: customerName,
+		Date:         date,
+		Time:         time,
+		ExpiresAt:    expiresAt,
+		ClaimLink:    claimLink,
 	})
 }
+
+// QueueWaitlistCheck queues a job that offers any free slots on date to
+// customers on the waitlist.
+func (n *Notifier) QueueWaitlistCheck(ctx context.Context, date string) error {
+	_, err := n.q.Client().Insert(ctx, workers.WaitlistCheckArgs{Date: date}, nil)
+	return err
+}
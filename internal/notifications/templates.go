@@ -7,6 +7,11 @@ const (
 	TPL_SYSTEM_VERIFY_EMAIL_ADDRESS TemplateType = "system-verify-email-address"
 	TPL_SYSTEM_PASSWORD_RESET       TemplateType = "system-password-reset"
 	TPL_BOOKING_CONFIRMATION        TemplateType = "booking-confirmation"
+	TPL_BOOKING_RESCHEDULED         TemplateType = "booking-rescheduled"
+	TPL_WAITLIST_SLOT_AVAILABLE     TemplateType = "waitlist-slot-available"
+	TPL_BOOKING_REMINDER            TemplateType = "booking-reminder"
+	TPL_BOOKING_FOLLOW_UP           TemplateType = "booking-follow-up"
+	TPL_BOOKING_REFUNDED            TemplateType = "booking-refunded"
 )
 
 func (s TemplateType) String() string {
@@ -42,8 +42,26 @@ type Booking struct {
 	Vehicle               *BookingVehicleInfo    `protobuf:"bytes,15,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
 	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	StatusHistory         []*BookingStatusChange `protobuf:"bytes,18,rep,name=status_history,json=statusHistory,proto3" json:"status_history,omitempty"`
+	// Owed back under the cancellation policy when the customer cancelled
+	RefundAmount  int64                 `protobuf:"varint,19,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	ResourceId    int64                 `protobuf:"varint,20,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	AssignedStaff []*BookingStaffMember `protobuf:"bytes,21,rep,name=assigned_staff,json=assignedStaff,proto3" json:"assigned_staff,omitempty"`
+	SeriesId      int64                 `protobuf:"varint,22,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// Set for mobile jobs done at the customer's address
+	ServiceLocation  *ServiceLocation `protobuf:"bytes,23,opt,name=service_location,json=serviceLocation,proto3" json:"service_location,omitempty"`
+	TravelDistanceKm float64          `protobuf:"fixed64,24,opt,name=travel_distance_km,json=travelDistanceKm,proto3" json:"travel_distance_km,omitempty"`
+	TravelSurcharge  int64            `protobuf:"varint,25,opt,name=travel_surcharge,json=travelSurcharge,proto3" json:"travel_surcharge,omitempty"`
+	// Paid amount kept when the customer did not turn up
+	ForfeitedAmount int64 `protobuf:"varint,26,opt,name=forfeited_amount,json=forfeitedAmount,proto3" json:"forfeited_amount,omitempty"`
+	// Staff user who entered the booking for the customer, if any
+	CreatedBy int64 `protobuf:"varint,27,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Net of tips and refunds, as the booking's payment ledger adds up
+	AmountPaid int64 `protobuf:"varint,28,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	// total_amount less amount_paid; negative when the customer is owed money
+	BalanceDue    int64 `protobuf:"varint,29,opt,name=balance_due,json=balanceDue,proto3" json:"balance_due,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Booking) Reset() {
@@ -195,6 +213,151 @@ func (x *Booking) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Booking) GetStatusHistory() []*BookingStatusChange {
+	if x != nil {
+		return x.StatusHistory
+	}
+	return nil
+}
+
+func (x *Booking) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+func (x *Booking) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *Booking) GetAssignedStaff() []*BookingStaffMember {
+	if x != nil {
+		return x.AssignedStaff
+	}
+	return nil
+}
+
+func (x *Booking) GetSeriesId() int64 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *Booking) GetServiceLocation() *ServiceLocation {
+	if x != nil {
+		return x.ServiceLocation
+	}
+	return nil
+}
+
+func (x *Booking) GetTravelDistanceKm() float64 {
+	if x != nil {
+		return x.TravelDistanceKm
+	}
+	return 0
+}
+
+func (x *Booking) GetTravelSurcharge() int64 {
+	if x != nil {
+		return x.TravelSurcharge
+	}
+	return 0
+}
+
+func (x *Booking) GetForfeitedAmount() int64 {
+	if x != nil {
+		return x.ForfeitedAmount
+	}
+	return 0
+}
+
+func (x *Booking) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Booking) GetAmountPaid() int64 {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return 0
+}
+
+func (x *Booking) GetBalanceDue() int64 {
+	if x != nil {
+		return x.BalanceDue
+	}
+	return 0
+}
+
+// Where a mobile job is done.
+type ServiceLocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Suburb        string                 `protobuf:"bytes,2,opt,name=suburb,proto3" json:"suburb,omitempty"`
+	Postcode      string                 `protobuf:"bytes,3,opt,name=postcode,proto3" json:"postcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceLocation) Reset() {
+	*x = ServiceLocation{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceLocation) ProtoMessage() {}
+
+func (x *ServiceLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceLocation.ProtoReflect.Descriptor instead.
+func (*ServiceLocation) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServiceLocation) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ServiceLocation) GetSuburb() string {
+	if x != nil {
+		return x.Suburb
+	}
+	return ""
+}
+
+func (x *ServiceLocation) GetPostcode() string {
+	if x != nil {
+		return x.Postcode
+	}
+	return ""
+}
+
 type BookingCustomerInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -206,7 +369,7 @@ type BookingCustomerInfo struct {
 
 func (x *BookingCustomerInfo) Reset() {
 	*x = BookingCustomerInfo{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[1]
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -218,7 +381,7 @@ func (x *BookingCustomerInfo) String() string {
 func (*BookingCustomerInfo) ProtoMessage() {}
 
 func (x *BookingCustomerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[1]
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -231,7 +394,7 @@ func (x *BookingCustomerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BookingCustomerInfo.ProtoReflect.Descriptor instead.
 func (*BookingCustomerInfo) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{1}
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *BookingCustomerInfo) GetUserId() int64 {
@@ -266,7 +429,7 @@ type BookingVehicleInfo struct {
 
 func (x *BookingVehicleInfo) Reset() {
 	*x = BookingVehicleInfo{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[2]
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +441,7 @@ func (x *BookingVehicleInfo) String() string {
 func (*BookingVehicleInfo) ProtoMessage() {}
 
 func (x *BookingVehicleInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[2]
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +454,7 @@ func (x *BookingVehicleInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BookingVehicleInfo.ProtoReflect.Descriptor instead.
 func (*BookingVehicleInfo) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{2}
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BookingVehicleInfo) GetMake() string {
@@ -329,7 +492,7 @@ type BookingServiceItem struct {
 
 func (x *BookingServiceItem) Reset() {
 	*x = BookingServiceItem{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[3]
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -341,7 +504,7 @@ func (x *BookingServiceItem) String() string {
 func (*BookingServiceItem) ProtoMessage() {}
 
 func (x *BookingServiceItem) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[3]
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,7 +517,7 @@ func (x *BookingServiceItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BookingServiceItem.ProtoReflect.Descriptor instead.
 func (*BookingServiceItem) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{3}
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BookingServiceItem) GetId() int64 {
@@ -411,7 +574,7 @@ type BookingServiceOptionItem struct {
 
 func (x *BookingServiceOptionItem) Reset() {
 	*x = BookingServiceOptionItem{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[4]
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -423,7 +586,7 @@ func (x *BookingServiceOptionItem) String() string {
 func (*BookingServiceOptionItem) ProtoMessage() {}
 
 func (x *BookingServiceOptionItem) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[4]
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -436,7 +599,7 @@ func (x *BookingServiceOptionItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BookingServiceOptionItem.ProtoReflect.Descriptor instead.
 func (*BookingServiceOptionItem) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{4}
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BookingServiceOptionItem) GetId() int64 {
@@ -467,30 +630,30 @@ func (x *BookingServiceOptionItem) GetPriceAtBooking() int64 {
 	return 0
 }
 
-type AvailableSlot struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Date                  string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Time                  string                 `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
-	AvailableDurationMins int32                  `protobuf:"varint,3,opt,name=available_duration_mins,json=availableDurationMins,proto3" json:"available_duration_mins,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+// A detailer assigned to a booking.
+type BookingStaffMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StaffId       int64                  `protobuf:"varint,1,opt,name=staff_id,json=staffId,proto3" json:"staff_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AvailableSlot) Reset() {
-	*x = AvailableSlot{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[5]
+func (x *BookingStaffMember) Reset() {
+	*x = BookingStaffMember{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AvailableSlot) String() string {
+func (x *BookingStaffMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailableSlot) ProtoMessage() {}
+func (*BookingStaffMember) ProtoMessage() {}
 
-func (x *AvailableSlot) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[5]
+func (x *BookingStaffMember) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -501,57 +664,55 @@ func (x *AvailableSlot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailableSlot.ProtoReflect.Descriptor instead.
-func (*AvailableSlot) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use BookingStaffMember.ProtoReflect.Descriptor instead.
+func (*BookingStaffMember) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AvailableSlot) GetDate() string {
+func (x *BookingStaffMember) GetStaffId() int64 {
 	if x != nil {
-		return x.Date
+		return x.StaffId
 	}
-	return ""
+	return 0
 }
 
-func (x *AvailableSlot) GetTime() string {
+func (x *BookingStaffMember) GetDisplayName() string {
 	if x != nil {
-		return x.Time
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *AvailableSlot) GetAvailableDurationMins() int32 {
-	if x != nil {
-		return x.AvailableDurationMins
-	}
-	return 0
-}
-
-type CreateBookingFromCartRequest struct {
+// A single entry in a booking's status timeline. field is either "status" or
+// "payment_status"; changed_by is 0 for system changes such as payment webhooks.
+type BookingStatusChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VehicleId     int64                  `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
-	ScheduledDate string                 `protobuf:"bytes,2,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"`
-	ScheduledTime string                 `protobuf:"bytes,3,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
-	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	FromValue     string                 `protobuf:"bytes,3,opt,name=from_value,json=fromValue,proto3" json:"from_value,omitempty"`
+	ToValue       string                 `protobuf:"bytes,4,opt,name=to_value,json=toValue,proto3" json:"to_value,omitempty"`
+	ChangedBy     int64                  `protobuf:"varint,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBookingFromCartRequest) Reset() {
-	*x = CreateBookingFromCartRequest{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[6]
+func (x *BookingStatusChange) Reset() {
+	*x = BookingStatusChange{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBookingFromCartRequest) String() string {
+func (x *BookingStatusChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBookingFromCartRequest) ProtoMessage() {}
+func (*BookingStatusChange) ProtoMessage() {}
 
-func (x *CreateBookingFromCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[6]
+func (x *BookingStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,61 +723,86 @@ func (x *CreateBookingFromCartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBookingFromCartRequest.ProtoReflect.Descriptor instead.
-func (*CreateBookingFromCartRequest) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BookingStatusChange.ProtoReflect.Descriptor instead.
+func (*BookingStatusChange) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateBookingFromCartRequest) GetVehicleId() int64 {
+func (x *BookingStatusChange) GetId() int64 {
 	if x != nil {
-		return x.VehicleId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *CreateBookingFromCartRequest) GetScheduledDate() string {
+func (x *BookingStatusChange) GetField() string {
 	if x != nil {
-		return x.ScheduledDate
+		return x.Field
 	}
 	return ""
 }
 
-func (x *CreateBookingFromCartRequest) GetScheduledTime() string {
+func (x *BookingStatusChange) GetFromValue() string {
 	if x != nil {
-		return x.ScheduledTime
+		return x.FromValue
 	}
 	return ""
 }
 
-func (x *CreateBookingFromCartRequest) GetNotes() string {
+func (x *BookingStatusChange) GetToValue() string {
 	if x != nil {
-		return x.Notes
+		return x.ToValue
 	}
 	return ""
 }
 
-type CreateBookingFromCartResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BookingStatusChange) GetChangedBy() int64 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
 }
 
-func (x *CreateBookingFromCartResponse) Reset() {
-	*x = CreateBookingFromCartResponse{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[7]
+func (x *BookingStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// One window of the cancellation policy: cancelling at least min_hours_before
+// the scheduled start refunds refund_percent of the amount paid.
+type CancellationPolicyTier struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MinHoursBefore int32                  `protobuf:"varint,1,opt,name=min_hours_before,json=minHoursBefore,proto3" json:"min_hours_before,omitempty"`
+	RefundPercent  int32                  `protobuf:"varint,2,opt,name=refund_percent,json=refundPercent,proto3" json:"refund_percent,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancellationPolicyTier) Reset() {
+	*x = CancellationPolicyTier{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBookingFromCartResponse) String() string {
+func (x *CancellationPolicyTier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBookingFromCartResponse) ProtoMessage() {}
+func (*CancellationPolicyTier) ProtoMessage() {}
 
-func (x *CreateBookingFromCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[7]
+func (x *CancellationPolicyTier) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,41 +813,913 @@ func (x *CreateBookingFromCartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBookingFromCartResponse.ProtoReflect.Descriptor instead.
-func (*CreateBookingFromCartResponse) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CancellationPolicyTier.ProtoReflect.Descriptor instead.
+func (*CancellationPolicyTier) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateBookingFromCartResponse) GetBooking() *Booking {
+func (x *CancellationPolicyTier) GetMinHoursBefore() int32 {
 	if x != nil {
-		return x.Booking
+		return x.MinHoursBefore
 	}
-	return nil
+	return 0
 }
 
-type GetAvailableSlotsRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Date            string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	DurationMinutes int32                  `protobuf:"varint,2,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *CancellationPolicyTier) GetRefundPercent() int32 {
+	if x != nil {
+		return x.RefundPercent
+	}
+	return 0
 }
 
-func (x *GetAvailableSlotsRequest) Reset() {
-	*x = GetAvailableSlotsRequest{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[8]
+func (x *CancellationPolicyTier) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// A recurring booking series. rrule is an RFC 5545 rule such as
+// "FREQ=WEEKLY;INTERVAL=2;COUNT=6"; status is active, cancelled or ended.
+type BookingSeries struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rrule             string                 `protobuf:"bytes,2,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	StartDate         string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	ScheduledTime     string                 `protobuf:"bytes,4,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	Status            string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	TemplateBookingId int64                  `protobuf:"varint,6,opt,name=template_booking_id,json=templateBookingId,proto3" json:"template_booking_id,omitempty"`
+	Occurrences       []*SeriesOccurrence    `protobuf:"bytes,7,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BookingSeries) Reset() {
+	*x = BookingSeries{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvailableSlotsRequest) String() string {
+func (x *BookingSeries) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableSlotsRequest) ProtoMessage() {}
+func (*BookingSeries) ProtoMessage() {}
 
-func (x *GetAvailableSlotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[8]
+func (x *BookingSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingSeries.ProtoReflect.Descriptor instead.
+func (*BookingSeries) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BookingSeries) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BookingSeries) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *BookingSeries) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *BookingSeries) GetScheduledTime() string {
+	if x != nil {
+		return x.ScheduledTime
+	}
+	return ""
+}
+
+func (x *BookingSeries) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BookingSeries) GetTemplateBookingId() int64 {
+	if x != nil {
+		return x.TemplateBookingId
+	}
+	return 0
+}
+
+func (x *BookingSeries) GetOccurrences() []*SeriesOccurrence {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+func (x *BookingSeries) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// What happened to one date of a series: booked, skipped, blocked (closed or
+// blacked out) or conflict (already taken). reason explains blocked and
+// conflict dates.
+type SeriesOccurrence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	BookingId     int64                  `protobuf:"varint,3,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesOccurrence) Reset() {
+	*x = SeriesOccurrence{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesOccurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesOccurrence) ProtoMessage() {}
+
+func (x *SeriesOccurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesOccurrence.ProtoReflect.Descriptor instead.
+func (*SeriesOccurrence) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SeriesOccurrence) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SeriesOccurrence) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SeriesOccurrence) GetBookingId() int64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *SeriesOccurrence) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// A customer's place on the waitlist for a date range. status is waiting,
+// offered, claimed, expired or cancelled. While offered, the slot in
+// offered_date/offered_time is held until offer_expires_at.
+type WaitlistEntry struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VehicleId             int64                  `protobuf:"varint,2,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	DateFrom              string                 `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo                string                 `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	EstimatedDurationMins int32                  `protobuf:"varint,5,opt,name=estimated_duration_mins,json=estimatedDurationMins,proto3" json:"estimated_duration_mins,omitempty"`
+	TotalAmount           int64                  `protobuf:"varint,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Status                string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	OfferedDate           string                 `protobuf:"bytes,8,opt,name=offered_date,json=offeredDate,proto3" json:"offered_date,omitempty"`
+	OfferedTime           string                 `protobuf:"bytes,9,opt,name=offered_time,json=offeredTime,proto3" json:"offered_time,omitempty"`
+	OfferExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=offer_expires_at,json=offerExpiresAt,proto3" json:"offer_expires_at,omitempty"`
+	BookingId             int64                  `protobuf:"varint,11,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set for mobile jobs done at the customer's address
+	ServiceLocation *ServiceLocation `protobuf:"bytes,13,opt,name=service_location,json=serviceLocation,proto3" json:"service_location,omitempty"`
+	TravelSurcharge int64            `protobuf:"varint,14,opt,name=travel_surcharge,json=travelSurcharge,proto3" json:"travel_surcharge,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WaitlistEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetVehicleId() int64 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetEstimatedDurationMins() int32 {
+	if x != nil {
+		return x.EstimatedDurationMins
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetOfferedDate() string {
+	if x != nil {
+		return x.OfferedDate
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetOfferedTime() string {
+	if x != nil {
+		return x.OfferedTime
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetOfferExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OfferExpiresAt
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetBookingId() int64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetServiceLocation() *ServiceLocation {
+	if x != nil {
+		return x.ServiceLocation
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetTravelSurcharge() int64 {
+	if x != nil {
+		return x.TravelSurcharge
+	}
+	return 0
+}
+
+type AvailableSlot struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Date                  string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Time                  string                 `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	AvailableDurationMins int32                  `protobuf:"varint,3,opt,name=available_duration_mins,json=availableDurationMins,proto3" json:"available_duration_mins,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AvailableSlot) Reset() {
+	*x = AvailableSlot{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailableSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableSlot) ProtoMessage() {}
+
+func (x *AvailableSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableSlot.ProtoReflect.Descriptor instead.
+func (*AvailableSlot) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AvailableSlot) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AvailableSlot) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *AvailableSlot) GetAvailableDurationMins() int32 {
+	if x != nil {
+		return x.AvailableDurationMins
+	}
+	return 0
+}
+
+// Availability summary for one date. status is open (slots available),
+// full (trading but no slot fits the job) or closed (not trading, blacked
+// out or inside the notice period).
+type CalendarDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	EarliestSlot  string                 `protobuf:"bytes,3,opt,name=earliest_slot,json=earliestSlot,proto3" json:"earliest_slot,omitempty"`
+	SlotCount     int32                  `protobuf:"varint,4,opt,name=slot_count,json=slotCount,proto3" json:"slot_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarDay) Reset() {
+	*x = CalendarDay{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarDay) ProtoMessage() {}
+
+func (x *CalendarDay) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarDay.ProtoReflect.Descriptor instead.
+func (*CalendarDay) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CalendarDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetEarliestSlot() string {
+	if x != nil {
+		return x.EarliestSlot
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetSlotCount() int32 {
+	if x != nil {
+		return x.SlotCount
+	}
+	return 0
+}
+
+type CreateBookingFromCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VehicleId     int64                  `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	ScheduledDate string                 `protobuf:"bytes,2,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"`
+	ScheduledTime string                 `protobuf:"bytes,3,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Optional RRULE making this booking the first of a weekly, fortnightly or
+	// monthly series, e.g. "FREQ=WEEKLY;INTERVAL=2;COUNT=6". COUNT or UNTIL is required.
+	Recurrence string `protobuf:"bytes,5,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Set to have the job done at this address by a mobile van instead of at
+	// the workshop. The postcode must be inside the service area.
+	ServiceLocation *ServiceLocation `protobuf:"bytes,6,opt,name=service_location,json=serviceLocation,proto3" json:"service_location,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateBookingFromCartRequest) Reset() {
+	*x = CreateBookingFromCartRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingFromCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingFromCartRequest) ProtoMessage() {}
+
+func (x *CreateBookingFromCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingFromCartRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingFromCartRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateBookingFromCartRequest) GetVehicleId() int64 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *CreateBookingFromCartRequest) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return ""
+}
+
+func (x *CreateBookingFromCartRequest) GetScheduledTime() string {
+	if x != nil {
+		return x.ScheduledTime
+	}
+	return ""
+}
+
+func (x *CreateBookingFromCartRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateBookingFromCartRequest) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *CreateBookingFromCartRequest) GetServiceLocation() *ServiceLocation {
+	if x != nil {
+		return x.ServiceLocation
+	}
+	return nil
+}
+
+type CreateBookingFromCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingFromCartResponse) Reset() {
+	*x = CreateBookingFromCartResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingFromCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingFromCartResponse) ProtoMessage() {}
+
+func (x *CreateBookingFromCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingFromCartResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookingFromCartResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateBookingFromCartResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+// A slot held for the caller while they pay. Other customers cannot book it
+// until expires_at; checking out the cart in it turns it into the booking.
+type CheckoutHold struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledDate   string                 `protobuf:"bytes,1,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"`
+	ScheduledTime   string                 `protobuf:"bytes,2,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckoutHold) Reset() {
+	*x = CheckoutHold{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutHold) ProtoMessage() {}
+
+func (x *CheckoutHold) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutHold.ProtoReflect.Descriptor instead.
+func (*CheckoutHold) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CheckoutHold) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return ""
+}
+
+func (x *CheckoutHold) GetScheduledTime() string {
+	if x != nil {
+		return x.ScheduledTime
+	}
+	return ""
+}
+
+func (x *CheckoutHold) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *CheckoutHold) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type HoldCheckoutSlotRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	VehicleId       int64                  `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	ScheduledDate   string                 `protobuf:"bytes,2,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"`
+	ScheduledTime   string                 `protobuf:"bytes,3,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	ServiceLocation *ServiceLocation       `protobuf:"bytes,4,opt,name=service_location,json=serviceLocation,proto3" json:"service_location,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HoldCheckoutSlotRequest) Reset() {
+	*x = HoldCheckoutSlotRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldCheckoutSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldCheckoutSlotRequest) ProtoMessage() {}
+
+func (x *HoldCheckoutSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldCheckoutSlotRequest.ProtoReflect.Descriptor instead.
+func (*HoldCheckoutSlotRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HoldCheckoutSlotRequest) GetVehicleId() int64 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *HoldCheckoutSlotRequest) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return ""
+}
+
+func (x *HoldCheckoutSlotRequest) GetScheduledTime() string {
+	if x != nil {
+		return x.ScheduledTime
+	}
+	return ""
+}
+
+func (x *HoldCheckoutSlotRequest) GetServiceLocation() *ServiceLocation {
+	if x != nil {
+		return x.ServiceLocation
+	}
+	return nil
+}
+
+type HoldCheckoutSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *CheckoutHold          `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldCheckoutSlotResponse) Reset() {
+	*x = HoldCheckoutSlotResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldCheckoutSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldCheckoutSlotResponse) ProtoMessage() {}
+
+func (x *HoldCheckoutSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldCheckoutSlotResponse.ProtoReflect.Descriptor instead.
+func (*HoldCheckoutSlotResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HoldCheckoutSlotResponse) GetHold() *CheckoutHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type ReleaseCheckoutHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseCheckoutHoldRequest) Reset() {
+	*x = ReleaseCheckoutHoldRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseCheckoutHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCheckoutHoldRequest) ProtoMessage() {}
+
+func (x *ReleaseCheckoutHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCheckoutHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseCheckoutHoldRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{19}
+}
+
+type ReleaseCheckoutHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseCheckoutHoldResponse) Reset() {
+	*x = ReleaseCheckoutHoldResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseCheckoutHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseCheckoutHoldResponse) ProtoMessage() {}
+
+func (x *ReleaseCheckoutHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseCheckoutHoldResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseCheckoutHoldResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReleaseCheckoutHoldResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetAvailableSlotsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Date  string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Defaults to the combined duration of service_ids
+	DurationMinutes int32 `protobuf:"varint,2,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	// Only offer slots on a bay or van able to perform all of these services
+	ServiceIds []int64 `protobuf:"varint,3,rep,packed,name=service_ids,json=serviceIds,proto3" json:"service_ids,omitempty"`
+	// Offer slots for a mobile job at this postcode, allowing travel time
+	// between neighbouring mobile jobs
+	Postcode string `protobuf:"bytes,4,opt,name=postcode,proto3" json:"postcode,omitempty"`
+	// Times the services for a vehicle of this category
+	VehicleCategoryId int64 `protobuf:"varint,5,opt,name=vehicle_category_id,json=vehicleCategoryId,proto3" json:"vehicle_category_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetAvailableSlotsRequest) Reset() {
+	*x = GetAvailableSlotsRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableSlotsRequest) ProtoMessage() {}
+
+func (x *GetAvailableSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,45 +1732,2365 @@ func (x *GetAvailableSlotsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAvailableSlotsRequest.ProtoReflect.Descriptor instead.
 func (*GetAvailableSlotsRequest) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{8}
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAvailableSlotsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetAvailableSlotsRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *GetAvailableSlotsRequest) GetServiceIds() []int64 {
+	if x != nil {
+		return x.ServiceIds
+	}
+	return nil
+}
+
+func (x *GetAvailableSlotsRequest) GetPostcode() string {
+	if x != nil {
+		return x.Postcode
+	}
+	return ""
+}
+
+func (x *GetAvailableSlotsRequest) GetVehicleCategoryId() int64 {
+	if x != nil {
+		return x.VehicleCategoryId
+	}
+	return 0
+}
+
+type GetAvailableSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*AvailableSlot       `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableSlotsResponse) Reset() {
+	*x = GetAvailableSlotsResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableSlotsResponse) ProtoMessage() {}
+
+func (x *GetAvailableSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableSlotsResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailableSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetAvailableSlotsResponse) GetSlots() []*AvailableSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type GetAvailabilityCalendarRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	DateFrom   string                 `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // YYYY-MM-DD
+	DateTo     string                 `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // YYYY-MM-DD, inclusive, at most 62 days after date_from
+	ServiceIds []int64                `protobuf:"varint,3,rep,packed,name=service_ids,json=serviceIds,proto3" json:"service_ids,omitempty"`
+	// Times the services for a vehicle of this category
+	VehicleCategoryId int64 `protobuf:"varint,4,opt,name=vehicle_category_id,json=vehicleCategoryId,proto3" json:"vehicle_category_id,omitempty"`
+	// Defaults to the combined duration of service_ids
+	DurationMinutes int32 `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	// Set for a mobile job at this postcode
+	Postcode      string `protobuf:"bytes,6,opt,name=postcode,proto3" json:"postcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityCalendarRequest) Reset() {
+	*x = GetAvailabilityCalendarRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityCalendarRequest) ProtoMessage() {}
+
+func (x *GetAvailabilityCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAvailabilityCalendarRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetAvailabilityCalendarRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetAvailabilityCalendarRequest) GetServiceIds() []int64 {
+	if x != nil {
+		return x.ServiceIds
+	}
+	return nil
+}
+
+func (x *GetAvailabilityCalendarRequest) GetVehicleCategoryId() int64 {
+	if x != nil {
+		return x.VehicleCategoryId
+	}
+	return 0
+}
+
+func (x *GetAvailabilityCalendarRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *GetAvailabilityCalendarRequest) GetPostcode() string {
+	if x != nil {
+		return x.Postcode
+	}
+	return ""
+}
+
+type GetAvailabilityCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*CalendarDay         `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityCalendarResponse) Reset() {
+	*x = GetAvailabilityCalendarResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityCalendarResponse) ProtoMessage() {}
+
+func (x *GetAvailabilityCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetAvailabilityCalendarResponse) GetDays() []*CalendarDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type CheckServiceAreaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Postcode      string                 `protobuf:"bytes,1,opt,name=postcode,proto3" json:"postcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckServiceAreaRequest) Reset() {
+	*x = CheckServiceAreaRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckServiceAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckServiceAreaRequest) ProtoMessage() {}
+
+func (x *CheckServiceAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckServiceAreaRequest.ProtoReflect.Descriptor instead.
+func (*CheckServiceAreaRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CheckServiceAreaRequest) GetPostcode() string {
+	if x != nil {
+		return x.Postcode
+	}
+	return ""
+}
+
+// Whether mobile jobs are available at a postcode and what travel there
+// costs. reason explains why a postcode is not covered.
+type CheckServiceAreaResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InArea          bool                   `protobuf:"varint,1,opt,name=in_area,json=inArea,proto3" json:"in_area,omitempty"`
+	Suburb          string                 `protobuf:"bytes,2,opt,name=suburb,proto3" json:"suburb,omitempty"`
+	DistanceKm      float64                `protobuf:"fixed64,3,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	TravelSurcharge int64                  `protobuf:"varint,4,opt,name=travel_surcharge,json=travelSurcharge,proto3" json:"travel_surcharge,omitempty"`
+	Reason          string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckServiceAreaResponse) Reset() {
+	*x = CheckServiceAreaResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckServiceAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckServiceAreaResponse) ProtoMessage() {}
+
+func (x *CheckServiceAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckServiceAreaResponse.ProtoReflect.Descriptor instead.
+func (*CheckServiceAreaResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CheckServiceAreaResponse) GetInArea() bool {
+	if x != nil {
+		return x.InArea
+	}
+	return false
+}
+
+func (x *CheckServiceAreaResponse) GetSuburb() string {
+	if x != nil {
+		return x.Suburb
+	}
+	return ""
+}
+
+func (x *CheckServiceAreaResponse) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *CheckServiceAreaResponse) GetTravelSurcharge() int64 {
+	if x != nil {
+		return x.TravelSurcharge
+	}
+	return 0
+}
+
+func (x *CheckServiceAreaResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListMyBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyBookingsRequest) Reset() {
+	*x = ListMyBookingsRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBookingsRequest) ProtoMessage() {}
+
+func (x *ListMyBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{27}
+}
+
+type ListMyBookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyBookingsResponse) Reset() {
+	*x = ListMyBookingsResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBookingsResponse) ProtoMessage() {}
+
+func (x *ListMyBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListMyBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+type GetMyBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyBookingRequest) Reset() {
+	*x = GetMyBookingRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyBookingRequest) ProtoMessage() {}
+
+func (x *GetMyBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetMyBookingRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetMyBookingRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetMyBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyBookingResponse) Reset() {
+	*x = GetMyBookingResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyBookingResponse) ProtoMessage() {}
+
+func (x *GetMyBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyBookingResponse.ProtoReflect.Descriptor instead.
+func (*GetMyBookingResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetMyBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type GetCancellationPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCancellationPolicyRequest) Reset() {
+	*x = GetCancellationPolicyRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCancellationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCancellationPolicyRequest) ProtoMessage() {}
+
+func (x *GetCancellationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCancellationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetCancellationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{31}
+}
+
+type GetCancellationPolicyResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Tiers         []*CancellationPolicyTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCancellationPolicyResponse) Reset() {
+	*x = GetCancellationPolicyResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCancellationPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCancellationPolicyResponse) ProtoMessage() {}
+
+func (x *GetCancellationPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCancellationPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetCancellationPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetCancellationPolicyResponse) GetTiers() []*CancellationPolicyTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelBookingRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CancelBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *CancelBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RescheduleBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduledDate string                 `protobuf:"bytes,2,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"` // YYYY-MM-DD
+	ScheduledTime string                 `protobuf:"bytes,3,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"` // HH:MM
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleBookingRequest) Reset() {
+	*x = RescheduleBookingRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleBookingRequest) ProtoMessage() {}
+
+func (x *RescheduleBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleBookingRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleBookingRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RescheduleBookingRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RescheduleBookingRequest) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return ""
+}
+
+func (x *RescheduleBookingRequest) GetScheduledTime() string {
+	if x != nil {
+		return x.ScheduledTime
+	}
+	return ""
+}
+
+type RescheduleBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleBookingResponse) Reset() {
+	*x = RescheduleBookingResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleBookingResponse) ProtoMessage() {}
+
+func (x *RescheduleBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleBookingResponse.ProtoReflect.Descriptor instead.
+func (*RescheduleBookingResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RescheduleBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type ListMyBookingSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyBookingSeriesRequest) Reset() {
+	*x = ListMyBookingSeriesRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBookingSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBookingSeriesRequest) ProtoMessage() {}
+
+func (x *ListMyBookingSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBookingSeriesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyBookingSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{37}
+}
+
+type ListMyBookingSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*BookingSeries       `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyBookingSeriesResponse) Reset() {
+	*x = ListMyBookingSeriesResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBookingSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBookingSeriesResponse) ProtoMessage() {}
+
+func (x *ListMyBookingSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBookingSeriesResponse.ProtoReflect.Descriptor instead.
+func (*ListMyBookingSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListMyBookingSeriesResponse) GetSeries() []*BookingSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type SkipSeriesOccurrenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipSeriesOccurrenceRequest) Reset() {
+	*x = SkipSeriesOccurrenceRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipSeriesOccurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipSeriesOccurrenceRequest) ProtoMessage() {}
+
+func (x *SkipSeriesOccurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipSeriesOccurrenceRequest.ProtoReflect.Descriptor instead.
+func (*SkipSeriesOccurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SkipSeriesOccurrenceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SkipSeriesOccurrenceRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type SkipSeriesOccurrenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Occurrence    *SeriesOccurrence      `protobuf:"bytes,1,opt,name=occurrence,proto3" json:"occurrence,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipSeriesOccurrenceResponse) Reset() {
+	*x = SkipSeriesOccurrenceResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipSeriesOccurrenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipSeriesOccurrenceResponse) ProtoMessage() {}
+
+func (x *SkipSeriesOccurrenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipSeriesOccurrenceResponse.ProtoReflect.Descriptor instead.
+func (*SkipSeriesOccurrenceResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SkipSeriesOccurrenceResponse) GetOccurrence() *SeriesOccurrence {
+	if x != nil {
+		return x.Occurrence
+	}
+	return nil
+}
+
+func (x *SkipSeriesOccurrenceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CancelBookingSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingSeriesRequest) Reset() {
+	*x = CancelBookingSeriesRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingSeriesRequest) ProtoMessage() {}
+
+func (x *CancelBookingSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CancelBookingSeriesRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelBookingSeriesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Series            *BookingSeries         `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	CancelledBookings int32                  `protobuf:"varint,2,opt,name=cancelled_bookings,json=cancelledBookings,proto3" json:"cancelled_bookings,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CancelBookingSeriesResponse) Reset() {
+	*x = CancelBookingSeriesResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingSeriesResponse) ProtoMessage() {}
+
+func (x *CancelBookingSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingSeriesResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CancelBookingSeriesResponse) GetSeries() *BookingSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *CancelBookingSeriesResponse) GetCancelledBookings() int32 {
+	if x != nil {
+		return x.CancelledBookings
+	}
+	return 0
+}
+
+type JoinWaitlistRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	VehicleId int64                  `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	DateFrom  string                 `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // YYYY-MM-DD
+	DateTo    string                 `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // YYYY-MM-DD, defaults to date_from
+	Notes     string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Set to have the job done at this address by a mobile van instead of at
+	// the workshop. The postcode must be inside the service area.
+	ServiceLocation *ServiceLocation `protobuf:"bytes,5,opt,name=service_location,json=serviceLocation,proto3" json:"service_location,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *JoinWaitlistRequest) GetVehicleId() int64 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *JoinWaitlistRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetServiceLocation() *ServiceLocation {
+	if x != nil {
+		return x.ServiceLocation
+	}
+	return nil
+}
+
+type JoinWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *WaitlistEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistResponse) Reset() {
+	*x = JoinWaitlistResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistResponse) ProtoMessage() {}
+
+func (x *JoinWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *JoinWaitlistResponse) GetEntry() *WaitlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ListMyWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyWaitlistRequest) Reset() {
+	*x = ListMyWaitlistRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyWaitlistRequest) ProtoMessage() {}
+
+func (x *ListMyWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*ListMyWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{45}
+}
+
+type ListMyWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*WaitlistEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyWaitlistResponse) Reset() {
+	*x = ListMyWaitlistResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyWaitlistResponse) ProtoMessage() {}
+
+func (x *ListMyWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*ListMyWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListMyWaitlistResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type LeaveWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistRequest) Reset() {
+	*x = LeaveWaitlistRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistRequest) ProtoMessage() {}
+
+func (x *LeaveWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *LeaveWaitlistRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type LeaveWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *WaitlistEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistResponse) Reset() {
+	*x = LeaveWaitlistResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistResponse) ProtoMessage() {}
+
+func (x *LeaveWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *LeaveWaitlistResponse) GetEntry() *WaitlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GetMyCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"` // "customer" (the default) or "staff"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyCalendarFeedRequest) Reset() {
+	*x = GetMyCalendarFeedRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyCalendarFeedRequest) ProtoMessage() {}
+
+func (x *GetMyCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetMyCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetMyCalendarFeedRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type GetMyCalendarFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // iCalendar feed to subscribe to; anyone with it can read the feed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyCalendarFeedResponse) Reset() {
+	*x = GetMyCalendarFeedResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyCalendarFeedResponse) ProtoMessage() {}
+
+func (x *GetMyCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetMyCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetMyCalendarFeedResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ResetMyCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"` // "customer" (the default) or "staff"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetMyCalendarFeedRequest) Reset() {
+	*x = ResetMyCalendarFeedRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetMyCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetMyCalendarFeedRequest) ProtoMessage() {}
+
+func (x *ResetMyCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetMyCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*ResetMyCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ResetMyCalendarFeedRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type ResetMyCalendarFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetMyCalendarFeedResponse) Reset() {
+	*x = ResetMyCalendarFeedResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetMyCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetMyCalendarFeedResponse) ProtoMessage() {}
+
+func (x *ResetMyCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetMyCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*ResetMyCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ResetMyCalendarFeedResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ClaimWaitlistOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimWaitlistOfferRequest) Reset() {
+	*x = ClaimWaitlistOfferRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimWaitlistOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimWaitlistOfferRequest) ProtoMessage() {}
+
+func (x *ClaimWaitlistOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimWaitlistOfferRequest.ProtoReflect.Descriptor instead.
+func (*ClaimWaitlistOfferRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ClaimWaitlistOfferRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ClaimWaitlistOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimWaitlistOfferResponse) Reset() {
+	*x = ClaimWaitlistOfferResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimWaitlistOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimWaitlistOfferResponse) ProtoMessage() {}
+
+func (x *ClaimWaitlistOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimWaitlistOfferResponse.ProtoReflect.Descriptor instead.
+func (*ClaimWaitlistOfferResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ClaimWaitlistOfferResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type ListAllBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DateFrom      string                 `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo        string                 `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllBookingsRequest) Reset() {
+	*x = ListAllBookingsRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllBookingsRequest) ProtoMessage() {}
+
+func (x *ListAllBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListAllBookingsRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *ListAllBookingsRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+type ListAllBookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllBookingsResponse) Reset() {
+	*x = ListAllBookingsResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllBookingsResponse) ProtoMessage() {}
+
+func (x *ListAllBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListAllBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+type GetBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingRequest) Reset() {
+	*x = GetBookingRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingRequest) ProtoMessage() {}
+
+func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetBookingRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingResponse) Reset() {
+	*x = GetBookingResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingResponse) ProtoMessage() {}
+
+func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type UpdateBookingStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookingStatusRequest) Reset() {
+	*x = UpdateBookingStatusRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingStatusRequest) ProtoMessage() {}
+
+func (x *UpdateBookingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBookingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateBookingStatusRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateBookingStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateBookingStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateBookingStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookingStatusResponse) Reset() {
+	*x = UpdateBookingStatusResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingStatusResponse) ProtoMessage() {}
+
+func (x *UpdateBookingStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBookingStatusResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateBookingStatusResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type CompleteBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Notes string                 `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Required to complete a booking with a balance owing; recorded in its
+	// status history
+	OverrideReason string `protobuf:"bytes,3,opt,name=override_reason,json=overrideReason,proto3" json:"override_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompleteBookingRequest) Reset() {
+	*x = CompleteBookingRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteBookingRequest) ProtoMessage() {}
+
+func (x *CompleteBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteBookingRequest.ProtoReflect.Descriptor instead.
+func (*CompleteBookingRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CompleteBookingRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CompleteBookingRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CompleteBookingRequest) GetOverrideReason() string {
+	if x != nil {
+		return x.OverrideReason
+	}
+	return ""
+}
+
+type CompleteBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteBookingResponse) Reset() {
+	*x = CompleteBookingResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteBookingResponse) ProtoMessage() {}
+
+func (x *CompleteBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteBookingResponse.ProtoReflect.Descriptor instead.
+func (*CompleteBookingResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CompleteBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+// A customer entered by staff while taking a phone or walk-in booking.
+type AdminBookingNewCustomer struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	FirstName string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	Surname   string                 `protobuf:"bytes,2,opt,name=surname,proto3" json:"surname,omitempty"`
+	Phone     string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	// Optional; a customer without one is not sent emails
+	Email         string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminBookingNewCustomer) Reset() {
+	*x = AdminBookingNewCustomer{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminBookingNewCustomer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBookingNewCustomer) ProtoMessage() {}
+
+func (x *AdminBookingNewCustomer) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBookingNewCustomer.ProtoReflect.Descriptor instead.
+func (*AdminBookingNewCustomer) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AdminBookingNewCustomer) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *AdminBookingNewCustomer) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *AdminBookingNewCustomer) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *AdminBookingNewCustomer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type AdminBookingNewVehicle struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Make              string                 `protobuf:"bytes,1,opt,name=make,proto3" json:"make,omitempty"`
+	Model             string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Rego              string                 `protobuf:"bytes,3,opt,name=rego,proto3" json:"rego,omitempty"`
+	VehicleCategoryId int64                  `protobuf:"varint,4,opt,name=vehicle_category_id,json=vehicleCategoryId,proto3" json:"vehicle_category_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AdminBookingNewVehicle) Reset() {
+	*x = AdminBookingNewVehicle{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminBookingNewVehicle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBookingNewVehicle) ProtoMessage() {}
+
+func (x *AdminBookingNewVehicle) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBookingNewVehicle.ProtoReflect.Descriptor instead.
+func (*AdminBookingNewVehicle) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AdminBookingNewVehicle) GetMake() string {
+	if x != nil {
+		return x.Make
+	}
+	return ""
+}
+
+func (x *AdminBookingNewVehicle) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *AdminBookingNewVehicle) GetRego() string {
+	if x != nil {
+		return x.Rego
+	}
+	return ""
+}
+
+func (x *AdminBookingNewVehicle) GetVehicleCategoryId() int64 {
+	if x != nil {
+		return x.VehicleCategoryId
+	}
+	return 0
+}
+
+type AdminBookingServiceItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId int64                  `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	OptionIds []int64                `protobuf:"varint,3,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+	// Replaces the service's own price, in cents; options are still charged
+	PriceOverride *int64 `protobuf:"varint,4,opt,name=price_override,json=priceOverride,proto3,oneof" json:"price_override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminBookingServiceItem) Reset() {
+	*x = AdminBookingServiceItem{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminBookingServiceItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBookingServiceItem) ProtoMessage() {}
+
+func (x *AdminBookingServiceItem) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBookingServiceItem.ProtoReflect.Descriptor instead.
+func (*AdminBookingServiceItem) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AdminBookingServiceItem) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *AdminBookingServiceItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AdminBookingServiceItem) GetOptionIds() []int64 {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+func (x *AdminBookingServiceItem) GetPriceOverride() int64 {
+	if x != nil && x.PriceOverride != nil {
+		return *x.PriceOverride
+	}
+	return 0
+}
+
+type CreateBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Either an existing customer or a new one
+	CustomerId  int64                    `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	NewCustomer *AdminBookingNewCustomer `protobuf:"bytes,2,opt,name=new_customer,json=newCustomer,proto3" json:"new_customer,omitempty"`
+	// Either one of the customer's vehicles or a new one; both may be omitted
+	VehicleId       int64                      `protobuf:"varint,3,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	NewVehicle      *AdminBookingNewVehicle    `protobuf:"bytes,4,opt,name=new_vehicle,json=newVehicle,proto3" json:"new_vehicle,omitempty"`
+	Services        []*AdminBookingServiceItem `protobuf:"bytes,5,rep,name=services,proto3" json:"services,omitempty"`
+	ScheduledDate   string                     `protobuf:"bytes,6,opt,name=scheduled_date,json=scheduledDate,proto3" json:"scheduled_date,omitempty"`
+	ScheduledTime   string                     `protobuf:"bytes,7,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	Notes           string                     `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	ServiceLocation *ServiceLocation           `protobuf:"bytes,9,opt,name=service_location,json=serviceLocation,proto3" json:"service_location,omitempty"`
+	// Confirm the booking without asking for a deposit
+	SkipDeposit   bool `protobuf:"varint,10,opt,name=skip_deposit,json=skipDeposit,proto3" json:"skip_deposit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *GetAvailableSlotsRequest) GetDate() string {
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateBookingRequest) GetCustomerId() int64 {
 	if x != nil {
-		return x.Date
+		return x.CustomerId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetAvailableSlotsRequest) GetDurationMinutes() int32 {
+func (x *CreateBookingRequest) GetNewCustomer() *AdminBookingNewCustomer {
 	if x != nil {
-		return x.DurationMinutes
+		return x.NewCustomer
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetVehicleId() int64 {
+	if x != nil {
+		return x.VehicleId
 	}
 	return 0
 }
 
-type GetAvailableSlotsResponse struct {
+func (x *CreateBookingRequest) GetNewVehicle() *AdminBookingNewVehicle {
+	if x != nil {
+		return x.NewVehicle
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetServices() []*AdminBookingServiceItem {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetScheduledDate() string {
+	if x != nil {
+		return x.ScheduledDate
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetScheduledTime() string {
+	if x != nil {
+		return x.ScheduledTime
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetServiceLocation() *ServiceLocation {
+	if x != nil {
+		return x.ServiceLocation
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetSkipDeposit() bool {
+	if x != nil {
+		return x.SkipDeposit
+	}
+	return false
+}
+
+type CreateBookingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slots         []*AvailableSlot       `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAvailableSlotsResponse) Reset() {
-	*x = GetAvailableSlotsResponse{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[9]
+func (x *CreateBookingResponse) Reset() {
+	*x = CreateBookingResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvailableSlotsResponse) String() string {
+func (x *CreateBookingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableSlotsResponse) ProtoMessage() {}
+func (*CreateBookingResponse) ProtoMessage() {}
 
-func (x *GetAvailableSlotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[9]
+func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,39 +4101,41 @@ func (x *GetAvailableSlotsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailableSlotsResponse.ProtoReflect.Descriptor instead.
-func (*GetAvailableSlotsResponse) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetAvailableSlotsResponse) GetSlots() []*AvailableSlot {
+func (x *CreateBookingResponse) GetBooking() *Booking {
 	if x != nil {
-		return x.Slots
+		return x.Booking
 	}
 	return nil
 }
 
-type ListMyBookingsRequest struct {
+type MarkBookingNoShowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyBookingsRequest) Reset() {
-	*x = ListMyBookingsRequest{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[10]
+func (x *MarkBookingNoShowRequest) Reset() {
+	*x = MarkBookingNoShowRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyBookingsRequest) String() string {
+func (x *MarkBookingNoShowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyBookingsRequest) ProtoMessage() {}
+func (*MarkBookingNoShowRequest) ProtoMessage() {}
 
-func (x *ListMyBookingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[10]
+func (x *MarkBookingNoShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,33 +4146,47 @@ func (x *ListMyBookingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyBookingsRequest.ProtoReflect.Descriptor instead.
-func (*ListMyBookingsRequest) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MarkBookingNoShowRequest.ProtoReflect.Descriptor instead.
+func (*MarkBookingNoShowRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{68}
 }
 
-type ListMyBookingsResponse struct {
+func (x *MarkBookingNoShowRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MarkBookingNoShowRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MarkBookingNoShowResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyBookingsResponse) Reset() {
-	*x = ListMyBookingsResponse{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[11]
+func (x *MarkBookingNoShowResponse) Reset() {
+	*x = MarkBookingNoShowResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyBookingsResponse) String() string {
+func (x *MarkBookingNoShowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyBookingsResponse) ProtoMessage() {}
+func (*MarkBookingNoShowResponse) ProtoMessage() {}
 
-func (x *ListMyBookingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[11]
+func (x *MarkBookingNoShowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,40 +4197,44 @@ func (x *ListMyBookingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyBookingsResponse.ProtoReflect.Descriptor instead.
-func (*ListMyBookingsResponse) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MarkBookingNoShowResponse.ProtoReflect.Descriptor instead.
+func (*MarkBookingNoShowResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ListMyBookingsResponse) GetBookings() []*Booking {
+func (x *MarkBookingNoShowResponse) GetBooking() *Booking {
 	if x != nil {
-		return x.Bookings
+		return x.Booking
 	}
 	return nil
 }
 
-type GetMyBookingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+type AddBookingServiceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceId int64                  `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	OptionIds []int64                `protobuf:"varint,3,rep,packed,name=option_ids,json=optionIds,proto3" json:"option_ids,omitempty"`
+	// Replaces the service's own price, in cents; options are still charged
+	PriceOverride *int64 `protobuf:"varint,4,opt,name=price_override,json=priceOverride,proto3,oneof" json:"price_override,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyBookingRequest) Reset() {
-	*x = GetMyBookingRequest{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[12]
+func (x *AddBookingServiceRequest) Reset() {
+	*x = AddBookingServiceRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyBookingRequest) String() string {
+func (x *AddBookingServiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyBookingRequest) ProtoMessage() {}
+func (*AddBookingServiceRequest) ProtoMessage() {}
 
-func (x *GetMyBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[12]
+func (x *AddBookingServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,40 +4245,61 @@ func (x *GetMyBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyBookingRequest.ProtoReflect.Descriptor instead.
-func (*GetMyBookingRequest) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use AddBookingServiceRequest.ProtoReflect.Descriptor instead.
+func (*AddBookingServiceRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetMyBookingRequest) GetId() int64 {
+func (x *AddBookingServiceRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetMyBookingResponse struct {
+func (x *AddBookingServiceRequest) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *AddBookingServiceRequest) GetOptionIds() []int64 {
+	if x != nil {
+		return x.OptionIds
+	}
+	return nil
+}
+
+func (x *AddBookingServiceRequest) GetPriceOverride() int64 {
+	if x != nil && x.PriceOverride != nil {
+		return *x.PriceOverride
+	}
+	return 0
+}
+
+type AddBookingServiceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyBookingResponse) Reset() {
-	*x = GetMyBookingResponse{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[13]
+func (x *AddBookingServiceResponse) Reset() {
+	*x = AddBookingServiceResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyBookingResponse) String() string {
+func (x *AddBookingServiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyBookingResponse) ProtoMessage() {}
+func (*AddBookingServiceResponse) ProtoMessage() {}
 
-func (x *GetMyBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[13]
+func (x *AddBookingServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,40 +4310,42 @@ func (x *GetMyBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyBookingResponse.ProtoReflect.Descriptor instead.
-func (*GetMyBookingResponse) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use AddBookingServiceResponse.ProtoReflect.Descriptor instead.
+func (*AddBookingServiceResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetMyBookingResponse) GetBooking() *Booking {
+func (x *AddBookingServiceResponse) GetBooking() *Booking {
 	if x != nil {
 		return x.Booking
 	}
 	return nil
 }
 
-type CancelBookingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RemoveBookingServiceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The booking's service line, BookingServiceItem.id
+	BookingServiceId int64 `protobuf:"varint,2,opt,name=booking_service_id,json=bookingServiceId,proto3" json:"booking_service_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CancelBookingRequest) Reset() {
-	*x = CancelBookingRequest{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[14]
+func (x *RemoveBookingServiceRequest) Reset() {
+	*x = RemoveBookingServiceRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBookingRequest) String() string {
+func (x *RemoveBookingServiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBookingRequest) ProtoMessage() {}
+func (*RemoveBookingServiceRequest) ProtoMessage() {}
 
-func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[14]
+func (x *RemoveBookingServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,41 +4356,47 @@ func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
-func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RemoveBookingServiceRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBookingServiceRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *CancelBookingRequest) GetId() int64 {
+func (x *RemoveBookingServiceRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type CancelBookingResponse struct {
+func (x *RemoveBookingServiceRequest) GetBookingServiceId() int64 {
+	if x != nil {
+		return x.BookingServiceId
+	}
+	return 0
+}
+
+type RemoveBookingServiceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelBookingResponse) Reset() {
-	*x = CancelBookingResponse{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[15]
+func (x *RemoveBookingServiceResponse) Reset() {
+	*x = RemoveBookingServiceResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBookingResponse) String() string {
+func (x *RemoveBookingServiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBookingResponse) ProtoMessage() {}
+func (*RemoveBookingServiceResponse) ProtoMessage() {}
 
-func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[15]
+func (x *RemoveBookingServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -980,48 +4407,147 @@ func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
-func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RemoveBookingServiceResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBookingServiceResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *CancelBookingResponse) GetBooking() *Booking {
+func (x *RemoveBookingServiceResponse) GetBooking() *Booking {
 	if x != nil {
 		return x.Booking
 	}
 	return nil
 }
 
-func (x *CancelBookingResponse) GetMessage() string {
+type AddBookingServiceOptionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingServiceId int64                  `protobuf:"varint,2,opt,name=booking_service_id,json=bookingServiceId,proto3" json:"booking_service_id,omitempty"`
+	ServiceOptionId  int64                  `protobuf:"varint,3,opt,name=service_option_id,json=serviceOptionId,proto3" json:"service_option_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AddBookingServiceOptionRequest) Reset() {
+	*x = AddBookingServiceOptionRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBookingServiceOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookingServiceOptionRequest) ProtoMessage() {}
+
+func (x *AddBookingServiceOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[74]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookingServiceOptionRequest.ProtoReflect.Descriptor instead.
+func (*AddBookingServiceOptionRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AddBookingServiceOptionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddBookingServiceOptionRequest) GetBookingServiceId() int64 {
+	if x != nil {
+		return x.BookingServiceId
+	}
+	return 0
+}
+
+func (x *AddBookingServiceOptionRequest) GetServiceOptionId() int64 {
+	if x != nil {
+		return x.ServiceOptionId
+	}
+	return 0
+}
+
+type AddBookingServiceOptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBookingServiceOptionResponse) Reset() {
+	*x = AddBookingServiceOptionResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBookingServiceOptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookingServiceOptionResponse) ProtoMessage() {}
+
+func (x *AddBookingServiceOptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookingServiceOptionResponse.ProtoReflect.Descriptor instead.
+func (*AddBookingServiceOptionResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AddBookingServiceOptionResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
 	}
-	return ""
+	return nil
 }
 
-type ListAllBookingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DateFrom      string                 `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
-	DateTo        string                 `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+type RemoveBookingServiceOptionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingServiceId int64                  `protobuf:"varint,2,opt,name=booking_service_id,json=bookingServiceId,proto3" json:"booking_service_id,omitempty"`
+	// The booking's option line, BookingServiceOptionItem.id
+	OptionId      int64 `protobuf:"varint,3,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllBookingsRequest) Reset() {
-	*x = ListAllBookingsRequest{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[16]
+func (x *RemoveBookingServiceOptionRequest) Reset() {
+	*x = RemoveBookingServiceOptionRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllBookingsRequest) String() string {
+func (x *RemoveBookingServiceOptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllBookingsRequest) ProtoMessage() {}
+func (*RemoveBookingServiceOptionRequest) ProtoMessage() {}
 
-func (x *ListAllBookingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[16]
+func (x *RemoveBookingServiceOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,47 +4558,54 @@ func (x *ListAllBookingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllBookingsRequest.ProtoReflect.Descriptor instead.
-func (*ListAllBookingsRequest) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RemoveBookingServiceOptionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBookingServiceOptionRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ListAllBookingsRequest) GetDateFrom() string {
+func (x *RemoveBookingServiceOptionRequest) GetId() int64 {
 	if x != nil {
-		return x.DateFrom
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *ListAllBookingsRequest) GetDateTo() string {
+func (x *RemoveBookingServiceOptionRequest) GetBookingServiceId() int64 {
 	if x != nil {
-		return x.DateTo
+		return x.BookingServiceId
 	}
-	return ""
+	return 0
 }
 
-type ListAllBookingsResponse struct {
+func (x *RemoveBookingServiceOptionRequest) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+type RemoveBookingServiceOptionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllBookingsResponse) Reset() {
-	*x = ListAllBookingsResponse{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[17]
+func (x *RemoveBookingServiceOptionResponse) Reset() {
+	*x = RemoveBookingServiceOptionResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllBookingsResponse) String() string {
+func (x *RemoveBookingServiceOptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllBookingsResponse) ProtoMessage() {}
+func (*RemoveBookingServiceOptionResponse) ProtoMessage() {}
 
-func (x *ListAllBookingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[17]
+func (x *RemoveBookingServiceOptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,40 +4616,56 @@ func (x *ListAllBookingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllBookingsResponse.ProtoReflect.Descriptor instead.
-func (*ListAllBookingsResponse) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RemoveBookingServiceOptionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBookingServiceOptionResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ListAllBookingsResponse) GetBookings() []*Booking {
+func (x *RemoveBookingServiceOptionResponse) GetBooking() *Booking {
 	if x != nil {
-		return x.Bookings
+		return x.Booking
 	}
 	return nil
 }
 
-type GetBookingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+// A payment, refund or adjustment in a booking's ledger
+type BookingPayment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// charge, refund or adjustment
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Refunds are positive; adjustments may be either sign
+	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Part of a charge that is a tip rather than towards the booking
+	TipAmount int64 `protobuf:"varint,4,opt,name=tip_amount,json=tipAmount,proto3" json:"tip_amount,omitempty"`
+	// card, cash, eftpos, bank_transfer or other
+	Method string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	// The payment provider's ID for it, if any
+	ProviderRef string `protobuf:"bytes,6,opt,name=provider_ref,json=providerRef,proto3" json:"provider_ref,omitempty"`
+	Note        string `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	// Staff user who recorded it; 0 when recorded by the system
+	CreatedBy     int64                  `protobuf:"varint,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedByName string                 `protobuf:"bytes,9,opt,name=created_by_name,json=createdByName,proto3" json:"created_by_name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookingRequest) Reset() {
-	*x = GetBookingRequest{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[18]
+func (x *BookingPayment) Reset() {
+	*x = BookingPayment{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookingRequest) String() string {
+func (x *BookingPayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookingRequest) ProtoMessage() {}
+func (*BookingPayment) ProtoMessage() {}
 
-func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[18]
+func (x *BookingPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,85 +4676,109 @@ func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
-func (*GetBookingRequest) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use BookingPayment.ProtoReflect.Descriptor instead.
+func (*BookingPayment) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetBookingRequest) GetId() int64 {
+func (x *BookingPayment) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type GetBookingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BookingPayment) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
 }
 
-func (x *GetBookingResponse) Reset() {
-	*x = GetBookingResponse{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BookingPayment) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (x *GetBookingResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BookingPayment) GetTipAmount() int64 {
+	if x != nil {
+		return x.TipAmount
+	}
+	return 0
 }
 
-func (*GetBookingResponse) ProtoMessage() {}
+func (x *BookingPayment) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
 
-func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[19]
+func (x *BookingPayment) GetProviderRef() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProviderRef
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetBookingResponse.ProtoReflect.Descriptor instead.
-func (*GetBookingResponse) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{19}
+func (x *BookingPayment) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
 }
 
-func (x *GetBookingResponse) GetBooking() *Booking {
+func (x *BookingPayment) GetCreatedBy() int64 {
 	if x != nil {
-		return x.Booking
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *BookingPayment) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *BookingPayment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type UpdateBookingStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+type RecordBookingPaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// cash, eftpos or bank_transfer
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Cents towards the booking; no more than the balance due
+	Amount        int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	TipAmount     int64  `protobuf:"varint,4,opt,name=tip_amount,json=tipAmount,proto3" json:"tip_amount,omitempty"`
+	Note          string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBookingStatusRequest) Reset() {
-	*x = UpdateBookingStatusRequest{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[20]
+func (x *RecordBookingPaymentRequest) Reset() {
+	*x = RecordBookingPaymentRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBookingStatusRequest) String() string {
+func (x *RecordBookingPaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBookingStatusRequest) ProtoMessage() {}
+func (*RecordBookingPaymentRequest) ProtoMessage() {}
 
-func (x *UpdateBookingStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[20]
+func (x *RecordBookingPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,47 +4789,68 @@ func (x *UpdateBookingStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBookingStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBookingStatusRequest) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use RecordBookingPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RecordBookingPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *UpdateBookingStatusRequest) GetId() int64 {
+func (x *RecordBookingPaymentRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateBookingStatusRequest) GetStatus() string {
+func (x *RecordBookingPaymentRequest) GetMethod() string {
 	if x != nil {
-		return x.Status
+		return x.Method
 	}
 	return ""
 }
 
-type UpdateBookingStatusResponse struct {
+func (x *RecordBookingPaymentRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecordBookingPaymentRequest) GetTipAmount() int64 {
+	if x != nil {
+		return x.TipAmount
+	}
+	return 0
+}
+
+func (x *RecordBookingPaymentRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RecordBookingPaymentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBookingStatusResponse) Reset() {
-	*x = UpdateBookingStatusResponse{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[21]
+func (x *RecordBookingPaymentResponse) Reset() {
+	*x = RecordBookingPaymentResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBookingStatusResponse) String() string {
+func (x *RecordBookingPaymentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBookingStatusResponse) ProtoMessage() {}
+func (*RecordBookingPaymentResponse) ProtoMessage() {}
 
-func (x *UpdateBookingStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[21]
+func (x *RecordBookingPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1267,41 +4861,40 @@ func (x *UpdateBookingStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBookingStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateBookingStatusResponse) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use RecordBookingPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RecordBookingPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *UpdateBookingStatusResponse) GetBooking() *Booking {
+func (x *RecordBookingPaymentResponse) GetBooking() *Booking {
 	if x != nil {
 		return x.Booking
 	}
 	return nil
 }
 
-type CompleteBookingRequest struct {
+type ListBookingPaymentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Notes         string                 `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteBookingRequest) Reset() {
-	*x = CompleteBookingRequest{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[22]
+func (x *ListBookingPaymentsRequest) Reset() {
+	*x = ListBookingPaymentsRequest{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteBookingRequest) String() string {
+func (x *ListBookingPaymentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteBookingRequest) ProtoMessage() {}
+func (*ListBookingPaymentsRequest) ProtoMessage() {}
 
-func (x *CompleteBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[22]
+func (x *ListBookingPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1312,47 +4905,45 @@ func (x *CompleteBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteBookingRequest.ProtoReflect.Descriptor instead.
-func (*CompleteBookingRequest) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ListBookingPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListBookingPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *CompleteBookingRequest) GetId() int64 {
+func (x *ListBookingPaymentsRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *CompleteBookingRequest) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
-}
-
-type CompleteBookingResponse struct {
+type ListBookingPaymentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Payments      []*BookingPayment      `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	TotalAmount   int64                  `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	AmountPaid    int64                  `protobuf:"varint,3,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	BalanceDue    int64                  `protobuf:"varint,4,opt,name=balance_due,json=balanceDue,proto3" json:"balance_due,omitempty"`
+	Refunded      int64                  `protobuf:"varint,5,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	Tips          int64                  `protobuf:"varint,6,opt,name=tips,proto3" json:"tips,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteBookingResponse) Reset() {
-	*x = CompleteBookingResponse{}
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[23]
+func (x *ListBookingPaymentsResponse) Reset() {
+	*x = ListBookingPaymentsResponse{}
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteBookingResponse) String() string {
+func (x *ListBookingPaymentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteBookingResponse) ProtoMessage() {}
+func (*ListBookingPaymentsResponse) ProtoMessage() {}
 
-func (x *CompleteBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_booking_service_proto_msgTypes[23]
+func (x *ListBookingPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_booking_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1363,24 +4954,59 @@ func (x *CompleteBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteBookingResponse.ProtoReflect.Descriptor instead.
-func (*CompleteBookingResponse) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListBookingPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListBookingPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_booking_service_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *CompleteBookingResponse) GetBooking() *Booking {
+func (x *ListBookingPaymentsResponse) GetPayments() []*BookingPayment {
 	if x != nil {
-		return x.Booking
+		return x.Payments
 	}
 	return nil
 }
 
+func (x *ListBookingPaymentsResponse) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *ListBookingPaymentsResponse) GetAmountPaid() int64 {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return 0
+}
+
+func (x *ListBookingPaymentsResponse) GetBalanceDue() int64 {
+	if x != nil {
+		return x.BalanceDue
+	}
+	return 0
+}
+
+func (x *ListBookingPaymentsResponse) GetRefunded() int64 {
+	if x != nil {
+		return x.Refunded
+	}
+	return 0
+}
+
+func (x *ListBookingPaymentsResponse) GetTips() int64 {
+	if x != nil {
+		return x.Tips
+	}
+	return 0
+}
+
 var File_degrees_v1_booking_service_proto protoreflect.FileDescriptor
 
 const file_degrees_v1_booking_service_proto_rawDesc = "" +
 	"\n" +
 	" degrees/v1/booking_service.proto\x12\n" +
-	"degrees.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x05\n" +
+	"degrees.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe2\t\n" +
 	"\aBooking\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vcustomer_id\x18\x02 \x01(\x03R\n" +
@@ -1403,7 +5029,27 @@ const file_degrees_v1_booking_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"X\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12F\n" +
+	"\x0estatus_history\x18\x12 \x03(\v2\x1f.degrees.v1.BookingStatusChangeR\rstatusHistory\x12#\n" +
+	"\rrefund_amount\x18\x13 \x01(\x03R\frefundAmount\x12\x1f\n" +
+	"\vresource_id\x18\x14 \x01(\x03R\n" +
+	"resourceId\x12E\n" +
+	"\x0eassigned_staff\x18\x15 \x03(\v2\x1e.degrees.v1.BookingStaffMemberR\rassignedStaff\x12\x1b\n" +
+	"\tseries_id\x18\x16 \x01(\x03R\bseriesId\x12F\n" +
+	"\x10service_location\x18\x17 \x01(\v2\x1b.degrees.v1.ServiceLocationR\x0fserviceLocation\x12,\n" +
+	"\x12travel_distance_km\x18\x18 \x01(\x01R\x10travelDistanceKm\x12)\n" +
+	"\x10travel_surcharge\x18\x19 \x01(\x03R\x0ftravelSurcharge\x12)\n" +
+	"\x10forfeited_amount\x18\x1a \x01(\x03R\x0fforfeitedAmount\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x1b \x01(\x03R\tcreatedBy\x12\x1f\n" +
+	"\vamount_paid\x18\x1c \x01(\x03R\n" +
+	"amountPaid\x12\x1f\n" +
+	"\vbalance_due\x18\x1d \x01(\x03R\n" +
+	"balanceDue\"_\n" +
+	"\x0fServiceLocation\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06suburb\x18\x02 \x01(\tR\x06suburb\x12\x1a\n" +
+	"\bpostcode\x18\x03 \x01(\tR\bpostcode\"X\n" +
 	"\x13BookingCustomerInfo\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
 	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x12\n" +
@@ -1425,36 +5071,193 @@ const file_degrees_v1_booking_service_proto_rawDesc = "" +
 	"\x11service_option_id\x18\x02 \x01(\x03R\x0fserviceOptionId\x12\x1f\n" +
 	"\voption_name\x18\x03 \x01(\tR\n" +
 	"optionName\x12(\n" +
-	"\x10price_at_booking\x18\x04 \x01(\x03R\x0epriceAtBooking\"o\n" +
+	"\x10price_at_booking\x18\x04 \x01(\x03R\x0epriceAtBooking\"R\n" +
+	"\x12BookingStaffMember\x12\x19\n" +
+	"\bstaff_id\x18\x01 \x01(\x03R\astaffId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"\xe7\x01\n" +
+	"\x13BookingStatusChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x1d\n" +
+	"\n" +
+	"from_value\x18\x03 \x01(\tR\tfromValue\x12\x19\n" +
+	"\bto_value\x18\x04 \x01(\tR\atoValue\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x05 \x01(\x03R\tchangedBy\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8b\x01\n" +
+	"\x16CancellationPolicyTier\x12(\n" +
+	"\x10min_hours_before\x18\x01 \x01(\x05R\x0eminHoursBefore\x12%\n" +
+	"\x0erefund_percent\x18\x02 \x01(\x05R\rrefundPercent\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\xbe\x02\n" +
+	"\rBookingSeries\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05rrule\x18\x02 \x01(\tR\x05rrule\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12%\n" +
+	"\x0escheduled_time\x18\x04 \x01(\tR\rscheduledTime\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12.\n" +
+	"\x13template_booking_id\x18\x06 \x01(\x03R\x11templateBookingId\x12>\n" +
+	"\voccurrences\x18\a \x03(\v2\x1c.degrees.v1.SeriesOccurrenceR\voccurrences\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"u\n" +
+	"\x10SeriesOccurrence\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x03 \x01(\x03R\tbookingId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xc0\x04\n" +
+	"\rWaitlistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x02 \x01(\x03R\tvehicleId\x12\x1b\n" +
+	"\tdate_from\x18\x03 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x04 \x01(\tR\x06dateTo\x126\n" +
+	"\x17estimated_duration_mins\x18\x05 \x01(\x05R\x15estimatedDurationMins\x12!\n" +
+	"\ftotal_amount\x18\x06 \x01(\x03R\vtotalAmount\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12!\n" +
+	"\foffered_date\x18\b \x01(\tR\vofferedDate\x12!\n" +
+	"\foffered_time\x18\t \x01(\tR\vofferedTime\x12D\n" +
+	"\x10offer_expires_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0eofferExpiresAt\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\v \x01(\x03R\tbookingId\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12F\n" +
+	"\x10service_location\x18\r \x01(\v2\x1b.degrees.v1.ServiceLocationR\x0fserviceLocation\x12)\n" +
+	"\x10travel_surcharge\x18\x0e \x01(\x03R\x0ftravelSurcharge\"o\n" +
 	"\rAvailableSlot\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
 	"\x04time\x18\x02 \x01(\tR\x04time\x126\n" +
-	"\x17available_duration_mins\x18\x03 \x01(\x05R\x15availableDurationMins\"\xa1\x01\n" +
+	"\x17available_duration_mins\x18\x03 \x01(\x05R\x15availableDurationMins\"}\n" +
+	"\vCalendarDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rearliest_slot\x18\x03 \x01(\tR\fearliestSlot\x12\x1d\n" +
+	"\n" +
+	"slot_count\x18\x04 \x01(\x05R\tslotCount\"\x89\x02\n" +
 	"\x1cCreateBookingFromCartRequest\x12\x1d\n" +
 	"\n" +
 	"vehicle_id\x18\x01 \x01(\x03R\tvehicleId\x12%\n" +
 	"\x0escheduled_date\x18\x02 \x01(\tR\rscheduledDate\x12%\n" +
 	"\x0escheduled_time\x18\x03 \x01(\tR\rscheduledTime\x12\x14\n" +
-	"\x05notes\x18\x04 \x01(\tR\x05notes\"N\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x05 \x01(\tR\n" +
+	"recurrence\x12F\n" +
+	"\x10service_location\x18\x06 \x01(\v2\x1b.degrees.v1.ServiceLocationR\x0fserviceLocation\"N\n" +
 	"\x1dCreateBookingFromCartResponse\x12-\n" +
-	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"Y\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"\xc2\x01\n" +
+	"\fCheckoutHold\x12%\n" +
+	"\x0escheduled_date\x18\x01 \x01(\tR\rscheduledDate\x12%\n" +
+	"\x0escheduled_time\x18\x02 \x01(\tR\rscheduledTime\x12)\n" +
+	"\x10duration_minutes\x18\x03 \x01(\x05R\x0fdurationMinutes\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xce\x01\n" +
+	"\x17HoldCheckoutSlotRequest\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x01 \x01(\x03R\tvehicleId\x12%\n" +
+	"\x0escheduled_date\x18\x02 \x01(\tR\rscheduledDate\x12%\n" +
+	"\x0escheduled_time\x18\x03 \x01(\tR\rscheduledTime\x12F\n" +
+	"\x10service_location\x18\x04 \x01(\v2\x1b.degrees.v1.ServiceLocationR\x0fserviceLocation\"H\n" +
+	"\x18HoldCheckoutSlotResponse\x12,\n" +
+	"\x04hold\x18\x01 \x01(\v2\x18.degrees.v1.CheckoutHoldR\x04hold\"\x1c\n" +
+	"\x1aReleaseCheckoutHoldRequest\"7\n" +
+	"\x1bReleaseCheckoutHoldResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc6\x01\n" +
 	"\x18GetAvailableSlotsRequest\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12)\n" +
-	"\x10duration_minutes\x18\x02 \x01(\x05R\x0fdurationMinutes\"L\n" +
+	"\x10duration_minutes\x18\x02 \x01(\x05R\x0fdurationMinutes\x12\x1f\n" +
+	"\vservice_ids\x18\x03 \x03(\x03R\n" +
+	"serviceIds\x12\x1a\n" +
+	"\bpostcode\x18\x04 \x01(\tR\bpostcode\x12.\n" +
+	"\x13vehicle_category_id\x18\x05 \x01(\x03R\x11vehicleCategoryId\"L\n" +
 	"\x19GetAvailableSlotsResponse\x12/\n" +
-	"\x05slots\x18\x01 \x03(\v2\x19.degrees.v1.AvailableSlotR\x05slots\"\x17\n" +
+	"\x05slots\x18\x01 \x03(\v2\x19.degrees.v1.AvailableSlotR\x05slots\"\xee\x01\n" +
+	"\x1eGetAvailabilityCalendarRequest\x12\x1b\n" +
+	"\tdate_from\x18\x01 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x02 \x01(\tR\x06dateTo\x12\x1f\n" +
+	"\vservice_ids\x18\x03 \x03(\x03R\n" +
+	"serviceIds\x12.\n" +
+	"\x13vehicle_category_id\x18\x04 \x01(\x03R\x11vehicleCategoryId\x12)\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\x12\x1a\n" +
+	"\bpostcode\x18\x06 \x01(\tR\bpostcode\"N\n" +
+	"\x1fGetAvailabilityCalendarResponse\x12+\n" +
+	"\x04days\x18\x01 \x03(\v2\x17.degrees.v1.CalendarDayR\x04days\"5\n" +
+	"\x17CheckServiceAreaRequest\x12\x1a\n" +
+	"\bpostcode\x18\x01 \x01(\tR\bpostcode\"\xaf\x01\n" +
+	"\x18CheckServiceAreaResponse\x12\x17\n" +
+	"\ain_area\x18\x01 \x01(\bR\x06inArea\x12\x16\n" +
+	"\x06suburb\x18\x02 \x01(\tR\x06suburb\x12\x1f\n" +
+	"\vdistance_km\x18\x03 \x01(\x01R\n" +
+	"distanceKm\x12)\n" +
+	"\x10travel_surcharge\x18\x04 \x01(\x03R\x0ftravelSurcharge\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x17\n" +
 	"\x15ListMyBookingsRequest\"I\n" +
 	"\x16ListMyBookingsResponse\x12/\n" +
 	"\bbookings\x18\x01 \x03(\v2\x13.degrees.v1.BookingR\bbookings\"%\n" +
 	"\x13GetMyBookingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"E\n" +
 	"\x14GetMyBookingResponse\x12-\n" +
-	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"&\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"\x1e\n" +
+	"\x1cGetCancellationPolicyRequest\"Y\n" +
+	"\x1dGetCancellationPolicyResponse\x128\n" +
+	"\x05tiers\x18\x01 \x03(\v2\".degrees.v1.CancellationPolicyTierR\x05tiers\"&\n" +
 	"\x14CancelBookingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"`\n" +
 	"\x15CancelBookingResponse\x12-\n" +
 	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"N\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"x\n" +
+	"\x18RescheduleBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0escheduled_date\x18\x02 \x01(\tR\rscheduledDate\x12%\n" +
+	"\x0escheduled_time\x18\x03 \x01(\tR\rscheduledTime\"J\n" +
+	"\x19RescheduleBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"\x1c\n" +
+	"\x1aListMyBookingSeriesRequest\"P\n" +
+	"\x1bListMyBookingSeriesResponse\x121\n" +
+	"\x06series\x18\x01 \x03(\v2\x19.degrees.v1.BookingSeriesR\x06series\"A\n" +
+	"\x1bSkipSeriesOccurrenceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"v\n" +
+	"\x1cSkipSeriesOccurrenceResponse\x12<\n" +
+	"\n" +
+	"occurrence\x18\x01 \x01(\v2\x1c.degrees.v1.SeriesOccurrenceR\n" +
+	"occurrence\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
+	"\x1aCancelBookingSeriesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x7f\n" +
+	"\x1bCancelBookingSeriesResponse\x121\n" +
+	"\x06series\x18\x01 \x01(\v2\x19.degrees.v1.BookingSeriesR\x06series\x12-\n" +
+	"\x12cancelled_bookings\x18\x02 \x01(\x05R\x11cancelledBookings\"\xc8\x01\n" +
+	"\x13JoinWaitlistRequest\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x01 \x01(\x03R\tvehicleId\x12\x1b\n" +
+	"\tdate_from\x18\x02 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\x03 \x01(\tR\x06dateTo\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x12F\n" +
+	"\x10service_location\x18\x05 \x01(\v2\x1b.degrees.v1.ServiceLocationR\x0fserviceLocation\"G\n" +
+	"\x14JoinWaitlistResponse\x12/\n" +
+	"\x05entry\x18\x01 \x01(\v2\x19.degrees.v1.WaitlistEntryR\x05entry\"\x17\n" +
+	"\x15ListMyWaitlistRequest\"M\n" +
+	"\x16ListMyWaitlistResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.degrees.v1.WaitlistEntryR\aentries\"&\n" +
+	"\x14LeaveWaitlistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"H\n" +
+	"\x15LeaveWaitlistResponse\x12/\n" +
+	"\x05entry\x18\x01 \x01(\v2\x19.degrees.v1.WaitlistEntryR\x05entry\"0\n" +
+	"\x18GetMyCalendarFeedRequest\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\"-\n" +
+	"\x19GetMyCalendarFeedResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"2\n" +
+	"\x1aResetMyCalendarFeedRequest\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\"/\n" +
+	"\x1bResetMyCalendarFeedResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"1\n" +
+	"\x19ClaimWaitlistOfferRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"K\n" +
+	"\x1aClaimWaitlistOfferResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"N\n" +
 	"\x16ListAllBookingsRequest\x12\x1b\n" +
 	"\tdate_from\x18\x01 \x01(\tR\bdateFrom\x12\x17\n" +
 	"\adate_to\x18\x02 \x01(\tR\x06dateTo\"J\n" +
@@ -1463,28 +5266,156 @@ const file_degrees_v1_booking_service_proto_rawDesc = "" +
 	"\x11GetBookingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"C\n" +
 	"\x12GetBookingResponse\x12-\n" +
-	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"D\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"\\\n" +
 	"\x1aUpdateBookingStatusRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"L\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"L\n" +
 	"\x1bUpdateBookingStatusResponse\x12-\n" +
-	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\">\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"g\n" +
 	"\x16CompleteBookingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
-	"\x05notes\x18\x02 \x01(\tR\x05notes\"H\n" +
+	"\x05notes\x18\x02 \x01(\tR\x05notes\x12'\n" +
+	"\x0foverride_reason\x18\x03 \x01(\tR\x0eoverrideReason\"H\n" +
 	"\x17CompleteBookingResponse\x12-\n" +
-	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking2\xab\t\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"~\n" +
+	"\x17AdminBookingNewCustomer\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x01 \x01(\tR\tfirstName\x12\x18\n" +
+	"\asurname\x18\x02 \x01(\tR\asurname\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\"\x86\x01\n" +
+	"\x16AdminBookingNewVehicle\x12\x12\n" +
+	"\x04make\x18\x01 \x01(\tR\x04make\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\x12\n" +
+	"\x04rego\x18\x03 \x01(\tR\x04rego\x12.\n" +
+	"\x13vehicle_category_id\x18\x04 \x01(\x03R\x11vehicleCategoryId\"\xb2\x01\n" +
+	"\x17AdminBookingServiceItem\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\x03R\tserviceId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"option_ids\x18\x03 \x03(\x03R\toptionIds\x12*\n" +
+	"\x0eprice_override\x18\x04 \x01(\x03H\x00R\rpriceOverride\x88\x01\x01B\x11\n" +
+	"\x0f_price_override\"\xf3\x03\n" +
+	"\x14CreateBookingRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12F\n" +
+	"\fnew_customer\x18\x02 \x01(\v2#.degrees.v1.AdminBookingNewCustomerR\vnewCustomer\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x03 \x01(\x03R\tvehicleId\x12C\n" +
+	"\vnew_vehicle\x18\x04 \x01(\v2\".degrees.v1.AdminBookingNewVehicleR\n" +
+	"newVehicle\x12?\n" +
+	"\bservices\x18\x05 \x03(\v2#.degrees.v1.AdminBookingServiceItemR\bservices\x12%\n" +
+	"\x0escheduled_date\x18\x06 \x01(\tR\rscheduledDate\x12%\n" +
+	"\x0escheduled_time\x18\a \x01(\tR\rscheduledTime\x12\x14\n" +
+	"\x05notes\x18\b \x01(\tR\x05notes\x12F\n" +
+	"\x10service_location\x18\t \x01(\v2\x1b.degrees.v1.ServiceLocationR\x0fserviceLocation\x12!\n" +
+	"\fskip_deposit\x18\n" +
+	" \x01(\bR\vskipDeposit\"F\n" +
+	"\x15CreateBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"B\n" +
+	"\x18MarkBookingNoShowRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"J\n" +
+	"\x19MarkBookingNoShowResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"\xa7\x01\n" +
+	"\x18AddBookingServiceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\x03R\tserviceId\x12\x1d\n" +
+	"\n" +
+	"option_ids\x18\x03 \x03(\x03R\toptionIds\x12*\n" +
+	"\x0eprice_override\x18\x04 \x01(\x03H\x00R\rpriceOverride\x88\x01\x01B\x11\n" +
+	"\x0f_price_override\"J\n" +
+	"\x19AddBookingServiceResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"[\n" +
+	"\x1bRemoveBookingServiceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
+	"\x12booking_service_id\x18\x02 \x01(\x03R\x10bookingServiceId\"M\n" +
+	"\x1cRemoveBookingServiceResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"\x8a\x01\n" +
+	"\x1eAddBookingServiceOptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
+	"\x12booking_service_id\x18\x02 \x01(\x03R\x10bookingServiceId\x12*\n" +
+	"\x11service_option_id\x18\x03 \x01(\x03R\x0fserviceOptionId\"P\n" +
+	"\x1fAddBookingServiceOptionResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"~\n" +
+	"!RemoveBookingServiceOptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
+	"\x12booking_service_id\x18\x02 \x01(\x03R\x10bookingServiceId\x12\x1b\n" +
+	"\toption_id\x18\x03 \x01(\x03R\boptionId\"S\n" +
+	"\"RemoveBookingServiceOptionResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\"\xbc\x02\n" +
+	"\x0eBookingPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1d\n" +
+	"\n" +
+	"tip_amount\x18\x04 \x01(\x03R\ttipAmount\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12!\n" +
+	"\fprovider_ref\x18\x06 \x01(\tR\vproviderRef\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\x03R\tcreatedBy\x12&\n" +
+	"\x0fcreated_by_name\x18\t \x01(\tR\rcreatedByName\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x90\x01\n" +
+	"\x1bRecordBookingPaymentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1d\n" +
+	"\n" +
+	"tip_amount\x18\x04 \x01(\x03R\ttipAmount\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"M\n" +
+	"\x1cRecordBookingPaymentResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\",\n" +
+	"\x1aListBookingPaymentsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xea\x01\n" +
+	"\x1bListBookingPaymentsResponse\x126\n" +
+	"\bpayments\x18\x01 \x03(\v2\x1a.degrees.v1.BookingPaymentR\bpayments\x12!\n" +
+	"\ftotal_amount\x18\x02 \x01(\x03R\vtotalAmount\x12\x1f\n" +
+	"\vamount_paid\x18\x03 \x01(\x03R\n" +
+	"amountPaid\x12\x1f\n" +
+	"\vbalance_due\x18\x04 \x01(\x03R\n" +
+	"balanceDue\x12\x1a\n" +
+	"\brefunded\x18\x05 \x01(\x03R\brefunded\x12\x12\n" +
+	"\x04tips\x18\x06 \x01(\x03R\x04tips2\x82$\n" +
 	"\x0eBookingService\x12\x89\x01\n" +
-	"\x15CreateBookingFromCart\x12(.degrees.v1.CreateBookingFromCartRequest\x1a).degrees.v1.CreateBookingFromCartResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/checkout\x12\x8a\x01\n" +
-	"\x11GetAvailableSlots\x12$.degrees.v1.GetAvailableSlotsRequest\x1a%.degrees.v1.GetAvailableSlotsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/checkout/available-slots\x12t\n" +
+	"\x15CreateBookingFromCart\x12(.degrees.v1.CreateBookingFromCartRequest\x1a).degrees.v1.CreateBookingFromCartResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/checkout\x12\x7f\n" +
+	"\x10HoldCheckoutSlot\x12#.degrees.v1.HoldCheckoutSlotRequest\x1a$.degrees.v1.HoldCheckoutSlotResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/checkout/hold\x12\x85\x01\n" +
+	"\x13ReleaseCheckoutHold\x12&.degrees.v1.ReleaseCheckoutHoldRequest\x1a'.degrees.v1.ReleaseCheckoutHoldResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/api/v1/checkout/hold\x12\x8a\x01\n" +
+	"\x11GetAvailableSlots\x12$.degrees.v1.GetAvailableSlotsRequest\x1a%.degrees.v1.GetAvailableSlotsResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/checkout/available-slots\x12\x9a\x01\n" +
+	"\x15GetCancellationPolicy\x12(.degrees.v1.GetCancellationPolicyRequest\x1a).degrees.v1.GetCancellationPolicyResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/checkout/cancellation-policy\x12\xa2\x01\n" +
+	"\x17GetAvailabilityCalendar\x12*.degrees.v1.GetAvailabilityCalendarRequest\x1a+.degrees.v1.GetAvailabilityCalendarResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/checkout/availability-calendar\x12\x84\x01\n" +
+	"\x10CheckServiceArea\x12#.degrees.v1.CheckServiceAreaRequest\x1a$.degrees.v1.CheckServiceAreaResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/checkout/service-area\x12t\n" +
 	"\x0eListMyBookings\x12!.degrees.v1.ListMyBookingsRequest\x1a\".degrees.v1.ListMyBookingsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/me/bookings\x12s\n" +
 	"\fGetMyBooking\x12\x1f.degrees.v1.GetMyBookingRequest\x1a .degrees.v1.GetMyBookingResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/me/bookings/{id}\x12\x80\x01\n" +
-	"\rCancelBooking\x12 .degrees.v1.CancelBookingRequest\x1a!.degrees.v1.CancelBookingResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/me/bookings/{id}/cancel\x12z\n" +
-	"\x0fListAllBookings\x12\".degrees.v1.ListAllBookingsRequest\x1a#.degrees.v1.ListAllBookingsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/admin/bookings\x12p\n" +
+	"\rCancelBooking\x12 .degrees.v1.CancelBookingRequest\x1a!.degrees.v1.CancelBookingResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/me/bookings/{id}/cancel\x12\x90\x01\n" +
+	"\x11RescheduleBooking\x12$.degrees.v1.RescheduleBookingRequest\x1a%.degrees.v1.RescheduleBookingResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/me/bookings/{id}/reschedule\x12\x89\x01\n" +
+	"\x13ListMyBookingSeries\x12&.degrees.v1.ListMyBookingSeriesRequest\x1a'.degrees.v1.ListMyBookingSeriesResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/me/booking-series\x12\x99\x01\n" +
+	"\x14SkipSeriesOccurrence\x12'.degrees.v1.SkipSeriesOccurrenceRequest\x1a(.degrees.v1.SkipSeriesOccurrenceResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/me/booking-series/{id}/skip\x12\x98\x01\n" +
+	"\x13CancelBookingSeries\x12&.degrees.v1.CancelBookingSeriesRequest\x1a'.degrees.v1.CancelBookingSeriesResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/me/booking-series/{id}/cancel\x12w\n" +
+	"\fJoinWaitlist\x12\x1f.degrees.v1.JoinWaitlistRequest\x1a .degrees.v1.JoinWaitlistResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/checkout/waitlist\x12t\n" +
+	"\x0eListMyWaitlist\x12!.degrees.v1.ListMyWaitlistRequest\x1a\".degrees.v1.ListMyWaitlistResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/me/waitlist\x12\x80\x01\n" +
+	"\rLeaveWaitlist\x12 .degrees.v1.LeaveWaitlistRequest\x1a!.degrees.v1.LeaveWaitlistResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/me/waitlist/{id}/cancel\x12\x86\x01\n" +
+	"\x12ClaimWaitlistOffer\x12%.degrees.v1.ClaimWaitlistOfferRequest\x1a&.degrees.v1.ClaimWaitlistOfferResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/waitlist/claim\x12\x82\x01\n" +
+	"\x11GetMyCalendarFeed\x12$.degrees.v1.GetMyCalendarFeedRequest\x1a%.degrees.v1.GetMyCalendarFeedResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/me/calendar-feed\x12\x91\x01\n" +
+	"\x13ResetMyCalendarFeed\x12&.degrees.v1.ResetMyCalendarFeedRequest\x1a'.degrees.v1.ResetMyCalendarFeedResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/me/calendar-feed/reset\x12z\n" +
+	"\x0fListAllBookings\x12\".degrees.v1.ListAllBookingsRequest\x1a#.degrees.v1.ListAllBookingsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/admin/bookings\x12w\n" +
+	"\rCreateBooking\x12 .degrees.v1.CreateBookingRequest\x1a!.degrees.v1.CreateBookingResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/admin/bookings\x12p\n" +
 	"\n" +
 	"GetBooking\x12\x1d.degrees.v1.GetBookingRequest\x1a\x1e.degrees.v1.GetBookingResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/admin/bookings/{id}\x12\x95\x01\n" +
 	"\x13UpdateBookingStatus\x12&.degrees.v1.UpdateBookingStatusRequest\x1a'.degrees.v1.UpdateBookingStatusResponse\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/api/v1/admin/bookings/{id}/status\x12\x8b\x01\n" +
-	"\x0fCompleteBooking\x12\".degrees.v1.CompleteBookingRequest\x1a#.degrees.v1.CompleteBookingResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/admin/bookings/{id}/completeB\xb1\x01\n" +
+	"\x0fCompleteBooking\x12\".degrees.v1.CompleteBookingRequest\x1a#.degrees.v1.CompleteBookingResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/admin/bookings/{id}/complete\x12\x90\x01\n" +
+	"\x11MarkBookingNoShow\x12$.degrees.v1.MarkBookingNoShowRequest\x1a%.degrees.v1.MarkBookingNoShowResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/admin/bookings/{id}/no-show\x12\x91\x01\n" +
+	"\x11AddBookingService\x12$.degrees.v1.AddBookingServiceRequest\x1a%.degrees.v1.AddBookingServiceResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/admin/bookings/{id}/services\x12\xac\x01\n" +
+	"\x14RemoveBookingService\x12'.degrees.v1.RemoveBookingServiceRequest\x1a(.degrees.v1.RemoveBookingServiceResponse\"A\x82\xd3\xe4\x93\x02;*9/api/v1/admin/bookings/{id}/services/{booking_service_id}\x12\xc0\x01\n" +
+	"\x17AddBookingServiceOption\x12*.degrees.v1.AddBookingServiceOptionRequest\x1a+.degrees.v1.AddBookingServiceOptionResponse\"L\x82\xd3\xe4\x93\x02F:\x01*\"A/api/v1/admin/bookings/{id}/services/{booking_service_id}/options\x12\xd2\x01\n" +
+	"\x1aRemoveBookingServiceOption\x12-.degrees.v1.RemoveBookingServiceOptionRequest\x1a..degrees.v1.RemoveBookingServiceOptionResponse\"U\x82\xd3\xe4\x93\x02O*M/api/v1/admin/bookings/{id}/services/{booking_service_id}/options/{option_id}\x12\x9a\x01\n" +
+	"\x14RecordBookingPayment\x12'.degrees.v1.RecordBookingPaymentRequest\x1a(.degrees.v1.RecordBookingPaymentResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/admin/bookings/{id}/payments\x12\x94\x01\n" +
+	"\x13ListBookingPayments\x12&.degrees.v1.ListBookingPaymentsRequest\x1a'.degrees.v1.ListBookingPaymentsResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/admin/bookings/{id}/paymentsB\xb1\x01\n" +
 	"\x0ecom.degrees.v1B\x13BookingServiceProtoP\x01ZAgithub.com/richardbowden/degrees/internal/pb/degrees/v1;degreesv1\xa2\x02\x03DXX\xaa\x02\n" +
 	"Degrees.V1\xca\x02\n" +
 	"Degrees\\V1\xe2\x02\x16Degrees\\V1\\GPBMetadata\xea\x02\vDegrees::V1b\x06proto3"
@@ -1501,73 +5432,215 @@ func file_degrees_v1_booking_service_proto_rawDescGZIP() []byte {
 	return file_degrees_v1_booking_service_proto_rawDescData
 }
 
-var file_degrees_v1_booking_service_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_degrees_v1_booking_service_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
 var file_degrees_v1_booking_service_proto_goTypes = []any{
-	(*Booking)(nil),                       // 0: degrees.v1.Booking
-	(*BookingCustomerInfo)(nil),           // 1: degrees.v1.BookingCustomerInfo
-	(*BookingVehicleInfo)(nil),            // 2: degrees.v1.BookingVehicleInfo
-	(*BookingServiceItem)(nil),            // 3: degrees.v1.BookingServiceItem
-	(*BookingServiceOptionItem)(nil),      // 4: degrees.v1.BookingServiceOptionItem
-	(*AvailableSlot)(nil),                 // 5: degrees.v1.AvailableSlot
-	(*CreateBookingFromCartRequest)(nil),  // 6: degrees.v1.CreateBookingFromCartRequest
-	(*CreateBookingFromCartResponse)(nil), // 7: degrees.v1.CreateBookingFromCartResponse
-	(*GetAvailableSlotsRequest)(nil),      // 8: degrees.v1.GetAvailableSlotsRequest
-	(*GetAvailableSlotsResponse)(nil),     // 9: degrees.v1.GetAvailableSlotsResponse
-	(*ListMyBookingsRequest)(nil),         // 10: degrees.v1.ListMyBookingsRequest
-	(*ListMyBookingsResponse)(nil),        // 11: degrees.v1.ListMyBookingsResponse
-	(*GetMyBookingRequest)(nil),           // 12: degrees.v1.GetMyBookingRequest
-	(*GetMyBookingResponse)(nil),          // 13: degrees.v1.GetMyBookingResponse
-	(*CancelBookingRequest)(nil),          // 14: degrees.v1.CancelBookingRequest
-	(*CancelBookingResponse)(nil),         // 15: degrees.v1.CancelBookingResponse
-	(*ListAllBookingsRequest)(nil),        // 16: degrees.v1.ListAllBookingsRequest
-	(*ListAllBookingsResponse)(nil),       // 17: degrees.v1.ListAllBookingsResponse
-	(*GetBookingRequest)(nil),             // 18: degrees.v1.GetBookingRequest
-	(*GetBookingResponse)(nil),            // 19: degrees.v1.GetBookingResponse
-	(*UpdateBookingStatusRequest)(nil),    // 20: degrees.v1.UpdateBookingStatusRequest
-	(*UpdateBookingStatusResponse)(nil),   // 21: degrees.v1.UpdateBookingStatusResponse
-	(*CompleteBookingRequest)(nil),        // 22: degrees.v1.CompleteBookingRequest
-	(*CompleteBookingResponse)(nil),       // 23: degrees.v1.CompleteBookingResponse
-	(*timestamppb.Timestamp)(nil),         // 24: google.protobuf.Timestamp
+	(*Booking)(nil),                            // 0: degrees.v1.Booking
+	(*ServiceLocation)(nil),                    // 1: degrees.v1.ServiceLocation
+	(*BookingCustomerInfo)(nil),                // 2: degrees.v1.BookingCustomerInfo
+	(*BookingVehicleInfo)(nil),                 // 3: degrees.v1.BookingVehicleInfo
+	(*BookingServiceItem)(nil),                 // 4: degrees.v1.BookingServiceItem
+	(*BookingServiceOptionItem)(nil),           // 5: degrees.v1.BookingServiceOptionItem
+	(*BookingStaffMember)(nil),                 // 6: degrees.v1.BookingStaffMember
+	(*BookingStatusChange)(nil),                // 7: degrees.v1.BookingStatusChange
+	(*CancellationPolicyTier)(nil),             // 8: degrees.v1.CancellationPolicyTier
+	(*BookingSeries)(nil),                      // 9: degrees.v1.BookingSeries
+	(*SeriesOccurrence)(nil),                   // 10: degrees.v1.SeriesOccurrence
+	(*WaitlistEntry)(nil),                      // 11: degrees.v1.WaitlistEntry
+	(*AvailableSlot)(nil),                      // 12: degrees.v1.AvailableSlot
+	(*CalendarDay)(nil),                        // 13: degrees.v1.CalendarDay
+	(*CreateBookingFromCartRequest)(nil),       // 14: degrees.v1.CreateBookingFromCartRequest
+	(*CreateBookingFromCartResponse)(nil),      // 15: degrees.v1.CreateBookingFromCartResponse
+	(*CheckoutHold)(nil),                       // 16: degrees.v1.CheckoutHold
+	(*HoldCheckoutSlotRequest)(nil),            // 17: degrees.v1.HoldCheckoutSlotRequest
+	(*HoldCheckoutSlotResponse)(nil),           // 18: degrees.v1.HoldCheckoutSlotResponse
+	(*ReleaseCheckoutHoldRequest)(nil),         // 19: degrees.v1.ReleaseCheckoutHoldRequest
+	(*ReleaseCheckoutHoldResponse)(nil),        // 20: degrees.v1.ReleaseCheckoutHoldResponse
+	(*GetAvailableSlotsRequest)(nil),           // 21: degrees.v1.GetAvailableSlotsRequest
+	(*GetAvailableSlotsResponse)(nil),          // 22: degrees.v1.GetAvailableSlotsResponse
+	(*GetAvailabilityCalendarRequest)(nil),     // 23: degrees.v1.GetAvailabilityCalendarRequest
+	(*GetAvailabilityCalendarResponse)(nil),    // 24: degrees.v1.GetAvailabilityCalendarResponse
+	(*CheckServiceAreaRequest)(nil),            // 25: degrees.v1.CheckServiceAreaRequest
+	(*CheckServiceAreaResponse)(nil),           // 26: degrees.v1.CheckServiceAreaResponse
+	(*ListMyBookingsRequest)(nil),              // 27: degrees.v1.ListMyBookingsRequest
+	(*ListMyBookingsResponse)(nil),             // 28: degrees.v1.ListMyBookingsResponse
+	(*GetMyBookingRequest)(nil),                // 29: degrees.v1.GetMyBookingRequest
+	(*GetMyBookingResponse)(nil),               // 30: degrees.v1.GetMyBookingResponse
+	(*GetCancellationPolicyRequest)(nil),       // 31: degrees.v1.GetCancellationPolicyRequest
+	(*GetCancellationPolicyResponse)(nil),      // 32: degrees.v1.GetCancellationPolicyResponse
+	(*CancelBookingRequest)(nil),               // 33: degrees.v1.CancelBookingRequest
+	(*CancelBookingResponse)(nil),              // 34: degrees.v1.CancelBookingResponse
+	(*RescheduleBookingRequest)(nil),           // 35: degrees.v1.RescheduleBookingRequest
+	(*RescheduleBookingResponse)(nil),          // 36: degrees.v1.RescheduleBookingResponse
+	(*ListMyBookingSeriesRequest)(nil),         // 37: degrees.v1.ListMyBookingSeriesRequest
+	(*ListMyBookingSeriesResponse)(nil),        // 38: degrees.v1.ListMyBookingSeriesResponse
+	(*SkipSeriesOccurrenceRequest)(nil),        // 39: degrees.v1.SkipSeriesOccurrenceRequest
+	(*SkipSeriesOccurrenceResponse)(nil),       // 40: degrees.v1.SkipSeriesOccurrenceResponse
+	(*CancelBookingSeriesRequest)(nil),         // 41: degrees.v1.CancelBookingSeriesRequest
+	(*CancelBookingSeriesResponse)(nil),        // 42: degrees.v1.CancelBookingSeriesResponse
+	(*JoinWaitlistRequest)(nil),                // 43: degrees.v1.JoinWaitlistRequest
+	(*JoinWaitlistResponse)(nil),               // 44: degrees.v1.JoinWaitlistResponse
+	(*ListMyWaitlistRequest)(nil),              // 45: degrees.v1.ListMyWaitlistRequest
+	(*ListMyWaitlistResponse)(nil),             // 46: degrees.v1.ListMyWaitlistResponse
+	(*LeaveWaitlistRequest)(nil),               // 47: degrees.v1.LeaveWaitlistRequest
+	(*LeaveWaitlistResponse)(nil),              // 48: degrees.v1.LeaveWaitlistResponse
+	(*GetMyCalendarFeedRequest)(nil),           // 49: degrees.v1.GetMyCalendarFeedRequest
+	(*GetMyCalendarFeedResponse)(nil),          // 50: degrees.v1.GetMyCalendarFeedResponse
+	(*ResetMyCalendarFeedRequest)(nil),         // 51: degrees.v1.ResetMyCalendarFeedRequest
+	(*ResetMyCalendarFeedResponse)(nil),        // 52: degrees.v1.ResetMyCalendarFeedResponse
+	(*ClaimWaitlistOfferRequest)(nil),          // 53: degrees.v1.ClaimWaitlistOfferRequest
+	(*ClaimWaitlistOfferResponse)(nil),         // 54: degrees.v1.ClaimWaitlistOfferResponse
+	(*ListAllBookingsRequest)(nil),             // 55: degrees.v1.ListAllBookingsRequest
+	(*ListAllBookingsResponse)(nil),            // 56: degrees.v1.ListAllBookingsResponse
+	(*GetBookingRequest)(nil),                  // 57: degrees.v1.GetBookingRequest
+	(*GetBookingResponse)(nil),                 // 58: degrees.v1.GetBookingResponse
+	(*UpdateBookingStatusRequest)(nil),         // 59: degrees.v1.UpdateBookingStatusRequest
+	(*UpdateBookingStatusResponse)(nil),        // 60: degrees.v1.UpdateBookingStatusResponse
+	(*CompleteBookingRequest)(nil),             // 61: degrees.v1.CompleteBookingRequest
+	(*CompleteBookingResponse)(nil),            // 62: degrees.v1.CompleteBookingResponse
+	(*AdminBookingNewCustomer)(nil),            // 63: degrees.v1.AdminBookingNewCustomer
+	(*AdminBookingNewVehicle)(nil),             // 64: degrees.v1.AdminBookingNewVehicle
+	(*AdminBookingServiceItem)(nil),            // 65: degrees.v1.AdminBookingServiceItem
+	(*CreateBookingRequest)(nil),               // 66: degrees.v1.CreateBookingRequest
+	(*CreateBookingResponse)(nil),              // 67: degrees.v1.CreateBookingResponse
+	(*MarkBookingNoShowRequest)(nil),           // 68: degrees.v1.MarkBookingNoShowRequest
+	(*MarkBookingNoShowResponse)(nil),          // 69: degrees.v1.MarkBookingNoShowResponse
+	(*AddBookingServiceRequest)(nil),           // 70: degrees.v1.AddBookingServiceRequest
+	(*AddBookingServiceResponse)(nil),          // 71: degrees.v1.AddBookingServiceResponse
+	(*RemoveBookingServiceRequest)(nil),        // 72: degrees.v1.RemoveBookingServiceRequest
+	(*RemoveBookingServiceResponse)(nil),       // 73: degrees.v1.RemoveBookingServiceResponse
+	(*AddBookingServiceOptionRequest)(nil),     // 74: degrees.v1.AddBookingServiceOptionRequest
+	(*AddBookingServiceOptionResponse)(nil),    // 75: degrees.v1.AddBookingServiceOptionResponse
+	(*RemoveBookingServiceOptionRequest)(nil),  // 76: degrees.v1.RemoveBookingServiceOptionRequest
+	(*RemoveBookingServiceOptionResponse)(nil), // 77: degrees.v1.RemoveBookingServiceOptionResponse
+	(*BookingPayment)(nil),                     // 78: degrees.v1.BookingPayment
+	(*RecordBookingPaymentRequest)(nil),        // 79: degrees.v1.RecordBookingPaymentRequest
+	(*RecordBookingPaymentResponse)(nil),       // 80: degrees.v1.RecordBookingPaymentResponse
+	(*ListBookingPaymentsRequest)(nil),         // 81: degrees.v1.ListBookingPaymentsRequest
+	(*ListBookingPaymentsResponse)(nil),        // 82: degrees.v1.ListBookingPaymentsResponse
+	(*timestamppb.Timestamp)(nil),              // 83: google.protobuf.Timestamp
 }
 var file_degrees_v1_booking_service_proto_depIdxs = []int32{
-	3,  // 0: degrees.v1.Booking.services:type_name -> degrees.v1.BookingServiceItem
-	1,  // 1: degrees.v1.Booking.customer:type_name -> degrees.v1.BookingCustomerInfo
-	2,  // 2: degrees.v1.Booking.vehicle:type_name -> degrees.v1.BookingVehicleInfo
-	24, // 3: degrees.v1.Booking.created_at:type_name -> google.protobuf.Timestamp
-	24, // 4: degrees.v1.Booking.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 5: degrees.v1.BookingServiceItem.options:type_name -> degrees.v1.BookingServiceOptionItem
-	0,  // 6: degrees.v1.CreateBookingFromCartResponse.booking:type_name -> degrees.v1.Booking
-	5,  // 7: degrees.v1.GetAvailableSlotsResponse.slots:type_name -> degrees.v1.AvailableSlot
-	0,  // 8: degrees.v1.ListMyBookingsResponse.bookings:type_name -> degrees.v1.Booking
-	0,  // 9: degrees.v1.GetMyBookingResponse.booking:type_name -> degrees.v1.Booking
-	0,  // 10: degrees.v1.CancelBookingResponse.booking:type_name -> degrees.v1.Booking
-	0,  // 11: degrees.v1.ListAllBookingsResponse.bookings:type_name -> degrees.v1.Booking
-	0,  // 12: degrees.v1.GetBookingResponse.booking:type_name -> degrees.v1.Booking
-	0,  // 13: degrees.v1.UpdateBookingStatusResponse.booking:type_name -> degrees.v1.Booking
-	0,  // 14: degrees.v1.CompleteBookingResponse.booking:type_name -> degrees.v1.Booking
-	6,  // 15: degrees.v1.BookingService.CreateBookingFromCart:input_type -> degrees.v1.CreateBookingFromCartRequest
-	8,  // 16: degrees.v1.BookingService.GetAvailableSlots:input_type -> degrees.v1.GetAvailableSlotsRequest
-	10, // 17: degrees.v1.BookingService.ListMyBookings:input_type -> degrees.v1.ListMyBookingsRequest
-	12, // 18: degrees.v1.BookingService.GetMyBooking:input_type -> degrees.v1.GetMyBookingRequest
-	14, // 19: degrees.v1.BookingService.CancelBooking:input_type -> degrees.v1.CancelBookingRequest
-	16, // 20: degrees.v1.BookingService.ListAllBookings:input_type -> degrees.v1.ListAllBookingsRequest
-	18, // 21: degrees.v1.BookingService.GetBooking:input_type -> degrees.v1.GetBookingRequest
-	20, // 22: degrees.v1.BookingService.UpdateBookingStatus:input_type -> degrees.v1.UpdateBookingStatusRequest
-	22, // 23: degrees.v1.BookingService.CompleteBooking:input_type -> degrees.v1.CompleteBookingRequest
-	7,  // 24: degrees.v1.BookingService.CreateBookingFromCart:output_type -> degrees.v1.CreateBookingFromCartResponse
-	9,  // 25: degrees.v1.BookingService.GetAvailableSlots:output_type -> degrees.v1.GetAvailableSlotsResponse
-	11, // 26: degrees.v1.BookingService.ListMyBookings:output_type -> degrees.v1.ListMyBookingsResponse
-	13, // 27: degrees.v1.BookingService.GetMyBooking:output_type -> degrees.v1.GetMyBookingResponse
-	15, // 28: degrees.v1.BookingService.CancelBooking:output_type -> degrees.v1.CancelBookingResponse
-	17, // 29: degrees.v1.BookingService.ListAllBookings:output_type -> degrees.v1.ListAllBookingsResponse
-	19, // 30: degrees.v1.BookingService.GetBooking:output_type -> degrees.v1.GetBookingResponse
-	21, // 31: degrees.v1.BookingService.UpdateBookingStatus:output_type -> degrees.v1.UpdateBookingStatusResponse
-	23, // 32: degrees.v1.BookingService.CompleteBooking:output_type -> degrees.v1.CompleteBookingResponse
-	24, // [24:33] is the sub-list for method output_type
-	15, // [15:24] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	4,  // 0: degrees.v1.Booking.services:type_name -> degrees.v1.BookingServiceItem
+	2,  // 1: degrees.v1.Booking.customer:type_name -> degrees.v1.BookingCustomerInfo
+	3,  // 2: degrees.v1.Booking.vehicle:type_name -> degrees.v1.BookingVehicleInfo
+	83, // 3: degrees.v1.Booking.created_at:type_name -> google.protobuf.Timestamp
+	83, // 4: degrees.v1.Booking.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: degrees.v1.Booking.status_history:type_name -> degrees.v1.BookingStatusChange
+	6,  // 6: degrees.v1.Booking.assigned_staff:type_name -> degrees.v1.BookingStaffMember
+	1,  // 7: degrees.v1.Booking.service_location:type_name -> degrees.v1.ServiceLocation
+	5,  // 8: degrees.v1.BookingServiceItem.options:type_name -> degrees.v1.BookingServiceOptionItem
+	83, // 9: degrees.v1.BookingStatusChange.created_at:type_name -> google.protobuf.Timestamp
+	10, // 10: degrees.v1.BookingSeries.occurrences:type_name -> degrees.v1.SeriesOccurrence
+	83, // 11: degrees.v1.BookingSeries.created_at:type_name -> google.protobuf.Timestamp
+	83, // 12: degrees.v1.WaitlistEntry.offer_expires_at:type_name -> google.protobuf.Timestamp
+	83, // 13: degrees.v1.WaitlistEntry.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 14: degrees.v1.WaitlistEntry.service_location:type_name -> degrees.v1.ServiceLocation
+	1,  // 15: degrees.v1.CreateBookingFromCartRequest.service_location:type_name -> degrees.v1.ServiceLocation
+	0,  // 16: degrees.v1.CreateBookingFromCartResponse.booking:type_name -> degrees.v1.Booking
+	83, // 17: degrees.v1.CheckoutHold.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 18: degrees.v1.HoldCheckoutSlotRequest.service_location:type_name -> degrees.v1.ServiceLocation
+	16, // 19: degrees.v1.HoldCheckoutSlotResponse.hold:type_name -> degrees.v1.CheckoutHold
+	12, // 20: degrees.v1.GetAvailableSlotsResponse.slots:type_name -> degrees.v1.AvailableSlot
+	13, // 21: degrees.v1.GetAvailabilityCalendarResponse.days:type_name -> degrees.v1.CalendarDay
+	0,  // 22: degrees.v1.ListMyBookingsResponse.bookings:type_name -> degrees.v1.Booking
+	0,  // 23: degrees.v1.GetMyBookingResponse.booking:type_name -> degrees.v1.Booking
+	8,  // 24: degrees.v1.GetCancellationPolicyResponse.tiers:type_name -> degrees.v1.CancellationPolicyTier
+	0,  // 25: degrees.v1.CancelBookingResponse.booking:type_name -> degrees.v1.Booking
+	0,  // 26: degrees.v1.RescheduleBookingResponse.booking:type_name -> degrees.v1.Booking
+	9,  // 27: degrees.v1.ListMyBookingSeriesResponse.series:type_name -> degrees.v1.BookingSeries
+	10, // 28: degrees.v1.SkipSeriesOccurrenceResponse.occurrence:type_name -> degrees.v1.SeriesOccurrence
+	9,  // 29: degrees.v1.CancelBookingSeriesResponse.series:type_name -> degrees.v1.BookingSeries
+	1,  // 30: degrees.v1.JoinWaitlistRequest.service_location:type_name -> degrees.v1.ServiceLocation
+	11, // 31: degrees.v1.JoinWaitlistResponse.entry:type_name -> degrees.v1.WaitlistEntry
+	11, // 32: degrees.v1.ListMyWaitlistResponse.entries:type_name -> degrees.v1.WaitlistEntry
+	11, // 33: degrees.v1.LeaveWaitlistResponse.entry:type_name -> degrees.v1.WaitlistEntry
+	0,  // 34: degrees.v1.ClaimWaitlistOfferResponse.booking:type_name -> degrees.v1.Booking
+	0,  // 35: degrees.v1.ListAllBookingsResponse.bookings:type_name -> degrees.v1.Booking
+	0,  // 36: degrees.v1.GetBookingResponse.booking:type_name -> degrees.v1.Booking
+	0,  // 37: degrees.v1.UpdateBookingStatusResponse.booking:type_name -> degrees.v1.Booking
+	0,  // 38: degrees.v1.CompleteBookingResponse.booking:type_name -> degrees.v1.Booking
+	63, // 39: degrees.v1.CreateBookingRequest.new_customer:type_name -> degrees.v1.AdminBookingNewCustomer
+	64, // 40: degrees.v1.CreateBookingRequest.new_vehicle:type_name -> degrees.v1.AdminBookingNewVehicle
+	65, // 41: degrees.v1.CreateBookingRequest.services:type_name -> degrees.v1.AdminBookingServiceItem
+	1,  // 42: degrees.v1.CreateBookingRequest.service_location:type_name -> degrees.v1.ServiceLocation
+	0,  // 43: degrees.v1.CreateBookingResponse.booking:type_name -> degrees.v1.Booking
+	0,  // 44: degrees.v1.MarkBookingNoShowResponse.booking:type_name -> degrees.v1.Booking
+	0,  // 45: degrees.v1.AddBookingServiceResponse.booking:type_name -> degrees.v1.Booking
+	0,  // 46: degrees.v1.RemoveBookingServiceResponse.booking:type_name -> degrees.v1.Booking
+	0,  // 47: degrees.v1.AddBookingServiceOptionResponse.booking:type_name -> degrees.v1.Booking
+	0,  // 48: degrees.v1.RemoveBookingServiceOptionResponse.booking:type_name -> degrees.v1.Booking
+	83, // 49: degrees.v1.BookingPayment.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 50: degrees.v1.RecordBookingPaymentResponse.booking:type_name -> degrees.v1.Booking
+	78, // 51: degrees.v1.ListBookingPaymentsResponse.payments:type_name -> degrees.v1.BookingPayment
+	14, // 52: degrees.v1.BookingService.CreateBookingFromCart:input_type -> degrees.v1.CreateBookingFromCartRequest
+	17, // 53: degrees.v1.BookingService.HoldCheckoutSlot:input_type -> degrees.v1.HoldCheckoutSlotRequest
+	19, // 54: degrees.v1.BookingService.ReleaseCheckoutHold:input_type -> degrees.v1.ReleaseCheckoutHoldRequest
+	21, // 55: degrees.v1.BookingService.GetAvailableSlots:input_type -> degrees.v1.GetAvailableSlotsRequest
+	31, // 56: degrees.v1.BookingService.GetCancellationPolicy:input_type -> degrees.v1.GetCancellationPolicyRequest
+	23, // 57: degrees.v1.BookingService.GetAvailabilityCalendar:input_type -> degrees.v1.GetAvailabilityCalendarRequest
+	25, // 58: degrees.v1.BookingService.CheckServiceArea:input_type -> degrees.v1.CheckServiceAreaRequest
+	27, // 59: degrees.v1.BookingService.ListMyBookings:input_type -> degrees.v1.ListMyBookingsRequest
+	29, // 60: degrees.v1.BookingService.GetMyBooking:input_type -> degrees.v1.GetMyBookingRequest
+	33, // 61: degrees.v1.BookingService.CancelBooking:input_type -> degrees.v1.CancelBookingRequest
+	35, // 62: degrees.v1.BookingService.RescheduleBooking:input_type -> degrees.v1.RescheduleBookingRequest
+	37, // 63: degrees.v1.BookingService.ListMyBookingSeries:input_type -> degrees.v1.ListMyBookingSeriesRequest
+	39, // 64: degrees.v1.BookingService.SkipSeriesOccurrence:input_type -> degrees.v1.SkipSeriesOccurrenceRequest
+	41, // 65: degrees.v1.BookingService.CancelBookingSeries:input_type -> degrees.v1.CancelBookingSeriesRequest
+	43, // 66: degrees.v1.BookingService.JoinWaitlist:input_type -> degrees.v1.JoinWaitlistRequest
+	45, // 67: degrees.v1.BookingService.ListMyWaitlist:input_type -> degrees.v1.ListMyWaitlistRequest
+	47, // 68: degrees.v1.BookingService.LeaveWaitlist:input_type -> degrees.v1.LeaveWaitlistRequest
+	53, // 69: degrees.v1.BookingService.ClaimWaitlistOffer:input_type -> degrees.v1.ClaimWaitlistOfferRequest
+	49, // 70: degrees.v1.BookingService.GetMyCalendarFeed:input_type -> degrees.v1.GetMyCalendarFeedRequest
+	51, // 71: degrees.v1.BookingService.ResetMyCalendarFeed:input_type -> degrees.v1.ResetMyCalendarFeedRequest
+	55, // 72: degrees.v1.BookingService.ListAllBookings:input_type -> degrees.v1.ListAllBookingsRequest
+	66, // 73: degrees.v1.BookingService.CreateBooking:input_type -> degrees.v1.CreateBookingRequest
+	57, // 74: degrees.v1.BookingService.GetBooking:input_type -> degrees.v1.GetBookingRequest
+	59, // 75: degrees.v1.BookingService.UpdateBookingStatus:input_type -> degrees.v1.UpdateBookingStatusRequest
+	61, // 76: degrees.v1.BookingService.CompleteBooking:input_type -> degrees.v1.CompleteBookingRequest
+	68, // 77: degrees.v1.BookingService.MarkBookingNoShow:input_type -> degrees.v1.MarkBookingNoShowRequest
+	70, // 78: degrees.v1.BookingService.AddBookingService:input_type -> degrees.v1.AddBookingServiceRequest
+	72, // 79: degrees.v1.BookingService.RemoveBookingService:input_type -> degrees.v1.RemoveBookingServiceRequest
+	74, // 80: degrees.v1.BookingService.AddBookingServiceOption:input_type -> degrees.v1.AddBookingServiceOptionRequest
+	76, // 81: degrees.v1.BookingService.RemoveBookingServiceOption:input_type -> degrees.v1.RemoveBookingServiceOptionRequest
+	79, // 82: degrees.v1.BookingService.RecordBookingPayment:input_type -> degrees.v1.RecordBookingPaymentRequest
+	81, // 83: degrees.v1.BookingService.ListBookingPayments:input_type -> degrees.v1.ListBookingPaymentsRequest
+	15, // 84: degrees.v1.BookingService.CreateBookingFromCart:output_type -> degrees.v1.CreateBookingFromCartResponse
+	18, // 85: degrees.v1.BookingService.HoldCheckoutSlot:output_type -> degrees.v1.HoldCheckoutSlotResponse
+	20, // 86: degrees.v1.BookingService.ReleaseCheckoutHold:output_type -> degrees.v1.ReleaseCheckoutHoldResponse
+	22, // 87: degrees.v1.BookingService.GetAvailableSlots:output_type -> degrees.v1.GetAvailableSlotsResponse
+	32, // 88: degrees.v1.BookingService.GetCancellationPolicy:output_type -> degrees.v1.GetCancellationPolicyResponse
+	24, // 89: degrees.v1.BookingService.GetAvailabilityCalendar:output_type -> degrees.v1.GetAvailabilityCalendarResponse
+	26, // 90: degrees.v1.BookingService.CheckServiceArea:output_type -> degrees.v1.CheckServiceAreaResponse
+	28, // 91: degrees.v1.BookingService.ListMyBookings:output_type -> degrees.v1.ListMyBookingsResponse
+	30, // 92: degrees.v1.BookingService.GetMyBooking:output_type -> degrees.v1.GetMyBookingResponse
+	34, // 93: degrees.v1.BookingService.CancelBooking:output_type -> degrees.v1.CancelBookingResponse
+	36, // 94: degrees.v1.BookingService.RescheduleBooking:output_type -> degrees.v1.RescheduleBookingResponse
+	38, // 95: degrees.v1.BookingService.ListMyBookingSeries:output_type -> degrees.v1.ListMyBookingSeriesResponse
+	40, // 96: degrees.v1.BookingService.SkipSeriesOccurrence:output_type -> degrees.v1.SkipSeriesOccurrenceResponse
+	42, // 97: degrees.v1.BookingService.CancelBookingSeries:output_type -> degrees.v1.CancelBookingSeriesResponse
+	44, // 98: degrees.v1.BookingService.JoinWaitlist:output_type -> degrees.v1.JoinWaitlistResponse
+	46, // 99: degrees.v1.BookingService.ListMyWaitlist:output_type -> degrees.v1.ListMyWaitlistResponse
+	48, // 100: degrees.v1.BookingService.LeaveWaitlist:output_type -> degrees.v1.LeaveWaitlistResponse
+	54, // 101: degrees.v1.BookingService.ClaimWaitlistOffer:output_type -> degrees.v1.ClaimWaitlistOfferResponse
+	50, // 102: degrees.v1.BookingService.GetMyCalendarFeed:output_type -> degrees.v1.GetMyCalendarFeedResponse
+	52, // 103: degrees.v1.BookingService.ResetMyCalendarFeed:output_type -> degrees.v1.ResetMyCalendarFeedResponse
+	56, // 104: degrees.v1.BookingService.ListAllBookings:output_type -> degrees.v1.ListAllBookingsResponse
+	67, // 105: degrees.v1.BookingService.CreateBooking:output_type -> degrees.v1.CreateBookingResponse
+	58, // 106: degrees.v1.BookingService.GetBooking:output_type -> degrees.v1.GetBookingResponse
+	60, // 107: degrees.v1.BookingService.UpdateBookingStatus:output_type -> degrees.v1.UpdateBookingStatusResponse
+	62, // 108: degrees.v1.BookingService.CompleteBooking:output_type -> degrees.v1.CompleteBookingResponse
+	69, // 109: degrees.v1.BookingService.MarkBookingNoShow:output_type -> degrees.v1.MarkBookingNoShowResponse
+	71, // 110: degrees.v1.BookingService.AddBookingService:output_type -> degrees.v1.AddBookingServiceResponse
+	73, // 111: degrees.v1.BookingService.RemoveBookingService:output_type -> degrees.v1.RemoveBookingServiceResponse
+	75, // 112: degrees.v1.BookingService.AddBookingServiceOption:output_type -> degrees.v1.AddBookingServiceOptionResponse
+	77, // 113: degrees.v1.BookingService.RemoveBookingServiceOption:output_type -> degrees.v1.RemoveBookingServiceOptionResponse
+	80, // 114: degrees.v1.BookingService.RecordBookingPayment:output_type -> degrees.v1.RecordBookingPaymentResponse
+	82, // 115: degrees.v1.BookingService.ListBookingPayments:output_type -> degrees.v1.ListBookingPaymentsResponse
+	84, // [84:116] is the sub-list for method output_type
+	52, // [52:84] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_degrees_v1_booking_service_proto_init() }
@@ -1575,13 +5648,15 @@ func file_degrees_v1_booking_service_proto_init() {
 	if File_degrees_v1_booking_service_proto != nil {
 		return
 	}
+	file_degrees_v1_booking_service_proto_msgTypes[65].OneofWrappers = []any{}
+	file_degrees_v1_booking_service_proto_msgTypes[70].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_degrees_v1_booking_service_proto_rawDesc), len(file_degrees_v1_booking_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,15 +19,38 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookingService_CreateBookingFromCart_FullMethodName = "/degrees.v1.BookingService/CreateBookingFromCart"
-	BookingService_GetAvailableSlots_FullMethodName     = "/degrees.v1.BookingService/GetAvailableSlots"
-	BookingService_ListMyBookings_FullMethodName        = "/degrees.v1.BookingService/ListMyBookings"
-	BookingService_GetMyBooking_FullMethodName          = "/degrees.v1.BookingService/GetMyBooking"
-	BookingService_CancelBooking_FullMethodName         = "/degrees.v1.BookingService/CancelBooking"
-	BookingService_ListAllBookings_FullMethodName       = "/degrees.v1.BookingService/ListAllBookings"
-	BookingService_GetBooking_FullMethodName            = "/degrees.v1.BookingService/GetBooking"
-	BookingService_UpdateBookingStatus_FullMethodName   = "/degrees.v1.BookingService/UpdateBookingStatus"
-	BookingService_CompleteBooking_FullMethodName       = "/degrees.v1.BookingService/CompleteBooking"
+	BookingService_CreateBookingFromCart_FullMethodName      = "/degrees.v1.BookingService/CreateBookingFromCart"
+	BookingService_HoldCheckoutSlot_FullMethodName           = "/degrees.v1.BookingService/HoldCheckoutSlot"
+	BookingService_ReleaseCheckoutHold_FullMethodName        = "/degrees.v1.BookingService/ReleaseCheckoutHold"
+	BookingService_GetAvailableSlots_FullMethodName          = "/degrees.v1.BookingService/GetAvailableSlots"
+	BookingService_GetCancellationPolicy_FullMethodName      = "/degrees.v1.BookingService/GetCancellationPolicy"
+	BookingService_GetAvailabilityCalendar_FullMethodName    = "/degrees.v1.BookingService/GetAvailabilityCalendar"
+	BookingService_CheckServiceArea_FullMethodName           = "/degrees.v1.BookingService/CheckServiceArea"
+	BookingService_ListMyBookings_FullMethodName             = "/degrees.v1.BookingService/ListMyBookings"
+	BookingService_GetMyBooking_FullMethodName               = "/degrees.v1.BookingService/GetMyBooking"
+	BookingService_CancelBooking_FullMethodName              = "/degrees.v1.BookingService/CancelBooking"
+	BookingService_RescheduleBooking_FullMethodName          = "/degrees.v1.BookingService/RescheduleBooking"
+	BookingService_ListMyBookingSeries_FullMethodName        = "/degrees.v1.BookingService/ListMyBookingSeries"
+	BookingService_SkipSeriesOccurrence_FullMethodName       = "/degrees.v1.BookingService/SkipSeriesOccurrence"
+	BookingService_CancelBookingSeries_FullMethodName        = "/degrees.v1.BookingService/CancelBookingSeries"
+	BookingService_JoinWaitlist_FullMethodName               = "/degrees.v1.BookingService/JoinWaitlist"
+	BookingService_ListMyWaitlist_FullMethodName             = "/degrees.v1.BookingService/ListMyWaitlist"
+	BookingService_LeaveWaitlist_FullMethodName              = "/degrees.v1.BookingService/LeaveWaitlist"
+	BookingService_ClaimWaitlistOffer_FullMethodName         = "/degrees.v1.BookingService/ClaimWaitlistOffer"
+	BookingService_GetMyCalendarFeed_FullMethodName          = "/degrees.v1.BookingService/GetMyCalendarFeed"
+	BookingService_ResetMyCalendarFeed_FullMethodName        = "/degrees.v1.BookingService/ResetMyCalendarFeed"
+	BookingService_ListAllBookings_FullMethodName            = "/degrees.v1.BookingService/ListAllBookings"
+	BookingService_CreateBooking_FullMethodName              = "/degrees.v1.BookingService/CreateBooking"
+	BookingService_GetBooking_FullMethodName                 = "/degrees.v1.BookingService/GetBooking"
+	BookingService_UpdateBookingStatus_FullMethodName        = "/degrees.v1.BookingService/UpdateBookingStatus"
+	BookingService_CompleteBooking_FullMethodName            = "/degrees.v1.BookingService/CompleteBooking"
+	BookingService_MarkBookingNoShow_FullMethodName          = "/degrees.v1.BookingService/MarkBookingNoShow"
+	BookingService_AddBookingService_FullMethodName          = "/degrees.v1.BookingService/AddBookingService"
+	BookingService_RemoveBookingService_FullMethodName       = "/degrees.v1.BookingService/RemoveBookingService"
+	BookingService_AddBookingServiceOption_FullMethodName    = "/degrees.v1.BookingService/AddBookingServiceOption"
+	BookingService_RemoveBookingServiceOption_FullMethodName = "/degrees.v1.BookingService/RemoveBookingServiceOption"
+	BookingService_RecordBookingPayment_FullMethodName       = "/degrees.v1.BookingService/RecordBookingPayment"
+	BookingService_ListBookingPayments_FullMethodName        = "/degrees.v1.BookingService/ListBookingPayments"
 )
 
 // BookingServiceClient is the client API for BookingService service.
@@ -36,22 +59,69 @@ const (
 type BookingServiceClient interface {
 	// Create a booking from the current cart
 	CreateBookingFromCart(ctx context.Context, in *CreateBookingFromCartRequest, opts ...grpc.CallOption) (*CreateBookingFromCartResponse, error)
+	// Hold the chosen slot for the cart while the customer pays, replacing any
+	// slot they held before
+	HoldCheckoutSlot(ctx context.Context, in *HoldCheckoutSlotRequest, opts ...grpc.CallOption) (*HoldCheckoutSlotResponse, error)
+	// Give up the caller's checkout hold
+	ReleaseCheckoutHold(ctx context.Context, in *ReleaseCheckoutHoldRequest, opts ...grpc.CallOption) (*ReleaseCheckoutHoldResponse, error)
 	// Get available time slots for a date
 	GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest, opts ...grpc.CallOption) (*GetAvailableSlotsResponse, error)
+	// Get the cancellation and refund policy shown before checkout
+	GetCancellationPolicy(ctx context.Context, in *GetCancellationPolicyRequest, opts ...grpc.CallOption) (*GetCancellationPolicyResponse, error)
+	// Summarise availability for each day in a date range, for a month view
+	GetAvailabilityCalendar(ctx context.Context, in *GetAvailabilityCalendarRequest, opts ...grpc.CallOption) (*GetAvailabilityCalendarResponse, error)
+	// Check whether a postcode is inside the mobile service area
+	CheckServiceArea(ctx context.Context, in *CheckServiceAreaRequest, opts ...grpc.CallOption) (*CheckServiceAreaResponse, error)
 	// List bookings for the authenticated user
 	ListMyBookings(ctx context.Context, in *ListMyBookingsRequest, opts ...grpc.CallOption) (*ListMyBookingsResponse, error)
 	// Get a specific booking for the authenticated user
 	GetMyBooking(ctx context.Context, in *GetMyBookingRequest, opts ...grpc.CallOption) (*GetMyBookingResponse, error)
 	// Cancel a booking
 	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
+	// Move a booking to a new date and time
+	RescheduleBooking(ctx context.Context, in *RescheduleBookingRequest, opts ...grpc.CallOption) (*RescheduleBookingResponse, error)
+	// List the caller's recurring booking series
+	ListMyBookingSeries(ctx context.Context, in *ListMyBookingSeriesRequest, opts ...grpc.CallOption) (*ListMyBookingSeriesResponse, error)
+	// Skip one date of a series, cancelling its booking if already made
+	SkipSeriesOccurrence(ctx context.Context, in *SkipSeriesOccurrenceRequest, opts ...grpc.CallOption) (*SkipSeriesOccurrenceResponse, error)
+	// Cancel a series and its upcoming bookings
+	CancelBookingSeries(ctx context.Context, in *CancelBookingSeriesRequest, opts ...grpc.CallOption) (*CancelBookingSeriesResponse, error)
+	// Join the waitlist for a fully booked date or range with the current cart
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error)
+	// List the caller's waitlist entries
+	ListMyWaitlist(ctx context.Context, in *ListMyWaitlistRequest, opts ...grpc.CallOption) (*ListMyWaitlistResponse, error)
+	// Leave the waitlist, releasing any slot held for the caller
+	LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error)
+	// Book the slot offered by a waitlist claim link
+	ClaimWaitlistOffer(ctx context.Context, in *ClaimWaitlistOfferRequest, opts ...grpc.CallOption) (*ClaimWaitlistOfferResponse, error)
+	// Get the URL of the caller's calendar feed, creating it if needed
+	GetMyCalendarFeed(ctx context.Context, in *GetMyCalendarFeedRequest, opts ...grpc.CallOption) (*GetMyCalendarFeedResponse, error)
+	// Replace the caller's calendar feed URL, disabling the old one
+	ResetMyCalendarFeed(ctx context.Context, in *ResetMyCalendarFeedRequest, opts ...grpc.CallOption) (*ResetMyCalendarFeedResponse, error)
 	// List all bookings (admin)
 	ListAllBookings(ctx context.Context, in *ListAllBookingsRequest, opts ...grpc.CallOption) (*ListAllBookingsResponse, error)
+	// Book on a customer's behalf, e.g. a phone or walk-in booking (admin)
+	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error)
 	// Get any booking by ID (admin)
 	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error)
 	// Update booking status (admin)
 	UpdateBookingStatus(ctx context.Context, in *UpdateBookingStatusRequest, opts ...grpc.CallOption) (*UpdateBookingStatusResponse, error)
 	// Complete a booking (admin)
 	CompleteBooking(ctx context.Context, in *CompleteBookingRequest, opts ...grpc.CallOption) (*CompleteBookingResponse, error)
+	// Mark a booking as a no-show, forfeiting what was paid (admin)
+	MarkBookingNoShow(ctx context.Context, in *MarkBookingNoShowRequest, opts ...grpc.CallOption) (*MarkBookingNoShowResponse, error)
+	// Add a service to a booking, repricing it; any extra is left owing (admin)
+	AddBookingService(ctx context.Context, in *AddBookingServiceRequest, opts ...grpc.CallOption) (*AddBookingServiceResponse, error)
+	// Remove a service from a booking, repricing it (admin)
+	RemoveBookingService(ctx context.Context, in *RemoveBookingServiceRequest, opts ...grpc.CallOption) (*RemoveBookingServiceResponse, error)
+	// Add an option to one of a booking's services (admin)
+	AddBookingServiceOption(ctx context.Context, in *AddBookingServiceOptionRequest, opts ...grpc.CallOption) (*AddBookingServiceOptionResponse, error)
+	// Remove an option from one of a booking's services (admin)
+	RemoveBookingServiceOption(ctx context.Context, in *RemoveBookingServiceOptionRequest, opts ...grpc.CallOption) (*RemoveBookingServiceOptionResponse, error)
+	// Record a payment taken in person by cash, EFTPOS or bank transfer (admin)
+	RecordBookingPayment(ctx context.Context, in *RecordBookingPaymentRequest, opts ...grpc.CallOption) (*RecordBookingPaymentResponse, error)
+	// List a booking's payments, refunds and adjustments (admin)
+	ListBookingPayments(ctx context.Context, in *ListBookingPaymentsRequest, opts ...grpc.CallOption) (*ListBookingPaymentsResponse, error)
 }
 
 type bookingServiceClient struct {
@@ -72,6 +142,26 @@ func (c *bookingServiceClient) CreateBookingFromCart(ctx context.Context, in *Cr
 	return out, nil
 }
 
+func (c *bookingServiceClient) HoldCheckoutSlot(ctx context.Context, in *HoldCheckoutSlotRequest, opts ...grpc.CallOption) (*HoldCheckoutSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HoldCheckoutSlotResponse)
+	err := c.cc.Invoke(ctx, BookingService_HoldCheckoutSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ReleaseCheckoutHold(ctx context.Context, in *ReleaseCheckoutHoldRequest, opts ...grpc.CallOption) (*ReleaseCheckoutHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseCheckoutHoldResponse)
+	err := c.cc.Invoke(ctx, BookingService_ReleaseCheckoutHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest, opts ...grpc.CallOption) (*GetAvailableSlotsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAvailableSlotsResponse)
@@ -82,6 +172,36 @@ func (c *bookingServiceClient) GetAvailableSlots(ctx context.Context, in *GetAva
 	return out, nil
 }
 
+func (c *bookingServiceClient) GetCancellationPolicy(ctx context.Context, in *GetCancellationPolicyRequest, opts ...grpc.CallOption) (*GetCancellationPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCancellationPolicyResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetCancellationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetAvailabilityCalendar(ctx context.Context, in *GetAvailabilityCalendarRequest, opts ...grpc.CallOption) (*GetAvailabilityCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAvailabilityCalendarResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetAvailabilityCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CheckServiceArea(ctx context.Context, in *CheckServiceAreaRequest, opts ...grpc.CallOption) (*CheckServiceAreaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckServiceAreaResponse)
+	err := c.cc.Invoke(ctx, BookingService_CheckServiceArea_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) ListMyBookings(ctx context.Context, in *ListMyBookingsRequest, opts ...grpc.CallOption) (*ListMyBookingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMyBookingsResponse)
@@ -112,6 +232,106 @@ func (c *bookingServiceClient) CancelBooking(ctx context.Context, in *CancelBook
 	return out, nil
 }
 
+func (c *bookingServiceClient) RescheduleBooking(ctx context.Context, in *RescheduleBookingRequest, opts ...grpc.CallOption) (*RescheduleBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescheduleBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_RescheduleBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListMyBookingSeries(ctx context.Context, in *ListMyBookingSeriesRequest, opts ...grpc.CallOption) (*ListMyBookingSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyBookingSeriesResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListMyBookingSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SkipSeriesOccurrence(ctx context.Context, in *SkipSeriesOccurrenceRequest, opts ...grpc.CallOption) (*SkipSeriesOccurrenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkipSeriesOccurrenceResponse)
+	err := c.cc.Invoke(ctx, BookingService_SkipSeriesOccurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CancelBookingSeries(ctx context.Context, in *CancelBookingSeriesRequest, opts ...grpc.CallOption) (*CancelBookingSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBookingSeriesResponse)
+	err := c.cc.Invoke(ctx, BookingService_CancelBookingSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinWaitlistResponse)
+	err := c.cc.Invoke(ctx, BookingService_JoinWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListMyWaitlist(ctx context.Context, in *ListMyWaitlistRequest, opts ...grpc.CallOption) (*ListMyWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyWaitlistResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListMyWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveWaitlistResponse)
+	err := c.cc.Invoke(ctx, BookingService_LeaveWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ClaimWaitlistOffer(ctx context.Context, in *ClaimWaitlistOfferRequest, opts ...grpc.CallOption) (*ClaimWaitlistOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimWaitlistOfferResponse)
+	err := c.cc.Invoke(ctx, BookingService_ClaimWaitlistOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetMyCalendarFeed(ctx context.Context, in *GetMyCalendarFeedRequest, opts ...grpc.CallOption) (*GetMyCalendarFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetMyCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ResetMyCalendarFeed(ctx context.Context, in *ResetMyCalendarFeedRequest, opts ...grpc.CallOption) (*ResetMyCalendarFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetMyCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, BookingService_ResetMyCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) ListAllBookings(ctx context.Context, in *ListAllBookingsRequest, opts ...grpc.CallOption) (*ListAllBookingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAllBookingsResponse)
@@ -122,6 +342,16 @@ func (c *bookingServiceClient) ListAllBookings(ctx context.Context, in *ListAllB
 	return out, nil
 }
 
+func (c *bookingServiceClient) CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_CreateBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetBookingResponse)
@@ -152,28 +382,145 @@ func (c *bookingServiceClient) CompleteBooking(ctx context.Context, in *Complete
 	return out, nil
 }
 
+func (c *bookingServiceClient) MarkBookingNoShow(ctx context.Context, in *MarkBookingNoShowRequest, opts ...grpc.CallOption) (*MarkBookingNoShowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkBookingNoShowResponse)
+	err := c.cc.Invoke(ctx, BookingService_MarkBookingNoShow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) AddBookingService(ctx context.Context, in *AddBookingServiceRequest, opts ...grpc.CallOption) (*AddBookingServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBookingServiceResponse)
+	err := c.cc.Invoke(ctx, BookingService_AddBookingService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RemoveBookingService(ctx context.Context, in *RemoveBookingServiceRequest, opts ...grpc.CallOption) (*RemoveBookingServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveBookingServiceResponse)
+	err := c.cc.Invoke(ctx, BookingService_RemoveBookingService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) AddBookingServiceOption(ctx context.Context, in *AddBookingServiceOptionRequest, opts ...grpc.CallOption) (*AddBookingServiceOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBookingServiceOptionResponse)
+	err := c.cc.Invoke(ctx, BookingService_AddBookingServiceOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RemoveBookingServiceOption(ctx context.Context, in *RemoveBookingServiceOptionRequest, opts ...grpc.CallOption) (*RemoveBookingServiceOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveBookingServiceOptionResponse)
+	err := c.cc.Invoke(ctx, BookingService_RemoveBookingServiceOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RecordBookingPayment(ctx context.Context, in *RecordBookingPaymentRequest, opts ...grpc.CallOption) (*RecordBookingPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordBookingPaymentResponse)
+	err := c.cc.Invoke(ctx, BookingService_RecordBookingPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListBookingPayments(ctx context.Context, in *ListBookingPaymentsRequest, opts ...grpc.CallOption) (*ListBookingPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingPaymentsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListBookingPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations should embed UnimplementedBookingServiceServer
 // for forward compatibility.
 type BookingServiceServer interface {
 	// Create a booking from the current cart
 	CreateBookingFromCart(context.Context, *CreateBookingFromCartRequest) (*CreateBookingFromCartResponse, error)
+	// Hold the chosen slot for the cart while the customer pays, replacing any
+	// slot they held before
+	HoldCheckoutSlot(context.Context, *HoldCheckoutSlotRequest) (*HoldCheckoutSlotResponse, error)
+	// Give up the caller's checkout hold
+	ReleaseCheckoutHold(context.Context, *ReleaseCheckoutHoldRequest) (*ReleaseCheckoutHoldResponse, error)
 	// Get available time slots for a date
 	GetAvailableSlots(context.Context, *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error)
+	// Get the cancellation and refund policy shown before checkout
+	GetCancellationPolicy(context.Context, *GetCancellationPolicyRequest) (*GetCancellationPolicyResponse, error)
+	// Summarise availability for each day in a date range, for a month view
+	GetAvailabilityCalendar(context.Context, *GetAvailabilityCalendarRequest) (*GetAvailabilityCalendarResponse, error)
+	// Check whether a postcode is inside the mobile service area
+	CheckServiceArea(context.Context, *CheckServiceAreaRequest) (*CheckServiceAreaResponse, error)
 	// List bookings for the authenticated user
 	ListMyBookings(context.Context, *ListMyBookingsRequest) (*ListMyBookingsResponse, error)
 	// Get a specific booking for the authenticated user
 	GetMyBooking(context.Context, *GetMyBookingRequest) (*GetMyBookingResponse, error)
 	// Cancel a booking
 	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
+	// Move a booking to a new date and time
+	RescheduleBooking(context.Context, *RescheduleBookingRequest) (*RescheduleBookingResponse, error)
+	// List the caller's recurring booking series
+	ListMyBookingSeries(context.Context, *ListMyBookingSeriesRequest) (*ListMyBookingSeriesResponse, error)
+	// Skip one date of a series, cancelling its booking if already made
+	SkipSeriesOccurrence(context.Context, *SkipSeriesOccurrenceRequest) (*SkipSeriesOccurrenceResponse, error)
+	// Cancel a series and its upcoming bookings
+	CancelBookingSeries(context.Context, *CancelBookingSeriesRequest) (*CancelBookingSeriesResponse, error)
+	// Join the waitlist for a fully booked date or range with the current cart
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error)
+	// List the caller's waitlist entries
+	ListMyWaitlist(context.Context, *ListMyWaitlistRequest) (*ListMyWaitlistResponse, error)
+	// Leave the waitlist, releasing any slot held for the caller
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error)
+	// Book the slot offered by a waitlist claim link
+	ClaimWaitlistOffer(context.Context, *ClaimWaitlistOfferRequest) (*ClaimWaitlistOfferResponse, error)
+	// Get the URL of the caller's calendar feed, creating it if needed
+	GetMyCalendarFeed(context.Context, *GetMyCalendarFeedRequest) (*GetMyCalendarFeedResponse, error)
+	// Replace the caller's calendar feed URL, disabling the old one
+	ResetMyCalendarFeed(context.Context, *ResetMyCalendarFeedRequest) (*ResetMyCalendarFeedResponse, error)
 	// List all bookings (admin)
 	ListAllBookings(context.Context, *ListAllBookingsRequest) (*ListAllBookingsResponse, error)
+	// Book on a customer's behalf, e.g. a phone or walk-in booking (admin)
+	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error)
 	// Get any booking by ID (admin)
 	GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error)
 	// Update booking status (admin)
 	UpdateBookingStatus(context.Context, *UpdateBookingStatusRequest) (*UpdateBookingStatusResponse, error)
 	// Complete a booking (admin)
 	CompleteBooking(context.Context, *CompleteBookingRequest) (*CompleteBookingResponse, error)
+	// Mark a booking as a no-show, forfeiting what was paid (admin)
+	MarkBookingNoShow(context.Context, *MarkBookingNoShowRequest) (*MarkBookingNoShowResponse, error)
+	// Add a service to a booking, repricing it; any extra is left owing (admin)
+	AddBookingService(context.Context, *AddBookingServiceRequest) (*AddBookingServiceResponse, error)
+	// Remove a service from a booking, repricing it (admin)
+	RemoveBookingService(context.Context, *RemoveBookingServiceRequest) (*RemoveBookingServiceResponse, error)
+	// Add an option to one of a booking's services (admin)
+	AddBookingServiceOption(context.Context, *AddBookingServiceOptionRequest) (*AddBookingServiceOptionResponse, error)
+	// Remove an option from one of a booking's services (admin)
+	RemoveBookingServiceOption(context.Context, *RemoveBookingServiceOptionRequest) (*RemoveBookingServiceOptionResponse, error)
+	// Record a payment taken in person by cash, EFTPOS or bank transfer (admin)
+	RecordBookingPayment(context.Context, *RecordBookingPaymentRequest) (*RecordBookingPaymentResponse, error)
+	// List a booking's payments, refunds and adjustments (admin)
+	ListBookingPayments(context.Context, *ListBookingPaymentsRequest) (*ListBookingPaymentsResponse, error)
 }
 
 // UnimplementedBookingServiceServer should be embedded to have
@@ -186,9 +533,24 @@ type UnimplementedBookingServiceServer struct{}
 func (UnimplementedBookingServiceServer) CreateBookingFromCart(context.Context, *CreateBookingFromCartRequest) (*CreateBookingFromCartResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateBookingFromCart not implemented")
 }
+func (UnimplementedBookingServiceServer) HoldCheckoutSlot(context.Context, *HoldCheckoutSlotRequest) (*HoldCheckoutSlotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HoldCheckoutSlot not implemented")
+}
+func (UnimplementedBookingServiceServer) ReleaseCheckoutHold(context.Context, *ReleaseCheckoutHoldRequest) (*ReleaseCheckoutHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseCheckoutHold not implemented")
+}
 func (UnimplementedBookingServiceServer) GetAvailableSlots(context.Context, *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAvailableSlots not implemented")
 }
+func (UnimplementedBookingServiceServer) GetCancellationPolicy(context.Context, *GetCancellationPolicyRequest) (*GetCancellationPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCancellationPolicy not implemented")
+}
+func (UnimplementedBookingServiceServer) GetAvailabilityCalendar(context.Context, *GetAvailabilityCalendarRequest) (*GetAvailabilityCalendarResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAvailabilityCalendar not implemented")
+}
+func (UnimplementedBookingServiceServer) CheckServiceArea(context.Context, *CheckServiceAreaRequest) (*CheckServiceAreaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckServiceArea not implemented")
+}
 func (UnimplementedBookingServiceServer) ListMyBookings(context.Context, *ListMyBookingsRequest) (*ListMyBookingsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMyBookings not implemented")
 }
@@ -198,9 +560,42 @@ func (UnimplementedBookingServiceServer) GetMyBooking(context.Context, *GetMyBoo
 func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) RescheduleBooking(context.Context, *RescheduleBookingRequest) (*RescheduleBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RescheduleBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) ListMyBookingSeries(context.Context, *ListMyBookingSeriesRequest) (*ListMyBookingSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyBookingSeries not implemented")
+}
+func (UnimplementedBookingServiceServer) SkipSeriesOccurrence(context.Context, *SkipSeriesOccurrenceRequest) (*SkipSeriesOccurrenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SkipSeriesOccurrence not implemented")
+}
+func (UnimplementedBookingServiceServer) CancelBookingSeries(context.Context, *CancelBookingSeriesRequest) (*CancelBookingSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelBookingSeries not implemented")
+}
+func (UnimplementedBookingServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedBookingServiceServer) ListMyWaitlist(context.Context, *ListMyWaitlistRequest) (*ListMyWaitlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyWaitlist not implemented")
+}
+func (UnimplementedBookingServiceServer) LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedBookingServiceServer) ClaimWaitlistOffer(context.Context, *ClaimWaitlistOfferRequest) (*ClaimWaitlistOfferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClaimWaitlistOffer not implemented")
+}
+func (UnimplementedBookingServiceServer) GetMyCalendarFeed(context.Context, *GetMyCalendarFeedRequest) (*GetMyCalendarFeedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMyCalendarFeed not implemented")
+}
+func (UnimplementedBookingServiceServer) ResetMyCalendarFeed(context.Context, *ResetMyCalendarFeedRequest) (*ResetMyCalendarFeedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetMyCalendarFeed not implemented")
+}
 func (UnimplementedBookingServiceServer) ListAllBookings(context.Context, *ListAllBookingsRequest) (*ListAllBookingsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListAllBookings not implemented")
 }
+func (UnimplementedBookingServiceServer) CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBooking not implemented")
+}
 func (UnimplementedBookingServiceServer) GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetBooking not implemented")
 }
@@ -210,6 +605,27 @@ func (UnimplementedBookingServiceServer) UpdateBookingStatus(context.Context, *U
 func (UnimplementedBookingServiceServer) CompleteBooking(context.Context, *CompleteBookingRequest) (*CompleteBookingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CompleteBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) MarkBookingNoShow(context.Context, *MarkBookingNoShowRequest) (*MarkBookingNoShowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkBookingNoShow not implemented")
+}
+func (UnimplementedBookingServiceServer) AddBookingService(context.Context, *AddBookingServiceRequest) (*AddBookingServiceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddBookingService not implemented")
+}
+func (UnimplementedBookingServiceServer) RemoveBookingService(context.Context, *RemoveBookingServiceRequest) (*RemoveBookingServiceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveBookingService not implemented")
+}
+func (UnimplementedBookingServiceServer) AddBookingServiceOption(context.Context, *AddBookingServiceOptionRequest) (*AddBookingServiceOptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddBookingServiceOption not implemented")
+}
+func (UnimplementedBookingServiceServer) RemoveBookingServiceOption(context.Context, *RemoveBookingServiceOptionRequest) (*RemoveBookingServiceOptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveBookingServiceOption not implemented")
+}
+func (UnimplementedBookingServiceServer) RecordBookingPayment(context.Context, *RecordBookingPaymentRequest) (*RecordBookingPaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordBookingPayment not implemented")
+}
+func (UnimplementedBookingServiceServer) ListBookingPayments(context.Context, *ListBookingPaymentsRequest) (*ListBookingPaymentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBookingPayments not implemented")
+}
 func (UnimplementedBookingServiceServer) testEmbeddedByValue() {}
 
 // UnsafeBookingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -248,167 +664,601 @@ func _BookingService_CreateBookingFromCart_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_GetAvailableSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAvailableSlotsRequest)
+func _BookingService_HoldCheckoutSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldCheckoutSlotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).GetAvailableSlots(ctx, in)
+		return srv.(BookingServiceServer).HoldCheckoutSlot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_GetAvailableSlots_FullMethodName,
+		FullMethod: BookingService_HoldCheckoutSlot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).GetAvailableSlots(ctx, req.(*GetAvailableSlotsRequest))
+		return srv.(BookingServiceServer).HoldCheckoutSlot(ctx, req.(*HoldCheckoutSlotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_ListMyBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMyBookingsRequest)
+func _BookingService_ReleaseCheckoutHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseCheckoutHoldRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).ListMyBookings(ctx, in)
+		return srv.(BookingServiceServer).ReleaseCheckoutHold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_ListMyBookings_FullMethodName,
+		FullMethod: BookingService_ReleaseCheckoutHold_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).ListMyBookings(ctx, req.(*ListMyBookingsRequest))
+		return srv.(BookingServiceServer).ReleaseCheckoutHold(ctx, req.(*ReleaseCheckoutHoldRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_GetMyBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMyBookingRequest)
+func _BookingService_GetAvailableSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableSlotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).GetMyBooking(ctx, in)
+		return srv.(BookingServiceServer).GetAvailableSlots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_GetMyBooking_FullMethodName,
+		FullMethod: BookingService_GetAvailableSlots_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).GetMyBooking(ctx, req.(*GetMyBookingRequest))
+		return srv.(BookingServiceServer).GetAvailableSlots(ctx, req.(*GetAvailableSlotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelBookingRequest)
+func _BookingService_GetCancellationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCancellationPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).CancelBooking(ctx, in)
+		return srv.(BookingServiceServer).GetCancellationPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_CancelBooking_FullMethodName,
+		FullMethod: BookingService_GetCancellationPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+		return srv.(BookingServiceServer).GetCancellationPolicy(ctx, req.(*GetCancellationPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_ListAllBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllBookingsRequest)
+func _BookingService_GetAvailabilityCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityCalendarRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).ListAllBookings(ctx, in)
+		return srv.(BookingServiceServer).GetAvailabilityCalendar(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_ListAllBookings_FullMethodName,
+		FullMethod: BookingService_GetAvailabilityCalendar_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).ListAllBookings(ctx, req.(*ListAllBookingsRequest))
+		return srv.(BookingServiceServer).GetAvailabilityCalendar(ctx, req.(*GetAvailabilityCalendarRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBookingRequest)
+func _BookingService_CheckServiceArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckServiceAreaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).GetBooking(ctx, in)
+		return srv.(BookingServiceServer).CheckServiceArea(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_GetBooking_FullMethodName,
+		FullMethod: BookingService_CheckServiceArea_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).GetBooking(ctx, req.(*GetBookingRequest))
+		return srv.(BookingServiceServer).CheckServiceArea(ctx, req.(*CheckServiceAreaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_UpdateBookingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBookingStatusRequest)
+func _BookingService_ListMyBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyBookingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).UpdateBookingStatus(ctx, in)
+		return srv.(BookingServiceServer).ListMyBookings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_UpdateBookingStatus_FullMethodName,
+		FullMethod: BookingService_ListMyBookings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UpdateBookingStatus(ctx, req.(*UpdateBookingStatusRequest))
+		return srv.(BookingServiceServer).ListMyBookings(ctx, req.(*ListMyBookingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_CompleteBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CompleteBookingRequest)
+func _BookingService_GetMyBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyBookingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).CompleteBooking(ctx, in)
+		return srv.(BookingServiceServer).GetMyBooking(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_CompleteBooking_FullMethodName,
+		FullMethod: BookingService_GetMyBooking_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).CompleteBooking(ctx, req.(*CompleteBookingRequest))
+		return srv.(BookingServiceServer).GetMyBooking(ctx, req.(*GetMyBookingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var BookingService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "degrees.v1.BookingService",
-	HandlerType: (*BookingServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateBookingFromCart",
-			Handler:    _BookingService_CreateBookingFromCart_Handler,
-		},
-		{
-			MethodName: "GetAvailableSlots",
-			Handler:    _BookingService_GetAvailableSlots_Handler,
-		},
-		{
-			MethodName: "ListMyBookings",
+func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RescheduleBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RescheduleBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_RescheduleBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RescheduleBooking(ctx, req.(*RescheduleBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListMyBookingSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyBookingSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListMyBookingSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListMyBookingSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListMyBookingSeries(ctx, req.(*ListMyBookingSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SkipSeriesOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipSeriesOccurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SkipSeriesOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SkipSeriesOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SkipSeriesOccurrence(ctx, req.(*SkipSeriesOccurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CancelBookingSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelBookingSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelBookingSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelBookingSeries(ctx, req.(*CancelBookingSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_JoinWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListMyWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListMyWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListMyWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListMyWaitlist(ctx, req.(*ListMyWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_LeaveWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ClaimWaitlistOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimWaitlistOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ClaimWaitlistOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ClaimWaitlistOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ClaimWaitlistOffer(ctx, req.(*ClaimWaitlistOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetMyCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetMyCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetMyCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetMyCalendarFeed(ctx, req.(*GetMyCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ResetMyCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetMyCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ResetMyCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ResetMyCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ResetMyCalendarFeed(ctx, req.(*ResetMyCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListAllBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListAllBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListAllBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListAllBookings(ctx, req.(*ListAllBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateBooking(ctx, req.(*CreateBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBooking(ctx, req.(*GetBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateBookingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookingStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateBookingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateBookingStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateBookingStatus(ctx, req.(*UpdateBookingStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CompleteBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CompleteBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CompleteBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CompleteBooking(ctx, req.(*CompleteBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_MarkBookingNoShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkBookingNoShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).MarkBookingNoShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_MarkBookingNoShow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).MarkBookingNoShow(ctx, req.(*MarkBookingNoShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_AddBookingService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookingServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).AddBookingService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_AddBookingService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).AddBookingService(ctx, req.(*AddBookingServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RemoveBookingService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBookingServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RemoveBookingService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_RemoveBookingService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RemoveBookingService(ctx, req.(*RemoveBookingServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_AddBookingServiceOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookingServiceOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).AddBookingServiceOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_AddBookingServiceOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).AddBookingServiceOption(ctx, req.(*AddBookingServiceOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RemoveBookingServiceOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBookingServiceOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RemoveBookingServiceOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_RemoveBookingServiceOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RemoveBookingServiceOption(ctx, req.(*RemoveBookingServiceOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RecordBookingPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordBookingPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RecordBookingPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_RecordBookingPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RecordBookingPayment(ctx, req.(*RecordBookingPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListBookingPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookingPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListBookingPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListBookingPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListBookingPayments(ctx, req.(*ListBookingPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BookingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "degrees.v1.BookingService",
+	HandlerType: (*BookingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBookingFromCart",
+			Handler:    _BookingService_CreateBookingFromCart_Handler,
+		},
+		{
+			MethodName: "HoldCheckoutSlot",
+			Handler:    _BookingService_HoldCheckoutSlot_Handler,
+		},
+		{
+			MethodName: "ReleaseCheckoutHold",
+			Handler:    _BookingService_ReleaseCheckoutHold_Handler,
+		},
+		{
+			MethodName: "GetAvailableSlots",
+			Handler:    _BookingService_GetAvailableSlots_Handler,
+		},
+		{
+			MethodName: "GetCancellationPolicy",
+			Handler:    _BookingService_GetCancellationPolicy_Handler,
+		},
+		{
+			MethodName: "GetAvailabilityCalendar",
+			Handler:    _BookingService_GetAvailabilityCalendar_Handler,
+		},
+		{
+			MethodName: "CheckServiceArea",
+			Handler:    _BookingService_CheckServiceArea_Handler,
+		},
+		{
+			MethodName: "ListMyBookings",
 			Handler:    _BookingService_ListMyBookings_Handler,
 		},
 		{
@@ -419,10 +1269,54 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelBooking",
 			Handler:    _BookingService_CancelBooking_Handler,
 		},
+		{
+			MethodName: "RescheduleBooking",
+			Handler:    _BookingService_RescheduleBooking_Handler,
+		},
+		{
+			MethodName: "ListMyBookingSeries",
+			Handler:    _BookingService_ListMyBookingSeries_Handler,
+		},
+		{
+			MethodName: "SkipSeriesOccurrence",
+			Handler:    _BookingService_SkipSeriesOccurrence_Handler,
+		},
+		{
+			MethodName: "CancelBookingSeries",
+			Handler:    _BookingService_CancelBookingSeries_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _BookingService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "ListMyWaitlist",
+			Handler:    _BookingService_ListMyWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _BookingService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "ClaimWaitlistOffer",
+			Handler:    _BookingService_ClaimWaitlistOffer_Handler,
+		},
+		{
+			MethodName: "GetMyCalendarFeed",
+			Handler:    _BookingService_GetMyCalendarFeed_Handler,
+		},
+		{
+			MethodName: "ResetMyCalendarFeed",
+			Handler:    _BookingService_ResetMyCalendarFeed_Handler,
+		},
 		{
 			MethodName: "ListAllBookings",
 			Handler:    _BookingService_ListAllBookings_Handler,
 		},
+		{
+			MethodName: "CreateBooking",
+			Handler:    _BookingService_CreateBooking_Handler,
+		},
 		{
 			MethodName: "GetBooking",
 			Handler:    _BookingService_GetBooking_Handler,
@@ -435,6 +1329,34 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CompleteBooking",
 			Handler:    _BookingService_CompleteBooking_Handler,
 		},
+		{
+			MethodName: "MarkBookingNoShow",
+			Handler:    _BookingService_MarkBookingNoShow_Handler,
+		},
+		{
+			MethodName: "AddBookingService",
+			Handler:    _BookingService_AddBookingService_Handler,
+		},
+		{
+			MethodName: "RemoveBookingService",
+			Handler:    _BookingService_RemoveBookingService_Handler,
+		},
+		{
+			MethodName: "AddBookingServiceOption",
+			Handler:    _BookingService_AddBookingServiceOption_Handler,
+		},
+		{
+			MethodName: "RemoveBookingServiceOption",
+			Handler:    _BookingService_RemoveBookingServiceOption_Handler,
+		},
+		{
+			MethodName: "RecordBookingPayment",
+			Handler:    _BookingService_RecordBookingPayment_Handler,
+		},
+		{
+			MethodName: "ListBookingPayments",
+			Handler:    _BookingService_ListBookingPayments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "degrees/v1/booking_service.proto",
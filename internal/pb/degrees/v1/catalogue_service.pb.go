@@ -132,6 +132,7 @@ type DetailingService struct {
 	CreatedAt       *timestamppb.Timestamp    `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp    `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	PriceTiers      []*ServicePriceTier       `protobuf:"bytes,15,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
+	ResourceTypes   []string                  `protobuf:"bytes,16,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"` // e.g. "bay", "van"
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -271,6 +272,13 @@ func (x *DetailingService) GetPriceTiers() []*ServicePriceTier {
 	return nil
 }
 
+func (x *DetailingService) GetResourceTypes() []string {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
 type VehicleCategory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -370,8 +378,11 @@ type ServicePriceTier struct {
 	CategoryName      string                 `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
 	CategorySlug      string                 `protobuf:"bytes,4,opt,name=category_slug,json=categorySlug,proto3" json:"category_slug,omitempty"`
 	Price             int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// How long the service takes for this category; 0 when it is the
+	// service's own duration
+	DurationMinutes int32 `protobuf:"varint,6,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ServicePriceTier) Reset() {
@@ -439,18 +450,26 @@ func (x *ServicePriceTier) GetPrice() int64 {
 	return 0
 }
 
+func (x *ServicePriceTier) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
 type DetailingServiceOption struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ServiceId     int64                  `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	IsActive      bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	SortOrder     int32                  `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceId       int64                  `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price           int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	IsActive        bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	SortOrder       int32                  `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,9,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DetailingServiceOption) Reset() {
@@ -539,6 +558,13 @@ func (x *DetailingServiceOption) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *DetailingServiceOption) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
 type ListCategoriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -798,6 +824,7 @@ type CreateServiceRequest struct {
 	DurationMinutes int32                  `protobuf:"varint,7,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
 	IsActive        bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	SortOrder       int32                  `protobuf:"varint,9,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	ResourceTypes   []string               `protobuf:"bytes,10,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"` // defaults to ["bay"]
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -895,6 +922,13 @@ func (x *CreateServiceRequest) GetSortOrder() int32 {
 	return 0
 }
 
+func (x *CreateServiceRequest) GetResourceTypes() []string {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
 type CreateServiceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Service       *DetailingService      `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
@@ -951,6 +985,7 @@ type UpdateServiceRequest struct {
 	DurationMinutes int32                  `protobuf:"varint,8,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
 	IsActive        bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
 	SortOrder       int32                  `protobuf:"varint,10,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	ResourceTypes   []string               `protobuf:"bytes,11,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"` // unchanged when empty
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -1055,6 +1090,13 @@ func (x *UpdateServiceRequest) GetSortOrder() int32 {
 	return 0
 }
 
+func (x *UpdateServiceRequest) GetResourceTypes() []string {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
 type UpdateServiceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Service       *DetailingService      `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
@@ -1188,15 +1230,16 @@ func (x *DeleteServiceResponse) GetSuccess() bool {
 }
 
 type AddServiceOptionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServiceId     int64                  `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	SortOrder     int32                  `protobuf:"varint,6,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId       int64                  `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price           int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	IsActive        bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	SortOrder       int32                  `protobuf:"varint,6,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,7,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AddServiceOptionRequest) Reset() {
@@ -1271,6 +1314,13 @@ func (x *AddServiceOptionRequest) GetSortOrder() int32 {
 	return 0
 }
 
+func (x *AddServiceOptionRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
 type AddServiceOptionResponse struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Option        *DetailingServiceOption `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
@@ -1719,8 +1769,10 @@ type PriceTierInput struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	VehicleCategoryId int64                  `protobuf:"varint,1,opt,name=vehicle_category_id,json=vehicleCategoryId,proto3" json:"vehicle_category_id,omitempty"`
 	Price             int64                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Overrides the service's duration for this category; 0 keeps it
+	DurationMinutes int32 `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *PriceTierInput) Reset() {
@@ -1767,6 +1819,13 @@ func (x *PriceTierInput) GetPrice() int64 {
 	return 0
 }
 
+func (x *PriceTierInput) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
 type SetServicePriceTiersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServiceId     int64                  `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
@@ -1879,7 +1938,7 @@ const file_degrees_v1_catalogue_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xca\x04\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf1\x04\n" +
 	"\x10DetailingService\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\x03R\n" +
@@ -1903,7 +1962,8 @@ const file_degrees_v1_catalogue_service_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
 	"\vprice_tiers\x18\x0f \x03(\v2\x1c.degrees.v1.ServicePriceTierR\n" +
-	"priceTiers\"\x80\x02\n" +
+	"priceTiers\x12%\n" +
+	"\x0eresource_types\x18\x10 \x03(\tR\rresourceTypes\"\x80\x02\n" +
 	"\x0fVehicleCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -1914,14 +1974,15 @@ const file_degrees_v1_catalogue_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc1\x01\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xec\x01\n" +
 	"\x10ServicePriceTier\x12\x1d\n" +
 	"\n" +
 	"service_id\x18\x01 \x01(\x03R\tserviceId\x12.\n" +
 	"\x13vehicle_category_id\x18\x02 \x01(\x03R\x11vehicleCategoryId\x12#\n" +
 	"\rcategory_name\x18\x03 \x01(\tR\fcategoryName\x12#\n" +
 	"\rcategory_slug\x18\x04 \x01(\tR\fcategorySlug\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x03R\x05price\"\x8a\x02\n" +
+	"\x05price\x18\x05 \x01(\x03R\x05price\x12)\n" +
+	"\x10duration_minutes\x18\x06 \x01(\x05R\x0fdurationMinutes\"\xb5\x02\n" +
 	"\x16DetailingServiceOption\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
@@ -1933,7 +1994,8 @@ const file_degrees_v1_catalogue_service_proto_rawDesc = "" +
 	"\n" +
 	"sort_order\x18\a \x01(\x05R\tsortOrder\x129\n" +
 	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x17\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12)\n" +
+	"\x10duration_minutes\x18\t \x01(\x05R\x0fdurationMinutes\"\x17\n" +
 	"\x15ListCategoriesRequest\"U\n" +
 	"\x16ListCategoriesResponse\x12;\n" +
 	"\n" +
@@ -1945,7 +2007,7 @@ const file_degrees_v1_catalogue_service_proto_rawDesc = "" +
 	"\x1aGetCatalogueServiceRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\"U\n" +
 	"\x1bGetCatalogueServiceResponse\x126\n" +
-	"\aservice\x18\x01 \x01(\v2\x1c.degrees.v1.DetailingServiceR\aservice\"\xa6\x02\n" +
+	"\aservice\x18\x01 \x01(\v2\x1c.degrees.v1.DetailingServiceR\aservice\"\xcd\x02\n" +
 	"\x14CreateServiceRequest\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\x03R\n" +
 	"categoryId\x12\x12\n" +
@@ -1959,9 +2021,11 @@ const file_degrees_v1_catalogue_service_proto_rawDesc = "" +
 	"\x10duration_minutes\x18\a \x01(\x05R\x0fdurationMinutes\x12\x1b\n" +
 	"\tis_active\x18\b \x01(\bR\bisActive\x12\x1d\n" +
 	"\n" +
-	"sort_order\x18\t \x01(\x05R\tsortOrder\"O\n" +
+	"sort_order\x18\t \x01(\x05R\tsortOrder\x12%\n" +
+	"\x0eresource_types\x18\n" +
+	" \x03(\tR\rresourceTypes\"O\n" +
 	"\x15CreateServiceResponse\x126\n" +
-	"\aservice\x18\x01 \x01(\v2\x1c.degrees.v1.DetailingServiceR\aservice\"\xb6\x02\n" +
+	"\aservice\x18\x01 \x01(\v2\x1c.degrees.v1.DetailingServiceR\aservice\"\xdd\x02\n" +
 	"\x14UpdateServiceRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\x03R\n" +
@@ -1977,13 +2041,14 @@ const file_degrees_v1_catalogue_service_proto_rawDesc = "" +
 	"\tis_active\x18\t \x01(\bR\bisActive\x12\x1d\n" +
 	"\n" +
 	"sort_order\x18\n" +
-	" \x01(\x05R\tsortOrder\"O\n" +
+	" \x01(\x05R\tsortOrder\x12%\n" +
+	"\x0eresource_types\x18\v \x03(\tR\rresourceTypes\"O\n" +
 	"\x15UpdateServiceResponse\x126\n" +
 	"\aservice\x18\x01 \x01(\v2\x1c.degrees.v1.DetailingServiceR\aservice\"&\n" +
 	"\x14DeleteServiceRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"1\n" +
 	"\x15DeleteServiceResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc0\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xeb\x01\n" +
 	"\x17AddServiceOptionRequest\x12\x1d\n" +
 	"\n" +
 	"service_id\x18\x01 \x01(\x03R\tserviceId\x12\x12\n" +
@@ -1992,7 +2057,8 @@ const file_degrees_v1_catalogue_service_proto_rawDesc = "" +
 	"\x05price\x18\x04 \x01(\x03R\x05price\x12\x1b\n" +
 	"\tis_active\x18\x05 \x01(\bR\bisActive\x12\x1d\n" +
 	"\n" +
-	"sort_order\x18\x06 \x01(\x05R\tsortOrder\"V\n" +
+	"sort_order\x18\x06 \x01(\x05R\tsortOrder\x12)\n" +
+	"\x10duration_minutes\x18\a \x01(\x05R\x0fdurationMinutes\"V\n" +
 	"\x18AddServiceOptionResponse\x12:\n" +
 	"\x06option\x18\x01 \x01(\v2\".degrees.v1.DetailingServiceOptionR\x06option\"\x1e\n" +
 	"\x1cListVehicleCategoriesRequest\"k\n" +
@@ -2018,10 +2084,11 @@ const file_degrees_v1_catalogue_service_proto_rawDesc = "" +
 	"\x1cDeleteVehicleCategoryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"9\n" +
 	"\x1dDeleteVehicleCategoryResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"V\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x81\x01\n" +
 	"\x0ePriceTierInput\x12.\n" +
 	"\x13vehicle_category_id\x18\x01 \x01(\x03R\x11vehicleCategoryId\x12\x14\n" +
-	"\x05price\x18\x02 \x01(\x03R\x05price\"n\n" +
+	"\x05price\x18\x02 \x01(\x03R\x05price\x12)\n" +
+	"\x10duration_minutes\x18\x03 \x01(\x05R\x0fdurationMinutes\"n\n" +
 	"\x1bSetServicePriceTiersRequest\x12\x1d\n" +
 	"\n" +
 	"service_id\x18\x01 \x01(\x03R\tserviceId\x120\n" +
@@ -34,6 +34,7 @@ type CustomerProfile struct {
 	Notes         string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	NoShowCount   int32                  `protobuf:"varint,10,opt,name=no_show_count,json=noShowCount,proto3" json:"no_show_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,6 +132,13 @@ func (x *CustomerProfile) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CustomerProfile) GetNoShowCount() int32 {
+	if x != nil {
+		return x.NoShowCount
+	}
+	return 0
+}
+
 type Vehicle struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1140,7 +1148,7 @@ var File_degrees_v1_customer_service_proto protoreflect.FileDescriptor
 const file_degrees_v1_customer_service_proto_rawDesc = "" +
 	"\n" +
 	"!degrees/v1/customer_service.proto\x12\n" +
-	"degrees.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaa\x02\n" +
+	"degrees.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x02\n" +
 	"\x0fCustomerProfile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x14\n" +
@@ -1152,7 +1160,9 @@ const file_degrees_v1_customer_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb1\x03\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\"\n" +
+	"\rno_show_count\x18\n" +
+	" \x01(\x05R\vnoShowCount\"\xb1\x03\n" +
 	"\aVehicle\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vcustomer_id\x18\x02 \x01(\x03R\n" +
@@ -118,20 +118,268 @@ func (x *CreateDepositSessionResponse) GetDepositAmount() int64 {
 	return 0
 }
 
+type CreateBalanceSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     int64                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBalanceSessionRequest) Reset() {
+	*x = CreateBalanceSessionRequest{}
+	mi := &file_degrees_v1_payment_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBalanceSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBalanceSessionRequest) ProtoMessage() {}
+
+func (x *CreateBalanceSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_payment_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBalanceSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateBalanceSessionRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_payment_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateBalanceSessionRequest) GetBookingId() int64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+type CreateBalanceSessionResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ClientSecret string                 `protobuf:"bytes,1,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// What is left to pay: total_amount less amount_paid
+	BalanceAmount int64 `protobuf:"varint,2,opt,name=balance_amount,json=balanceAmount,proto3" json:"balance_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBalanceSessionResponse) Reset() {
+	*x = CreateBalanceSessionResponse{}
+	mi := &file_degrees_v1_payment_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBalanceSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBalanceSessionResponse) ProtoMessage() {}
+
+func (x *CreateBalanceSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_payment_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBalanceSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateBalanceSessionResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_payment_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateBalanceSessionResponse) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *CreateBalanceSessionResponse) GetBalanceAmount() int64 {
+	if x != nil {
+		return x.BalanceAmount
+	}
+	return 0
+}
+
+type RefundBookingRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BookingId int64                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// Cents to refund; 0 refunds what the cancellation policy gave back on a
+	// cancelled booking, and everything paid by card on any other
+	Amount        int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundBookingRequest) Reset() {
+	*x = RefundBookingRequest{}
+	mi := &file_degrees_v1_payment_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundBookingRequest) ProtoMessage() {}
+
+func (x *RefundBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_payment_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundBookingRequest.ProtoReflect.Descriptor instead.
+func (*RefundBookingRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_payment_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RefundBookingRequest) GetBookingId() int64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *RefundBookingRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundBookingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RefundBookingResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Booking *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	// Refunded and recorded against the booking
+	Refunded int64 `protobuf:"varint,2,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	// Accepted by the payment provider but not yet settled; recorded once it is
+	Pending int64 `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Turned down by the payment provider; still owed to the customer
+	Failed        int64 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundBookingResponse) Reset() {
+	*x = RefundBookingResponse{}
+	mi := &file_degrees_v1_payment_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundBookingResponse) ProtoMessage() {}
+
+func (x *RefundBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_payment_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundBookingResponse.ProtoReflect.Descriptor instead.
+func (*RefundBookingResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_payment_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RefundBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *RefundBookingResponse) GetRefunded() int64 {
+	if x != nil {
+		return x.Refunded
+	}
+	return 0
+}
+
+func (x *RefundBookingResponse) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *RefundBookingResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
 var File_degrees_v1_payment_service_proto protoreflect.FileDescriptor
 
 const file_degrees_v1_payment_service_proto_rawDesc = "" +
 	"\n" +
 	" degrees/v1/payment_service.proto\x12\n" +
-	"degrees.v1\x1a\x1cgoogle/api/annotations.proto\"<\n" +
+	"degrees.v1\x1a\x1cgoogle/api/annotations.proto\x1a degrees/v1/booking_service.proto\"<\n" +
 	"\x1bCreateDepositSessionRequest\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\x03R\tbookingId\"j\n" +
 	"\x1cCreateDepositSessionResponse\x12#\n" +
 	"\rclient_secret\x18\x01 \x01(\tR\fclientSecret\x12%\n" +
-	"\x0edeposit_amount\x18\x02 \x01(\x03R\rdepositAmount2\xa1\x01\n" +
+	"\x0edeposit_amount\x18\x02 \x01(\x03R\rdepositAmount\"<\n" +
+	"\x1bCreateBalanceSessionRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x03R\tbookingId\"j\n" +
+	"\x1cCreateBalanceSessionResponse\x12#\n" +
+	"\rclient_secret\x18\x01 \x01(\tR\fclientSecret\x12%\n" +
+	"\x0ebalance_amount\x18\x02 \x01(\x03R\rbalanceAmount\"e\n" +
+	"\x14RefundBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x03R\tbookingId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x94\x01\n" +
+	"\x15RefundBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.degrees.v1.BookingR\abooking\x12\x1a\n" +
+	"\brefunded\x18\x02 \x01(\x03R\brefunded\x12\x18\n" +
+	"\apending\x18\x03 \x01(\x03R\apending\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x03R\x06failed2\xc0\x03\n" +
 	"\x0ePaymentService\x12\x8e\x01\n" +
-	"\x14CreateDepositSession\x12'.degrees.v1.CreateDepositSessionRequest\x1a(.degrees.v1.CreateDepositSessionResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/checkout/depositB\xb1\x01\n" +
+	"\x14CreateDepositSession\x12'.degrees.v1.CreateDepositSessionRequest\x1a(.degrees.v1.CreateDepositSessionResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/checkout/deposit\x12\x8e\x01\n" +
+	"\x14CreateBalanceSession\x12'.degrees.v1.CreateBalanceSessionRequest\x1a(.degrees.v1.CreateBalanceSessionResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/checkout/balance\x12\x8b\x01\n" +
+	"\rRefundBooking\x12 .degrees.v1.RefundBookingRequest\x1a!.degrees.v1.RefundBookingResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/admin/bookings/{booking_id}/refundB\xb1\x01\n" +
 	"\x0ecom.degrees.v1B\x13PaymentServiceProtoP\x01ZAgithub.com/richardbowden/degrees/internal/pb/degrees/v1;degreesv1\xa2\x02\x03DXX\xaa\x02\n" +
 	"Degrees.V1\xca\x02\n" +
 	"Degrees\\V1\xe2\x02\x16Degrees\\V1\\GPBMetadata\xea\x02\vDegrees::V1b\x06proto3"
@@ -148,19 +396,29 @@ func file_degrees_v1_payment_service_proto_rawDescGZIP() []byte {
 	return file_degrees_v1_payment_service_proto_rawDescData
 }
 
-var file_degrees_v1_payment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_degrees_v1_payment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_degrees_v1_payment_service_proto_goTypes = []any{
 	(*CreateDepositSessionRequest)(nil),  // 0: degrees.v1.CreateDepositSessionRequest
 	(*CreateDepositSessionResponse)(nil), // 1: degrees.v1.CreateDepositSessionResponse
+	(*CreateBalanceSessionRequest)(nil),  // 2: degrees.v1.CreateBalanceSessionRequest
+	(*CreateBalanceSessionResponse)(nil), // 3: degrees.v1.CreateBalanceSessionResponse
+	(*RefundBookingRequest)(nil),         // 4: degrees.v1.RefundBookingRequest
+	(*RefundBookingResponse)(nil),        // 5: degrees.v1.RefundBookingResponse
+	(*Booking)(nil),                      // 6: degrees.v1.Booking
 }
 var file_degrees_v1_payment_service_proto_depIdxs = []int32{
-	0, // 0: degrees.v1.PaymentService.CreateDepositSession:input_type -> degrees.v1.CreateDepositSessionRequest
-	1, // 1: degrees.v1.PaymentService.CreateDepositSession:output_type -> degrees.v1.CreateDepositSessionResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	6, // 0: degrees.v1.RefundBookingResponse.booking:type_name -> degrees.v1.Booking
+	0, // 1: degrees.v1.PaymentService.CreateDepositSession:input_type -> degrees.v1.CreateDepositSessionRequest
+	2, // 2: degrees.v1.PaymentService.CreateBalanceSession:input_type -> degrees.v1.CreateBalanceSessionRequest
+	4, // 3: degrees.v1.PaymentService.RefundBooking:input_type -> degrees.v1.RefundBookingRequest
+	1, // 4: degrees.v1.PaymentService.CreateDepositSession:output_type -> degrees.v1.CreateDepositSessionResponse
+	3, // 5: degrees.v1.PaymentService.CreateBalanceSession:output_type -> degrees.v1.CreateBalanceSessionResponse
+	5, // 6: degrees.v1.PaymentService.RefundBooking:output_type -> degrees.v1.RefundBookingResponse
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_degrees_v1_payment_service_proto_init() }
@@ -168,13 +426,14 @@ func file_degrees_v1_payment_service_proto_init() {
 	if File_degrees_v1_payment_service_proto != nil {
 		return
 	}
+	file_degrees_v1_booking_service_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_degrees_v1_payment_service_proto_rawDesc), len(file_degrees_v1_payment_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,6 +20,8 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	PaymentService_CreateDepositSession_FullMethodName = "/degrees.v1.PaymentService/CreateDepositSession"
+	PaymentService_CreateBalanceSession_FullMethodName = "/degrees.v1.PaymentService/CreateBalanceSession"
+	PaymentService_RefundBooking_FullMethodName        = "/degrees.v1.PaymentService/RefundBooking"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -28,6 +30,11 @@ const (
 type PaymentServiceClient interface {
 	// Create a Stripe deposit payment session for a booking
 	CreateDepositSession(ctx context.Context, in *CreateDepositSessionRequest, opts ...grpc.CallOption) (*CreateDepositSessionResponse, error)
+	// Create a Stripe payment session for the rest of a booking once the
+	// deposit is paid
+	CreateBalanceSession(ctx context.Context, in *CreateBalanceSessionRequest, opts ...grpc.CallOption) (*CreateBalanceSessionResponse, error)
+	// Refund some or all of what was paid by card for a booking (admin)
+	RefundBooking(ctx context.Context, in *RefundBookingRequest, opts ...grpc.CallOption) (*RefundBookingResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -48,12 +55,37 @@ func (c *paymentServiceClient) CreateDepositSession(ctx context.Context, in *Cre
 	return out, nil
 }
 
+func (c *paymentServiceClient) CreateBalanceSession(ctx context.Context, in *CreateBalanceSessionRequest, opts ...grpc.CallOption) (*CreateBalanceSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBalanceSessionResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CreateBalanceSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RefundBooking(ctx context.Context, in *RefundBookingRequest, opts ...grpc.CallOption) (*RefundBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundBookingResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RefundBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations should embed UnimplementedPaymentServiceServer
 // for forward compatibility.
 type PaymentServiceServer interface {
 	// Create a Stripe deposit payment session for a booking
 	CreateDepositSession(context.Context, *CreateDepositSessionRequest) (*CreateDepositSessionResponse, error)
+	// Create a Stripe payment session for the rest of a booking once the
+	// deposit is paid
+	CreateBalanceSession(context.Context, *CreateBalanceSessionRequest) (*CreateBalanceSessionResponse, error)
+	// Refund some or all of what was paid by card for a booking (admin)
+	RefundBooking(context.Context, *RefundBookingRequest) (*RefundBookingResponse, error)
 }
 
 // UnimplementedPaymentServiceServer should be embedded to have
@@ -66,6 +98,12 @@ type UnimplementedPaymentServiceServer struct{}
 func (UnimplementedPaymentServiceServer) CreateDepositSession(context.Context, *CreateDepositSessionRequest) (*CreateDepositSessionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateDepositSession not implemented")
 }
+func (UnimplementedPaymentServiceServer) CreateBalanceSession(context.Context, *CreateBalanceSessionRequest) (*CreateBalanceSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBalanceSession not implemented")
+}
+func (UnimplementedPaymentServiceServer) RefundBooking(context.Context, *RefundBookingRequest) (*RefundBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefundBooking not implemented")
+}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue() {}
 
 // UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -104,6 +142,42 @@ func _PaymentService_CreateDepositSession_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_CreateBalanceSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBalanceSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreateBalanceSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CreateBalanceSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreateBalanceSession(ctx, req.(*CreateBalanceSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RefundBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RefundBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RefundBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RefundBooking(ctx, req.(*RefundBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +189,14 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateDepositSession",
 			Handler:    _PaymentService_CreateDepositSession_Handler,
 		},
+		{
+			MethodName: "CreateBalanceSession",
+			Handler:    _PaymentService_CreateBalanceSession_Handler,
+		},
+		{
+			MethodName: "RefundBooking",
+			Handler:    _PaymentService_RefundBooking_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "degrees/v1/payment_service.proto",
@@ -30,6 +30,9 @@ type ScheduleDay struct {
 	CloseTime     string                 `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
 	IsOpen        bool                   `protobuf:"varint,5,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
 	BufferMinutes int32                  `protobuf:"varint,6,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"`
+	// Set when the day has split hours; open_time and close_time are then the
+	// first opening and the last closing
+	Windows       []*TimeWindow `protobuf:"bytes,7,rep,name=windows,proto3" json:"windows,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -106,30 +109,969 @@ func (x *ScheduleDay) GetBufferMinutes() int32 {
 	return 0
 }
 
+func (x *ScheduleDay) GetWindows() []*TimeWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+// A period of opening hours within a day, HH:MM to HH:MM.
+type TimeWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OpenTime      string                 `protobuf:"bytes,1,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime     string                 `protobuf:"bytes,2,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TimeWindow) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetCloseTime() string {
+	if x != nil {
+		return x.CloseTime
+	}
+	return ""
+}
+
+// A closed date. A recurring blackout closes the same day and month every
+// year from date onwards.
 type Blackout struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date           string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RecursAnnually bool                   `protobuf:"varint,4,opt,name=recurs_annually,json=recursAnnually,proto3" json:"recurs_annually,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Blackout) Reset() {
+	*x = Blackout{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Blackout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Blackout) ProtoMessage() {}
+
+func (x *Blackout) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Blackout.ProtoReflect.Descriptor instead.
+func (*Blackout) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Blackout) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Blackout) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Blackout) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Blackout) GetRecursAnnually() bool {
+	if x != nil {
+		return x.RecursAnnually
+	}
+	return false
+}
+
+// Hours for a single date that replace the weekly hours, e.g. closing early
+// or opening late.
+type ScheduleOverride struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Windows       []*TimeWindow          `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleOverride) Reset() {
+	*x = ScheduleOverride{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleOverride) ProtoMessage() {}
+
+func (x *ScheduleOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleOverride.ProtoReflect.Descriptor instead.
+func (*ScheduleOverride) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ScheduleOverride) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ScheduleOverride) GetWindows() []*TimeWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *ScheduleOverride) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// A bay, van or other unit a job is performed on. Each resource takes one
+// job at a time.
+type Resource struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ResourceType string                 `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	IsActive     bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	SortOrder    int32                  `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// Days with their own hours; other days follow the business hours
+	Hours         []*ResourceHours `protobuf:"bytes,6,rep,name=hours,proto3" json:"hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Resource) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Resource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Resource) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *Resource) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Resource) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *Resource) GetHours() []*ResourceHours {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+type ResourceHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId    int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	DayOfWeek     int32                  `protobuf:"varint,2,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	OpenTime      string                 `protobuf:"bytes,3,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime     string                 `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	IsOpen        bool                   `protobuf:"varint,5,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceHours) Reset() {
+	*x = ResourceHours{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceHours) ProtoMessage() {}
+
+func (x *ResourceHours) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceHours.ProtoReflect.Descriptor instead.
+func (*ResourceHours) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResourceHours) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *ResourceHours) GetDayOfWeek() int32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *ResourceHours) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *ResourceHours) GetCloseTime() string {
+	if x != nil {
+		return x.CloseTime
+	}
+	return ""
+}
+
+func (x *ResourceHours) GetIsOpen() bool {
+	if x != nil {
+		return x.IsOpen
+	}
+	return false
+}
+
+type GetScheduleConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleConfigRequest) Reset() {
+	*x = GetScheduleConfigRequest{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleConfigRequest) ProtoMessage() {}
+
+func (x *GetScheduleConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleConfigRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{6}
+}
+
+type GetScheduleConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*ScheduleDay         `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleConfigResponse) Reset() {
+	*x = GetScheduleConfigResponse{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleConfigResponse) ProtoMessage() {}
+
+func (x *GetScheduleConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleConfigResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetScheduleConfigResponse) GetDays() []*ScheduleDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type UpdateScheduleConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DayOfWeek     int32                  `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	OpenTime      string                 `protobuf:"bytes,2,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime     string                 `protobuf:"bytes,3,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	IsOpen        bool                   `protobuf:"varint,4,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	BufferMinutes int32                  `protobuf:"varint,5,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"`
+	// Split hours, e.g. around a lunch break. When set, open_time and
+	// close_time are ignored and taken from the first and last window.
+	Windows       []*TimeWindow `protobuf:"bytes,6,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleConfigRequest) Reset() {
+	*x = UpdateScheduleConfigRequest{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleConfigRequest) ProtoMessage() {}
+
+func (x *UpdateScheduleConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleConfigRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateScheduleConfigRequest) GetDayOfWeek() int32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *UpdateScheduleConfigRequest) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *UpdateScheduleConfigRequest) GetCloseTime() string {
+	if x != nil {
+		return x.CloseTime
+	}
+	return ""
+}
+
+func (x *UpdateScheduleConfigRequest) GetIsOpen() bool {
+	if x != nil {
+		return x.IsOpen
+	}
+	return false
+}
+
+func (x *UpdateScheduleConfigRequest) GetBufferMinutes() int32 {
+	if x != nil {
+		return x.BufferMinutes
+	}
+	return 0
+}
+
+func (x *UpdateScheduleConfigRequest) GetWindows() []*TimeWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type UpdateScheduleConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           *ScheduleDay           `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleConfigResponse) Reset() {
+	*x = UpdateScheduleConfigResponse{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleConfigResponse) ProtoMessage() {}
+
+func (x *UpdateScheduleConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleConfigResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleConfigResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateScheduleConfigResponse) GetDay() *ScheduleDay {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+type AddBlackoutRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Date           string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	RecursAnnually bool                   `protobuf:"varint,3,opt,name=recurs_annually,json=recursAnnually,proto3" json:"recurs_annually,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddBlackoutRequest) Reset() {
+	*x = AddBlackoutRequest{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBlackoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBlackoutRequest) ProtoMessage() {}
+
+func (x *AddBlackoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBlackoutRequest.ProtoReflect.Descriptor instead.
+func (*AddBlackoutRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddBlackoutRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AddBlackoutRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AddBlackoutRequest) GetRecursAnnually() bool {
+	if x != nil {
+		return x.RecursAnnually
+	}
+	return false
+}
+
+type AddBlackoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blackout      *Blackout              `protobuf:"bytes,1,opt,name=blackout,proto3" json:"blackout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBlackoutResponse) Reset() {
+	*x = AddBlackoutResponse{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBlackoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBlackoutResponse) ProtoMessage() {}
+
+func (x *AddBlackoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBlackoutResponse.ProtoReflect.Descriptor instead.
+func (*AddBlackoutResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddBlackoutResponse) GetBlackout() *Blackout {
+	if x != nil {
+		return x.Blackout
+	}
+	return nil
+}
+
+type RemoveBlackoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBlackoutRequest) Reset() {
+	*x = RemoveBlackoutRequest{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBlackoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBlackoutRequest) ProtoMessage() {}
+
+func (x *RemoveBlackoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBlackoutRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBlackoutRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveBlackoutRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveBlackoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBlackoutResponse) Reset() {
+	*x = RemoveBlackoutResponse{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBlackoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBlackoutResponse) ProtoMessage() {}
+
+func (x *RemoveBlackoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBlackoutResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBlackoutResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveBlackoutResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListScheduleOverridesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleOverridesRequest) Reset() {
+	*x = ListScheduleOverridesRequest{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleOverridesRequest) ProtoMessage() {}
+
+func (x *ListScheduleOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleOverridesRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduleOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{14}
+}
+
+type ListScheduleOverridesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overrides     []*ScheduleOverride    `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleOverridesResponse) Reset() {
+	*x = ListScheduleOverridesResponse{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleOverridesResponse) ProtoMessage() {}
+
+func (x *ListScheduleOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleOverridesResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduleOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListScheduleOverridesResponse) GetOverrides() []*ScheduleOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+type SetScheduleOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Windows       []*TimeWindow          `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetScheduleOverrideRequest) Reset() {
+	*x = SetScheduleOverrideRequest{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetScheduleOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScheduleOverrideRequest) ProtoMessage() {}
+
+func (x *SetScheduleOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScheduleOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SetScheduleOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetScheduleOverrideRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SetScheduleOverrideRequest) GetWindows() []*TimeWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *SetScheduleOverrideRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetScheduleOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Override      *ScheduleOverride      `protobuf:"bytes,1,opt,name=override,proto3" json:"override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetScheduleOverrideResponse) Reset() {
+	*x = SetScheduleOverrideResponse{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetScheduleOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScheduleOverrideResponse) ProtoMessage() {}
+
+func (x *SetScheduleOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScheduleOverrideResponse.ProtoReflect.Descriptor instead.
+func (*SetScheduleOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetScheduleOverrideResponse) GetOverride() *ScheduleOverride {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+type RemoveScheduleOverrideRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Blackout) Reset() {
-	*x = Blackout{}
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[1]
+func (x *RemoveScheduleOverrideRequest) Reset() {
+	*x = RemoveScheduleOverrideRequest{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Blackout) String() string {
+func (x *RemoveScheduleOverrideRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Blackout) ProtoMessage() {}
+func (*RemoveScheduleOverrideRequest) ProtoMessage() {}
 
-func (x *Blackout) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[1]
+func (x *RemoveScheduleOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -140,53 +1082,83 @@ func (x *Blackout) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Blackout.ProtoReflect.Descriptor instead.
-func (*Blackout) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use RemoveScheduleOverrideRequest.ProtoReflect.Descriptor instead.
+func (*RemoveScheduleOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Blackout) GetId() int64 {
+func (x *RemoveScheduleOverrideRequest) GetDate() string {
 	if x != nil {
-		return x.Id
+		return x.Date
 	}
-	return 0
+	return ""
 }
 
-func (x *Blackout) GetDate() string {
+type RemoveScheduleOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveScheduleOverrideResponse) Reset() {
+	*x = RemoveScheduleOverrideResponse{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveScheduleOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveScheduleOverrideResponse) ProtoMessage() {}
+
+func (x *RemoveScheduleOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[19]
 	if x != nil {
-		return x.Date
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Blackout) GetReason() string {
+// Deprecated: Use RemoveScheduleOverrideResponse.ProtoReflect.Descriptor instead.
+func (*RemoveScheduleOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RemoveScheduleOverrideResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Reason
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type GetScheduleConfigRequest struct {
+type ListResourcesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetScheduleConfigRequest) Reset() {
-	*x = GetScheduleConfigRequest{}
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[2]
+func (x *ListResourcesRequest) Reset() {
+	*x = ListResourcesRequest{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetScheduleConfigRequest) String() string {
+func (x *ListResourcesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetScheduleConfigRequest) ProtoMessage() {}
+func (*ListResourcesRequest) ProtoMessage() {}
 
-func (x *GetScheduleConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[2]
+func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -197,33 +1169,33 @@ func (x *GetScheduleConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetScheduleConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetScheduleConfigRequest) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{20}
 }
 
-type GetScheduleConfigResponse struct {
+type ListResourcesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Days          []*ScheduleDay         `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	Resources     []*Resource            `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetScheduleConfigResponse) Reset() {
-	*x = GetScheduleConfigResponse{}
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[3]
+func (x *ListResourcesResponse) Reset() {
+	*x = ListResourcesResponse{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetScheduleConfigResponse) String() string {
+func (x *ListResourcesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetScheduleConfigResponse) ProtoMessage() {}
+func (*ListResourcesResponse) ProtoMessage() {}
 
-func (x *GetScheduleConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[3]
+func (x *ListResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,44 +1206,43 @@ func (x *GetScheduleConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetScheduleConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetScheduleConfigResponse) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListResourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetScheduleConfigResponse) GetDays() []*ScheduleDay {
+func (x *ListResourcesResponse) GetResources() []*Resource {
 	if x != nil {
-		return x.Days
+		return x.Resources
 	}
 	return nil
 }
 
-type UpdateScheduleConfigRequest struct {
+type CreateResourceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DayOfWeek     int32                  `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
-	OpenTime      string                 `protobuf:"bytes,2,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
-	CloseTime     string                 `protobuf:"bytes,3,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
-	IsOpen        bool                   `protobuf:"varint,4,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
-	BufferMinutes int32                  `protobuf:"varint,5,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateScheduleConfigRequest) Reset() {
-	*x = UpdateScheduleConfigRequest{}
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[4]
+func (x *CreateResourceRequest) Reset() {
+	*x = CreateResourceRequest{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateScheduleConfigRequest) String() string {
+func (x *CreateResourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateScheduleConfigRequest) ProtoMessage() {}
+func (*CreateResourceRequest) ProtoMessage() {}
 
-func (x *UpdateScheduleConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[4]
+func (x *CreateResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,68 +1253,61 @@ func (x *UpdateScheduleConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateScheduleConfigRequest.ProtoReflect.Descriptor instead.
-func (*UpdateScheduleConfigRequest) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *UpdateScheduleConfigRequest) GetDayOfWeek() int32 {
-	if x != nil {
-		return x.DayOfWeek
-	}
-	return 0
+// Deprecated: Use CreateResourceRequest.ProtoReflect.Descriptor instead.
+func (*CreateResourceRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateScheduleConfigRequest) GetOpenTime() string {
+func (x *CreateResourceRequest) GetName() string {
 	if x != nil {
-		return x.OpenTime
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateScheduleConfigRequest) GetCloseTime() string {
+func (x *CreateResourceRequest) GetResourceType() string {
 	if x != nil {
-		return x.CloseTime
+		return x.ResourceType
 	}
 	return ""
 }
 
-func (x *UpdateScheduleConfigRequest) GetIsOpen() bool {
+func (x *CreateResourceRequest) GetIsActive() bool {
 	if x != nil {
-		return x.IsOpen
+		return x.IsActive
 	}
 	return false
 }
 
-func (x *UpdateScheduleConfigRequest) GetBufferMinutes() int32 {
+func (x *CreateResourceRequest) GetSortOrder() int32 {
 	if x != nil {
-		return x.BufferMinutes
+		return x.SortOrder
 	}
 	return 0
 }
 
-type UpdateScheduleConfigResponse struct {
+type CreateResourceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Day           *ScheduleDay           `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateScheduleConfigResponse) Reset() {
-	*x = UpdateScheduleConfigResponse{}
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[5]
+func (x *CreateResourceResponse) Reset() {
+	*x = CreateResourceResponse{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateScheduleConfigResponse) String() string {
+func (x *CreateResourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateScheduleConfigResponse) ProtoMessage() {}
+func (*CreateResourceResponse) ProtoMessage() {}
 
-func (x *UpdateScheduleConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[5]
+func (x *CreateResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,41 +1318,44 @@ func (x *UpdateScheduleConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateScheduleConfigResponse.ProtoReflect.Descriptor instead.
-func (*UpdateScheduleConfigResponse) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CreateResourceResponse.ProtoReflect.Descriptor instead.
+func (*CreateResourceResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *UpdateScheduleConfigResponse) GetDay() *ScheduleDay {
+func (x *CreateResourceResponse) GetResource() *Resource {
 	if x != nil {
-		return x.Day
+		return x.Resource
 	}
 	return nil
 }
 
-type AddBlackoutRequest struct {
+type UpdateResourceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddBlackoutRequest) Reset() {
-	*x = AddBlackoutRequest{}
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[6]
+func (x *UpdateResourceRequest) Reset() {
+	*x = UpdateResourceRequest{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddBlackoutRequest) String() string {
+func (x *UpdateResourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddBlackoutRequest) ProtoMessage() {}
+func (*UpdateResourceRequest) ProtoMessage() {}
 
-func (x *AddBlackoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[6]
+func (x *UpdateResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,47 +1366,68 @@ func (x *AddBlackoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddBlackoutRequest.ProtoReflect.Descriptor instead.
-func (*AddBlackoutRequest) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use UpdateResourceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateResourceRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *AddBlackoutRequest) GetDate() string {
+func (x *UpdateResourceRequest) GetId() int64 {
 	if x != nil {
-		return x.Date
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AddBlackoutRequest) GetReason() string {
+func (x *UpdateResourceRequest) GetResourceType() string {
 	if x != nil {
-		return x.Reason
+		return x.ResourceType
 	}
 	return ""
 }
 
-type AddBlackoutResponse struct {
+func (x *UpdateResourceRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateResourceRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type UpdateResourceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Blackout      *Blackout              `protobuf:"bytes,1,opt,name=blackout,proto3" json:"blackout,omitempty"`
+	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddBlackoutResponse) Reset() {
-	*x = AddBlackoutResponse{}
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[7]
+func (x *UpdateResourceResponse) Reset() {
+	*x = UpdateResourceResponse{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddBlackoutResponse) String() string {
+func (x *UpdateResourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddBlackoutResponse) ProtoMessage() {}
+func (*UpdateResourceResponse) ProtoMessage() {}
 
-func (x *AddBlackoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[7]
+func (x *UpdateResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,40 +1438,46 @@ func (x *AddBlackoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddBlackoutResponse.ProtoReflect.Descriptor instead.
-func (*AddBlackoutResponse) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UpdateResourceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResourceResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *AddBlackoutResponse) GetBlackout() *Blackout {
+func (x *UpdateResourceResponse) GetResource() *Resource {
 	if x != nil {
-		return x.Blackout
+		return x.Resource
 	}
 	return nil
 }
 
-type RemoveBlackoutRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SetResourceHoursRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ResourceId int64                  `protobuf:"varint,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	DayOfWeek  int32                  `protobuf:"varint,2,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	OpenTime   string                 `protobuf:"bytes,3,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime  string                 `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	IsOpen     bool                   `protobuf:"varint,5,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	// Remove the resource's own hours so the day follows the business hours
+	UseBusinessHours bool `protobuf:"varint,6,opt,name=use_business_hours,json=useBusinessHours,proto3" json:"use_business_hours,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *RemoveBlackoutRequest) Reset() {
-	*x = RemoveBlackoutRequest{}
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[8]
+func (x *SetResourceHoursRequest) Reset() {
+	*x = SetResourceHoursRequest{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveBlackoutRequest) String() string {
+func (x *SetResourceHoursRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveBlackoutRequest) ProtoMessage() {}
+func (*SetResourceHoursRequest) ProtoMessage() {}
 
-func (x *RemoveBlackoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[8]
+func (x *SetResourceHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -494,40 +1488,75 @@ func (x *RemoveBlackoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveBlackoutRequest.ProtoReflect.Descriptor instead.
-func (*RemoveBlackoutRequest) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SetResourceHoursRequest.ProtoReflect.Descriptor instead.
+func (*SetResourceHoursRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *RemoveBlackoutRequest) GetId() int64 {
+func (x *SetResourceHoursRequest) GetResourceId() int64 {
 	if x != nil {
-		return x.Id
+		return x.ResourceId
 	}
 	return 0
 }
 
-type RemoveBlackoutResponse struct {
+func (x *SetResourceHoursRequest) GetDayOfWeek() int32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *SetResourceHoursRequest) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *SetResourceHoursRequest) GetCloseTime() string {
+	if x != nil {
+		return x.CloseTime
+	}
+	return ""
+}
+
+func (x *SetResourceHoursRequest) GetIsOpen() bool {
+	if x != nil {
+		return x.IsOpen
+	}
+	return false
+}
+
+func (x *SetResourceHoursRequest) GetUseBusinessHours() bool {
+	if x != nil {
+		return x.UseBusinessHours
+	}
+	return false
+}
+
+type SetResourceHoursResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Hours         *ResourceHours         `protobuf:"bytes,1,opt,name=hours,proto3" json:"hours,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveBlackoutResponse) Reset() {
-	*x = RemoveBlackoutResponse{}
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[9]
+func (x *SetResourceHoursResponse) Reset() {
+	*x = SetResourceHoursResponse{}
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveBlackoutResponse) String() string {
+func (x *SetResourceHoursResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveBlackoutResponse) ProtoMessage() {}
+func (*SetResourceHoursResponse) ProtoMessage() {}
 
-func (x *RemoveBlackoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_degrees_v1_schedule_service_proto_msgTypes[9]
+func (x *SetResourceHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_schedule_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,16 +1567,16 @@ func (x *RemoveBlackoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveBlackoutResponse.ProtoReflect.Descriptor instead.
-func (*RemoveBlackoutResponse) Descriptor() ([]byte, []int) {
-	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SetResourceHoursResponse.ProtoReflect.Descriptor instead.
+func (*SetResourceHoursResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_schedule_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *RemoveBlackoutResponse) GetSuccess() bool {
+func (x *SetResourceHoursResponse) GetHours() *ResourceHours {
 	if x != nil {
-		return x.Success
+		return x.Hours
 	}
-	return false
+	return nil
 }
 
 var File_degrees_v1_schedule_service_proto protoreflect.FileDescriptor
@@ -555,7 +1584,7 @@ var File_degrees_v1_schedule_service_proto protoreflect.FileDescriptor
 const file_degrees_v1_schedule_service_proto_rawDesc = "" +
 	"\n" +
 	"!degrees/v1/schedule_service.proto\x12\n" +
-	"degrees.v1\x1a\x1cgoogle/api/annotations.proto\"\xb9\x01\n" +
+	"degrees.v1\x1a\x1cgoogle/api/annotations.proto\"\xeb\x01\n" +
 	"\vScheduleDay\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
 	"\vday_of_week\x18\x02 \x01(\x05R\tdayOfWeek\x12\x1b\n" +
@@ -563,37 +1592,117 @@ const file_degrees_v1_schedule_service_proto_rawDesc = "" +
 	"\n" +
 	"close_time\x18\x04 \x01(\tR\tcloseTime\x12\x17\n" +
 	"\ais_open\x18\x05 \x01(\bR\x06isOpen\x12%\n" +
-	"\x0ebuffer_minutes\x18\x06 \x01(\x05R\rbufferMinutes\"F\n" +
+	"\x0ebuffer_minutes\x18\x06 \x01(\x05R\rbufferMinutes\x120\n" +
+	"\awindows\x18\a \x03(\v2\x16.degrees.v1.TimeWindowR\awindows\"H\n" +
+	"\n" +
+	"TimeWindow\x12\x1b\n" +
+	"\topen_time\x18\x01 \x01(\tR\bopenTime\x12\x1d\n" +
+	"\n" +
+	"close_time\x18\x02 \x01(\tR\tcloseTime\"o\n" +
 	"\bBlackout\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04date\x18\x02 \x01(\tR\x04date\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x1a\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12'\n" +
+	"\x0frecurs_annually\x18\x04 \x01(\bR\x0erecursAnnually\"p\n" +
+	"\x10ScheduleOverride\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x120\n" +
+	"\awindows\x18\x02 \x03(\v2\x16.degrees.v1.TimeWindowR\awindows\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xc0\x01\n" +
+	"\bResource\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rresource_type\x18\x03 \x01(\tR\fresourceType\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\x05R\tsortOrder\x12/\n" +
+	"\x05hours\x18\x06 \x03(\v2\x19.degrees.v1.ResourceHoursR\x05hours\"\xa5\x01\n" +
+	"\rResourceHours\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x1e\n" +
+	"\vday_of_week\x18\x02 \x01(\x05R\tdayOfWeek\x12\x1b\n" +
+	"\topen_time\x18\x03 \x01(\tR\bopenTime\x12\x1d\n" +
+	"\n" +
+	"close_time\x18\x04 \x01(\tR\tcloseTime\x12\x17\n" +
+	"\ais_open\x18\x05 \x01(\bR\x06isOpen\"\x1a\n" +
 	"\x18GetScheduleConfigRequest\"H\n" +
 	"\x19GetScheduleConfigResponse\x12+\n" +
-	"\x04days\x18\x01 \x03(\v2\x17.degrees.v1.ScheduleDayR\x04days\"\xb9\x01\n" +
+	"\x04days\x18\x01 \x03(\v2\x17.degrees.v1.ScheduleDayR\x04days\"\xeb\x01\n" +
 	"\x1bUpdateScheduleConfigRequest\x12\x1e\n" +
 	"\vday_of_week\x18\x01 \x01(\x05R\tdayOfWeek\x12\x1b\n" +
 	"\topen_time\x18\x02 \x01(\tR\bopenTime\x12\x1d\n" +
 	"\n" +
 	"close_time\x18\x03 \x01(\tR\tcloseTime\x12\x17\n" +
 	"\ais_open\x18\x04 \x01(\bR\x06isOpen\x12%\n" +
-	"\x0ebuffer_minutes\x18\x05 \x01(\x05R\rbufferMinutes\"I\n" +
+	"\x0ebuffer_minutes\x18\x05 \x01(\x05R\rbufferMinutes\x120\n" +
+	"\awindows\x18\x06 \x03(\v2\x16.degrees.v1.TimeWindowR\awindows\"I\n" +
 	"\x1cUpdateScheduleConfigResponse\x12)\n" +
-	"\x03day\x18\x01 \x01(\v2\x17.degrees.v1.ScheduleDayR\x03day\"@\n" +
+	"\x03day\x18\x01 \x01(\v2\x17.degrees.v1.ScheduleDayR\x03day\"i\n" +
 	"\x12AddBlackoutRequest\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"G\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12'\n" +
+	"\x0frecurs_annually\x18\x03 \x01(\bR\x0erecursAnnually\"G\n" +
 	"\x13AddBlackoutResponse\x120\n" +
 	"\bblackout\x18\x01 \x01(\v2\x14.degrees.v1.BlackoutR\bblackout\"'\n" +
 	"\x15RemoveBlackoutRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
 	"\x16RemoveBlackoutResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xb5\x04\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1e\n" +
+	"\x1cListScheduleOverridesRequest\"[\n" +
+	"\x1dListScheduleOverridesResponse\x12:\n" +
+	"\toverrides\x18\x01 \x03(\v2\x1c.degrees.v1.ScheduleOverrideR\toverrides\"z\n" +
+	"\x1aSetScheduleOverrideRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x120\n" +
+	"\awindows\x18\x02 \x03(\v2\x16.degrees.v1.TimeWindowR\awindows\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"W\n" +
+	"\x1bSetScheduleOverrideResponse\x128\n" +
+	"\boverride\x18\x01 \x01(\v2\x1c.degrees.v1.ScheduleOverrideR\boverride\"3\n" +
+	"\x1dRemoveScheduleOverrideRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\":\n" +
+	"\x1eRemoveScheduleOverrideResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x16\n" +
+	"\x14ListResourcesRequest\"K\n" +
+	"\x15ListResourcesResponse\x122\n" +
+	"\tresources\x18\x01 \x03(\v2\x14.degrees.v1.ResourceR\tresources\"\x8c\x01\n" +
+	"\x15CreateResourceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x05R\tsortOrder\"J\n" +
+	"\x16CreateResourceResponse\x120\n" +
+	"\bresource\x18\x01 \x01(\v2\x14.degrees.v1.ResourceR\bresource\"\x9c\x01\n" +
+	"\x15UpdateResourceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rresource_type\x18\x03 \x01(\tR\fresourceType\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\x05R\tsortOrder\"J\n" +
+	"\x16UpdateResourceResponse\x120\n" +
+	"\bresource\x18\x01 \x01(\v2\x14.degrees.v1.ResourceR\bresource\"\xdd\x01\n" +
+	"\x17SetResourceHoursRequest\x12\x1f\n" +
+	"\vresource_id\x18\x01 \x01(\x03R\n" +
+	"resourceId\x12\x1e\n" +
+	"\vday_of_week\x18\x02 \x01(\x05R\tdayOfWeek\x12\x1b\n" +
+	"\topen_time\x18\x03 \x01(\tR\bopenTime\x12\x1d\n" +
+	"\n" +
+	"close_time\x18\x04 \x01(\tR\tcloseTime\x12\x17\n" +
+	"\ais_open\x18\x05 \x01(\bR\x06isOpen\x12,\n" +
+	"\x12use_business_hours\x18\x06 \x01(\bR\x10useBusinessHours\"K\n" +
+	"\x18SetResourceHoursResponse\x12/\n" +
+	"\x05hours\x18\x01 \x01(\v2\x19.degrees.v1.ResourceHoursR\x05hours2\xc2\f\n" +
 	"\x0fScheduleService\x12\x87\x01\n" +
 	"\x11GetScheduleConfig\x12$.degrees.v1.GetScheduleConfigRequest\x1a%.degrees.v1.GetScheduleConfigResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/admin/schedule/config\x12\x93\x01\n" +
 	"\x14UpdateScheduleConfig\x12'.degrees.v1.UpdateScheduleConfigRequest\x1a(.degrees.v1.UpdateScheduleConfigResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/api/v1/admin/schedule/config\x12z\n" +
 	"\vAddBlackout\x12\x1e.degrees.v1.AddBlackoutRequest\x1a\x1f.degrees.v1.AddBlackoutResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/admin/schedule/blackout\x12\x85\x01\n" +
-	"\x0eRemoveBlackout\x12!.degrees.v1.RemoveBlackoutRequest\x1a\".degrees.v1.RemoveBlackoutResponse\",\x82\xd3\xe4\x93\x02&*$/api/v1/admin/schedule/blackout/{id}B\xb2\x01\n" +
+	"\x0eRemoveBlackout\x12!.degrees.v1.RemoveBlackoutRequest\x1a\".degrees.v1.RemoveBlackoutResponse\",\x82\xd3\xe4\x93\x02&*$/api/v1/admin/schedule/blackout/{id}\x12\x96\x01\n" +
+	"\x15ListScheduleOverrides\x12(.degrees.v1.ListScheduleOverridesRequest\x1a).degrees.v1.ListScheduleOverridesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/admin/schedule/overrides\x12\x9a\x01\n" +
+	"\x13SetScheduleOverride\x12&.degrees.v1.SetScheduleOverrideRequest\x1a'.degrees.v1.SetScheduleOverrideResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\x1a'/api/v1/admin/schedule/overrides/{date}\x12\xa0\x01\n" +
+	"\x16RemoveScheduleOverride\x12).degrees.v1.RemoveScheduleOverrideRequest\x1a*.degrees.v1.RemoveScheduleOverrideResponse\"/\x82\xd3\xe4\x93\x02)*'/api/v1/admin/schedule/overrides/{date}\x12~\n" +
+	"\rListResources\x12 .degrees.v1.ListResourcesRequest\x1a!.degrees.v1.ListResourcesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/admin/schedule/resources\x12\x84\x01\n" +
+	"\x0eCreateResource\x12!.degrees.v1.CreateResourceRequest\x1a\".degrees.v1.CreateResourceResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/admin/schedule/resources\x12\x89\x01\n" +
+	"\x0eUpdateResource\x12!.degrees.v1.UpdateResourceRequest\x1a\".degrees.v1.UpdateResourceResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\x1a%/api/v1/admin/schedule/resources/{id}\x12\x9e\x01\n" +
+	"\x10SetResourceHours\x12#.degrees.v1.SetResourceHoursRequest\x1a$.degrees.v1.SetResourceHoursResponse\"?\x82\xd3\xe4\x93\x029:\x01*\x1a4/api/v1/admin/schedule/resources/{resource_id}/hoursB\xb2\x01\n" +
 	"\x0ecom.degrees.v1B\x14ScheduleServiceProtoP\x01ZAgithub.com/richardbowden/degrees/internal/pb/degrees/v1;degreesv1\xa2\x02\x03DXX\xaa\x02\n" +
 	"Degrees.V1\xca\x02\n" +
 	"Degrees\\V1\xe2\x02\x16Degrees\\V1\\GPBMetadata\xea\x02\vDegrees::V1b\x06proto3"
@@ -610,36 +1719,79 @@ func file_degrees_v1_schedule_service_proto_rawDescGZIP() []byte {
 	return file_degrees_v1_schedule_service_proto_rawDescData
 }
 
-var file_degrees_v1_schedule_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_degrees_v1_schedule_service_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_degrees_v1_schedule_service_proto_goTypes = []any{
-	(*ScheduleDay)(nil),                  // 0: degrees.v1.ScheduleDay
-	(*Blackout)(nil),                     // 1: degrees.v1.Blackout
-	(*GetScheduleConfigRequest)(nil),     // 2: degrees.v1.GetScheduleConfigRequest
-	(*GetScheduleConfigResponse)(nil),    // 3: degrees.v1.GetScheduleConfigResponse
-	(*UpdateScheduleConfigRequest)(nil),  // 4: degrees.v1.UpdateScheduleConfigRequest
-	(*UpdateScheduleConfigResponse)(nil), // 5: degrees.v1.UpdateScheduleConfigResponse
-	(*AddBlackoutRequest)(nil),           // 6: degrees.v1.AddBlackoutRequest
-	(*AddBlackoutResponse)(nil),          // 7: degrees.v1.AddBlackoutResponse
-	(*RemoveBlackoutRequest)(nil),        // 8: degrees.v1.RemoveBlackoutRequest
-	(*RemoveBlackoutResponse)(nil),       // 9: degrees.v1.RemoveBlackoutResponse
+	(*ScheduleDay)(nil),                    // 0: degrees.v1.ScheduleDay
+	(*TimeWindow)(nil),                     // 1: degrees.v1.TimeWindow
+	(*Blackout)(nil),                       // 2: degrees.v1.Blackout
+	(*ScheduleOverride)(nil),               // 3: degrees.v1.ScheduleOverride
+	(*Resource)(nil),                       // 4: degrees.v1.Resource
+	(*ResourceHours)(nil),                  // 5: degrees.v1.ResourceHours
+	(*GetScheduleConfigRequest)(nil),       // 6: degrees.v1.GetScheduleConfigRequest
+	(*GetScheduleConfigResponse)(nil),      // 7: degrees.v1.GetScheduleConfigResponse
+	(*UpdateScheduleConfigRequest)(nil),    // 8: degrees.v1.UpdateScheduleConfigRequest
+	(*UpdateScheduleConfigResponse)(nil),   // 9: degrees.v1.UpdateScheduleConfigResponse
+	(*AddBlackoutRequest)(nil),             // 10: degrees.v1.AddBlackoutRequest
+	(*AddBlackoutResponse)(nil),            // 11: degrees.v1.AddBlackoutResponse
+	(*RemoveBlackoutRequest)(nil),          // 12: degrees.v1.RemoveBlackoutRequest
+	(*RemoveBlackoutResponse)(nil),         // 13: degrees.v1.RemoveBlackoutResponse
+	(*ListScheduleOverridesRequest)(nil),   // 14: degrees.v1.ListScheduleOverridesRequest
+	(*ListScheduleOverridesResponse)(nil),  // 15: degrees.v1.ListScheduleOverridesResponse
+	(*SetScheduleOverrideRequest)(nil),     // 16: degrees.v1.SetScheduleOverrideRequest
+	(*SetScheduleOverrideResponse)(nil),    // 17: degrees.v1.SetScheduleOverrideResponse
+	(*RemoveScheduleOverrideRequest)(nil),  // 18: degrees.v1.RemoveScheduleOverrideRequest
+	(*RemoveScheduleOverrideResponse)(nil), // 19: degrees.v1.RemoveScheduleOverrideResponse
+	(*ListResourcesRequest)(nil),           // 20: degrees.v1.ListResourcesRequest
+	(*ListResourcesResponse)(nil),          // 21: degrees.v1.ListResourcesResponse
+	(*CreateResourceRequest)(nil),          // 22: degrees.v1.CreateResourceRequest
+	(*CreateResourceResponse)(nil),         // 23: degrees.v1.CreateResourceResponse
+	(*UpdateResourceRequest)(nil),          // 24: degrees.v1.UpdateResourceRequest
+	(*UpdateResourceResponse)(nil),         // 25: degrees.v1.UpdateResourceResponse
+	(*SetResourceHoursRequest)(nil),        // 26: degrees.v1.SetResourceHoursRequest
+	(*SetResourceHoursResponse)(nil),       // 27: degrees.v1.SetResourceHoursResponse
 }
 var file_degrees_v1_schedule_service_proto_depIdxs = []int32{
-	0, // 0: degrees.v1.GetScheduleConfigResponse.days:type_name -> degrees.v1.ScheduleDay
-	0, // 1: degrees.v1.UpdateScheduleConfigResponse.day:type_name -> degrees.v1.ScheduleDay
-	1, // 2: degrees.v1.AddBlackoutResponse.blackout:type_name -> degrees.v1.Blackout
-	2, // 3: degrees.v1.ScheduleService.GetScheduleConfig:input_type -> degrees.v1.GetScheduleConfigRequest
-	4, // 4: degrees.v1.ScheduleService.UpdateScheduleConfig:input_type -> degrees.v1.UpdateScheduleConfigRequest
-	6, // 5: degrees.v1.ScheduleService.AddBlackout:input_type -> degrees.v1.AddBlackoutRequest
-	8, // 6: degrees.v1.ScheduleService.RemoveBlackout:input_type -> degrees.v1.RemoveBlackoutRequest
-	3, // 7: degrees.v1.ScheduleService.GetScheduleConfig:output_type -> degrees.v1.GetScheduleConfigResponse
-	5, // 8: degrees.v1.ScheduleService.UpdateScheduleConfig:output_type -> degrees.v1.UpdateScheduleConfigResponse
-	7, // 9: degrees.v1.ScheduleService.AddBlackout:output_type -> degrees.v1.AddBlackoutResponse
-	9, // 10: degrees.v1.ScheduleService.RemoveBlackout:output_type -> degrees.v1.RemoveBlackoutResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	1,  // 0: degrees.v1.ScheduleDay.windows:type_name -> degrees.v1.TimeWindow
+	1,  // 1: degrees.v1.ScheduleOverride.windows:type_name -> degrees.v1.TimeWindow
+	5,  // 2: degrees.v1.Resource.hours:type_name -> degrees.v1.ResourceHours
+	0,  // 3: degrees.v1.GetScheduleConfigResponse.days:type_name -> degrees.v1.ScheduleDay
+	1,  // 4: degrees.v1.UpdateScheduleConfigRequest.windows:type_name -> degrees.v1.TimeWindow
+	0,  // 5: degrees.v1.UpdateScheduleConfigResponse.day:type_name -> degrees.v1.ScheduleDay
+	2,  // 6: degrees.v1.AddBlackoutResponse.blackout:type_name -> degrees.v1.Blackout
+	3,  // 7: degrees.v1.ListScheduleOverridesResponse.overrides:type_name -> degrees.v1.ScheduleOverride
+	1,  // 8: degrees.v1.SetScheduleOverrideRequest.windows:type_name -> degrees.v1.TimeWindow
+	3,  // 9: degrees.v1.SetScheduleOverrideResponse.override:type_name -> degrees.v1.ScheduleOverride
+	4,  // 10: degrees.v1.ListResourcesResponse.resources:type_name -> degrees.v1.Resource
+	4,  // 11: degrees.v1.CreateResourceResponse.resource:type_name -> degrees.v1.Resource
+	4,  // 12: degrees.v1.UpdateResourceResponse.resource:type_name -> degrees.v1.Resource
+	5,  // 13: degrees.v1.SetResourceHoursResponse.hours:type_name -> degrees.v1.ResourceHours
+	6,  // 14: degrees.v1.ScheduleService.GetScheduleConfig:input_type -> degrees.v1.GetScheduleConfigRequest
+	8,  // 15: degrees.v1.ScheduleService.UpdateScheduleConfig:input_type -> degrees.v1.UpdateScheduleConfigRequest
+	10, // 16: degrees.v1.ScheduleService.AddBlackout:input_type -> degrees.v1.AddBlackoutRequest
+	12, // 17: degrees.v1.ScheduleService.RemoveBlackout:input_type -> degrees.v1.RemoveBlackoutRequest
+	14, // 18: degrees.v1.ScheduleService.ListScheduleOverrides:input_type -> degrees.v1.ListScheduleOverridesRequest
+	16, // 19: degrees.v1.ScheduleService.SetScheduleOverride:input_type -> degrees.v1.SetScheduleOverrideRequest
+	18, // 20: degrees.v1.ScheduleService.RemoveScheduleOverride:input_type -> degrees.v1.RemoveScheduleOverrideRequest
+	20, // 21: degrees.v1.ScheduleService.ListResources:input_type -> degrees.v1.ListResourcesRequest
+	22, // 22: degrees.v1.ScheduleService.CreateResource:input_type -> degrees.v1.CreateResourceRequest
+	24, // 23: degrees.v1.ScheduleService.UpdateResource:input_type -> degrees.v1.UpdateResourceRequest
+	26, // 24: degrees.v1.ScheduleService.SetResourceHours:input_type -> degrees.v1.SetResourceHoursRequest
+	7,  // 25: degrees.v1.ScheduleService.GetScheduleConfig:output_type -> degrees.v1.GetScheduleConfigResponse
+	9,  // 26: degrees.v1.ScheduleService.UpdateScheduleConfig:output_type -> degrees.v1.UpdateScheduleConfigResponse
+	11, // 27: degrees.v1.ScheduleService.AddBlackout:output_type -> degrees.v1.AddBlackoutResponse
+	13, // 28: degrees.v1.ScheduleService.RemoveBlackout:output_type -> degrees.v1.RemoveBlackoutResponse
+	15, // 29: degrees.v1.ScheduleService.ListScheduleOverrides:output_type -> degrees.v1.ListScheduleOverridesResponse
+	17, // 30: degrees.v1.ScheduleService.SetScheduleOverride:output_type -> degrees.v1.SetScheduleOverrideResponse
+	19, // 31: degrees.v1.ScheduleService.RemoveScheduleOverride:output_type -> degrees.v1.RemoveScheduleOverrideResponse
+	21, // 32: degrees.v1.ScheduleService.ListResources:output_type -> degrees.v1.ListResourcesResponse
+	23, // 33: degrees.v1.ScheduleService.CreateResource:output_type -> degrees.v1.CreateResourceResponse
+	25, // 34: degrees.v1.ScheduleService.UpdateResource:output_type -> degrees.v1.UpdateResourceResponse
+	27, // 35: degrees.v1.ScheduleService.SetResourceHours:output_type -> degrees.v1.SetResourceHoursResponse
+	25, // [25:36] is the sub-list for method output_type
+	14, // [14:25] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_degrees_v1_schedule_service_proto_init() }
@@ -653,7 +1805,7 @@ func file_degrees_v1_schedule_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_degrees_v1_schedule_service_proto_rawDesc), len(file_degrees_v1_schedule_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ScheduleService_GetScheduleConfig_FullMethodName    = "/degrees.v1.ScheduleService/GetScheduleConfig"
-	ScheduleService_UpdateScheduleConfig_FullMethodName = "/degrees.v1.ScheduleService/UpdateScheduleConfig"
-	ScheduleService_AddBlackout_FullMethodName          = "/degrees.v1.ScheduleService/AddBlackout"
-	ScheduleService_RemoveBlackout_FullMethodName       = "/degrees.v1.ScheduleService/RemoveBlackout"
+	ScheduleService_GetScheduleConfig_FullMethodName      = "/degrees.v1.ScheduleService/GetScheduleConfig"
+	ScheduleService_UpdateScheduleConfig_FullMethodName   = "/degrees.v1.ScheduleService/UpdateScheduleConfig"
+	ScheduleService_AddBlackout_FullMethodName            = "/degrees.v1.ScheduleService/AddBlackout"
+	ScheduleService_RemoveBlackout_FullMethodName         = "/degrees.v1.ScheduleService/RemoveBlackout"
+	ScheduleService_ListScheduleOverrides_FullMethodName  = "/degrees.v1.ScheduleService/ListScheduleOverrides"
+	ScheduleService_SetScheduleOverride_FullMethodName    = "/degrees.v1.ScheduleService/SetScheduleOverride"
+	ScheduleService_RemoveScheduleOverride_FullMethodName = "/degrees.v1.ScheduleService/RemoveScheduleOverride"
+	ScheduleService_ListResources_FullMethodName          = "/degrees.v1.ScheduleService/ListResources"
+	ScheduleService_CreateResource_FullMethodName         = "/degrees.v1.ScheduleService/CreateResource"
+	ScheduleService_UpdateResource_FullMethodName         = "/degrees.v1.ScheduleService/UpdateResource"
+	ScheduleService_SetResourceHours_FullMethodName       = "/degrees.v1.ScheduleService/SetResourceHours"
 )
 
 // ScheduleServiceClient is the client API for ScheduleService service.
@@ -37,6 +44,20 @@ type ScheduleServiceClient interface {
 	AddBlackout(ctx context.Context, in *AddBlackoutRequest, opts ...grpc.CallOption) (*AddBlackoutResponse, error)
 	// Remove a blackout date
 	RemoveBlackout(ctx context.Context, in *RemoveBlackoutRequest, opts ...grpc.CallOption) (*RemoveBlackoutResponse, error)
+	// List hours set for specific upcoming dates
+	ListScheduleOverrides(ctx context.Context, in *ListScheduleOverridesRequest, opts ...grpc.CallOption) (*ListScheduleOverridesResponse, error)
+	// Set the hours for a specific date, replacing the weekly hours
+	SetScheduleOverride(ctx context.Context, in *SetScheduleOverrideRequest, opts ...grpc.CallOption) (*SetScheduleOverrideResponse, error)
+	// Return a date to the weekly hours
+	RemoveScheduleOverride(ctx context.Context, in *RemoveScheduleOverrideRequest, opts ...grpc.CallOption) (*RemoveScheduleOverrideResponse, error)
+	// List bays, vans and other resources with their hours
+	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
+	// Add a resource
+	CreateResource(ctx context.Context, in *CreateResourceRequest, opts ...grpc.CallOption) (*CreateResourceResponse, error)
+	// Update a resource
+	UpdateResource(ctx context.Context, in *UpdateResourceRequest, opts ...grpc.CallOption) (*UpdateResourceResponse, error)
+	// Set a resource's hours for a day of the week
+	SetResourceHours(ctx context.Context, in *SetResourceHoursRequest, opts ...grpc.CallOption) (*SetResourceHoursResponse, error)
 }
 
 type scheduleServiceClient struct {
@@ -87,6 +108,76 @@ func (c *scheduleServiceClient) RemoveBlackout(ctx context.Context, in *RemoveBl
 	return out, nil
 }
 
+func (c *scheduleServiceClient) ListScheduleOverrides(ctx context.Context, in *ListScheduleOverridesRequest, opts ...grpc.CallOption) (*ListScheduleOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduleOverridesResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_ListScheduleOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) SetScheduleOverride(ctx context.Context, in *SetScheduleOverrideRequest, opts ...grpc.CallOption) (*SetScheduleOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetScheduleOverrideResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_SetScheduleOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) RemoveScheduleOverride(ctx context.Context, in *RemoveScheduleOverrideRequest, opts ...grpc.CallOption) (*RemoveScheduleOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveScheduleOverrideResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_RemoveScheduleOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResourcesResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_ListResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) CreateResource(ctx context.Context, in *CreateResourceRequest, opts ...grpc.CallOption) (*CreateResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateResourceResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_CreateResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) UpdateResource(ctx context.Context, in *UpdateResourceRequest, opts ...grpc.CallOption) (*UpdateResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateResourceResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_UpdateResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) SetResourceHours(ctx context.Context, in *SetResourceHoursRequest, opts ...grpc.CallOption) (*SetResourceHoursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetResourceHoursResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_SetResourceHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScheduleServiceServer is the server API for ScheduleService service.
 // All implementations should embed UnimplementedScheduleServiceServer
 // for forward compatibility.
@@ -99,6 +190,20 @@ type ScheduleServiceServer interface {
 	AddBlackout(context.Context, *AddBlackoutRequest) (*AddBlackoutResponse, error)
 	// Remove a blackout date
 	RemoveBlackout(context.Context, *RemoveBlackoutRequest) (*RemoveBlackoutResponse, error)
+	// List hours set for specific upcoming dates
+	ListScheduleOverrides(context.Context, *ListScheduleOverridesRequest) (*ListScheduleOverridesResponse, error)
+	// Set the hours for a specific date, replacing the weekly hours
+	SetScheduleOverride(context.Context, *SetScheduleOverrideRequest) (*SetScheduleOverrideResponse, error)
+	// Return a date to the weekly hours
+	RemoveScheduleOverride(context.Context, *RemoveScheduleOverrideRequest) (*RemoveScheduleOverrideResponse, error)
+	// List bays, vans and other resources with their hours
+	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
+	// Add a resource
+	CreateResource(context.Context, *CreateResourceRequest) (*CreateResourceResponse, error)
+	// Update a resource
+	UpdateResource(context.Context, *UpdateResourceRequest) (*UpdateResourceResponse, error)
+	// Set a resource's hours for a day of the week
+	SetResourceHours(context.Context, *SetResourceHoursRequest) (*SetResourceHoursResponse, error)
 }
 
 // UnimplementedScheduleServiceServer should be embedded to have
@@ -120,6 +225,27 @@ func (UnimplementedScheduleServiceServer) AddBlackout(context.Context, *AddBlack
 func (UnimplementedScheduleServiceServer) RemoveBlackout(context.Context, *RemoveBlackoutRequest) (*RemoveBlackoutResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RemoveBlackout not implemented")
 }
+func (UnimplementedScheduleServiceServer) ListScheduleOverrides(context.Context, *ListScheduleOverridesRequest) (*ListScheduleOverridesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduleOverrides not implemented")
+}
+func (UnimplementedScheduleServiceServer) SetScheduleOverride(context.Context, *SetScheduleOverrideRequest) (*SetScheduleOverrideResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetScheduleOverride not implemented")
+}
+func (UnimplementedScheduleServiceServer) RemoveScheduleOverride(context.Context, *RemoveScheduleOverrideRequest) (*RemoveScheduleOverrideResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveScheduleOverride not implemented")
+}
+func (UnimplementedScheduleServiceServer) ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListResources not implemented")
+}
+func (UnimplementedScheduleServiceServer) CreateResource(context.Context, *CreateResourceRequest) (*CreateResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateResource not implemented")
+}
+func (UnimplementedScheduleServiceServer) UpdateResource(context.Context, *UpdateResourceRequest) (*UpdateResourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateResource not implemented")
+}
+func (UnimplementedScheduleServiceServer) SetResourceHours(context.Context, *SetResourceHoursRequest) (*SetResourceHoursResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetResourceHours not implemented")
+}
 func (UnimplementedScheduleServiceServer) testEmbeddedByValue() {}
 
 // UnsafeScheduleServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -212,6 +338,132 @@ func _ScheduleService_RemoveBlackout_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScheduleService_ListScheduleOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduleOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).ListScheduleOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_ListScheduleOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).ListScheduleOverrides(ctx, req.(*ListScheduleOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_SetScheduleOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScheduleOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).SetScheduleOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_SetScheduleOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).SetScheduleOverride(ctx, req.(*SetScheduleOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_RemoveScheduleOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveScheduleOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).RemoveScheduleOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_RemoveScheduleOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).RemoveScheduleOverride(ctx, req.(*RemoveScheduleOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_ListResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).ListResources(ctx, req.(*ListResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_CreateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).CreateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_CreateResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).CreateResource(ctx, req.(*CreateResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_UpdateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).UpdateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_UpdateResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).UpdateResource(ctx, req.(*UpdateResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_SetResourceHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetResourceHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).SetResourceHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_SetResourceHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).SetResourceHours(ctx, req.(*SetResourceHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScheduleService_ServiceDesc is the grpc.ServiceDesc for ScheduleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -235,6 +487,34 @@ var ScheduleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveBlackout",
 			Handler:    _ScheduleService_RemoveBlackout_Handler,
 		},
+		{
+			MethodName: "ListScheduleOverrides",
+			Handler:    _ScheduleService_ListScheduleOverrides_Handler,
+		},
+		{
+			MethodName: "SetScheduleOverride",
+			Handler:    _ScheduleService_SetScheduleOverride_Handler,
+		},
+		{
+			MethodName: "RemoveScheduleOverride",
+			Handler:    _ScheduleService_RemoveScheduleOverride_Handler,
+		},
+		{
+			MethodName: "ListResources",
+			Handler:    _ScheduleService_ListResources_Handler,
+		},
+		{
+			MethodName: "CreateResource",
+			Handler:    _ScheduleService_CreateResource_Handler,
+		},
+		{
+			MethodName: "UpdateResource",
+			Handler:    _ScheduleService_UpdateResource_Handler,
+		},
+		{
+			MethodName: "SetResourceHours",
+			Handler:    _ScheduleService_SetResourceHours_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "degrees/v1/schedule_service.proto",
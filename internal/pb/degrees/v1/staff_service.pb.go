@@ -0,0 +1,1245 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: degrees/v1/staff_service.proto
+
+package degreesv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StaffMember struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email       string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName string                 `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	IsActive    bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Service categories the staff member is skilled in
+	CategoryIds   []int64        `protobuf:"varint,6,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	Roster        []*RosterShift `protobuf:"bytes,7,rep,name=roster,proto3" json:"roster,omitempty"`
+	Leave         []*StaffLeave  `protobuf:"bytes,8,rep,name=leave,proto3" json:"leave,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffMember) Reset() {
+	*x = StaffMember{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffMember) ProtoMessage() {}
+
+func (x *StaffMember) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffMember.ProtoReflect.Descriptor instead.
+func (*StaffMember) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StaffMember) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StaffMember) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StaffMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *StaffMember) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *StaffMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *StaffMember) GetCategoryIds() []int64 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *StaffMember) GetRoster() []*RosterShift {
+	if x != nil {
+		return x.Roster
+	}
+	return nil
+}
+
+func (x *StaffMember) GetLeave() []*StaffLeave {
+	if x != nil {
+		return x.Leave
+	}
+	return nil
+}
+
+// A weekly shift. Days without a shift are not worked.
+type RosterShift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DayOfWeek     int32                  `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	StartTime     string                 `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RosterShift) Reset() {
+	*x = RosterShift{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RosterShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterShift) ProtoMessage() {}
+
+func (x *RosterShift) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterShift.ProtoReflect.Descriptor instead.
+func (*RosterShift) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RosterShift) GetDayOfWeek() int32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *RosterShift) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *RosterShift) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+type StaffLeave struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StaffId       int64                  `protobuf:"varint,2,opt,name=staff_id,json=staffId,proto3" json:"staff_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaffLeave) Reset() {
+	*x = StaffLeave{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaffLeave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaffLeave) ProtoMessage() {}
+
+func (x *StaffLeave) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaffLeave.ProtoReflect.Descriptor instead.
+func (*StaffLeave) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StaffLeave) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StaffLeave) GetStaffId() int64 {
+	if x != nil {
+		return x.StaffId
+	}
+	return 0
+}
+
+func (x *StaffLeave) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *StaffLeave) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *StaffLeave) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ListStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaffRequest) Reset() {
+	*x = ListStaffRequest{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffRequest) ProtoMessage() {}
+
+func (x *ListStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffRequest.ProtoReflect.Descriptor instead.
+func (*ListStaffRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{3}
+}
+
+type ListStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staff         []*StaffMember         `protobuf:"bytes,1,rep,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaffResponse) Reset() {
+	*x = ListStaffResponse{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffResponse) ProtoMessage() {}
+
+func (x *ListStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffResponse.ProtoReflect.Descriptor instead.
+func (*ListStaffResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListStaffResponse) GetStaff() []*StaffMember {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+type CreateStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CategoryIds   []int64                `protobuf:"varint,4,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStaffRequest) Reset() {
+	*x = CreateStaffRequest{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStaffRequest) ProtoMessage() {}
+
+func (x *CreateStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStaffRequest.ProtoReflect.Descriptor instead.
+func (*CreateStaffRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateStaffRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateStaffRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CreateStaffRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateStaffRequest) GetCategoryIds() []int64 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type CreateStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staff         *StaffMember           `protobuf:"bytes,1,opt,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStaffResponse) Reset() {
+	*x = CreateStaffResponse{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStaffResponse) ProtoMessage() {}
+
+func (x *CreateStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStaffResponse.ProtoReflect.Descriptor instead.
+func (*CreateStaffResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateStaffResponse) GetStaff() *StaffMember {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+type UpdateStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CategoryIds   []int64                `protobuf:"varint,4,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStaffRequest) Reset() {
+	*x = UpdateStaffRequest{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStaffRequest) ProtoMessage() {}
+
+func (x *UpdateStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStaffRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStaffRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateStaffRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateStaffRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateStaffRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateStaffRequest) GetCategoryIds() []int64 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type UpdateStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staff         *StaffMember           `protobuf:"bytes,1,opt,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStaffResponse) Reset() {
+	*x = UpdateStaffResponse{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStaffResponse) ProtoMessage() {}
+
+func (x *UpdateStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStaffResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStaffResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateStaffResponse) GetStaff() *StaffMember {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+type SetStaffRosterRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StaffId   int64                  `protobuf:"varint,1,opt,name=staff_id,json=staffId,proto3" json:"staff_id,omitempty"`
+	DayOfWeek int32                  `protobuf:"varint,2,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	StartTime string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Take the staff member off the roster for this day
+	Off           bool `protobuf:"varint,5,opt,name=off,proto3" json:"off,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStaffRosterRequest) Reset() {
+	*x = SetStaffRosterRequest{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStaffRosterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStaffRosterRequest) ProtoMessage() {}
+
+func (x *SetStaffRosterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStaffRosterRequest.ProtoReflect.Descriptor instead.
+func (*SetStaffRosterRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetStaffRosterRequest) GetStaffId() int64 {
+	if x != nil {
+		return x.StaffId
+	}
+	return 0
+}
+
+func (x *SetStaffRosterRequest) GetDayOfWeek() int32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *SetStaffRosterRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *SetStaffRosterRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *SetStaffRosterRequest) GetOff() bool {
+	if x != nil {
+		return x.Off
+	}
+	return false
+}
+
+type SetStaffRosterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shift         *RosterShift           `protobuf:"bytes,1,opt,name=shift,proto3" json:"shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStaffRosterResponse) Reset() {
+	*x = SetStaffRosterResponse{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStaffRosterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStaffRosterResponse) ProtoMessage() {}
+
+func (x *SetStaffRosterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStaffRosterResponse.ProtoReflect.Descriptor instead.
+func (*SetStaffRosterResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetStaffRosterResponse) GetShift() *RosterShift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+type AddStaffLeaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StaffId       int64                  `protobuf:"varint,1,opt,name=staff_id,json=staffId,proto3" json:"staff_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddStaffLeaveRequest) Reset() {
+	*x = AddStaffLeaveRequest{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStaffLeaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStaffLeaveRequest) ProtoMessage() {}
+
+func (x *AddStaffLeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStaffLeaveRequest.ProtoReflect.Descriptor instead.
+func (*AddStaffLeaveRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddStaffLeaveRequest) GetStaffId() int64 {
+	if x != nil {
+		return x.StaffId
+	}
+	return 0
+}
+
+func (x *AddStaffLeaveRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AddStaffLeaveRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AddStaffLeaveRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AddStaffLeaveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Leave         *StaffLeave            `protobuf:"bytes,1,opt,name=leave,proto3" json:"leave,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddStaffLeaveResponse) Reset() {
+	*x = AddStaffLeaveResponse{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddStaffLeaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStaffLeaveResponse) ProtoMessage() {}
+
+func (x *AddStaffLeaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStaffLeaveResponse.ProtoReflect.Descriptor instead.
+func (*AddStaffLeaveResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddStaffLeaveResponse) GetLeave() *StaffLeave {
+	if x != nil {
+		return x.Leave
+	}
+	return nil
+}
+
+type RemoveStaffLeaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStaffLeaveRequest) Reset() {
+	*x = RemoveStaffLeaveRequest{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStaffLeaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStaffLeaveRequest) ProtoMessage() {}
+
+func (x *RemoveStaffLeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStaffLeaveRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStaffLeaveRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveStaffLeaveRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveStaffLeaveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveStaffLeaveResponse) Reset() {
+	*x = RemoveStaffLeaveResponse{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveStaffLeaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStaffLeaveResponse) ProtoMessage() {}
+
+func (x *RemoveStaffLeaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStaffLeaveResponse.ProtoReflect.Descriptor instead.
+func (*RemoveStaffLeaveResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveStaffLeaveResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AssignBookingStaffRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BookingId int64                  `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// Replaces the current assignment; empty unassigns everyone
+	StaffIds      []int64 `protobuf:"varint,2,rep,packed,name=staff_ids,json=staffIds,proto3" json:"staff_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignBookingStaffRequest) Reset() {
+	*x = AssignBookingStaffRequest{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignBookingStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignBookingStaffRequest) ProtoMessage() {}
+
+func (x *AssignBookingStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignBookingStaffRequest.ProtoReflect.Descriptor instead.
+func (*AssignBookingStaffRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AssignBookingStaffRequest) GetBookingId() int64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *AssignBookingStaffRequest) GetStaffIds() []int64 {
+	if x != nil {
+		return x.StaffIds
+	}
+	return nil
+}
+
+type AssignBookingStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignedStaff []*BookingStaffMember  `protobuf:"bytes,1,rep,name=assigned_staff,json=assignedStaff,proto3" json:"assigned_staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignBookingStaffResponse) Reset() {
+	*x = AssignBookingStaffResponse{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignBookingStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignBookingStaffResponse) ProtoMessage() {}
+
+func (x *AssignBookingStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignBookingStaffResponse.ProtoReflect.Descriptor instead.
+func (*AssignBookingStaffResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AssignBookingStaffResponse) GetAssignedStaff() []*BookingStaffMember {
+	if x != nil {
+		return x.AssignedStaff
+	}
+	return nil
+}
+
+type ListMyJobsTodayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyJobsTodayRequest) Reset() {
+	*x = ListMyJobsTodayRequest{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyJobsTodayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyJobsTodayRequest) ProtoMessage() {}
+
+func (x *ListMyJobsTodayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyJobsTodayRequest.ProtoReflect.Descriptor instead.
+func (*ListMyJobsTodayRequest) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{17}
+}
+
+type ListMyJobsTodayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Booking             `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyJobsTodayResponse) Reset() {
+	*x = ListMyJobsTodayResponse{}
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyJobsTodayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyJobsTodayResponse) ProtoMessage() {}
+
+func (x *ListMyJobsTodayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_degrees_v1_staff_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyJobsTodayResponse.ProtoReflect.Descriptor instead.
+func (*ListMyJobsTodayResponse) Descriptor() ([]byte, []int) {
+	return file_degrees_v1_staff_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListMyJobsTodayResponse) GetJobs() []*Booking {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+var File_degrees_v1_staff_service_proto protoreflect.FileDescriptor
+
+const file_degrees_v1_staff_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1edegrees/v1/staff_service.proto\x12\n" +
+	"degrees.v1\x1a\x1cgoogle/api/annotations.proto\x1a degrees/v1/booking_service.proto\"\x8e\x02\n" +
+	"\vStaffMember\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12!\n" +
+	"\fdisplay_name\x18\x04 \x01(\tR\vdisplayName\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x12!\n" +
+	"\fcategory_ids\x18\x06 \x03(\x03R\vcategoryIds\x12/\n" +
+	"\x06roster\x18\a \x03(\v2\x17.degrees.v1.RosterShiftR\x06roster\x12,\n" +
+	"\x05leave\x18\b \x03(\v2\x16.degrees.v1.StaffLeaveR\x05leave\"g\n" +
+	"\vRosterShift\x12\x1e\n" +
+	"\vday_of_week\x18\x01 \x01(\x05R\tdayOfWeek\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTime\"\x89\x01\n" +
+	"\n" +
+	"StaffLeave\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bstaff_id\x18\x02 \x01(\x03R\astaffId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x12\n" +
+	"\x10ListStaffRequest\"B\n" +
+	"\x11ListStaffResponse\x12-\n" +
+	"\x05staff\x18\x01 \x03(\v2\x17.degrees.v1.StaffMemberR\x05staff\"\x90\x01\n" +
+	"\x12CreateStaffRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12!\n" +
+	"\fcategory_ids\x18\x04 \x03(\x03R\vcategoryIds\"D\n" +
+	"\x13CreateStaffResponse\x12-\n" +
+	"\x05staff\x18\x01 \x01(\v2\x17.degrees.v1.StaffMemberR\x05staff\"\x87\x01\n" +
+	"\x12UpdateStaffRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12!\n" +
+	"\fcategory_ids\x18\x04 \x03(\x03R\vcategoryIds\"D\n" +
+	"\x13UpdateStaffResponse\x12-\n" +
+	"\x05staff\x18\x01 \x01(\v2\x17.degrees.v1.StaffMemberR\x05staff\"\x9e\x01\n" +
+	"\x15SetStaffRosterRequest\x12\x19\n" +
+	"\bstaff_id\x18\x01 \x01(\x03R\astaffId\x12\x1e\n" +
+	"\vday_of_week\x18\x02 \x01(\x05R\tdayOfWeek\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\tR\aendTime\x12\x10\n" +
+	"\x03off\x18\x05 \x01(\bR\x03off\"G\n" +
+	"\x16SetStaffRosterResponse\x12-\n" +
+	"\x05shift\x18\x01 \x01(\v2\x17.degrees.v1.RosterShiftR\x05shift\"\x83\x01\n" +
+	"\x14AddStaffLeaveRequest\x12\x19\n" +
+	"\bstaff_id\x18\x01 \x01(\x03R\astaffId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"E\n" +
+	"\x15AddStaffLeaveResponse\x12,\n" +
+	"\x05leave\x18\x01 \x01(\v2\x16.degrees.v1.StaffLeaveR\x05leave\")\n" +
+	"\x17RemoveStaffLeaveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"4\n" +
+	"\x18RemoveStaffLeaveResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"W\n" +
+	"\x19AssignBookingStaffRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x03R\tbookingId\x12\x1b\n" +
+	"\tstaff_ids\x18\x02 \x03(\x03R\bstaffIds\"c\n" +
+	"\x1aAssignBookingStaffResponse\x12E\n" +
+	"\x0eassigned_staff\x18\x01 \x03(\v2\x1e.degrees.v1.BookingStaffMemberR\rassignedStaff\"\x18\n" +
+	"\x16ListMyJobsTodayRequest\"B\n" +
+	"\x17ListMyJobsTodayResponse\x12'\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x13.degrees.v1.BookingR\x04jobs2\x8d\b\n" +
+	"\fStaffService\x12e\n" +
+	"\tListStaff\x12\x1c.degrees.v1.ListStaffRequest\x1a\x1d.degrees.v1.ListStaffResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/admin/staff\x12n\n" +
+	"\vCreateStaff\x12\x1e.degrees.v1.CreateStaffRequest\x1a\x1f.degrees.v1.CreateStaffResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/admin/staff\x12s\n" +
+	"\vUpdateStaff\x12\x1e.degrees.v1.UpdateStaffRequest\x1a\x1f.degrees.v1.UpdateStaffResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/api/v1/admin/staff/{id}\x12\x89\x01\n" +
+	"\x0eSetStaffRoster\x12!.degrees.v1.SetStaffRosterRequest\x1a\".degrees.v1.SetStaffRosterResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\x1a%/api/v1/admin/staff/{staff_id}/roster\x12\x85\x01\n" +
+	"\rAddStaffLeave\x12 .degrees.v1.AddStaffLeaveRequest\x1a!.degrees.v1.AddStaffLeaveResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/admin/staff/{staff_id}/leave\x12\x85\x01\n" +
+	"\x10RemoveStaffLeave\x12#.degrees.v1.RemoveStaffLeaveRequest\x1a$.degrees.v1.RemoveStaffLeaveResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/api/v1/admin/staff/leave/{id}\x12\x99\x01\n" +
+	"\x12AssignBookingStaff\x12%.degrees.v1.AssignBookingStaffRequest\x1a&.degrees.v1.AssignBookingStaffResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\x1a)/api/v1/admin/bookings/{booking_id}/staff\x12y\n" +
+	"\x0fListMyJobsToday\x12\".degrees.v1.ListMyJobsTodayRequest\x1a#.degrees.v1.ListMyJobsTodayResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/me/jobs/todayB\xaf\x01\n" +
+	"\x0ecom.degrees.v1B\x11StaffServiceProtoP\x01ZAgithub.com/richardbowden/degrees/internal/pb/degrees/v1;degreesv1\xa2\x02\x03DXX\xaa\x02\n" +
+	"Degrees.V1\xca\x02\n" +
+	"Degrees\\V1\xe2\x02\x16Degrees\\V1\\GPBMetadata\xea\x02\vDegrees::V1b\x06proto3"
+
+var (
+	file_degrees_v1_staff_service_proto_rawDescOnce sync.Once
+	file_degrees_v1_staff_service_proto_rawDescData []byte
+)
+
+func file_degrees_v1_staff_service_proto_rawDescGZIP() []byte {
+	file_degrees_v1_staff_service_proto_rawDescOnce.Do(func() {
+		file_degrees_v1_staff_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_degrees_v1_staff_service_proto_rawDesc), len(file_degrees_v1_staff_service_proto_rawDesc)))
+	})
+	return file_degrees_v1_staff_service_proto_rawDescData
+}
+
+var file_degrees_v1_staff_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_degrees_v1_staff_service_proto_goTypes = []any{
+	(*StaffMember)(nil),                // 0: degrees.v1.StaffMember
+	(*RosterShift)(nil),                // 1: degrees.v1.RosterShift
+	(*StaffLeave)(nil),                 // 2: degrees.v1.StaffLeave
+	(*ListStaffRequest)(nil),           // 3: degrees.v1.ListStaffRequest
+	(*ListStaffResponse)(nil),          // 4: degrees.v1.ListStaffResponse
+	(*CreateStaffRequest)(nil),         // 5: degrees.v1.CreateStaffRequest
+	(*CreateStaffResponse)(nil),        // 6: degrees.v1.CreateStaffResponse
+	(*UpdateStaffRequest)(nil),         // 7: degrees.v1.UpdateStaffRequest
+	(*UpdateStaffResponse)(nil),        // 8: degrees.v1.UpdateStaffResponse
+	(*SetStaffRosterRequest)(nil),      // 9: degrees.v1.SetStaffRosterRequest
+	(*SetStaffRosterResponse)(nil),     // 10: degrees.v1.SetStaffRosterResponse
+	(*AddStaffLeaveRequest)(nil),       // 11: degrees.v1.AddStaffLeaveRequest
+	(*AddStaffLeaveResponse)(nil),      // 12: degrees.v1.AddStaffLeaveResponse
+	(*RemoveStaffLeaveRequest)(nil),    // 13: degrees.v1.RemoveStaffLeaveRequest
+	(*RemoveStaffLeaveResponse)(nil),   // 14: degrees.v1.RemoveStaffLeaveResponse
+	(*AssignBookingStaffRequest)(nil),  // 15: degrees.v1.AssignBookingStaffRequest
+	(*AssignBookingStaffResponse)(nil), // 16: degrees.v1.AssignBookingStaffResponse
+	(*ListMyJobsTodayRequest)(nil),     // 17: degrees.v1.ListMyJobsTodayRequest
+	(*ListMyJobsTodayResponse)(nil),    // 18: degrees.v1.ListMyJobsTodayResponse
+	(*BookingStaffMember)(nil),         // 19: degrees.v1.BookingStaffMember
+	(*Booking)(nil),                    // 20: degrees.v1.Booking
+}
+var file_degrees_v1_staff_service_proto_depIdxs = []int32{
+	1,  // 0: degrees.v1.StaffMember.roster:type_name -> degrees.v1.RosterShift
+	2,  // 1: degrees.v1.StaffMember.leave:type_name -> degrees.v1.StaffLeave
+	0,  // 2: degrees.v1.ListStaffResponse.staff:type_name -> degrees.v1.StaffMember
+	0,  // 3: degrees.v1.CreateStaffResponse.staff:type_name -> degrees.v1.StaffMember
+	0,  // 4: degrees.v1.UpdateStaffResponse.staff:type_name -> degrees.v1.StaffMember
+	1,  // 5: degrees.v1.SetStaffRosterResponse.shift:type_name -> degrees.v1.RosterShift
+	2,  // 6: degrees.v1.AddStaffLeaveResponse.leave:type_name -> degrees.v1.StaffLeave
+	19, // 7: degrees.v1.AssignBookingStaffResponse.assigned_staff:type_name -> degrees.v1.BookingStaffMember
+	20, // 8: degrees.v1.ListMyJobsTodayResponse.jobs:type_name -> degrees.v1.Booking
+	3,  // 9: degrees.v1.StaffService.ListStaff:input_type -> degrees.v1.ListStaffRequest
+	5,  // 10: degrees.v1.StaffService.CreateStaff:input_type -> degrees.v1.CreateStaffRequest
+	7,  // 11: degrees.v1.StaffService.UpdateStaff:input_type -> degrees.v1.UpdateStaffRequest
+	9,  // 12: degrees.v1.StaffService.SetStaffRoster:input_type -> degrees.v1.SetStaffRosterRequest
+	11, // 13: degrees.v1.StaffService.AddStaffLeave:input_type -> degrees.v1.AddStaffLeaveRequest
+	13, // 14: degrees.v1.StaffService.RemoveStaffLeave:input_type -> degrees.v1.RemoveStaffLeaveRequest
+	15, // 15: degrees.v1.StaffService.AssignBookingStaff:input_type -> degrees.v1.AssignBookingStaffRequest
+	17, // 16: degrees.v1.StaffService.ListMyJobsToday:input_type -> degrees.v1.ListMyJobsTodayRequest
+	4,  // 17: degrees.v1.StaffService.ListStaff:output_type -> degrees.v1.ListStaffResponse
+	6,  // 18: degrees.v1.StaffService.CreateStaff:output_type -> degrees.v1.CreateStaffResponse
+	8,  // 19: degrees.v1.StaffService.UpdateStaff:output_type -> degrees.v1.UpdateStaffResponse
+	10, // 20: degrees.v1.StaffService.SetStaffRoster:output_type -> degrees.v1.SetStaffRosterResponse
+	12, // 21: degrees.v1.StaffService.AddStaffLeave:output_type -> degrees.v1.AddStaffLeaveResponse
+	14, // 22: degrees.v1.StaffService.RemoveStaffLeave:output_type -> degrees.v1.RemoveStaffLeaveResponse
+	16, // 23: degrees.v1.StaffService.AssignBookingStaff:output_type -> degrees.v1.AssignBookingStaffResponse
+	18, // 24: degrees.v1.StaffService.ListMyJobsToday:output_type -> degrees.v1.ListMyJobsTodayResponse
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_degrees_v1_staff_service_proto_init() }
+func file_degrees_v1_staff_service_proto_init() {
+	if File_degrees_v1_staff_service_proto != nil {
+		return
+	}
+	file_degrees_v1_booking_service_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_degrees_v1_staff_service_proto_rawDesc), len(file_degrees_v1_staff_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_degrees_v1_staff_service_proto_goTypes,
+		DependencyIndexes: file_degrees_v1_staff_service_proto_depIdxs,
+		MessageInfos:      file_degrees_v1_staff_service_proto_msgTypes,
+	}.Build()
+	File_degrees_v1_staff_service_proto = out.File
+	file_degrees_v1_staff_service_proto_goTypes = nil
+	file_degrees_v1_staff_service_proto_depIdxs = nil
+}
@@ -0,0 +1,401 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             (unknown)
+// source: degrees/v1/staff_service.proto
+
+package degreesv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StaffService_ListStaff_FullMethodName          = "/degrees.v1.StaffService/ListStaff"
+	StaffService_CreateStaff_FullMethodName        = "/degrees.v1.StaffService/CreateStaff"
+	StaffService_UpdateStaff_FullMethodName        = "/degrees.v1.StaffService/UpdateStaff"
+	StaffService_SetStaffRoster_FullMethodName     = "/degrees.v1.StaffService/SetStaffRoster"
+	StaffService_AddStaffLeave_FullMethodName      = "/degrees.v1.StaffService/AddStaffLeave"
+	StaffService_RemoveStaffLeave_FullMethodName   = "/degrees.v1.StaffService/RemoveStaffLeave"
+	StaffService_AssignBookingStaff_FullMethodName = "/degrees.v1.StaffService/AssignBookingStaff"
+	StaffService_ListMyJobsToday_FullMethodName    = "/degrees.v1.StaffService/ListMyJobsToday"
+)
+
+// StaffServiceClient is the client API for StaffService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StaffServiceClient interface {
+	// List staff with their skills, roster and upcoming leave
+	ListStaff(ctx context.Context, in *ListStaffRequest, opts ...grpc.CallOption) (*ListStaffResponse, error)
+	// Add a staff member for an existing user
+	CreateStaff(ctx context.Context, in *CreateStaffRequest, opts ...grpc.CallOption) (*CreateStaffResponse, error)
+	// Update a staff member and their skills
+	UpdateStaff(ctx context.Context, in *UpdateStaffRequest, opts ...grpc.CallOption) (*UpdateStaffResponse, error)
+	// Set a staff member's shift for a day of the week
+	SetStaffRoster(ctx context.Context, in *SetStaffRosterRequest, opts ...grpc.CallOption) (*SetStaffRosterResponse, error)
+	// Record leave for a staff member
+	AddStaffLeave(ctx context.Context, in *AddStaffLeaveRequest, opts ...grpc.CallOption) (*AddStaffLeaveResponse, error)
+	// Remove a leave entry
+	RemoveStaffLeave(ctx context.Context, in *RemoveStaffLeaveRequest, opts ...grpc.CallOption) (*RemoveStaffLeaveResponse, error)
+	// Assign detailers to a booking
+	AssignBookingStaff(ctx context.Context, in *AssignBookingStaffRequest, opts ...grpc.CallOption) (*AssignBookingStaffResponse, error)
+	// List the calling detailer's jobs for today
+	ListMyJobsToday(ctx context.Context, in *ListMyJobsTodayRequest, opts ...grpc.CallOption) (*ListMyJobsTodayResponse, error)
+}
+
+type staffServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStaffServiceClient(cc grpc.ClientConnInterface) StaffServiceClient {
+	return &staffServiceClient{cc}
+}
+
+func (c *staffServiceClient) ListStaff(ctx context.Context, in *ListStaffRequest, opts ...grpc.CallOption) (*ListStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStaffResponse)
+	err := c.cc.Invoke(ctx, StaffService_ListStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) CreateStaff(ctx context.Context, in *CreateStaffRequest, opts ...grpc.CallOption) (*CreateStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateStaffResponse)
+	err := c.cc.Invoke(ctx, StaffService_CreateStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) UpdateStaff(ctx context.Context, in *UpdateStaffRequest, opts ...grpc.CallOption) (*UpdateStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateStaffResponse)
+	err := c.cc.Invoke(ctx, StaffService_UpdateStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) SetStaffRoster(ctx context.Context, in *SetStaffRosterRequest, opts ...grpc.CallOption) (*SetStaffRosterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStaffRosterResponse)
+	err := c.cc.Invoke(ctx, StaffService_SetStaffRoster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) AddStaffLeave(ctx context.Context, in *AddStaffLeaveRequest, opts ...grpc.CallOption) (*AddStaffLeaveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddStaffLeaveResponse)
+	err := c.cc.Invoke(ctx, StaffService_AddStaffLeave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) RemoveStaffLeave(ctx context.Context, in *RemoveStaffLeaveRequest, opts ...grpc.CallOption) (*RemoveStaffLeaveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveStaffLeaveResponse)
+	err := c.cc.Invoke(ctx, StaffService_RemoveStaffLeave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) AssignBookingStaff(ctx context.Context, in *AssignBookingStaffRequest, opts ...grpc.CallOption) (*AssignBookingStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignBookingStaffResponse)
+	err := c.cc.Invoke(ctx, StaffService_AssignBookingStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *staffServiceClient) ListMyJobsToday(ctx context.Context, in *ListMyJobsTodayRequest, opts ...grpc.CallOption) (*ListMyJobsTodayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyJobsTodayResponse)
+	err := c.cc.Invoke(ctx, StaffService_ListMyJobsToday_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StaffServiceServer is the server API for StaffService service.
+// All implementations should embed UnimplementedStaffServiceServer
+// for forward compatibility.
+type StaffServiceServer interface {
+	// List staff with their skills, roster and upcoming leave
+	ListStaff(context.Context, *ListStaffRequest) (*ListStaffResponse, error)
+	// Add a staff member for an existing user
+	CreateStaff(context.Context, *CreateStaffRequest) (*CreateStaffResponse, error)
+	// Update a staff member and their skills
+	UpdateStaff(context.Context, *UpdateStaffRequest) (*UpdateStaffResponse, error)
+	// Set a staff member's shift for a day of the week
+	SetStaffRoster(context.Context, *SetStaffRosterRequest) (*SetStaffRosterResponse, error)
+	// Record leave for a staff member
+	AddStaffLeave(context.Context, *AddStaffLeaveRequest) (*AddStaffLeaveResponse, error)
+	// Remove a leave entry
+	RemoveStaffLeave(context.Context, *RemoveStaffLeaveRequest) (*RemoveStaffLeaveResponse, error)
+	// Assign detailers to a booking
+	AssignBookingStaff(context.Context, *AssignBookingStaffRequest) (*AssignBookingStaffResponse, error)
+	// List the calling detailer's jobs for today
+	ListMyJobsToday(context.Context, *ListMyJobsTodayRequest) (*ListMyJobsTodayResponse, error)
+}
+
+// UnimplementedStaffServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStaffServiceServer struct{}
+
+func (UnimplementedStaffServiceServer) ListStaff(context.Context, *ListStaffRequest) (*ListStaffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListStaff not implemented")
+}
+func (UnimplementedStaffServiceServer) CreateStaff(context.Context, *CreateStaffRequest) (*CreateStaffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateStaff not implemented")
+}
+func (UnimplementedStaffServiceServer) UpdateStaff(context.Context, *UpdateStaffRequest) (*UpdateStaffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateStaff not implemented")
+}
+func (UnimplementedStaffServiceServer) SetStaffRoster(context.Context, *SetStaffRosterRequest) (*SetStaffRosterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetStaffRoster not implemented")
+}
+func (UnimplementedStaffServiceServer) AddStaffLeave(context.Context, *AddStaffLeaveRequest) (*AddStaffLeaveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddStaffLeave not implemented")
+}
+func (UnimplementedStaffServiceServer) RemoveStaffLeave(context.Context, *RemoveStaffLeaveRequest) (*RemoveStaffLeaveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveStaffLeave not implemented")
+}
+func (UnimplementedStaffServiceServer) AssignBookingStaff(context.Context, *AssignBookingStaffRequest) (*AssignBookingStaffResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignBookingStaff not implemented")
+}
+func (UnimplementedStaffServiceServer) ListMyJobsToday(context.Context, *ListMyJobsTodayRequest) (*ListMyJobsTodayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyJobsToday not implemented")
+}
+func (UnimplementedStaffServiceServer) testEmbeddedByValue() {}
+
+// UnsafeStaffServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StaffServiceServer will
+// result in compilation errors.
+type UnsafeStaffServiceServer interface {
+	mustEmbedUnimplementedStaffServiceServer()
+}
+
+func RegisterStaffServiceServer(s grpc.ServiceRegistrar, srv StaffServiceServer) {
+	// If the following call panics, it indicates UnimplementedStaffServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StaffService_ServiceDesc, srv)
+}
+
+func _StaffService_ListStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ListStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_ListStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ListStaff(ctx, req.(*ListStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_CreateStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).CreateStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_CreateStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).CreateStaff(ctx, req.(*CreateStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_UpdateStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).UpdateStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_UpdateStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).UpdateStaff(ctx, req.(*UpdateStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_SetStaffRoster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStaffRosterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).SetStaffRoster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_SetStaffRoster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).SetStaffRoster(ctx, req.(*SetStaffRosterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_AddStaffLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStaffLeaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).AddStaffLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_AddStaffLeave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).AddStaffLeave(ctx, req.(*AddStaffLeaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_RemoveStaffLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStaffLeaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).RemoveStaffLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_RemoveStaffLeave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).RemoveStaffLeave(ctx, req.(*RemoveStaffLeaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_AssignBookingStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignBookingStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).AssignBookingStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_AssignBookingStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).AssignBookingStaff(ctx, req.(*AssignBookingStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StaffService_ListMyJobsToday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyJobsTodayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StaffServiceServer).ListMyJobsToday(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StaffService_ListMyJobsToday_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StaffServiceServer).ListMyJobsToday(ctx, req.(*ListMyJobsTodayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StaffService_ServiceDesc is the grpc.ServiceDesc for StaffService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StaffService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "degrees.v1.StaffService",
+	HandlerType: (*StaffServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListStaff",
+			Handler:    _StaffService_ListStaff_Handler,
+		},
+		{
+			MethodName: "CreateStaff",
+			Handler:    _StaffService_CreateStaff_Handler,
+		},
+		{
+			MethodName: "UpdateStaff",
+			Handler:    _StaffService_UpdateStaff_Handler,
+		},
+		{
+			MethodName: "SetStaffRoster",
+			Handler:    _StaffService_SetStaffRoster_Handler,
+		},
+		{
+			MethodName: "AddStaffLeave",
+			Handler:    _StaffService_AddStaffLeave_Handler,
+		},
+		{
+			MethodName: "RemoveStaffLeave",
+			Handler:    _StaffService_RemoveStaffLeave_Handler,
+		},
+		{
+			MethodName: "AssignBookingStaff",
+			Handler:    _StaffService_AssignBookingStaff_Handler,
+		},
+		{
+			MethodName: "ListMyJobsToday",
+			Handler:    _StaffService_ListMyJobsToday_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "degrees/v1/staff_service.proto",
+}
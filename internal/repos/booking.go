@@ -25,6 +25,9 @@ func (r *Bookings) CreateBooking(ctx context.Context, params dbpg.CreateBookingP
 
 	booking, err := tx.CreateBooking(ctx, params)
 	if err != nil {
+		if dbpg.IsErrExclusionViolation(err) {
+			return dbpg.Booking{}, services.ErrConflict
+		}
 		return dbpg.Booking{}, err
 	}
 
@@ -71,30 +74,31 @@ func (r *Bookings) ListBookingsForDate(ctx context.Context, params dbpg.ListBook
 	return r.store.ListBookingsForDate(ctx, params)
 }
 
-func (r *Bookings) UpdateBookingStatus(ctx context.Context, params dbpg.UpdateBookingStatusParams) (dbpg.Booking, error) {
-	b, err := r.store.UpdateBookingStatus(ctx, params)
-	if err != nil {
-		if dbpg.IsErrNoRows(err) {
-			return dbpg.Booking{}, services.ErrNoRecord
-		}
-		return dbpg.Booking{}, err
-	}
-	return b, nil
+func (r *Bookings) ListBookingStatusHistory(ctx context.Context, bookingID int64) ([]dbpg.BookingStatusHistory, error) {
+	return r.store.ListBookingStatusHistory(ctx, dbpg.ListBookingStatusHistoryParams{BookingID: bookingID})
 }
 
-func (r *Bookings) UpdateBookingPaymentStatus(ctx context.Context, params dbpg.UpdateBookingPaymentStatusParams) (dbpg.Booking, error) {
-	b, err := r.store.UpdateBookingPaymentStatus(ctx, params)
+func (r *Bookings) ListBookingServices(ctx context.Context, bookingID int64) ([]dbpg.ListBookingServicesRow, error) {
+	return r.store.ListBookingServices(ctx, dbpg.ListBookingServicesParams{BookingID: bookingID})
+}
+
+func (r *Bookings) ListBookingStaff(ctx context.Context, bookingID int64) ([]dbpg.ListBookingStaffRow, error) {
+	return r.store.ListBookingStaff(ctx, dbpg.ListBookingStaffParams{BookingID: bookingID})
+}
+
+func (r *Bookings) ListBookingPayments(ctx context.Context, bookingID int64) ([]dbpg.ListBookingPaymentsRow, error) {
+	return r.store.ListBookingPayments(ctx, dbpg.ListBookingPaymentsParams{BookingID: bookingID})
+}
+
+func (r *Bookings) GetChargeByProviderRef(ctx context.Context, providerRef string) (dbpg.Payment, error) {
+	p, err := r.store.GetChargeByProviderRef(ctx, dbpg.GetChargeByProviderRefParams{ProviderRef: dbpg.StringToPGString(providerRef)})
 	if err != nil {
 		if dbpg.IsErrNoRows(err) {
-			return dbpg.Booking{}, services.ErrNoRecord
+			return dbpg.Payment{}, services.ErrNoRecord
 		}
-		return dbpg.Booking{}, err
+		return dbpg.Payment{}, err
 	}
-	return b, nil
-}
-
-func (r *Bookings) ListBookingServices(ctx context.Context, bookingID int64) ([]dbpg.ListBookingServicesRow, error) {
-	return r.store.ListBookingServices(ctx, dbpg.ListBookingServicesParams{BookingID: bookingID})
+	return p, nil
 }
 
 func (r *Bookings) ListBookingServiceOptions(ctx context.Context, bookingServiceID int64) ([]dbpg.ListBookingServiceOptionsRow, error) {
@@ -129,6 +133,10 @@ func (r *Bookings) ListCartItems(ctx context.Context, cartSessionID int64) ([]db
 	return r.store.ListCartItems(ctx, dbpg.ListCartItemsParams{CartSessionID: cartSessionID})
 }
 
+func (r *Bookings) ListCartItemOptionsBySession(ctx context.Context, cartSessionID int64) ([]dbpg.ListCartItemOptionsBySessionRow, error) {
+	return r.store.ListCartItemOptionsBySession(ctx, dbpg.ListCartItemOptionsBySessionParams{CartSessionID: cartSessionID})
+}
+
 func (r *Bookings) ClearCart(ctx context.Context, cartSessionID int64) error {
 	return r.store.ClearCart(ctx, dbpg.ClearCartParams{CartSessionID: cartSessionID})
 }
@@ -144,6 +152,28 @@ func (r *Bookings) GetCustomerProfileByUserID(ctx context.Context, userID int64)
 	return cp, nil
 }
 
+func (r *Bookings) GetCustomerProfileByID(ctx context.Context, id int64) (dbpg.CustomerProfile, error) {
+	cp, err := r.store.GetCustomerProfileByID(ctx, dbpg.GetCustomerProfileByIDParams{ID: id})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.CustomerProfile{}, services.ErrNoRecord
+		}
+		return dbpg.CustomerProfile{}, err
+	}
+	return cp, nil
+}
+
+func (r *Bookings) GetServiceOptionByID(ctx context.Context, id int64) (dbpg.ServiceOption, error) {
+	opt, err := r.store.GetServiceOptionByID(ctx, dbpg.GetServiceOptionByIDParams{ID: id})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.ServiceOption{}, services.ErrNoRecord
+		}
+		return dbpg.ServiceOption{}, err
+	}
+	return opt, nil
+}
+
 func (r *Bookings) GetServiceByID(ctx context.Context, serviceID int64) (dbpg.Service, error) {
 	svc, err := r.store.GetServiceByID(ctx, dbpg.GetServiceByIDParams{ID: serviceID})
 	if err != nil {
@@ -183,3 +213,468 @@ func (r *Bookings) GetPriceTier(ctx context.Context, serviceID, vehicleCategoryI
 	}
 	return row, nil
 }
+
+func (r *Bookings) GetBookingSeriesByID(ctx context.Context, id int64) (dbpg.BookingSeries, error) {
+	series, err := r.store.GetBookingSeriesByID(ctx, dbpg.GetBookingSeriesByIDParams{ID: id})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.BookingSeries{}, services.ErrNoRecord
+		}
+		return dbpg.BookingSeries{}, err
+	}
+	return series, nil
+}
+
+func (r *Bookings) ListBookingSeriesByCustomer(ctx context.Context, customerID int64) ([]dbpg.BookingSeries, error) {
+	return r.store.ListBookingSeriesByCustomer(ctx, dbpg.ListBookingSeriesByCustomerParams{CustomerID: customerID})
+}
+
+func (r *Bookings) ListActiveBookingSeries(ctx context.Context) ([]dbpg.BookingSeries, error) {
+	return r.store.ListActiveBookingSeries(ctx)
+}
+
+func (r *Bookings) UpdateBookingSeriesStatus(ctx context.Context, id int64, status string) (dbpg.BookingSeries, error) {
+	series, err := r.store.UpdateBookingSeriesStatus(ctx, dbpg.UpdateBookingSeriesStatusParams{ID: id, Status: status})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.BookingSeries{}, services.ErrNoRecord
+		}
+		return dbpg.BookingSeries{}, err
+	}
+	return series, nil
+}
+
+func (r *Bookings) ListSeriesOccurrences(ctx context.Context, seriesID int64) ([]dbpg.BookingSeriesOccurrence, error) {
+	return r.store.ListSeriesOccurrences(ctx, dbpg.ListSeriesOccurrencesParams{SeriesID: seriesID})
+}
+
+func (r *Bookings) UpsertSeriesOccurrence(ctx context.Context, params dbpg.UpsertSeriesOccurrenceParams) (dbpg.BookingSeriesOccurrence, error) {
+	return r.store.UpsertSeriesOccurrence(ctx, params)
+}
+
+func (r *Bookings) ListUpcomingSeriesBookings(ctx context.Context, seriesID int64) ([]dbpg.Booking, error) {
+	return r.store.ListUpcomingSeriesBookings(ctx, dbpg.ListUpcomingSeriesBookingsParams{
+		SeriesID: pgtype.Int8{Int64: seriesID, Valid: true},
+	})
+}
+
+func (r *Bookings) ListWaitlistEntriesByCustomer(ctx context.Context, customerID int64) ([]dbpg.ListWaitlistEntriesByCustomerRow, error) {
+	return r.store.ListWaitlistEntriesByCustomer(ctx, dbpg.ListWaitlistEntriesByCustomerParams{CustomerID: customerID})
+}
+
+func (r *Bookings) GetWaitlistEntryByID(ctx context.Context, id int64) (dbpg.WaitlistEntry, error) {
+	entry, err := r.store.GetWaitlistEntryByID(ctx, dbpg.GetWaitlistEntryByIDParams{ID: id})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.WaitlistEntry{}, services.ErrNoRecord
+		}
+		return dbpg.WaitlistEntry{}, err
+	}
+	return entry, nil
+}
+
+func (r *Bookings) GetWaitlistEntryByClaimToken(ctx context.Context, token string) (dbpg.WaitlistEntry, error) {
+	entry, err := r.store.GetWaitlistEntryByClaimToken(ctx, dbpg.GetWaitlistEntryByClaimTokenParams{
+		ClaimToken: dbpg.StringToPGString(token),
+	})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.WaitlistEntry{}, services.ErrNoRecord
+		}
+		return dbpg.WaitlistEntry{}, err
+	}
+	return entry, nil
+}
+
+func (r *Bookings) ListWaitingEntriesForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ListWaitingEntriesForDateRow, error) {
+	return r.store.ListWaitingEntriesForDate(ctx, dbpg.ListWaitingEntriesForDateParams{Date: date})
+}
+
+func (r *Bookings) ListWaitlistEntryServices(ctx context.Context, entryID int64) ([]dbpg.WaitlistEntryService, error) {
+	return r.store.ListWaitlistEntryServices(ctx, dbpg.ListWaitlistEntryServicesParams{WaitlistEntryID: entryID})
+}
+
+func (r *Bookings) ListWaitlistEntryServiceOptions(ctx context.Context, entryID int64) ([]dbpg.WaitlistEntryServiceOption, error) {
+	return r.store.ListWaitlistEntryServiceOptions(ctx, dbpg.ListWaitlistEntryServiceOptionsParams{WaitlistEntryID: entryID})
+}
+
+func (r *Bookings) GetSlotHoldByID(ctx context.Context, id int64) (dbpg.SlotHold, error) {
+	hold, err := r.store.GetSlotHoldByID(ctx, dbpg.GetSlotHoldByIDParams{ID: id})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.SlotHold{}, services.ErrNoRecord
+		}
+		return dbpg.SlotHold{}, err
+	}
+	return hold, nil
+}
+
+func (r *Bookings) GetCheckoutHoldForCustomer(ctx context.Context, customerID int64) (dbpg.SlotHold, error) {
+	hold, err := r.store.GetCheckoutHoldForCustomer(ctx, dbpg.GetCheckoutHoldForCustomerParams{CustomerID: customerID})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.SlotHold{}, services.ErrNoRecord
+		}
+		return dbpg.SlotHold{}, err
+	}
+	return hold, nil
+}
+
+func (r *Bookings) DeleteExpiredCheckoutHolds(ctx context.Context) ([]dbpg.SlotHold, error) {
+	return r.store.DeleteExpiredCheckoutHolds(ctx)
+}
+
+func (r *Bookings) ListExpiredWaitlistOffers(ctx context.Context) ([]dbpg.ListExpiredWaitlistOffersRow, error) {
+	return r.store.ListExpiredWaitlistOffers(ctx)
+}
+
+func (r *Bookings) ExpirePastWaitlistEntries(ctx context.Context) (int64, error) {
+	return r.store.ExpirePastWaitlistEntries(ctx)
+}
+
+// WithTx runs fn inside a single database transaction. The transaction is
+// committed only if fn returns nil.
+func (r *Bookings) WithTx(ctx context.Context, fn func(tx services.BookingTx) error) error {
+	tx, err := r.store.GetTX(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(&bookingTx{q: tx}); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// bookingTx implements services.BookingTx on top of an open transaction.
+type bookingTx struct {
+	q dbpg.Querier
+}
+
+func (t *bookingTx) LockScheduleDate(ctx context.Context, date pgtype.Date) error {
+	return t.q.LockScheduleDate(ctx, dbpg.LockScheduleDateParams{ScheduledDate: date})
+}
+
+func (t *bookingTx) GetScheduleConfigForDay(ctx context.Context, dayOfWeek int32) (dbpg.ScheduleConfig, error) {
+	cfg, err := t.q.GetScheduleConfigForDay(ctx, dbpg.GetScheduleConfigForDayParams{DayOfWeek: dayOfWeek})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.ScheduleConfig{}, services.ErrNoRecord
+		}
+		return dbpg.ScheduleConfig{}, err
+	}
+	return cfg, nil
+}
+
+func (t *bookingTx) ListScheduleWindowsForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ScheduleWindow, error) {
+	return t.q.ListScheduleWindowsForDay(ctx, dbpg.ListScheduleWindowsForDayParams{DayOfWeek: dayOfWeek})
+}
+
+func (t *bookingTx) ListScheduleOverridesForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ScheduleOverride, error) {
+	return t.q.ListScheduleOverridesForDate(ctx, dbpg.ListScheduleOverridesForDateParams{Date: date})
+}
+
+func (t *bookingTx) IsDateBlackedOut(ctx context.Context, params dbpg.IsDateBlackedOutParams) (bool, error) {
+	return t.q.IsDateBlackedOut(ctx, params)
+}
+
+func (t *bookingTx) ListBookingsForDate(ctx context.Context, params dbpg.ListBookingsForDateParams) ([]dbpg.Booking, error) {
+	return t.q.ListBookingsForDate(ctx, params)
+}
+
+func (t *bookingTx) ListActiveResources(ctx context.Context) ([]dbpg.Resource, error) {
+	return t.q.ListActiveResources(ctx)
+}
+
+func (t *bookingTx) ListResourceHoursForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ResourceHour, error) {
+	return t.q.ListResourceHoursForDay(ctx, dbpg.ListResourceHoursForDayParams{DayOfWeek: dayOfWeek})
+}
+
+func (t *bookingTx) HasActiveStaff(ctx context.Context) (bool, error) {
+	return t.q.HasActiveStaff(ctx)
+}
+
+func (t *bookingTx) ListRosteredStaffForDate(ctx context.Context, params dbpg.ListRosteredStaffForDateParams) ([]dbpg.ListRosteredStaffForDateRow, error) {
+	return t.q.ListRosteredStaffForDate(ctx, params)
+}
+
+func (t *bookingTx) ListBookingStaffForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ListBookingStaffForDateRow, error) {
+	return t.q.ListBookingStaffForDate(ctx, dbpg.ListBookingStaffForDateParams{ScheduledDate: date})
+}
+
+func (t *bookingTx) CreateBooking(ctx context.Context, params dbpg.CreateBookingParams) (dbpg.Booking, error) {
+	b, err := t.q.CreateBooking(ctx, params)
+	if err != nil {
+		if dbpg.IsErrExclusionViolation(err) {
+			return dbpg.Booking{}, services.ErrConflict
+		}
+		return dbpg.Booking{}, err
+	}
+	return b, nil
+}
+
+func (t *bookingTx) CreateBookingService(ctx context.Context, params dbpg.CreateBookingServiceParams) (dbpg.BookingService, error) {
+	return t.q.CreateBookingService(ctx, params)
+}
+
+func (t *bookingTx) CreateBookingServiceOption(ctx context.Context, params dbpg.CreateBookingServiceOptionParams) (dbpg.BookingServiceOption, error) {
+	return t.q.CreateBookingServiceOption(ctx, params)
+}
+
+func (t *bookingTx) ClearCart(ctx context.Context, cartSessionID int64) error {
+	return t.q.ClearCart(ctx, dbpg.ClearCartParams{CartSessionID: cartSessionID})
+}
+
+func (t *bookingTx) GetBookingForUpdate(ctx context.Context, id int64) (dbpg.Booking, error) {
+	b, err := t.q.GetBookingForUpdate(ctx, dbpg.GetBookingForUpdateParams{ID: id})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.Booking{}, services.ErrNoRecord
+		}
+		return dbpg.Booking{}, err
+	}
+	return b, nil
+}
+
+func (t *bookingTx) UpdateBookingStatus(ctx context.Context, params dbpg.UpdateBookingStatusParams) (dbpg.Booking, error) {
+	b, err := t.q.UpdateBookingStatus(ctx, params)
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.Booking{}, services.ErrNoRecord
+		}
+		if dbpg.IsErrExclusionViolation(err) {
+			return dbpg.Booking{}, services.ErrConflict
+		}
+		return dbpg.Booking{}, err
+	}
+	return b, nil
+}
+
+func (t *bookingTx) UpdateBookingPaymentStatus(ctx context.Context, params dbpg.UpdateBookingPaymentStatusParams) (dbpg.Booking, error) {
+	b, err := t.q.UpdateBookingPaymentStatus(ctx, params)
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.Booking{}, services.ErrNoRecord
+		}
+		return dbpg.Booking{}, err
+	}
+	return b, nil
+}
+
+func (t *bookingTx) CreateBookingStatusHistory(ctx context.Context, params dbpg.CreateBookingStatusHistoryParams) (dbpg.BookingStatusHistory, error) {
+	return t.q.CreateBookingStatusHistory(ctx, params)
+}
+
+func (t *bookingTx) RescheduleBooking(ctx context.Context, params dbpg.RescheduleBookingParams) (dbpg.Booking, error) {
+	b, err := t.q.RescheduleBooking(ctx, params)
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.Booking{}, services.ErrNoRecord
+		}
+		if dbpg.IsErrExclusionViolation(err) {
+			return dbpg.Booking{}, services.ErrConflict
+		}
+		return dbpg.Booking{}, err
+	}
+	return b, nil
+}
+
+func (t *bookingTx) SetBookingRefundAmount(ctx context.Context, params dbpg.SetBookingRefundAmountParams) (dbpg.Booking, error) {
+	return t.q.SetBookingRefundAmount(ctx, params)
+}
+
+func (t *bookingTx) SetBookingForfeitedAmount(ctx context.Context, params dbpg.SetBookingForfeitedAmountParams) (dbpg.Booking, error) {
+	return t.q.SetBookingForfeitedAmount(ctx, params)
+}
+
+func (t *bookingTx) IncrementCustomerNoShowCount(ctx context.Context, customerID int64) (dbpg.CustomerProfile, error) {
+	return t.q.IncrementCustomerNoShowCount(ctx, dbpg.IncrementCustomerNoShowCountParams{ID: customerID})
+}
+
+func (t *bookingTx) ListBookingServices(ctx context.Context, bookingID int64) ([]dbpg.ListBookingServicesRow, error) {
+	return t.q.ListBookingServices(ctx, dbpg.ListBookingServicesParams{BookingID: bookingID})
+}
+
+func (t *bookingTx) ListBookingServiceOptions(ctx context.Context, bookingServiceID int64) ([]dbpg.ListBookingServiceOptionsRow, error) {
+	return t.q.ListBookingServiceOptions(ctx, dbpg.ListBookingServiceOptionsParams{BookingServiceID: bookingServiceID})
+}
+
+func (t *bookingTx) GetBookingService(ctx context.Context, params dbpg.GetBookingServiceParams) (dbpg.BookingService, error) {
+	bs, err := t.q.GetBookingService(ctx, params)
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.BookingService{}, services.ErrNoRecord
+		}
+		return dbpg.BookingService{}, err
+	}
+	return bs, nil
+}
+
+func (t *bookingTx) GetBookingServiceOption(ctx context.Context, params dbpg.GetBookingServiceOptionParams) (dbpg.BookingServiceOption, error) {
+	opt, err := t.q.GetBookingServiceOption(ctx, params)
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.BookingServiceOption{}, services.ErrNoRecord
+		}
+		return dbpg.BookingServiceOption{}, err
+	}
+	return opt, nil
+}
+
+func (t *bookingTx) DeleteBookingService(ctx context.Context, id int64) error {
+	return t.q.DeleteBookingService(ctx, dbpg.DeleteBookingServiceParams{ID: id})
+}
+
+func (t *bookingTx) DeleteBookingServiceOption(ctx context.Context, id int64) error {
+	return t.q.DeleteBookingServiceOption(ctx, dbpg.DeleteBookingServiceOptionParams{ID: id})
+}
+
+func (t *bookingTx) UpdateBookingPricing(ctx context.Context, params dbpg.UpdateBookingPricingParams) (dbpg.Booking, error) {
+	b, err := t.q.UpdateBookingPricing(ctx, params)
+	if err != nil {
+		if dbpg.IsErrExclusionViolation(err) {
+			return dbpg.Booking{}, services.ErrConflict
+		}
+		return dbpg.Booking{}, err
+	}
+	return b, nil
+}
+
+func (t *bookingTx) SetBookingAmountPaid(ctx context.Context, params dbpg.SetBookingAmountPaidParams) (dbpg.Booking, error) {
+	return t.q.SetBookingAmountPaid(ctx, params)
+}
+
+// RecordStripeEvent reports false if the event was already recorded.
+func (t *bookingTx) RecordStripeEvent(ctx context.Context, params dbpg.RecordStripeEventParams) (bool, error) {
+	n, err := t.q.RecordStripeEvent(ctx, params)
+	return n > 0, err
+}
+
+// CreatePayment reports services.ErrConflict for a provider payment already
+// recorded.
+func (t *bookingTx) CreatePayment(ctx context.Context, params dbpg.CreatePaymentParams) (dbpg.Payment, error) {
+	p, err := t.q.CreatePayment(ctx, params)
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.Payment{}, services.ErrConflict
+		}
+		return dbpg.Payment{}, err
+	}
+	return p, nil
+}
+
+func (t *bookingTx) SumBookingPayments(ctx context.Context, bookingID int64) (dbpg.SumBookingPaymentsRow, error) {
+	return t.q.SumBookingPayments(ctx, dbpg.SumBookingPaymentsParams{BookingID: bookingID})
+}
+
+func (t *bookingTx) GetUserByEmail(ctx context.Context, email string) (dbpg.User, error) {
+	u, err := t.q.GetUserByEmail(ctx, dbpg.GetUserByEmailParams{LoginEmail: email})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.User{}, services.ErrNoRecord
+		}
+		return dbpg.User{}, err
+	}
+	return u, nil
+}
+
+func (t *bookingTx) CreateUser(ctx context.Context, params dbpg.CreateUserParams) (dbpg.User, error) {
+	return t.q.CreateUser(ctx, params)
+}
+
+func (t *bookingTx) CreateUserEmail(ctx context.Context, params dbpg.CreateUserEmailParams) (dbpg.UserEmail, error) {
+	return t.q.CreateUserEmail(ctx, params)
+}
+
+func (t *bookingTx) CreateCustomerProfile(ctx context.Context, params dbpg.CreateCustomerProfileParams) (dbpg.CustomerProfile, error) {
+	return t.q.CreateCustomerProfile(ctx, params)
+}
+
+func (t *bookingTx) CreateVehicle(ctx context.Context, params dbpg.CreateVehicleParams) (dbpg.Vehicle, error) {
+	return t.q.CreateVehicle(ctx, params)
+}
+
+func (t *bookingTx) CreateBookingSeries(ctx context.Context, params dbpg.CreateBookingSeriesParams) (dbpg.BookingSeries, error) {
+	return t.q.CreateBookingSeries(ctx, params)
+}
+
+func (t *bookingTx) SetBookingSeriesID(ctx context.Context, bookingID, seriesID int64) error {
+	return t.q.SetBookingSeriesID(ctx, dbpg.SetBookingSeriesIDParams{
+		ID:       bookingID,
+		SeriesID: pgtype.Int8{Int64: seriesID, Valid: true},
+	})
+}
+
+func (t *bookingTx) UpsertSeriesOccurrence(ctx context.Context, params dbpg.UpsertSeriesOccurrenceParams) (dbpg.BookingSeriesOccurrence, error) {
+	return t.q.UpsertSeriesOccurrence(ctx, params)
+}
+
+func (t *bookingTx) ListActiveSlotHoldsForDate(ctx context.Context, date pgtype.Date) ([]dbpg.SlotHold, error) {
+	return t.q.ListActiveSlotHoldsForDate(ctx, dbpg.ListActiveSlotHoldsForDateParams{ScheduledDate: date})
+}
+
+func (t *bookingTx) CreateSlotHold(ctx context.Context, params dbpg.CreateSlotHoldParams) (dbpg.SlotHold, error) {
+	return t.q.CreateSlotHold(ctx, params)
+}
+
+func (t *bookingTx) DeleteSlotHold(ctx context.Context, id int64) error {
+	return t.q.DeleteSlotHold(ctx, dbpg.DeleteSlotHoldParams{ID: id})
+}
+
+func (t *bookingTx) DeleteCheckoutHoldsForCustomer(ctx context.Context, customerID int64) ([]dbpg.SlotHold, error) {
+	return t.q.DeleteCheckoutHoldsForCustomer(ctx, dbpg.DeleteCheckoutHoldsForCustomerParams{CustomerID: customerID})
+}
+
+func (t *bookingTx) CreateWaitlistEntry(ctx context.Context, params dbpg.CreateWaitlistEntryParams) (dbpg.WaitlistEntry, error) {
+	return t.q.CreateWaitlistEntry(ctx, params)
+}
+
+func (t *bookingTx) CreateWaitlistEntryService(ctx context.Context, params dbpg.CreateWaitlistEntryServiceParams) (dbpg.WaitlistEntryService, error) {
+	return t.q.CreateWaitlistEntryService(ctx, params)
+}
+
+func (t *bookingTx) CreateWaitlistEntryServiceOption(ctx context.Context, params dbpg.CreateWaitlistEntryServiceOptionParams) (dbpg.WaitlistEntryServiceOption, error) {
+	return t.q.CreateWaitlistEntryServiceOption(ctx, params)
+}
+
+func (t *bookingTx) UpdateWaitlistEntryStatus(ctx context.Context, id int64, status string) (dbpg.WaitlistEntry, error) {
+	entry, err := t.q.UpdateWaitlistEntryStatus(ctx, dbpg.UpdateWaitlistEntryStatusParams{ID: id, Status: status})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.WaitlistEntry{}, services.ErrNoRecord
+		}
+		return dbpg.WaitlistEntry{}, err
+	}
+	return entry, nil
+}
+
+func (t *bookingTx) OfferWaitlistEntry(ctx context.Context, params dbpg.OfferWaitlistEntryParams) (dbpg.WaitlistEntry, error) {
+	entry, err := t.q.OfferWaitlistEntry(ctx, params)
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.WaitlistEntry{}, services.ErrNoRecord
+		}
+		return dbpg.WaitlistEntry{}, err
+	}
+	return entry, nil
+}
+
+func (t *bookingTx) ClaimWaitlistEntry(ctx context.Context, id, bookingID int64) (dbpg.WaitlistEntry, error) {
+	entry, err := t.q.ClaimWaitlistEntry(ctx, dbpg.ClaimWaitlistEntryParams{
+		ID:        id,
+		BookingID: pgtype.Int8{Int64: bookingID, Valid: true},
+	})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.WaitlistEntry{}, services.ErrNoRecord
+		}
+		return dbpg.WaitlistEntry{}, err
+	}
+	return entry, nil
+}
+
+func (t *bookingTx) ExpireWaitlistOffer(ctx context.Context, id int64) error {
+	return t.q.ExpireWaitlistOffer(ctx, dbpg.ExpireWaitlistOfferParams{ID: id})
+}
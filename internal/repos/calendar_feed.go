@@ -0,0 +1,72 @@
+package repos
+
+import (
+	"context"
+
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/services"
+)
+
+type CalendarFeeds struct {
+	store dbpg.Storer
+}
+
+func NewCalendarFeedRepo(store dbpg.Storer) *CalendarFeeds {
+	return &CalendarFeeds{store: store}
+}
+
+func (r *CalendarFeeds) GetCalendarFeedForUser(ctx context.Context, userID int64, scope string) (dbpg.CalendarFeed, error) {
+	feed, err := r.store.GetCalendarFeedForUser(ctx, dbpg.GetCalendarFeedForUserParams{UserID: userID, Scope: scope})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.CalendarFeed{}, services.ErrNoRecord
+		}
+		return dbpg.CalendarFeed{}, err
+	}
+	return feed, nil
+}
+
+func (r *CalendarFeeds) GetCalendarFeedByToken(ctx context.Context, token string) (dbpg.CalendarFeed, error) {
+	feed, err := r.store.GetCalendarFeedByToken(ctx, dbpg.GetCalendarFeedByTokenParams{Token: token})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.CalendarFeed{}, services.ErrNoRecord
+		}
+		return dbpg.CalendarFeed{}, err
+	}
+	return feed, nil
+}
+
+func (r *CalendarFeeds) SetCalendarFeedToken(ctx context.Context, params dbpg.SetCalendarFeedTokenParams) (dbpg.CalendarFeed, error) {
+	return r.store.SetCalendarFeedToken(ctx, params)
+}
+
+func (r *CalendarFeeds) ListCalendarBookings(ctx context.Context, params dbpg.ListCalendarBookingsParams) ([]dbpg.ListCalendarBookingsRow, error) {
+	return r.store.ListCalendarBookings(ctx, params)
+}
+
+func (r *CalendarFeeds) ListBookingServiceNames(ctx context.Context, bookingIDs []int64) ([]dbpg.ListBookingServiceNamesRow, error) {
+	return r.store.ListBookingServiceNames(ctx, dbpg.ListBookingServiceNamesParams{BookingIds: bookingIDs})
+}
+
+func (r *CalendarFeeds) GetStaffByUserID(ctx context.Context, userID int64) (dbpg.Staff, error) {
+	st, err := r.store.GetStaffByUserID(ctx, dbpg.GetStaffByUserIDParams{UserID: userID})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.Staff{}, services.ErrNoRecord
+		}
+		return dbpg.Staff{}, err
+	}
+	return st, nil
+}
+
+func (r *CalendarFeeds) GetCustomerProfileByUserID(ctx context.Context, userID int64) (dbpg.CustomerProfile, error) {
+	cp, err := r.store.GetCustomerProfileByUserID(ctx, dbpg.GetCustomerProfileByUserIDParams{UserID: userID})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.CustomerProfile{}, services.ErrNoRecord
+		}
+		return dbpg.CustomerProfile{}, err
+	}
+	return cp, nil
+}
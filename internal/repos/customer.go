@@ -156,15 +156,16 @@ func (r *Customer) DeleteVehicle(ctx context.Context, vehicleID int64) error {
 
 func dbProfileToService(p dbpg.CustomerProfile) services.CustomerProfile {
 	return services.CustomerProfile{
-		ID:        p.ID,
-		UserID:    p.UserID,
-		Phone:     p.Phone.String,
-		Address:   p.Address.String,
-		Suburb:    p.Suburb.String,
-		Postcode:  p.Postcode.String,
-		Notes:     p.Notes.String,
-		CreatedAt: p.CreatedAt.Time,
-		UpdatedAt: p.UpdatedAt.Time,
+		ID:          p.ID,
+		UserID:      p.UserID,
+		Phone:       p.Phone.String,
+		Address:     p.Address.String,
+		Suburb:      p.Suburb.String,
+		Postcode:    p.Postcode.String,
+		Notes:       p.Notes.String,
+		NoShowCount: p.NoShowCount,
+		CreatedAt:   p.CreatedAt.Time,
+		UpdatedAt:   p.UpdatedAt.Time,
 	}
 }
 
@@ -3,6 +3,7 @@ package repos
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/richardbowden/degrees/internal/dbpg"
 	"github.com/richardbowden/degrees/internal/services"
 )
@@ -38,12 +39,51 @@ func (r *Schedule) IsDateBlackedOut(ctx context.Context, params dbpg.IsDateBlack
 	return r.store.IsDateBlackedOut(ctx, params)
 }
 
+func (r *Schedule) ListScheduleWindows(ctx context.Context) ([]dbpg.ScheduleWindow, error) {
+	return r.store.ListScheduleWindows(ctx)
+}
+
+func (r *Schedule) ListScheduleWindowsForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ScheduleWindow, error) {
+	return r.store.ListScheduleWindowsForDay(ctx, dbpg.ListScheduleWindowsForDayParams{DayOfWeek: dayOfWeek})
+}
+
+func (r *Schedule) SetScheduleWindows(ctx context.Context, params dbpg.SetScheduleWindowsParams) error {
+	return r.store.SetScheduleWindows(ctx, params)
+}
+
+func (r *Schedule) ListUpcomingScheduleOverrides(ctx context.Context) ([]dbpg.ScheduleOverride, error) {
+	return r.store.ListUpcomingScheduleOverrides(ctx)
+}
+
+func (r *Schedule) ListScheduleOverridesForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ScheduleOverride, error) {
+	return r.store.ListScheduleOverridesForDate(ctx, dbpg.ListScheduleOverridesForDateParams{Date: date})
+}
+
+func (r *Schedule) ListScheduleOverridesInRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.ScheduleOverride, error) {
+	return r.store.ListScheduleOverridesInRange(ctx, dbpg.ListScheduleOverridesInRangeParams{DateFrom: from, DateTo: to})
+}
+
+func (r *Schedule) SetScheduleOverride(ctx context.Context, params dbpg.SetScheduleOverrideParams) ([]dbpg.ScheduleOverride, error) {
+	return r.store.SetScheduleOverride(ctx, params)
+}
+
+func (r *Schedule) DeleteScheduleOverride(ctx context.Context, date pgtype.Date) (int64, error) {
+	return r.store.DeleteScheduleOverride(ctx, dbpg.DeleteScheduleOverrideParams{Date: date})
+}
+
 func (r *Schedule) CreateBlackout(ctx context.Context, params dbpg.CreateBlackoutParams) (dbpg.ScheduleBlackout, error) {
 	return r.store.CreateBlackout(ctx, params)
 }
 
-func (r *Schedule) DeleteBlackout(ctx context.Context, id int64) error {
-	return r.store.DeleteBlackout(ctx, dbpg.DeleteBlackoutParams{ID: id})
+func (r *Schedule) DeleteBlackout(ctx context.Context, id int64) (dbpg.ScheduleBlackout, error) {
+	blackout, err := r.store.DeleteBlackout(ctx, dbpg.DeleteBlackoutParams{ID: id})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.ScheduleBlackout{}, services.ErrNoRecord
+		}
+		return dbpg.ScheduleBlackout{}, err
+	}
+	return blackout, nil
 }
 
 func (r *Schedule) ListBlackoutDates(ctx context.Context) ([]dbpg.ScheduleBlackout, error) {
@@ -53,3 +93,93 @@ func (r *Schedule) ListBlackoutDates(ctx context.Context) ([]dbpg.ScheduleBlacko
 func (r *Schedule) ListBookingsForDate(ctx context.Context, params dbpg.ListBookingsForDateParams) ([]dbpg.Booking, error) {
 	return r.store.ListBookingsForDate(ctx, params)
 }
+
+func (r *Schedule) ListServiceRequirements(ctx context.Context, serviceIDs []int64, vehicleCategoryID int64) ([]dbpg.ListServiceRequirementsRow, error) {
+	return r.store.ListServiceRequirements(ctx, dbpg.ListServiceRequirementsParams{Ids: serviceIDs, VehicleCategoryID: vehicleCategoryID})
+}
+
+func (r *Schedule) ListResources(ctx context.Context) ([]dbpg.Resource, error) {
+	return r.store.ListResources(ctx)
+}
+
+func (r *Schedule) ListActiveResources(ctx context.Context) ([]dbpg.Resource, error) {
+	return r.store.ListActiveResources(ctx)
+}
+
+func (r *Schedule) CreateResource(ctx context.Context, params dbpg.CreateResourceParams) (dbpg.Resource, error) {
+	return r.store.CreateResource(ctx, params)
+}
+
+func (r *Schedule) UpdateResource(ctx context.Context, params dbpg.UpdateResourceParams) (dbpg.Resource, error) {
+	res, err := r.store.UpdateResource(ctx, params)
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.Resource{}, services.ErrNoRecord
+		}
+		return dbpg.Resource{}, err
+	}
+	return res, nil
+}
+
+func (r *Schedule) ListResourceHours(ctx context.Context) ([]dbpg.ResourceHour, error) {
+	return r.store.ListResourceHours(ctx)
+}
+
+func (r *Schedule) ListResourceHoursForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ResourceHour, error) {
+	return r.store.ListResourceHoursForDay(ctx, dbpg.ListResourceHoursForDayParams{DayOfWeek: dayOfWeek})
+}
+
+func (r *Schedule) UpsertResourceHours(ctx context.Context, params dbpg.UpsertResourceHoursParams) (dbpg.ResourceHour, error) {
+	return r.store.UpsertResourceHours(ctx, params)
+}
+
+func (r *Schedule) DeleteResourceHours(ctx context.Context, resourceID int64, dayOfWeek int32) error {
+	return r.store.DeleteResourceHours(ctx, dbpg.DeleteResourceHoursParams{ResourceID: resourceID, DayOfWeek: dayOfWeek})
+}
+
+func (r *Schedule) HasActiveStaff(ctx context.Context) (bool, error) {
+	return r.store.HasActiveStaff(ctx)
+}
+
+func (r *Schedule) ListRosteredStaffForDate(ctx context.Context, params dbpg.ListRosteredStaffForDateParams) ([]dbpg.ListRosteredStaffForDateRow, error) {
+	return r.store.ListRosteredStaffForDate(ctx, params)
+}
+
+func (r *Schedule) ListBookingStaffForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ListBookingStaffForDateRow, error) {
+	return r.store.ListBookingStaffForDate(ctx, dbpg.ListBookingStaffForDateParams{ScheduledDate: date})
+}
+
+func (r *Schedule) GetCustomerProfileByUserID(ctx context.Context, userID int64) (dbpg.CustomerProfile, error) {
+	cp, err := r.store.GetCustomerProfileByUserID(ctx, dbpg.GetCustomerProfileByUserIDParams{UserID: userID})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.CustomerProfile{}, services.ErrNoRecord
+		}
+		return dbpg.CustomerProfile{}, err
+	}
+	return cp, nil
+}
+
+func (r *Schedule) ListActiveSlotHoldsForDate(ctx context.Context, date pgtype.Date) ([]dbpg.SlotHold, error) {
+	return r.store.ListActiveSlotHoldsForDate(ctx, dbpg.ListActiveSlotHoldsForDateParams{ScheduledDate: date})
+}
+
+func (r *Schedule) ListBlackoutsInRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.ScheduleBlackout, error) {
+	return r.store.ListBlackoutsInRange(ctx, dbpg.ListBlackoutsInRangeParams{DateFrom: from, DateTo: to})
+}
+
+func (r *Schedule) ListBookingsForRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.Booking, error) {
+	return r.store.ListBookingsForRange(ctx, dbpg.ListBookingsForRangeParams{DateFrom: from, DateTo: to})
+}
+
+func (r *Schedule) ListActiveSlotHoldsForRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.SlotHold, error) {
+	return r.store.ListActiveSlotHoldsForRange(ctx, dbpg.ListActiveSlotHoldsForRangeParams{DateFrom: from, DateTo: to})
+}
+
+func (r *Schedule) ListRosteredStaffForRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.ListRosteredStaffForRangeRow, error) {
+	return r.store.ListRosteredStaffForRange(ctx, dbpg.ListRosteredStaffForRangeParams{DateFrom: from, DateTo: to})
+}
+
+func (r *Schedule) ListBookingStaffForRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.ListBookingStaffForRangeRow, error) {
+	return r.store.ListBookingStaffForRange(ctx, dbpg.ListBookingStaffForRangeParams{DateFrom: from, DateTo: to})
+}
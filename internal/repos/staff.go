@@ -0,0 +1,125 @@
+package repos
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/services"
+)
+
+type Staff struct {
+	store dbpg.Storer
+}
+
+func NewStaffRepo(store dbpg.Storer) *Staff {
+	return &Staff{store: store}
+}
+
+func (r *Staff) ListStaff(ctx context.Context) ([]dbpg.ListStaffRow, error) {
+	return r.store.ListStaff(ctx)
+}
+
+func (r *Staff) GetStaffByID(ctx context.Context, id int64) (dbpg.Staff, error) {
+	st, err := r.store.GetStaffByID(ctx, dbpg.GetStaffByIDParams{ID: id})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.Staff{}, services.ErrNoRecord
+		}
+		return dbpg.Staff{}, err
+	}
+	return st, nil
+}
+
+func (r *Staff) GetStaffByUserID(ctx context.Context, userID int64) (dbpg.Staff, error) {
+	st, err := r.store.GetStaffByUserID(ctx, dbpg.GetStaffByUserIDParams{UserID: userID})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.Staff{}, services.ErrNoRecord
+		}
+		return dbpg.Staff{}, err
+	}
+	return st, nil
+}
+
+func (r *Staff) CreateStaff(ctx context.Context, params dbpg.CreateStaffParams) (dbpg.Staff, error) {
+	return r.store.CreateStaff(ctx, params)
+}
+
+func (r *Staff) UpdateStaff(ctx context.Context, params dbpg.UpdateStaffParams) (dbpg.Staff, error) {
+	st, err := r.store.UpdateStaff(ctx, params)
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.Staff{}, services.ErrNoRecord
+		}
+		return dbpg.Staff{}, err
+	}
+	return st, nil
+}
+
+func (r *Staff) ListAllStaffSkills(ctx context.Context) ([]dbpg.StaffSkill, error) {
+	return r.store.ListAllStaffSkills(ctx)
+}
+
+func (r *Staff) SetStaffSkills(ctx context.Context, staffID int64, categoryIDs []int64) error {
+	// A nil slice is sent as NULL, which would match nothing to remove
+	if categoryIDs == nil {
+		categoryIDs = []int64{}
+	}
+	return r.store.SetStaffSkills(ctx, dbpg.SetStaffSkillsParams{StaffID: staffID, CategoryIds: categoryIDs})
+}
+
+func (r *Staff) ListStaffRoster(ctx context.Context) ([]dbpg.StaffRoster, error) {
+	return r.store.ListStaffRoster(ctx)
+}
+
+func (r *Staff) UpsertStaffRoster(ctx context.Context, params dbpg.UpsertStaffRosterParams) (dbpg.StaffRoster, error) {
+	return r.store.UpsertStaffRoster(ctx, params)
+}
+
+func (r *Staff) DeleteStaffRoster(ctx context.Context, staffID int64, dayOfWeek int32) error {
+	return r.store.DeleteStaffRoster(ctx, dbpg.DeleteStaffRosterParams{StaffID: staffID, DayOfWeek: dayOfWeek})
+}
+
+func (r *Staff) ListUpcomingStaffLeave(ctx context.Context) ([]dbpg.StaffLeave, error) {
+	return r.store.ListUpcomingStaffLeave(ctx)
+}
+
+func (r *Staff) CreateStaffLeave(ctx context.Context, params dbpg.CreateStaffLeaveParams) (dbpg.StaffLeave, error) {
+	return r.store.CreateStaffLeave(ctx, params)
+}
+
+func (r *Staff) DeleteStaffLeave(ctx context.Context, id int64) error {
+	return r.store.DeleteStaffLeave(ctx, dbpg.DeleteStaffLeaveParams{ID: id})
+}
+
+func (r *Staff) GetBookingByID(ctx context.Context, id int64) (dbpg.GetBookingByIDRow, error) {
+	row, err := r.store.GetBookingByID(ctx, dbpg.GetBookingByIDParams{ID: id})
+	if err != nil {
+		if dbpg.IsErrNoRows(err) {
+			return dbpg.GetBookingByIDRow{}, services.ErrNoRecord
+		}
+		return dbpg.GetBookingByIDRow{}, err
+	}
+	return row, nil
+}
+
+func (r *Staff) ListBookingStaff(ctx context.Context, bookingID int64) ([]dbpg.ListBookingStaffRow, error) {
+	return r.store.ListBookingStaff(ctx, dbpg.ListBookingStaffParams{BookingID: bookingID})
+}
+
+func (r *Staff) SetBookingStaff(ctx context.Context, params dbpg.SetBookingStaffParams) error {
+	// A nil slice is sent as NULL, which would match nothing to remove
+	if params.StaffIds == nil {
+		params.StaffIds = []int64{}
+	}
+	return r.store.SetBookingStaff(ctx, params)
+}
+
+func (r *Staff) ListStaffBookingsForDate(ctx context.Context, staffID int64, date pgtype.Date) ([]dbpg.Booking, error) {
+	return r.store.ListStaffBookingsForDate(ctx, dbpg.ListStaffBookingsForDateParams{StaffID: staffID, ScheduledDate: date})
+}
+
+func (r *Staff) ListRosteredStaffForDate(ctx context.Context, params dbpg.ListRosteredStaffForDateParams) ([]dbpg.ListRosteredStaffForDateRow, error) {
+	return r.store.ListRosteredStaffForDate(ctx, params)
+}
@@ -0,0 +1,316 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/valgen"
+)
+
+// walkInEmailDomain is the domain of the placeholder login email given to a
+// customer booked in by staff without an email address. Such customers
+// cannot sign in and are not sent emails.
+const walkInEmailDomain = "walk-in.invalid"
+
+// hasEmail reports whether a customer's email address can be written to.
+func hasEmail(addr string) bool {
+	return addr != "" && !strings.HasSuffix(addr, "@"+walkInEmailDomain)
+}
+
+// NewCustomer is a customer entered by staff while taking a booking.
+type NewCustomer struct {
+	FirstName string
+	Surname   string
+	Phone     string
+	Email     string // optional
+}
+
+// NewVehicle is a vehicle entered by staff while taking a booking.
+type NewVehicle struct {
+	Make              string
+	Model             string
+	Rego              string
+	VehicleCategoryID int64
+}
+
+// AdminBookingService is one service booked by staff.
+type AdminBookingService struct {
+	ServiceID int64
+	Quantity  int32 // defaults to 1
+	OptionIDs []int64
+	Price     *int64 // overrides the service's own price; options are still charged
+}
+
+type CreateAdminBookingParams struct {
+	ActorID       int64        // the staff member entering the booking
+	CustomerID    int64        // an existing customer, or
+	NewCustomer   *NewCustomer // one created along with the booking
+	VehicleID     int64        // an existing vehicle of the customer's, or
+	NewVehicle    *NewVehicle  // one created along with the booking
+	Services      []AdminBookingService
+	ScheduledDate string // YYYY-MM-DD
+	ScheduledTime string // HH:MM
+	Notes         string
+	Location      *ServiceLocation // set for a mobile job at the customer's address
+	SkipDeposit   bool             // confirm the booking without asking for a deposit
+}
+
+// CreateAdminBooking books services on a customer's behalf, as for a phone or
+// walk-in booking. The slot is checked and priced as at checkout, except that
+// staff may override prices and waive the deposit.
+func (s *BookingService) CreateAdminBooking(ctx context.Context, params CreateAdminBookingParams) (*dbpg.Booking, error) {
+	if err := validateAdminBooking(params); err != nil {
+		return nil, err
+	}
+
+	var customer dbpg.CustomerProfile
+	if params.CustomerID > 0 {
+		c, err := s.repo.GetCustomerProfileByID(ctx, params.CustomerID)
+		if err != nil {
+			if errors.Is(err, ErrNoRecord) {
+				return nil, problems.New(problems.NotExist, "customer not found")
+			}
+			return nil, problems.New(problems.Database, "failed to get customer profile", err)
+		}
+		customer = c
+	}
+
+	var vehicleCategoryID int64
+	switch {
+	case params.VehicleID > 0:
+		vehicle, err := s.repo.GetVehicleByID(ctx, params.VehicleID)
+		if err != nil {
+			if errors.Is(err, ErrNoRecord) {
+				return nil, problems.New(problems.NotExist, "vehicle not found")
+			}
+			return nil, problems.New(problems.Database, "failed to get vehicle", err)
+		}
+		if vehicle.CustomerID != customer.ID {
+			return nil, problems.New(problems.InvalidRequest, "vehicle does not belong to the customer")
+		}
+		vehicleCategoryID = vehicle.VehicleCategoryID
+	case params.NewVehicle != nil:
+		vehicleCategoryID = params.NewVehicle.VehicleCategoryID
+	}
+
+	scheduledDate, err := time.Parse("2006-01-02", params.ScheduledDate)
+	if err != nil {
+		return nil, problems.New(problems.InvalidRequest, "invalid date format, expected YYYY-MM-DD")
+	}
+	scheduledTime, err := time.Parse("15:04", params.ScheduledTime)
+	if err != nil {
+		return nil, problems.New(problems.InvalidRequest, "invalid time format, expected HH:MM")
+	}
+	startMins := int32(scheduledTime.Hour()*60 + scheduledTime.Minute())
+
+	items, err := s.adminQuoteItems(ctx, params.Services)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := s.quoteItems(ctx, items, vehicleCategoryID)
+	if err != nil {
+		return nil, err
+	}
+	travel, err := s.quoteTravel(ctx, &quote, params.Location)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBookingRules(ctx, &quote.requirements, scheduledDate, startMins); err != nil {
+		return nil, err
+	}
+
+	totalAmount := quote.subtotal + travel.Surcharge
+	var depositAmount int64
+	if !params.SkipDeposit {
+		depositAmount = s.depositFor(ctx, customer, totalAmount)
+	}
+
+	bookingParams := dbpg.CreateBookingParams{
+		CustomerID:            customer.ID,
+		VehicleID:             pgtype.Int8{Int64: params.VehicleID, Valid: params.VehicleID > 0},
+		ScheduledDate:         pgtype.Date{Time: scheduledDate, Valid: true},
+		ScheduledTime:         pgtype.Time{Microseconds: int64(startMins) * 60000000, Valid: true},
+		EstimatedDurationMins: quote.duration,
+		Status:                initialStatus(depositAmount),
+		PaymentStatus:         dbpg.PaymentStatusPending,
+		Subtotal:              quote.subtotal,
+		DepositAmount:         depositAmount,
+		TotalAmount:           totalAmount,
+		Notes:                 dbpg.StringToPGString(params.Notes),
+		TravelDistanceKm:      travel.DistanceKm,
+		TravelSurcharge:       travel.Surcharge,
+		CreatedBy:             pgtype.Int8{Int64: params.ActorID, Valid: params.ActorID > 0},
+	}
+	if params.Location != nil {
+		suburb := params.Location.Suburb
+		if suburb == "" {
+			suburb = travel.Location.Suburb
+		}
+		bookingParams.ServiceAddress = dbpg.StringToPGString(params.Location.Address)
+		bookingParams.ServiceSuburb = dbpg.StringToPGString(suburb)
+		bookingParams.ServicePostcode = dbpg.StringToPGString(travel.Location.Postcode)
+	}
+
+	var booking dbpg.Booking
+	err = s.repo.WithTx(ctx, func(tx BookingTx) error {
+		if params.NewCustomer != nil {
+			created, err := createWalkInCustomer(ctx, tx, *params.NewCustomer)
+			if err != nil {
+				return err
+			}
+			bookingParams.CustomerID = created.ID
+		}
+		if params.NewVehicle != nil {
+			v, err := tx.CreateVehicle(ctx, dbpg.CreateVehicleParams{
+				CustomerID:        bookingParams.CustomerID,
+				Make:              params.NewVehicle.Make,
+				Model:             params.NewVehicle.Model,
+				Rego:              dbpg.StringToPGString(params.NewVehicle.Rego),
+				IsPrimary:         params.NewCustomer != nil,
+				VehicleCategoryID: pgtype.Int8{Int64: params.NewVehicle.VehicleCategoryID, Valid: params.NewVehicle.VehicleCategoryID > 0},
+			})
+			if err != nil {
+				return problems.New(problems.Database, "failed to create vehicle", err)
+			}
+			bookingParams.VehicleID = pgtype.Int8{Int64: v.ID, Valid: true}
+		}
+
+		created, err := placeBooking(ctx, tx, bookingParams, quote, params.ActorID, "booked by staff")
+		if err != nil {
+			return err
+		}
+		booking = created
+		return nil
+	})
+	if err != nil {
+		return nil, txError(err, "failed to create booking")
+	}
+
+	s.notifyBooked(ctx, booking.ID)
+	return &booking, nil
+}
+
+func validateAdminBooking(params CreateAdminBookingParams) error {
+	if (params.CustomerID > 0) == (params.NewCustomer != nil) {
+		return problems.New(problems.InvalidRequest, "either an existing customer or a new customer is required")
+	}
+	if params.VehicleID > 0 && params.NewVehicle != nil {
+		return problems.New(problems.InvalidRequest, "either an existing vehicle or a new vehicle may be given, not both")
+	}
+	if params.NewCustomer != nil && params.VehicleID > 0 {
+		return problems.New(problems.InvalidRequest, "a new customer has no existing vehicles")
+	}
+	if len(params.Services) == 0 {
+		return problems.New(problems.InvalidRequest, "at least one service is required")
+	}
+
+	if c := params.NewCustomer; c != nil {
+		if strings.TrimSpace(c.FirstName) == "" {
+			return problems.New(problems.InvalidRequest, "customer name is required")
+		}
+		if strings.TrimSpace(c.Phone) == "" {
+			return problems.New(problems.InvalidRequest, "customer phone is required")
+		}
+		if c.Email != "" {
+			if err := valgen.ValidateEmail(c.Email); err != nil {
+				return problems.New(problems.InvalidRequest, "invalid customer email: "+err.Error())
+			}
+		}
+	}
+	if v := params.NewVehicle; v != nil && (strings.TrimSpace(v.Make) == "" || strings.TrimSpace(v.Model) == "") {
+		return problems.New(problems.InvalidRequest, "vehicle make and model are required")
+	}
+
+	for _, svc := range params.Services {
+		if svc.Quantity < 0 {
+			return problems.New(problems.InvalidRequest, "service quantity cannot be negative")
+		}
+		if svc.Price != nil && *svc.Price < 0 {
+			return problems.New(problems.InvalidRequest, "price override cannot be negative")
+		}
+	}
+	return nil
+}
+
+// adminQuoteItems resolves the options staff picked for each service.
+func (s *BookingService) adminQuoteItems(ctx context.Context, services []AdminBookingService) ([]quoteItem, error) {
+	items := make([]quoteItem, len(services))
+	for i, svc := range services {
+		item := quoteItem{serviceID: svc.ServiceID, quantity: svc.Quantity, price: svc.Price}
+		if item.quantity == 0 {
+			item.quantity = 1
+		}
+		for _, id := range svc.OptionIDs {
+			opt, err := s.repo.GetServiceOptionByID(ctx, id)
+			if err != nil {
+				if errors.Is(err, ErrNoRecord) {
+					return nil, problems.New(problems.NotExist, "service option not found")
+				}
+				return nil, problems.New(problems.Database, "failed to get service option", err)
+			}
+			item.options = append(item.options, quoteOption{
+				id:        opt.ID,
+				serviceID: opt.ServiceID,
+				name:      opt.Name,
+				price:     opt.Price,
+				duration:  opt.DurationMinutes,
+				active:    opt.IsActive,
+			})
+		}
+		items[i] = item
+	}
+	return items, nil
+}
+
+// createWalkInCustomer creates the account and profile for a customer booked
+// in by staff. The account has no password, so it cannot be signed in to
+// until the customer resets one through their email. Without an email, a
+// placeholder login is used and the customer is not sent emails.
+func createWalkInCustomer(ctx context.Context, tx BookingTx, c NewCustomer) (dbpg.CustomerProfile, error) {
+	email := strings.ToLower(strings.TrimSpace(c.Email))
+	if email == "" {
+		b := make([]byte, 8)
+		if _, err := rand.Read(b); err != nil {
+			return dbpg.CustomerProfile{}, problems.New(problems.Internal, "failed to generate customer login", err)
+		}
+		email = "customer-" + hex.EncodeToString(b) + "@" + walkInEmailDomain
+	} else {
+		_, err := tx.GetUserByEmail(ctx, email)
+		if err == nil {
+			return dbpg.CustomerProfile{}, problems.New(problems.Exist, "a customer with this email already exists, book for them instead")
+		}
+		if !errors.Is(err, ErrNoRecord) {
+			return dbpg.CustomerProfile{}, problems.New(problems.Database, "failed to check customer email", err)
+		}
+	}
+
+	user, err := tx.CreateUser(ctx, dbpg.CreateUserParams{
+		FirstName:   strings.TrimSpace(c.FirstName),
+		Surname:     dbpg.StringToPGString(strings.TrimSpace(c.Surname)),
+		Username:    email,
+		LoginEmail:  email,
+		SignUpStage: UserStateInitial,
+	})
+	if err != nil {
+		return dbpg.CustomerProfile{}, problems.New(problems.Database, "failed to create customer account", err)
+	}
+	if _, err := tx.CreateUserEmail(ctx, dbpg.CreateUserEmailParams{UserID: user.ID, Email: email, Enabled: true}); err != nil {
+		return dbpg.CustomerProfile{}, problems.New(problems.Database, "failed to create customer email", err)
+	}
+
+	profile, err := tx.CreateCustomerProfile(ctx, dbpg.CreateCustomerProfileParams{
+		UserID: user.ID,
+		Phone:  dbpg.StringToPGString(strings.TrimSpace(c.Phone)),
+	})
+	if err != nil {
+		return dbpg.CustomerProfile{}, problems.New(problems.Database, "failed to create customer profile", err)
+	}
+	return profile, nil
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAdminBooking(t *testing.T) {
+	services := []AdminBookingService{{ServiceID: 1}}
+	walkIn := &NewCustomer{FirstName: "Sam", Phone: "0400 000 000"}
+
+	tests := []struct {
+		name   string
+		params CreateAdminBookingParams
+		valid  bool
+	}{
+		{"existing customer", CreateAdminBookingParams{CustomerID: 1, VehicleID: 2, Services: services}, true},
+		{"walk-in", CreateAdminBookingParams{NewCustomer: walkIn, NewVehicle: &NewVehicle{Make: "Mazda", Model: "3"}, Services: services}, true},
+		{"no customer", CreateAdminBookingParams{Services: services}, false},
+		{"both customers", CreateAdminBookingParams{CustomerID: 1, NewCustomer: walkIn, Services: services}, false},
+		{"walk-in with existing vehicle", CreateAdminBookingParams{NewCustomer: walkIn, VehicleID: 2, Services: services}, false},
+		{"walk-in without phone", CreateAdminBookingParams{NewCustomer: &NewCustomer{FirstName: "Sam"}, Services: services}, false},
+		{"no services", CreateAdminBookingParams{CustomerID: 1}, false},
+	}
+	for _, tt := range tests {
+		err := validateAdminBooking(tt.params)
+		assert.Equal(t, tt.valid, err == nil, "%s: %v", tt.name, err)
+	}
+}
+
+func TestHasEmail(t *testing.T) {
+	assert.True(t, hasEmail("sam@example.com"))
+	assert.False(t, hasEmail("customer-0a1b2c3d@"+walkInEmailDomain))
+	assert.False(t, hasEmail(""))
+}
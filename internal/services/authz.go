@@ -87,6 +87,12 @@ func (az *AuthzSvc) ListSysopUserIDs(ctx context.Context) (map[int64]bool, error
 	return ids, nil
 }
 
+// AdminChecker reports whether a user has system admin privileges. AuthzSvc
+// is the one used outside of tests.
+type AdminChecker interface {
+	IsSystemAdmin(ctx context.Context, userID int64) (bool, error)
+}
+
 // IsSystemAdmin checks if a user has system admin privileges (sysop or admin)
 func (az *AuthzSvc) IsSystemAdmin(ctx context.Context, userID int64) (bool, error) {
 	user := fmt.Sprintf("user:%d", userID)
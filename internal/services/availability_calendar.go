@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+)
+
+// Day statuses in the availability calendar.
+const (
+	CalendarDayOpen   = "open"   // at least one slot can take the job
+	CalendarDayClosed = "closed" // not trading, blacked out or inside the notice period
+	CalendarDayFull   = "full"   // trading, but no slot is long enough for the job
+)
+
+// maxCalendarDays bounds a single calendar request to roughly two months.
+const maxCalendarDays = 62
+
+// CalendarDay summarises availability on one date.
+type CalendarDay struct {
+	Date         string
+	Status       string
+	EarliestSlot string // HH:MM, empty unless Status is open
+	SlotCount    int32
+}
+
+type AvailabilityCalendarParams struct {
+	DateFrom          string // YYYY-MM-DD
+	DateTo            string // YYYY-MM-DD, inclusive
+	ServiceIDs        []int64
+	VehicleCategoryID int64
+	DurationMinutes   int32  // overrides the services' combined duration if set
+	Postcode          string // set for a mobile job
+	UserID            int64  // the signed-in user, whose own slot holds are ignored
+}
+
+// GetAvailabilityCalendar summarises availability for every date in a range.
+// Schedule data for the whole range is read in a fixed number of queries,
+// however many days are asked for.
+func (s *ScheduleService) GetAvailabilityCalendar(ctx context.Context, params AvailabilityCalendarParams) ([]CalendarDay, error) {
+	from, err := time.Parse("2006-01-02", params.DateFrom)
+	if err != nil {
+		return nil, problems.New(problems.InvalidRequest, "invalid date_from, expected YYYY-MM-DD")
+	}
+	to, err := time.Parse("2006-01-02", params.DateTo)
+	if err != nil {
+		return nil, problems.New(problems.InvalidRequest, "invalid date_to, expected YYYY-MM-DD")
+	}
+	if to.Before(from) {
+		return nil, problems.New(problems.InvalidRequest, "date_to must not be before date_from")
+	}
+	if days := int(to.Sub(from).Hours()/24) + 1; days > maxCalendarDays {
+		return nil, problems.New(problems.InvalidRequest, fmt.Sprintf("at most %d days can be requested at once", maxCalendarDays))
+	}
+
+	req, duration, err := s.requirementsForServices(ctx, params.ServiceIDs, params.VehicleCategoryID, params.Postcode)
+	if err != nil {
+		return nil, err
+	}
+	rules := loadBookingRules(ctx, s.settings)
+	if params.DurationMinutes > 0 {
+		duration = params.DurationMinutes
+	}
+	if duration <= 0 {
+		duration = int32(rules.DefaultDurationMinutes)
+	}
+	jobRules := rules.forJob(req.categoryIDs)
+	req.step = jobRules.step
+
+	holder, err := s.holderFor(ctx, params.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := loadScheduleRange(ctx, s.repo, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	clock := s.clock(ctx)
+	calendar := make([]CalendarDay, 0, len(days))
+	for i, day := range days {
+		date := from.AddDate(0, 0, i)
+		cd := CalendarDay{Date: date.Format("2006-01-02"), Status: CalendarDayClosed}
+		if day.open && jobRules.dayBookable(clock, date) {
+			day = day.withoutHoldsFor(holder)
+			cd.Status = CalendarDayFull
+			if fits := jobRules.bookable(clock, date, day.freeSlots(duration, req)); len(fits) > 0 {
+				cd.Status = CalendarDayOpen
+				cd.EarliestSlot = fmt.Sprintf("%02d:%02d", fits[0].start/60, fits[0].start%60)
+				cd.SlotCount = int32(len(fits))
+			}
+		}
+		calendar = append(calendar, cd)
+	}
+	return calendar, nil
+}
+
+// loadScheduleRange builds the schedule for every date from from to to
+// inclusive, indexed by days after from. It reads the same data as
+// loadDaySchedule but batched across the range.
+func loadScheduleRange(ctx context.Context, r ScheduleRepository, from, to time.Time) ([]daySchedule, error) {
+	pgFrom := pgtype.Date{Time: from, Valid: true}
+	pgTo := pgtype.Date{Time: to, Valid: true}
+	dayIndex := func(d pgtype.Date) int { return int(d.Time.Sub(from).Hours() / 24) }
+	n := dayIndex(pgTo) + 1
+
+	blackouts, err := r.ListBlackoutsInRange(ctx, pgFrom, pgTo)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list blackouts", err)
+	}
+	configs, err := r.GetScheduleConfig(ctx)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to get schedule config", err)
+	}
+	windows, err := r.ListScheduleWindows(ctx)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list schedule windows", err)
+	}
+	overrides, err := r.ListScheduleOverridesInRange(ctx, pgFrom, pgTo)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list schedule overrides", err)
+	}
+	resources, err := r.ListActiveResources(ctx)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list resources", err)
+	}
+	hours, err := r.ListResourceHours(ctx)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list resource hours", err)
+	}
+	bookings, err := r.ListBookingsForRange(ctx, pgFrom, pgTo)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list bookings", err)
+	}
+	holds, err := r.ListActiveSlotHoldsForRange(ctx, pgFrom, pgTo)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list slot holds", err)
+	}
+	staffed, err := r.HasActiveStaff(ctx)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to check staff", err)
+	}
+
+	closed := make([]bool, n)
+	for _, b := range blackouts {
+		if !b.RecursAnnually {
+			closed[dayIndex(b.Date)] = true
+			continue
+		}
+		for i := range closed {
+			if blackoutFallsOn(b, from.AddDate(0, 0, i)) {
+				closed[i] = true
+			}
+		}
+	}
+	configByWeekday := make(map[int32]dbpg.ScheduleConfig, len(configs))
+	for _, c := range configs {
+		configByWeekday[c.DayOfWeek] = c
+	}
+	windowsByWeekday := make(map[int32][]dbpg.ScheduleWindow)
+	for _, w := range windows {
+		windowsByWeekday[w.DayOfWeek] = append(windowsByWeekday[w.DayOfWeek], w)
+	}
+	overridesByDay := make(map[int][]dbpg.ScheduleOverride)
+	for _, o := range overrides {
+		i := dayIndex(o.Date)
+		overridesByDay[i] = append(overridesByDay[i], o)
+	}
+	hoursByWeekday := make(map[int32][]dbpg.ResourceHour)
+	for _, h := range hours {
+		hoursByWeekday[h.DayOfWeek] = append(hoursByWeekday[h.DayOfWeek], h)
+	}
+
+	inputs := make([]dayInputs, n)
+	for i := range inputs {
+		weekday := int32(from.AddDate(0, 0, i).Weekday())
+		config := configByWeekday[weekday]
+		inputs[i] = dayInputs{
+			config:    config,
+			resources: resources,
+			hours:     hoursByWeekday[weekday],
+			staffed:   staffed,
+		}
+		if o, ok := overridesByDay[i]; ok {
+			inputs[i].windows = overrideIntervals(o)
+			inputs[i].override = true
+		} else if config.IsOpen {
+			inputs[i].windows = weeklyIntervals(config, windowsByWeekday[weekday])
+		}
+	}
+	for _, b := range bookings {
+		i := dayIndex(b.ScheduledDate)
+		inputs[i].bookings = append(inputs[i].bookings, b)
+	}
+	for _, h := range holds {
+		i := dayIndex(h.ScheduledDate)
+		inputs[i].holds = append(inputs[i].holds, h)
+	}
+
+	if staffed {
+		rostered, err := r.ListRosteredStaffForRange(ctx, pgFrom, pgTo)
+		if err != nil {
+			return nil, problems.New(problems.Database, "failed to list rostered staff", err)
+		}
+		for _, rs := range rostered {
+			i := dayIndex(rs.ScheduledDate)
+			inputs[i].staff = append(inputs[i].staff, staffShift{
+				id:          rs.StaffID,
+				startMins:   minutesOf(rs.StartTime),
+				endMins:     minutesOf(rs.EndTime),
+				categoryIDs: rs.CategoryIds,
+			})
+		}
+
+		assignments, err := r.ListBookingStaffForRange(ctx, pgFrom, pgTo)
+		if err != nil {
+			return nil, problems.New(problems.Database, "failed to list staff assignments", err)
+		}
+		for i := range inputs {
+			inputs[i].assigned = make(map[int64][]int64)
+		}
+		for _, a := range assignments {
+			i := dayIndex(a.ScheduledDate)
+			inputs[i].assigned[a.BookingID] = append(inputs[i].assigned[a.BookingID], a.StaffID)
+		}
+	}
+
+	days := make([]daySchedule, n)
+	for i, in := range inputs {
+		if closed[i] || len(in.windows) == 0 {
+			continue
+		}
+		days[i] = newDaySchedule(in)
+	}
+	return days, nil
+}
+
+// blackoutFallsOn reports whether a recurring blackout closes date: the same
+// day and month as the blackout, in its year or later.
+func blackoutFallsOn(b dbpg.ScheduleBlackout, date time.Time) bool {
+	return !date.Before(b.Date.Time) && date.Month() == b.Date.Time.Month() && date.Day() == b.Date.Time.Day()
+}
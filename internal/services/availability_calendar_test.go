@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// calendarRepo serves the batched range queries from fixed data. Any other
+// ScheduleRepository method panics through the nil embedded interface.
+type calendarRepo struct {
+	ScheduleRepository
+	blackouts []dbpg.ScheduleBlackout
+	bookings  []dbpg.Booking
+	overrides []dbpg.ScheduleOverride
+}
+
+func pgDateOf(s string) pgtype.Date {
+	d, _ := time.Parse("2006-01-02", s)
+	return pgtype.Date{Time: d, Valid: true}
+}
+
+func pgTimeOf(h, m int) pgtype.Time {
+	return pgtype.Time{Microseconds: int64(h*60+m) * 60000000, Valid: true}
+}
+
+func (r calendarRepo) ListBlackoutsInRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.ScheduleBlackout, error) {
+	return r.blackouts, nil
+}
+
+func (r calendarRepo) GetScheduleConfig(ctx context.Context) ([]dbpg.ScheduleConfig, error) {
+	// Open 08:00-12:00 Monday to Friday
+	var configs []dbpg.ScheduleConfig
+	for d := int32(1); d <= 5; d++ {
+		configs = append(configs, dbpg.ScheduleConfig{DayOfWeek: d, IsOpen: true, OpenTime: pgTimeOf(8, 0), CloseTime: pgTimeOf(12, 0)})
+	}
+	return configs, nil
+}
+
+func (r calendarRepo) ListScheduleWindows(ctx context.Context) ([]dbpg.ScheduleWindow, error) {
+	return nil, nil
+}
+
+func (r calendarRepo) ListScheduleOverridesInRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.ScheduleOverride, error) {
+	return r.overrides, nil
+}
+
+func (r calendarRepo) ListActiveResources(ctx context.Context) ([]dbpg.Resource, error) {
+	return []dbpg.Resource{{ID: 1, ResourceType: "bay"}}, nil
+}
+
+func (r calendarRepo) ListResourceHours(ctx context.Context) ([]dbpg.ResourceHour, error) {
+	return nil, nil
+}
+
+func (r calendarRepo) ListBookingsForRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.Booking, error) {
+	return r.bookings, nil
+}
+
+func (r calendarRepo) ListActiveSlotHoldsForRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.SlotHold, error) {
+	return nil, nil
+}
+
+func (r calendarRepo) HasActiveStaff(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func TestGetAvailabilityCalendar(t *testing.T) {
+	repo := calendarRepo{
+		// Tuesday is blacked out, from a blackout recurring since last
+		// year, and Wednesday is booked all morning
+		blackouts: []dbpg.ScheduleBlackout{{Date: pgDateOf("2029-01-08"), RecursAnnually: true}},
+		bookings: []dbpg.Booking{{
+			ID: 1, ResourceID: 1, ScheduledDate: pgDateOf("2030-01-09"),
+			ScheduledTime: pgTimeOf(8, 0), EstimatedDurationMins: 240,
+		}},
+	}
+	svc := NewScheduleService(repo, nil)
+	// Sunday 09:00 in Perth, so Monday is bookable from 09:00
+	perth, err := time.LoadLocation(DefaultTimezone)
+	require.NoError(t, err)
+	svc.now = func() time.Time { return time.Date(2030, 1, 6, 9, 0, 0, 0, perth) }
+
+	days, err := svc.GetAvailabilityCalendar(context.Background(), AvailabilityCalendarParams{
+		DateFrom:        "2030-01-06", // Sunday
+		DateTo:          "2030-01-09",
+		DurationMinutes: 120,
+	})
+	require.NoError(t, err)
+	require.Len(t, days, 4)
+
+	assert.Equal(t, CalendarDay{Date: "2030-01-06", Status: CalendarDayClosed}, days[0])
+	// 09:00, 09:30 and 10:00 fit a two hour job; earlier is inside the notice
+	assert.Equal(t, CalendarDay{Date: "2030-01-07", Status: CalendarDayOpen, EarliestSlot: "09:00", SlotCount: 3}, days[1])
+	assert.Equal(t, CalendarDayClosed, days[2].Status)
+	assert.Equal(t, CalendarDayFull, days[3].Status)
+
+	// The next Sunday opened for the afternoon by an override
+	repo.bookings = nil
+	repo.overrides = []dbpg.ScheduleOverride{{Date: pgDateOf("2030-01-13"), OpenTime: pgTimeOf(13, 0), CloseTime: pgTimeOf(16, 0)}}
+	svc.repo = repo
+	days, err = svc.GetAvailabilityCalendar(context.Background(), AvailabilityCalendarParams{
+		DateFrom:        "2030-01-13",
+		DateTo:          "2030-01-13",
+		DurationMinutes: 120,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, CalendarDay{Date: "2030-01-13", Status: CalendarDayOpen, EarliestSlot: "13:00", SlotCount: 3}, days[0])
+
+	_, err = svc.GetAvailabilityCalendar(context.Background(), AvailabilityCalendarParams{
+		DateFrom: "2030-01-01",
+		DateTo:   "2030-04-01",
+	})
+	assert.Error(t, err)
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/go-chi/httplog"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/geo"
+	notification "github.com/richardbowden/degrees/internal/notifications"
 	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/settings"
 )
 
 type BookingRepository interface {
@@ -20,28 +25,140 @@ type BookingRepository interface {
 	ListBookingsByDateRange(ctx context.Context, params dbpg.ListBookingsByDateRangeParams) ([]dbpg.Booking, error)
 	ListAllBookingsAdmin(ctx context.Context, params dbpg.ListAllBookingsAdminParams) ([]dbpg.ListAllBookingsAdminRow, error)
 	ListBookingsForDate(ctx context.Context, params dbpg.ListBookingsForDateParams) ([]dbpg.Booking, error)
-	UpdateBookingStatus(ctx context.Context, params dbpg.UpdateBookingStatusParams) (dbpg.Booking, error)
-	UpdateBookingPaymentStatus(ctx context.Context, params dbpg.UpdateBookingPaymentStatusParams) (dbpg.Booking, error)
+	ListBookingStatusHistory(ctx context.Context, bookingID int64) ([]dbpg.BookingStatusHistory, error)
 	ListBookingServices(ctx context.Context, bookingID int64) ([]dbpg.ListBookingServicesRow, error)
 	ListBookingServiceOptions(ctx context.Context, bookingServiceID int64) ([]dbpg.ListBookingServiceOptionsRow, error)
+	ListBookingStaff(ctx context.Context, bookingID int64) ([]dbpg.ListBookingStaffRow, error)
+	ListBookingPayments(ctx context.Context, bookingID int64) ([]dbpg.ListBookingPaymentsRow, error)
 	GetCartByUserID(ctx context.Context, userID int64) (dbpg.CartSession, error)
 	GetCartBySessionToken(ctx context.Context, token string) (dbpg.CartSession, error)
 	ListCartItems(ctx context.Context, cartSessionID int64) ([]dbpg.ListCartItemsRow, error)
+	ListCartItemOptionsBySession(ctx context.Context, cartSessionID int64) ([]dbpg.ListCartItemOptionsBySessionRow, error)
 	ClearCart(ctx context.Context, cartSessionID int64) error
 	GetCustomerProfileByUserID(ctx context.Context, userID int64) (dbpg.CustomerProfile, error)
+	GetCustomerProfileByID(ctx context.Context, id int64) (dbpg.CustomerProfile, error)
 	GetServiceByID(ctx context.Context, serviceID int64) (dbpg.Service, error)
+	GetServiceOptionByID(ctx context.Context, id int64) (dbpg.ServiceOption, error)
 	GetVehicleByID(ctx context.Context, vehicleID int64) (Vehicle, error)
 	GetPriceTier(ctx context.Context, serviceID, vehicleCategoryID int64) (dbpg.GetPriceTierRow, error)
+	GetBookingSeriesByID(ctx context.Context, id int64) (dbpg.BookingSeries, error)
+	ListBookingSeriesByCustomer(ctx context.Context, customerID int64) ([]dbpg.BookingSeries, error)
+	ListActiveBookingSeries(ctx context.Context) ([]dbpg.BookingSeries, error)
+	UpdateBookingSeriesStatus(ctx context.Context, id int64, status string) (dbpg.BookingSeries, error)
+	ListSeriesOccurrences(ctx context.Context, seriesID int64) ([]dbpg.BookingSeriesOccurrence, error)
+	UpsertSeriesOccurrence(ctx context.Context, params dbpg.UpsertSeriesOccurrenceParams) (dbpg.BookingSeriesOccurrence, error)
+	ListUpcomingSeriesBookings(ctx context.Context, seriesID int64) ([]dbpg.Booking, error)
+	ListWaitlistEntriesByCustomer(ctx context.Context, customerID int64) ([]dbpg.ListWaitlistEntriesByCustomerRow, error)
+	GetWaitlistEntryByID(ctx context.Context, id int64) (dbpg.WaitlistEntry, error)
+	ListWaitingEntriesForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ListWaitingEntriesForDateRow, error)
+	ListWaitlistEntryServices(ctx context.Context, entryID int64) ([]dbpg.WaitlistEntryService, error)
+	ListWaitlistEntryServiceOptions(ctx context.Context, entryID int64) ([]dbpg.WaitlistEntryServiceOption, error)
+	GetWaitlistEntryByClaimToken(ctx context.Context, token string) (dbpg.WaitlistEntry, error)
+	GetSlotHoldByID(ctx context.Context, id int64) (dbpg.SlotHold, error)
+	GetCheckoutHoldForCustomer(ctx context.Context, customerID int64) (dbpg.SlotHold, error)
+	DeleteExpiredCheckoutHolds(ctx context.Context) ([]dbpg.SlotHold, error)
+	ListExpiredWaitlistOffers(ctx context.Context) ([]dbpg.ListExpiredWaitlistOffersRow, error)
+	ExpirePastWaitlistEntries(ctx context.Context) (int64, error)
+	WithTx(ctx context.Context, fn func(tx BookingTx) error) error
+}
+
+// BookingTx is the set of repository operations available inside a booking
+// transaction. Everything done through it commits or rolls back together.
+type BookingTx interface {
+	LockScheduleDate(ctx context.Context, date pgtype.Date) error
+	GetScheduleConfigForDay(ctx context.Context, dayOfWeek int32) (dbpg.ScheduleConfig, error)
+	ListScheduleWindowsForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ScheduleWindow, error)
+	ListScheduleOverridesForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ScheduleOverride, error)
+	IsDateBlackedOut(ctx context.Context, params dbpg.IsDateBlackedOutParams) (bool, error)
+	ListBookingsForDate(ctx context.Context, params dbpg.ListBookingsForDateParams) ([]dbpg.Booking, error)
+	ListActiveResources(ctx context.Context) ([]dbpg.Resource, error)
+	ListResourceHoursForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ResourceHour, error)
+	HasActiveStaff(ctx context.Context) (bool, error)
+	ListRosteredStaffForDate(ctx context.Context, params dbpg.ListRosteredStaffForDateParams) ([]dbpg.ListRosteredStaffForDateRow, error)
+	ListBookingStaffForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ListBookingStaffForDateRow, error)
+	ListBookingServices(ctx context.Context, bookingID int64) ([]dbpg.ListBookingServicesRow, error)
+	ListBookingServiceOptions(ctx context.Context, bookingServiceID int64) ([]dbpg.ListBookingServiceOptionsRow, error)
+	GetBookingService(ctx context.Context, params dbpg.GetBookingServiceParams) (dbpg.BookingService, error)
+	GetBookingServiceOption(ctx context.Context, params dbpg.GetBookingServiceOptionParams) (dbpg.BookingServiceOption, error)
+	DeleteBookingService(ctx context.Context, id int64) error
+	DeleteBookingServiceOption(ctx context.Context, id int64) error
+	UpdateBookingPricing(ctx context.Context, params dbpg.UpdateBookingPricingParams) (dbpg.Booking, error)
+	SetBookingAmountPaid(ctx context.Context, params dbpg.SetBookingAmountPaidParams) (dbpg.Booking, error)
+	RecordStripeEvent(ctx context.Context, params dbpg.RecordStripeEventParams) (bool, error)
+	CreatePayment(ctx context.Context, params dbpg.CreatePaymentParams) (dbpg.Payment, error)
+	SumBookingPayments(ctx context.Context, bookingID int64) (dbpg.SumBookingPaymentsRow, error)
+	CreateBooking(ctx context.Context, params dbpg.CreateBookingParams) (dbpg.Booking, error)
+	CreateBookingService(ctx context.Context, params dbpg.CreateBookingServiceParams) (dbpg.BookingService, error)
+	CreateBookingServiceOption(ctx context.Context, params dbpg.CreateBookingServiceOptionParams) (dbpg.BookingServiceOption, error)
+	ClearCart(ctx context.Context, cartSessionID int64) error
+	SetBookingRefundAmount(ctx context.Context, params dbpg.SetBookingRefundAmountParams) (dbpg.Booking, error)
+	SetBookingForfeitedAmount(ctx context.Context, params dbpg.SetBookingForfeitedAmountParams) (dbpg.Booking, error)
+	IncrementCustomerNoShowCount(ctx context.Context, customerID int64) (dbpg.CustomerProfile, error)
+	GetUserByEmail(ctx context.Context, email string) (dbpg.User, error)
+	CreateUser(ctx context.Context, params dbpg.CreateUserParams) (dbpg.User, error)
+	CreateUserEmail(ctx context.Context, params dbpg.CreateUserEmailParams) (dbpg.UserEmail, error)
+	CreateCustomerProfile(ctx context.Context, params dbpg.CreateCustomerProfileParams) (dbpg.CustomerProfile, error)
+	CreateVehicle(ctx context.Context, params dbpg.CreateVehicleParams) (dbpg.Vehicle, error)
+	RescheduleBooking(ctx context.Context, params dbpg.RescheduleBookingParams) (dbpg.Booking, error)
+	GetBookingForUpdate(ctx context.Context, id int64) (dbpg.Booking, error)
+	UpdateBookingStatus(ctx context.Context, params dbpg.UpdateBookingStatusParams) (dbpg.Booking, error)
+	UpdateBookingPaymentStatus(ctx context.Context, params dbpg.UpdateBookingPaymentStatusParams) (dbpg.Booking, error)
+	CreateBookingStatusHistory(ctx context.Context, params dbpg.CreateBookingStatusHistoryParams) (dbpg.BookingStatusHistory, error)
+	CreateBookingSeries(ctx context.Context, params dbpg.CreateBookingSeriesParams) (dbpg.BookingSeries, error)
+	SetBookingSeriesID(ctx context.Context, bookingID, seriesID int64) error
+	UpsertSeriesOccurrence(ctx context.Context, params dbpg.UpsertSeriesOccurrenceParams) (dbpg.BookingSeriesOccurrence, error)
+	ListActiveSlotHoldsForDate(ctx context.Context, date pgtype.Date) ([]dbpg.SlotHold, error)
+	CreateSlotHold(ctx context.Context, params dbpg.CreateSlotHoldParams) (dbpg.SlotHold, error)
+	DeleteSlotHold(ctx context.Context, id int64) error
+	DeleteCheckoutHoldsForCustomer(ctx context.Context, customerID int64) ([]dbpg.SlotHold, error)
+	CreateWaitlistEntry(ctx context.Context, params dbpg.CreateWaitlistEntryParams) (dbpg.WaitlistEntry, error)
+	CreateWaitlistEntryService(ctx context.Context, params dbpg.CreateWaitlistEntryServiceParams) (dbpg.WaitlistEntryService, error)
+	CreateWaitlistEntryServiceOption(ctx context.Context, params dbpg.CreateWaitlistEntryServiceOptionParams) (dbpg.WaitlistEntryServiceOption, error)
+	UpdateWaitlistEntryStatus(ctx context.Context, id int64, status string) (dbpg.WaitlistEntry, error)
+	OfferWaitlistEntry(ctx context.Context, params dbpg.OfferWaitlistEntryParams) (dbpg.WaitlistEntry, error)
+	ClaimWaitlistEntry(ctx context.Context, id, bookingID int64) (dbpg.WaitlistEntry, error)
+	ExpireWaitlistOffer(ctx context.Context, id int64) error
+}
+
+// BookingNotifier queues customer notifications about changes to a booking.
+// Reminders and follow-ups are scheduled ahead of time and withdrawn if the
+// booking is cancelled or moved.
+type BookingNotifier interface {
+	QueueBookingConfirmation(ctx context.Context, bookingID int64, to, bookingDate, bookingTime string, depositAmount, totalAmount int64, calendar []byte) error
+	QueueBookingRescheduled(ctx context.Context, bookingID int64, to, customerName, oldDate, oldTime, newDate, newTime string) error
+	ScheduleBookingReminder(ctx context.Context, bookingID int64, bookingDate, bookingTime string, sendAt time.Time) error
+	ScheduleBookingFollowUp(ctx context.Context, bookingID int64, sendAt time.Time) error
+	CancelBookingReminders(ctx context.Context, bookingID int64) error
+	SendBookingReminder(ctx context.Context, to string, data notification.BookingReminderData) error
+	SendBookingFollowUp(ctx context.Context, to string, data notification.BookingFollowUpData) error
+	SendWaitlistOffer(ctx context.Context, to, customerName, date, time, expiresAt, claimLink string) error
+}
+
+// WaitlistQueue schedules a re-check of the waitlist for a date after
+// something frees up capacity on it.
+type WaitlistQueue interface {
+	QueueWaitlistCheck(ctx context.Context, date string) error
 }
 
 const DepositPercentage = 30
 
 type BookingService struct {
-	repo BookingRepository
+	repo     BookingRepository
+	settings *settings.Service
+	Notifier BookingNotifier
+	Waitlist WaitlistQueue
+	BaseURL  string // used to build waitlist claim links and calendar event UIDs
+	now      func() time.Time
 }
 
-func NewBookingService(repo BookingRepository) *BookingService {
-	return &BookingService{repo: repo}
+func NewBookingService(repo BookingRepository, settingsService *settings.Service) *BookingService {
+	return &BookingService{repo: repo, settings: settingsService, now: time.Now}
+}
+
+// bookingLine is one booking_services row and the options snapshotted with it.
+type bookingLine struct {
+	service dbpg.CreateBookingServiceParams
+	options []dbpg.CreateBookingServiceOptionParams
 }
 
 type CreateBookingFromCartParams struct {
@@ -50,7 +167,9 @@ type CreateBookingFromCartParams struct {
 	ScheduledDate    string // YYYY-MM-DD
 	ScheduledTime    string // HH:MM
 	Notes            string
-	CartSessionToken string // fallback: look up cart by session token if user cart not found
+	CartSessionToken string           // fallback: look up cart by session token if user cart not found
+	Recurrence       string           // optional RRULE; makes this booking the first of a series
+	Location         *ServiceLocation // set for a mobile job at the customer's address
 }
 
 func (s *BookingService) CreateBookingFromCart(ctx context.Context, params CreateBookingFromCartParams) (*dbpg.Booking, error) {
@@ -69,122 +188,555 @@ func (s *BookingService) CreateBookingFromCart(ctx context.Context, params Creat
 		return nil, problems.New(problems.InvalidRequest, "invalid date format, expected YYYY-MM-DD")
 	}
 
-	// Enforce minimum 24-hour advance notice
-	now := time.Now()
-	if scheduledDate.Before(now.Add(24 * time.Hour)) {
-		return nil, problems.New(problems.InvalidRequest, "bookings require at least 24 hours advance notice")
-	}
-
 	// Parse scheduled time
 	scheduledTime, err := time.Parse("15:04", params.ScheduledTime)
 	if err != nil {
 		return nil, problems.New(problems.InvalidRequest, "invalid time format, expected HH:MM")
 	}
+	startMins := int32(scheduledTime.Hour()*60 + scheduledTime.Minute())
+
+	var rule RecurrenceRule
+	if params.Recurrence != "" {
+		rule, err = ParseRecurrenceRule(params.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+		if !rule.Until.IsZero() && rule.Until.Before(scheduledDate) {
+			return nil, problems.New(problems.InvalidRequest, "recurrence UNTIL is before the first booking")
+		}
+	}
+
+	quote, travel, err := s.quoteCheckout(ctx, params.UserID, params.CartSessionToken, params.VehicleID, params.Location)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBookingRules(ctx, &quote.requirements, scheduledDate, startMins); err != nil {
+		return nil, err
+	}
+
+	totalAmount := quote.subtotal + travel.Surcharge
+	depositAmount := s.depositFor(ctx, customer, totalAmount)
+
+	pgDate := pgtype.Date{Time: scheduledDate, Valid: true}
+	pgTime := pgtype.Time{
+		Microseconds: int64(startMins) * 60000000,
+		Valid:        true,
+	}
+
+	bookingParams := dbpg.CreateBookingParams{
+		CustomerID:            customer.ID,
+		ScheduledDate:         pgDate,
+		ScheduledTime:         pgTime,
+		EstimatedDurationMins: quote.duration,
+		Status:                initialStatus(depositAmount),
+		PaymentStatus:         dbpg.PaymentStatusPending,
+		Subtotal:              quote.subtotal,
+		DepositAmount:         depositAmount,
+		TotalAmount:           totalAmount,
+		Notes:                 dbpg.StringToPGString(params.Notes),
+		TravelDistanceKm:      travel.DistanceKm,
+		TravelSurcharge:       travel.Surcharge,
+	}
+	if params.Location != nil {
+		suburb := params.Location.Suburb
+		if suburb == "" {
+			suburb = travel.Location.Suburb
+		}
+		bookingParams.ServiceAddress = dbpg.StringToPGString(params.Location.Address)
+		bookingParams.ServiceSuburb = dbpg.StringToPGString(suburb)
+		bookingParams.ServicePostcode = dbpg.StringToPGString(travel.Location.Postcode)
+	}
+
+	if params.VehicleID > 0 {
+		bookingParams.VehicleID = pgtype.Int8{Int64: params.VehicleID, Valid: true}
+	}
+
+	// Re-check availability and write the booking, its services and the cart
+	// clear as one unit
+	var booking dbpg.Booking
+	err = s.repo.WithTx(ctx, func(tx BookingTx) error {
+		created, err := placeBooking(ctx, tx, bookingParams, quote, params.UserID, "booking created")
+		if err != nil {
+			return err
+		}
+		booking = created
+
+		if params.Recurrence != "" {
+			series, err := startSeries(ctx, tx, booking, rule)
+			if err != nil {
+				return err
+			}
+			booking.SeriesID = pgtype.Int8{Int64: series.ID, Valid: true}
+		}
+
+		// Clear the cart after checkout
+		if err := tx.ClearCart(ctx, quote.cart.ID); err != nil {
+			return problems.New(problems.Database, "failed to clear cart", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, txError(err, "failed to create booking")
+	}
+
+	s.notifyBooked(ctx, booking.ID)
+
+	// Book the rest of the series up to the horizon now rather than waiting
+	// for the next periodic run; failures are retried by that run.
+	if booking.SeriesID.Valid {
+		if _, err := s.materialiseSeriesByID(ctx, booking.SeriesID.Int64); err != nil {
+			log := httplog.LogEntry(ctx)
+			log.Error().Err(err).Int64("series_id", booking.SeriesID.Int64).Msg("failed to book recurring occurrences")
+		}
+	}
+
+	return &booking, nil
+}
+
+// cartQuote is a customer's cart priced and timed for their vehicle, ready to
+// be snapshotted into a booking or waitlist entry.
+type cartQuote struct {
+	cart         dbpg.CartSession
+	lines        []bookingLine
+	subtotal     int64
+	duration     int32
+	requirements jobRequirements
+}
+
+// quoteItem is one service to be priced, with the options chosen for it.
+type quoteItem struct {
+	serviceID int64
+	quantity  int32
+	options   []quoteOption
+	price     *int64 // replaces the service's own price when set
+}
+
+type quoteOption struct {
+	id        int64
+	serviceID int64
+	name      string
+	price     int64
+	duration  int32
+	active    bool
+}
+
+// quoteCheckout prices the caller's cart for checkout. Mobile jobs must be
+// inside the service area and pay for travel beyond the free distance.
+func (s *BookingService) quoteCheckout(ctx context.Context, userID int64, cartSessionToken string, vehicleID int64, loc *ServiceLocation) (cartQuote, TravelQuote, error) {
+	quote, err := s.quoteCart(ctx, userID, cartSessionToken, vehicleID)
+	if err != nil {
+		return cartQuote{}, TravelQuote{}, err
+	}
+	travel, err := s.quoteTravel(ctx, &quote, loc)
+	if err != nil {
+		return cartQuote{}, TravelQuote{}, err
+	}
+	return quote, travel, nil
+}
 
+// quoteTravel prices travel to a mobile job and ties the quote to the site
+// it is done at. There is nothing to pay when loc is nil.
+func (s *BookingService) quoteTravel(ctx context.Context, quote *cartQuote, loc *ServiceLocation) (TravelQuote, error) {
+	if loc == nil {
+		return TravelQuote{}, nil
+	}
+	area := loadServiceArea(ctx, s.settings)
+	travel, err := area.Quote(loc.Postcode)
+	if err != nil {
+		return TravelQuote{}, err
+	}
+	quote.requirements.site = area.site(travel.Location)
+	return travel, nil
+}
+
+// quoteCart prices the caller's cart for their vehicle.
+func (s *BookingService) quoteCart(ctx context.Context, userID int64, cartSessionToken string, vehicleID int64) (cartQuote, error) {
 	// Get user's cart — first by user ID, then fall back to session token.
-	cart, err := s.repo.GetCartByUserID(ctx, params.UserID)
-	if errors.Is(err, ErrNoRecord) && params.CartSessionToken != "" {
-		cart, err = s.repo.GetCartBySessionToken(ctx, params.CartSessionToken)
+	cart, err := s.repo.GetCartByUserID(ctx, userID)
+	if errors.Is(err, ErrNoRecord) && cartSessionToken != "" {
+		cart, err = s.repo.GetCartBySessionToken(ctx, cartSessionToken)
 	}
 	if err != nil {
 		if errors.Is(err, ErrNoRecord) {
-			return nil, problems.New(problems.NotExist, "no active cart found")
+			return cartQuote{}, problems.New(problems.NotExist, "no active cart found")
 		}
-		return nil, problems.New(problems.Database, "failed to get cart", err)
+		return cartQuote{}, problems.New(problems.Database, "failed to get cart", err)
 	}
 
 	// Get cart items
 	cartItems, err := s.repo.ListCartItems(ctx, cart.ID)
 	if err != nil {
-		return nil, problems.New(problems.Database, "failed to list cart items", err)
+		return cartQuote{}, problems.New(problems.Database, "failed to list cart items", err)
 	}
 	if len(cartItems) == 0 {
-		return nil, problems.New(problems.InvalidRequest, "cart is empty")
+		return cartQuote{}, problems.New(problems.InvalidRequest, "cart is empty")
 	}
 
-	// Resolve vehicle category for tier-based pricing
-	var vehicleCategoryID int64
-	if params.VehicleID > 0 {
-		vehicle, vErr := s.repo.GetVehicleByID(ctx, params.VehicleID)
-		if vErr == nil && vehicle.VehicleCategoryID > 0 {
-			vehicleCategoryID = vehicle.VehicleCategoryID
+	// Options selected for each cart item
+	cartOptions, err := s.repo.ListCartItemOptionsBySession(ctx, cart.ID)
+	if err != nil {
+		return cartQuote{}, problems.New(problems.Database, "failed to list cart item options", err)
+	}
+	optionsByItem := make(map[int64][]quoteOption)
+	for _, opt := range cartOptions {
+		optionsByItem[opt.CartItemID] = append(optionsByItem[opt.CartItemID], quoteOption{
+			id:        opt.ServiceOptionID,
+			serviceID: opt.ServiceID,
+			name:      opt.OptionName,
+			price:     opt.Price,
+			duration:  opt.DurationMinutes,
+			active:    opt.IsActive,
+		})
+	}
+
+	items := make([]quoteItem, len(cartItems))
+	for i, item := range cartItems {
+		items[i] = quoteItem{
+			serviceID: item.ServiceID,
+			quantity:  item.Quantity,
+			options:   optionsByItem[item.ID],
 		}
 	}
 
-	// Calculate totals and estimated duration, using tier price when available
+	quote, err := s.quoteItems(ctx, items, s.vehicleCategory(ctx, vehicleID))
+	if err != nil {
+		return cartQuote{}, err
+	}
+	quote.cart = cart
+	return quote, nil
+}
+
+// vehicleCategory resolves the category a vehicle is priced by, or 0 when it
+// has none.
+func (s *BookingService) vehicleCategory(ctx context.Context, vehicleID int64) int64 {
+	if vehicleID == 0 {
+		return 0
+	}
+	vehicle, err := s.repo.GetVehicleByID(ctx, vehicleID)
+	if err != nil {
+		return 0
+	}
+	return vehicle.VehicleCategoryID
+}
+
+// tierFor returns the price of a service and how long it takes for a vehicle
+// of the given category, falling back to the service's own where the
+// category has no tier.
+func (s *BookingService) tierFor(ctx context.Context, svc dbpg.Service, vehicleCategoryID int64) (int64, int32) {
+	if vehicleCategoryID == 0 {
+		return svc.BasePrice, svc.DurationMinutes
+	}
+	tier, err := s.repo.GetPriceTier(ctx, svc.ID, vehicleCategoryID)
+	if err != nil {
+		return svc.BasePrice, svc.DurationMinutes
+	}
+	if tier.DurationMinutes.Valid {
+		return tier.Price, tier.DurationMinutes.Int32
+	}
+	return tier.Price, svc.DurationMinutes
+}
+
+// quoteItems prices and times services, using the tier price and duration for
+// the vehicle category where one exists.
+func (s *BookingService) quoteItems(ctx context.Context, items []quoteItem, vehicleCategoryID int64) (cartQuote, error) {
+	// Calculate totals and estimated duration, using the tier when available
 	var subtotal int64
 	var totalDuration int32
-	for _, item := range cartItems {
-		svc, err := s.repo.GetServiceByID(ctx, item.ServiceID)
+	var lines []bookingLine
+	var serviceTypes [][]string
+	var categoryIDs []int64
+	for _, item := range items {
+		svc, err := s.repo.GetServiceByID(ctx, item.serviceID)
 		if err != nil {
-			return nil, problems.New(problems.Database, fmt.Sprintf("failed to get service %d", item.ServiceID), err)
+			if errors.Is(err, ErrNoRecord) {
+				return cartQuote{}, problems.New(problems.NotExist, fmt.Sprintf("service %d not found", item.serviceID))
+			}
+			return cartQuote{}, problems.New(problems.Database, fmt.Sprintf("failed to get service %d", item.serviceID), err)
 		}
-		price := svc.BasePrice
-		if vehicleCategoryID > 0 {
-			tier, tErr := s.repo.GetPriceTier(ctx, item.ServiceID, vehicleCategoryID)
-			if tErr == nil {
-				price = tier.Price
+		serviceTypes = append(serviceTypes, svc.ResourceTypes)
+		categoryIDs = append(categoryIDs, svc.CategoryID)
+		price, duration := s.tierFor(ctx, svc, vehicleCategoryID)
+		if item.price != nil {
+			price = *item.price
+		}
+
+		// Options are priced and timed per unit of the service they belong to
+		var options []dbpg.CreateBookingServiceOptionParams
+		unitPrice := price
+		unitDuration := duration
+		for _, opt := range item.options {
+			if opt.serviceID != item.serviceID {
+				return cartQuote{}, problems.New(problems.InvalidRequest, fmt.Sprintf("option %q does not belong to %s", opt.name, svc.Name))
 			}
+			if !opt.active {
+				return cartQuote{}, problems.New(problems.InvalidRequest, fmt.Sprintf("option %q is no longer available", opt.name))
+			}
+			unitPrice += opt.price
+			unitDuration += opt.duration
+			options = append(options, dbpg.CreateBookingServiceOptionParams{
+				ServiceOptionID:   opt.id,
+				PriceAtBooking:    opt.price,
+				DurationAtBooking: opt.duration,
+			})
 		}
-		subtotal += price * int64(item.Quantity)
-		totalDuration += svc.DurationMinutes * item.Quantity
-	}
 
-	totalAmount := subtotal
-	depositAmount := totalAmount * DepositPercentage / 100
+		subtotal += unitPrice * int64(item.quantity)
+		totalDuration += unitDuration * item.quantity
+		for q := int32(0); q < item.quantity; q++ {
+			lines = append(lines, bookingLine{
+				service: dbpg.CreateBookingServiceParams{
+					ServiceID:         item.serviceID,
+					PriceAtBooking:    price,
+					DurationAtBooking: duration,
+				},
+				options: options,
+			})
+		}
+	}
 
-	pgDate := pgtype.Date{Time: scheduledDate, Valid: true}
-	pgTime := pgtype.Time{
-		Microseconds: int64(scheduledTime.Hour())*3600000000 + int64(scheduledTime.Minute())*60000000,
-		Valid:        true,
+	// Every service in the booking is done on the same bay or van
+	requirements, err := newJobRequirements(serviceTypes, categoryIDs)
+	if err != nil {
+		return cartQuote{}, err
 	}
 
-	bookingParams := dbpg.CreateBookingParams{
-		CustomerID:            customer.ID,
-		ScheduledDate:         pgDate,
-		ScheduledTime:         pgTime,
-		EstimatedDurationMins: totalDuration,
-		Status:                dbpg.BookingStatusConfirmed,
-		PaymentStatus:         dbpg.PaymentStatusPending,
-		Subtotal:              subtotal,
-		DepositAmount:         depositAmount,
-		TotalAmount:           totalAmount,
-		Notes:                 dbpg.StringToPGString(params.Notes),
+	return cartQuote{
+		lines:        lines,
+		subtotal:     subtotal,
+		duration:     totalDuration,
+		requirements: requirements,
+	}, nil
+}
+
+// placeBooking re-checks the slot and writes a booking along with its
+// services and history. The per-day lock serialises concurrent bookings for
+// the same date; the bookings_no_overlap constraint is the final guard.
+func placeBooking(ctx context.Context, tx BookingTx, params dbpg.CreateBookingParams, quote cartQuote, changedBy int64, reason string) (dbpg.Booking, error) {
+	if err := tx.LockScheduleDate(ctx, params.ScheduledDate); err != nil {
+		return dbpg.Booking{}, problems.New(problems.Database, "failed to lock schedule", err)
 	}
 
-	if params.VehicleID > 0 {
-		bookingParams.VehicleID = pgtype.Int8{Int64: params.VehicleID, Valid: true}
+	// A hold the customer placed when checkout began keeps others off the
+	// slot but not them; it becomes this booking
+	day, err := loadDaySchedule(ctx, tx, params.ScheduledDate.Time)
+	if err != nil {
+		return dbpg.Booking{}, err
+	}
+	resourceID, err := day.withoutHoldsFor(params.CustomerID).check(minutesOf(params.ScheduledTime), quote.duration, quote.requirements)
+	if err != nil {
+		return dbpg.Booking{}, err
+	}
+	params.ResourceID = resourceID
+	if _, err := tx.DeleteCheckoutHoldsForCustomer(ctx, params.CustomerID); err != nil {
+		return dbpg.Booking{}, problems.New(problems.Database, "failed to release checkout hold", err)
 	}
 
-	booking, err := s.repo.CreateBooking(ctx, bookingParams)
+	booking, err := tx.CreateBooking(ctx, params)
 	if err != nil {
-		return nil, problems.New(problems.Database, "failed to create booking", err)
+		if errors.Is(err, ErrConflict) {
+			return dbpg.Booking{}, problems.New(problems.Exist, "the selected time slot is no longer available")
+		}
+		return dbpg.Booking{}, problems.New(problems.Database, "failed to create booking", err)
+	}
+
+	if err := recordInitialStatus(ctx, tx, booking, changedBy, reason); err != nil {
+		return dbpg.Booking{}, err
 	}
 
-	// Snapshot cart items into booking_services with tier-adjusted pricing
-	for _, item := range cartItems {
-		svc, _ := s.repo.GetServiceByID(ctx, item.ServiceID)
-		price := svc.BasePrice
-		if vehicleCategoryID > 0 {
-			tier, tErr := s.repo.GetPriceTier(ctx, item.ServiceID, vehicleCategoryID)
-			if tErr == nil {
-				price = tier.Price
+	// Snapshot the priced services into booking_services, and their options
+	// into booking_service_options
+	if err := insertBookingLines(ctx, tx, booking.ID, quote.lines); err != nil {
+		return dbpg.Booking{}, err
+	}
+	return booking, nil
+}
+
+// insertBookingLines writes a booking's services and their options.
+func insertBookingLines(ctx context.Context, tx BookingTx, bookingID int64, lines []bookingLine) error {
+	for _, line := range lines {
+		line.service.BookingID = bookingID
+		bs, err := tx.CreateBookingService(ctx, line.service)
+		if err != nil {
+			return problems.New(problems.Database, "failed to create booking service", err)
+		}
+		for _, opt := range line.options {
+			opt.BookingServiceID = bs.ID
+			if _, err := tx.CreateBookingServiceOption(ctx, opt); err != nil {
+				return problems.New(problems.Database, "failed to create booking service option", err)
 			}
 		}
-		for q := int32(0); q < item.Quantity; q++ {
-			_, err := s.repo.CreateBookingService(ctx, dbpg.CreateBookingServiceParams{
-				BookingID:      booking.ID,
-				ServiceID:      item.ServiceID,
-				PriceAtBooking: price,
-			})
-			if err != nil {
-				return nil, problems.New(problems.Database, "failed to create booking service", err)
+	}
+	return nil
+}
+
+func formatDate(d pgtype.Date) string {
+	if !d.Valid {
+		return ""
+	}
+	return d.Time.Format("2006-01-02")
+}
+
+func formatTime(t pgtype.Time) string {
+	if !t.Valid {
+		return ""
+	}
+	totalMins := t.Microseconds / 60000000
+	return fmt.Sprintf("%02d:%02d", totalMins/60, totalMins%60)
+}
+
+// formatCents formats an amount in cents as dollars, e.g. 12345 -> "$123.45".
+func formatCents(cents int64) string {
+	return fmt.Sprintf("$%d.%02d", cents/100, cents%100)
+}
+
+// bookingRequirements returns what a booking's services need from the
+// schedule. servicePostcode is the booking's service postcode, set for
+// mobile jobs.
+func (s *BookingService) bookingRequirements(ctx context.Context, bookingID int64, servicePostcode pgtype.Text) (jobRequirements, error) {
+	services, err := s.repo.ListBookingServices(ctx, bookingID)
+	if err != nil {
+		return jobRequirements{}, problems.New(problems.Database, "failed to list booking services", err)
+	}
+	serviceIDs := make([]int64, len(services))
+	for i, bs := range services {
+		serviceIDs[i] = bs.ServiceID
+	}
+	return s.requirementsAt(ctx, serviceIDs, servicePostcode)
+}
+
+// requirementsAt returns what a booking made up of the given services needs
+// from the schedule, done at its service postcode.
+func (s *BookingService) requirementsAt(ctx context.Context, serviceIDs []int64, servicePostcode pgtype.Text) (jobRequirements, error) {
+	req, err := s.serviceRequirements(ctx, serviceIDs)
+	if err != nil {
+		return jobRequirements{}, err
+	}
+	// A booking already made keeps its location even if the service area
+	// has since shrunk
+	if servicePostcode.Valid {
+		req.site = &jobSite{}
+		if loc, ok := geo.Lookup(servicePostcode.String); ok {
+			req.site = loadServiceArea(ctx, s.settings).site(loc)
+		}
+	}
+	return req, nil
+}
+
+// serviceRequirements returns what a job made up of the given services needs
+// from the schedule.
+func (s *BookingService) serviceRequirements(ctx context.Context, serviceIDs []int64) (jobRequirements, error) {
+	var types [][]string
+	var categoryIDs []int64
+	for _, id := range serviceIDs {
+		svc, err := s.repo.GetServiceByID(ctx, id)
+		if err != nil {
+			return jobRequirements{}, problems.New(problems.Database, fmt.Sprintf("failed to get service %d", id), err)
+		}
+		types = append(types, svc.ResourceTypes)
+		categoryIDs = append(categoryIDs, svc.CategoryID)
+	}
+	return newJobRequirements(types, categoryIDs)
+}
+
+type RescheduleBookingParams struct {
+	UserID        int64
+	BookingID     int64
+	ScheduledDate string // YYYY-MM-DD
+	ScheduledTime string // HH:MM
+}
+
+// RescheduleBooking moves a customer's booking to a new date and time. The
+// booking keeps its services, pricing and any deposit already paid; only the
+// slot changes. The customer is notified once the move is committed.
+func (s *BookingService) RescheduleBooking(ctx context.Context, params RescheduleBookingParams) (*dbpg.Booking, error) {
+	row, err := s.GetBookingByID(ctx, params.BookingID)
+	if err != nil {
+		return nil, err
+	}
+	if row.CustomerUserID != params.UserID {
+		return nil, problems.New(problems.NotExist, "booking not found")
+	}
+
+	switch row.Status {
+	case dbpg.BookingStatusPendingPayment, dbpg.BookingStatusDepositPaid, dbpg.BookingStatusConfirmed:
+	default:
+		return nil, problems.New(problems.InvalidRequest, fmt.Sprintf("a %s booking cannot be rescheduled", row.Status))
+	}
+
+	scheduledDate, err := time.Parse("2006-01-02", params.ScheduledDate)
+	if err != nil {
+		return nil, problems.New(problems.InvalidRequest, "invalid date format, expected YYYY-MM-DD")
+	}
+	scheduledTime, err := time.Parse("15:04", params.ScheduledTime)
+	if err != nil {
+		return nil, problems.New(problems.InvalidRequest, "invalid time format, expected HH:MM")
+	}
+	startMins := int32(scheduledTime.Hour()*60 + scheduledTime.Minute())
+
+	requirements, err := s.bookingRequirements(ctx, params.BookingID, row.ServicePostcode)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBookingRules(ctx, &requirements, scheduledDate, startMins); err != nil {
+		return nil, err
+	}
+
+	pgDate := pgtype.Date{Time: scheduledDate, Valid: true}
+	pgTime := pgtype.Time{Microseconds: int64(startMins) * 60000000, Valid: true}
+
+	var booking dbpg.Booking
+	err = s.repo.WithTx(ctx, func(tx BookingTx) error {
+		if err := tx.LockScheduleDate(ctx, pgDate); err != nil {
+			return problems.New(problems.Database, "failed to lock schedule", err)
+		}
+
+		// Re-read under lock so a concurrent cancel or status change is seen.
+		current, err := tx.GetBookingForUpdate(ctx, params.BookingID)
+		if err != nil {
+			return problems.New(problems.Database, "failed to get booking", err)
+		}
+		if current.Status != row.Status {
+			return problems.New(problems.Exist, "booking was changed, please try again")
+		}
+
+		resourceID, err := checkSlotAvailable(ctx, tx, scheduledDate, startMins, current.EstimatedDurationMins, requirements, current.ID)
+		if err != nil {
+			return err
+		}
+
+		updated, err := tx.RescheduleBooking(ctx, dbpg.RescheduleBookingParams{
+			ID:            current.ID,
+			ScheduledDate: pgDate,
+			ScheduledTime: pgTime,
+			ResourceID:    resourceID,
+		})
+		if err != nil {
+			if errors.Is(err, ErrConflict) {
+				return problems.New(problems.Exist, "the selected time slot is no longer available")
 			}
+			return problems.New(problems.Database, "failed to reschedule booking", err)
 		}
+		booking = updated
+		return nil
+	})
+	if err != nil {
+		return nil, txError(err, "failed to reschedule booking")
 	}
 
-	// Clear the cart after checkout
-	_ = s.repo.ClearCart(ctx, cart.ID)
+	// The slot the booking moved out of may suit someone on the waitlist
+	queueWaitlistCheck(ctx, s.Waitlist, row.ScheduledDate)
+
+	s.cancelReminders(ctx, booking.ID)
+	s.scheduleReminder(ctx, booking.ID, booking.ScheduledDate, booking.ScheduledTime)
+
+	if s.Notifier != nil {
+		err = s.Notifier.QueueBookingRescheduled(ctx, booking.ID, row.CustomerEmail, row.CustomerName,
+			formatDate(row.ScheduledDate), formatTime(row.ScheduledTime), params.ScheduledDate, params.ScheduledTime)
+		if err != nil {
+			log := httplog.LogEntry(ctx)
+			log.Error().Err(err).Int64("booking_id", booking.ID).Msg("failed to queue booking rescheduled notification")
+		}
+	}
 
 	return &booking, nil
 }
@@ -270,104 +822,183 @@ func (s *BookingService) CancelBooking(ctx context.Context, userID int64, bookin
 		return nil, "", problems.New(problems.InvalidRequest, "booking is already cancelled")
 	}
 
-	if row.Status == dbpg.BookingStatusCompleted {
-		return nil, "", problems.New(problems.InvalidRequest, "cannot cancel a completed booking")
+	if row.Status == dbpg.BookingStatusCompleted || row.Status == dbpg.BookingStatusNoShow {
+		return nil, "", problems.New(problems.InvalidRequest, fmt.Sprintf("cannot cancel a %s booking", row.Status))
 	}
 
-	booking, err := s.repo.UpdateBookingStatus(ctx, dbpg.UpdateBookingStatusParams{
-		ID:     bookingID,
-		Status: dbpg.BookingStatusCancelled,
+	policy := loadCancellationPolicy(ctx, s.settings)
+
+	var booking dbpg.Booking
+	var paid int64
+	err = s.repo.WithTx(ctx, func(tx BookingTx) error {
+		current, err := tx.GetBookingForUpdate(ctx, bookingID)
+		if err != nil {
+			return problems.New(problems.Database, "failed to get booking", err)
+		}
+		if current.Status == dbpg.BookingStatusCancelled {
+			return problems.New(problems.InvalidRequest, "booking is already cancelled")
+		}
+
+		paid = amountPaid(current)
+		clock := s.clock(ctx)
+		refund := paid * int64(policy.RefundPercent(clock.bookingStart(current).Sub(clock.now))) / 100
+
+		if _, err := applyStatusChange(ctx, tx, bookingID, StatusChange{
+			Status:    dbpg.BookingStatusCancelled,
+			ChangedBy: userID,
+			Reason:    "cancelled by customer",
+		}); err != nil {
+			return err
+		}
+
+		// The refund owed is recorded here and paid by RefundBooking, which
+		// moves the payment status once the money has gone back
+		updated, err := tx.SetBookingRefundAmount(ctx, dbpg.SetBookingRefundAmountParams{ID: bookingID, RefundAmount: refund})
+		if err != nil {
+			return problems.New(problems.Database, "failed to record refund amount", err)
+		}
+		booking = updated
+		return nil
 	})
 	if err != nil {
-		return nil, "", problems.New(problems.Database, "failed to cancel booking", err)
+		return nil, "", txError(err, "failed to cancel booking")
 	}
+	queueWaitlistCheck(ctx, s.Waitlist, booking.ScheduledDate)
+	s.cancelReminders(ctx, booking.ID)
 
 	msg := "booking cancelled"
-	if row.ScheduledDate.Valid {
-		scheduledDateTime := row.ScheduledDate.Time
-		if time.Until(scheduledDateTime) < 24*time.Hour {
-			msg = "booking cancelled, deposit may be forfeited due to late cancellation"
-		}
+	switch {
+	case booking.RefundAmount > 0:
+		msg = fmt.Sprintf("booking cancelled, %s will be refunded", formatCents(booking.RefundAmount))
+	case paid > 0:
+		msg = "booking cancelled, no refund is due under the cancellation policy"
 	}
 
 	return &booking, msg, nil
 }
 
-func (s *BookingService) UpdateBookingStatus(ctx context.Context, bookingID int64, status string) (*dbpg.Booking, error) {
-	booking, err := s.repo.UpdateBookingStatus(ctx, dbpg.UpdateBookingStatusParams{
-		ID:     bookingID,
-		Status: dbpg.BookingStatus(status),
-	})
+// CheckServiceArea reports whether mobile jobs are taken at postcode and what
+// travel there costs. A postcode outside the area is an InvalidRequest
+// problem explaining why.
+func (s *BookingService) CheckServiceArea(ctx context.Context, postcode string) (TravelQuote, error) {
+	return loadServiceArea(ctx, s.settings).Quote(postcode)
+}
+
+// GetCancellationPolicy returns the policy applied when a customer cancels.
+func (s *BookingService) GetCancellationPolicy(ctx context.Context) CancellationPolicy {
+	return loadCancellationPolicy(ctx, s.settings)
+}
+
+// UpdateBookingStatus moves a booking to a new status on behalf of an admin,
+// rejecting transitions the booking lifecycle does not allow.
+func (s *BookingService) UpdateBookingStatus(ctx context.Context, actorID, bookingID int64, status, reason string) (*dbpg.Booking, error) {
+	bookingStatus, err := ParseBookingStatus(status)
 	if err != nil {
-		if errors.Is(err, ErrNoRecord) {
-			return nil, problems.New(problems.NotExist, "booking not found")
-		}
-		return nil, problems.New(problems.Database, "failed to update booking status", err)
+		return nil, err
 	}
-	return &booking, nil
+	switch bookingStatus {
+	case dbpg.BookingStatusNoShow:
+		return s.MarkNoShow(ctx, actorID, bookingID, reason)
+	case dbpg.BookingStatusCompleted:
+		return s.CompleteBooking(ctx, actorID, bookingID, reason, "")
+	}
+	return s.changeStatus(ctx, bookingID, StatusChange{
+		Status:    bookingStatus,
+		ChangedBy: actorID,
+		Reason:    reason,
+	})
 }
 
-func (s *BookingService) CompleteBooking(ctx context.Context, bookingID int64, notes string) (*dbpg.Booking, error) {
-	booking, err := s.repo.UpdateBookingStatus(ctx, dbpg.UpdateBookingStatusParams{
-		ID:     bookingID,
-		Status: dbpg.BookingStatusCompleted,
-	})
-	if err != nil {
-		if errors.Is(err, ErrNoRecord) {
-			return nil, problems.New(problems.NotExist, "booking not found")
+// CompleteBooking marks the work on a booking as done. A booking with a
+// balance owing is only completed with an override reason, which is recorded
+// in its history. Payment status is left alone; it only moves when money is
+// actually taken.
+func (s *BookingService) CompleteBooking(ctx context.Context, actorID, bookingID int64, notes, overrideReason string) (*dbpg.Booking, error) {
+	overrideReason = strings.TrimSpace(overrideReason)
+	var booking dbpg.Booking
+	var completed bool
+	err := s.repo.WithTx(ctx, func(tx BookingTx) error {
+		current, err := tx.GetBookingForUpdate(ctx, bookingID)
+		if err != nil {
+			if errors.Is(err, ErrNoRecord) {
+				return problems.New(problems.NotExist, "booking not found")
+			}
+			return problems.New(problems.Database, "failed to get booking", err)
+		}
+
+		reason := notes
+		if owing := balanceDue(current); owing > 0 && current.Status != dbpg.BookingStatusCompleted {
+			if overrideReason == "" {
+				return problems.New(problems.InvalidRequest,
+					fmt.Sprintf("booking has %s left to pay; record the payment or give an override reason", formatCents(owing)))
+			}
+			reason = fmt.Sprintf("completed with %s unpaid: %s", formatCents(owing), overrideReason)
+			if notes != "" {
+				reason = notes + "; " + reason
+			}
 		}
-		return nil, problems.New(problems.Database, "failed to complete booking", err)
-	}
 
-	// Also mark as fully paid
-	booking, err = s.repo.UpdateBookingPaymentStatus(ctx, dbpg.UpdateBookingPaymentStatusParams{
-		ID:            bookingID,
-		PaymentStatus: dbpg.PaymentStatusFullyPaid,
+		updated, err := applyStatusChange(ctx, tx, bookingID, StatusChange{
+			Status:    dbpg.BookingStatusCompleted,
+			ChangedBy: actorID,
+			Reason:    reason,
+		})
+		if err != nil {
+			return err
+		}
+		booking = updated
+		completed = current.Status != dbpg.BookingStatusCompleted
+		return nil
 	})
 	if err != nil {
-		return nil, problems.New(problems.Database, "failed to update payment status", err)
+		return nil, txError(err, "failed to complete booking")
+	}
+	// Completing a booking again does not send a second follow-up
+	if completed {
+		s.scheduleFollowUp(ctx, booking.ID)
 	}
-
 	return &booking, nil
 }
 
-func (s *BookingService) UpdatePaymentStatus(ctx context.Context, bookingID int64, paymentStatus dbpg.PaymentStatus) (*dbpg.Booking, error) {
-	booking, err := s.repo.UpdateBookingPaymentStatus(ctx, dbpg.UpdateBookingPaymentStatusParams{
-		ID:            bookingID,
-		PaymentStatus: paymentStatus,
+// changeStatus applies a status change in its own transaction.
+func (s *BookingService) changeStatus(ctx context.Context, bookingID int64, change StatusChange) (*dbpg.Booking, error) {
+	var booking dbpg.Booking
+	err := s.repo.WithTx(ctx, func(tx BookingTx) error {
+		updated, err := applyStatusChange(ctx, tx, bookingID, change)
+		if err != nil {
+			return err
+		}
+		booking = updated
+		return nil
 	})
 	if err != nil {
-		if errors.Is(err, ErrNoRecord) {
-			return nil, problems.New(problems.NotExist, "booking not found")
-		}
-		return nil, problems.New(problems.Database, "failed to update payment status", err)
+		return nil, txError(err, "failed to update booking status")
+	}
+	switch change.Status {
+	case dbpg.BookingStatusCancelled:
+		queueWaitlistCheck(ctx, s.Waitlist, booking.ScheduledDate)
+		s.cancelReminders(ctx, booking.ID)
+	case dbpg.BookingStatusCompleted:
+		s.scheduleFollowUp(ctx, booking.ID)
 	}
 	return &booking, nil
 }
 
-func (s *BookingService) OnDepositPaid(ctx context.Context, bookingID int64) (*dbpg.Booking, error) {
-	// Update payment status to deposit_paid
-	booking, err := s.repo.UpdateBookingPaymentStatus(ctx, dbpg.UpdateBookingPaymentStatusParams{
-		ID:            bookingID,
-		PaymentStatus: dbpg.PaymentStatusDepositPaid,
-	})
+// ListStatusHistory returns a booking's status timeline, oldest first.
+func (s *BookingService) ListStatusHistory(ctx context.Context, bookingID int64) ([]dbpg.BookingStatusHistory, error) {
+	history, err := s.repo.ListBookingStatusHistory(ctx, bookingID)
 	if err != nil {
-		if errors.Is(err, ErrNoRecord) {
-			return nil, problems.New(problems.NotExist, "booking not found")
-		}
-		return nil, problems.New(problems.Database, "failed to update payment status", err)
+		return nil, problems.New(problems.Database, "failed to list booking status history", err)
 	}
+	return history, nil
+}
 
-	// Update booking status to deposit_paid
-	booking, err = s.repo.UpdateBookingStatus(ctx, dbpg.UpdateBookingStatusParams{
-		ID:     bookingID,
-		Status: dbpg.BookingStatusDepositPaid,
-	})
+func (s *BookingService) ListBookingStaff(ctx context.Context, bookingID int64) ([]dbpg.ListBookingStaffRow, error) {
+	staff, err := s.repo.ListBookingStaff(ctx, bookingID)
 	if err != nil {
-		return nil, problems.New(problems.Database, "failed to update booking status", err)
+		return nil, problems.New(problems.Database, "failed to list booking staff", err)
 	}
-
-	return &booking, nil
+	return staff, nil
 }
 
 func (s *BookingService) ListBookingServices(ctx context.Context, bookingID int64) ([]dbpg.ListBookingServicesRow, error) {
@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+)
+
+// balanceDue is what the customer still owes on a booking. It is negative
+// when they have paid more than the booking now costs.
+func balanceDue(b dbpg.Booking) int64 {
+	return b.TotalAmount - b.AmountPaid
+}
+
+// lineChange is what an edit to a booking's services adds to its price and
+// duration; both are negative when services are removed.
+type lineChange struct {
+	price    int64
+	duration int32
+	reason   string
+}
+
+// AddBookingService adds a service, with its options, to an existing booking,
+// e.g. an upsell at the counter (admin). price overrides the service's own
+// price when set. A service already on the booking is added as another unit.
+func (s *BookingService) AddBookingService(ctx context.Context, actorID, bookingID, serviceID int64, optionIDs []int64, price *int64) (*dbpg.Booking, error) {
+	if price != nil && *price < 0 {
+		return nil, problems.New(problems.InvalidRequest, "price override cannot be negative")
+	}
+	row, err := s.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.adminQuoteItems(ctx, []AdminBookingService{{ServiceID: serviceID, OptionIDs: optionIDs, Price: price}})
+	if err != nil {
+		return nil, err
+	}
+	quote, err := s.quoteItems(ctx, items, s.vehicleCategory(ctx, row.VehicleID.Int64))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.changeBookingLines(ctx, actorID, row, func(tx BookingTx, current []dbpg.ListBookingServicesRow) (lineChange, error) {
+		if err := insertBookingLines(ctx, tx, bookingID, quote.lines); err != nil {
+			return lineChange{}, err
+		}
+		return lineChange{price: quote.subtotal, duration: quote.duration, reason: "services added"}, nil
+	})
+}
+
+// RemoveBookingService takes one unit of a service, with its options, off a
+// booking (admin).
+func (s *BookingService) RemoveBookingService(ctx context.Context, actorID, bookingID, bookingServiceID int64) (*dbpg.Booking, error) {
+	row, err := s.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.changeBookingLines(ctx, actorID, row, func(tx BookingTx, current []dbpg.ListBookingServicesRow) (lineChange, error) {
+		bs, err := getBookingService(ctx, tx, bookingID, bookingServiceID)
+		if err != nil {
+			return lineChange{}, err
+		}
+		if len(current) == 1 {
+			return lineChange{}, problems.New(problems.InvalidRequest, "a booking must keep at least one service, cancel it instead")
+		}
+		// What the service and its options added when booked comes off,
+		// whatever the catalogue says now
+		change := lineChange{price: -bs.PriceAtBooking, duration: -bs.DurationAtBooking, reason: "services removed"}
+
+		options, err := tx.ListBookingServiceOptions(ctx, bs.ID)
+		if err != nil {
+			return lineChange{}, problems.New(problems.Database, "failed to list booking service options", err)
+		}
+		for _, o := range options {
+			change.price -= o.PriceAtBooking
+			change.duration -= o.DurationAtBooking
+		}
+
+		if err := tx.DeleteBookingService(ctx, bs.ID); err != nil {
+			return lineChange{}, problems.New(problems.Database, "failed to remove booking service", err)
+		}
+		return change, nil
+	})
+}
+
+// AddBookingServiceOption adds an option to one of a booking's services
+// (admin).
+func (s *BookingService) AddBookingServiceOption(ctx context.Context, actorID, bookingID, bookingServiceID, optionID int64) (*dbpg.Booking, error) {
+	row, err := s.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	opt, err := s.repo.GetServiceOptionByID(ctx, optionID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.NotExist, "service option not found")
+		}
+		return nil, problems.New(problems.Database, "failed to get service option", err)
+	}
+	if !opt.IsActive {
+		return nil, problems.New(problems.InvalidRequest, fmt.Sprintf("option %q is no longer available", opt.Name))
+	}
+
+	return s.changeBookingLines(ctx, actorID, row, func(tx BookingTx, current []dbpg.ListBookingServicesRow) (lineChange, error) {
+		bs, err := getBookingService(ctx, tx, bookingID, bookingServiceID)
+		if err != nil {
+			return lineChange{}, err
+		}
+		if opt.ServiceID != bs.ServiceID {
+			return lineChange{}, problems.New(problems.InvalidRequest, fmt.Sprintf("option %q does not belong to this service", opt.Name))
+		}
+		options, err := tx.ListBookingServiceOptions(ctx, bs.ID)
+		if err != nil {
+			return lineChange{}, problems.New(problems.Database, "failed to list booking service options", err)
+		}
+		for _, o := range options {
+			if o.ServiceOptionID == opt.ID {
+				return lineChange{}, problems.New(problems.Exist, fmt.Sprintf("option %q is already on the booking", opt.Name))
+			}
+		}
+
+		if _, err := tx.CreateBookingServiceOption(ctx, dbpg.CreateBookingServiceOptionParams{
+			BookingServiceID:  bs.ID,
+			ServiceOptionID:   opt.ID,
+			PriceAtBooking:    opt.Price,
+			DurationAtBooking: opt.DurationMinutes,
+		}); err != nil {
+			return lineChange{}, problems.New(problems.Database, "failed to create booking service option", err)
+		}
+		return lineChange{price: opt.Price, duration: opt.DurationMinutes, reason: "options added"}, nil
+	})
+}
+
+// RemoveBookingServiceOption takes an option off one of a booking's services
+// (admin).
+func (s *BookingService) RemoveBookingServiceOption(ctx context.Context, actorID, bookingID, bookingServiceID, bookingServiceOptionID int64) (*dbpg.Booking, error) {
+	row, err := s.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.changeBookingLines(ctx, actorID, row, func(tx BookingTx, current []dbpg.ListBookingServicesRow) (lineChange, error) {
+		bs, err := getBookingService(ctx, tx, bookingID, bookingServiceID)
+		if err != nil {
+			return lineChange{}, err
+		}
+		o, err := tx.GetBookingServiceOption(ctx, dbpg.GetBookingServiceOptionParams{ID: bookingServiceOptionID, BookingServiceID: bs.ID})
+		if err != nil {
+			if errors.Is(err, ErrNoRecord) {
+				return lineChange{}, problems.New(problems.NotExist, "option not found on booking")
+			}
+			return lineChange{}, problems.New(problems.Database, "failed to get booking service option", err)
+		}
+		if err := tx.DeleteBookingServiceOption(ctx, o.ID); err != nil {
+			return lineChange{}, problems.New(problems.Database, "failed to remove booking service option", err)
+		}
+		return lineChange{price: -o.PriceAtBooking, duration: -o.DurationAtBooking, reason: "options removed"}, nil
+	})
+}
+
+func getBookingService(ctx context.Context, tx BookingTx, bookingID, bookingServiceID int64) (dbpg.BookingService, error) {
+	bs, err := tx.GetBookingService(ctx, dbpg.GetBookingServiceParams{ID: bookingServiceID, BookingID: bookingID})
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return dbpg.BookingService{}, problems.New(problems.NotExist, "service not found on booking")
+		}
+		return dbpg.BookingService{}, problems.New(problems.Database, "failed to get booking service", err)
+	}
+	return bs, nil
+}
+
+// changeBookingLines applies edit to a booking's services, then reprices the
+// booking and checks the job still fits its slot at its new length. A change
+// in price after payment is left as a balance to settle, or to refund when
+// negative.
+func (s *BookingService) changeBookingLines(ctx context.Context, actorID int64, row *dbpg.GetBookingByIDRow, edit func(tx BookingTx, current []dbpg.ListBookingServicesRow) (lineChange, error)) (*dbpg.Booking, error) {
+	switch row.Status {
+	case dbpg.BookingStatusPendingPayment, dbpg.BookingStatusDepositPaid, dbpg.BookingStatusConfirmed, dbpg.BookingStatusInProgress:
+	default:
+		return nil, problems.New(problems.InvalidRequest, fmt.Sprintf("the services on a %s booking cannot be changed", row.Status))
+	}
+
+	var booking dbpg.Booking
+	err := s.repo.WithTx(ctx, func(tx BookingTx) error {
+		if err := tx.LockScheduleDate(ctx, row.ScheduledDate); err != nil {
+			return problems.New(problems.Database, "failed to lock schedule", err)
+		}
+
+		// Re-read under lock so a concurrent reschedule or status change is seen
+		current, err := tx.GetBookingForUpdate(ctx, row.ID)
+		if err != nil {
+			return problems.New(problems.Database, "failed to get booking", err)
+		}
+		if current.Status != row.Status || current.ScheduledDate != row.ScheduledDate {
+			return problems.New(problems.Exist, "booking was changed, please try again")
+		}
+
+		lines, err := tx.ListBookingServices(ctx, current.ID)
+		if err != nil {
+			return problems.New(problems.Database, "failed to list booking services", err)
+		}
+		change, err := edit(tx, lines)
+		if err != nil {
+			return err
+		}
+
+		lines, err = tx.ListBookingServices(ctx, current.ID)
+		if err != nil {
+			return problems.New(problems.Database, "failed to list booking services", err)
+		}
+		serviceIDs := make([]int64, len(lines))
+		for i, l := range lines {
+			serviceIDs[i] = l.ServiceID
+		}
+		requirements, err := s.requirementsAt(ctx, serviceIDs, current.ServicePostcode)
+		if err != nil {
+			return err
+		}
+		duration := max(current.EstimatedDurationMins+change.duration, 0)
+		resourceID, err := checkSlotAvailable(ctx, tx, current.ScheduledDate.Time, minutesOf(current.ScheduledTime), duration, requirements, current.ID)
+		if err != nil {
+			return err
+		}
+
+		updated, err := tx.UpdateBookingPricing(ctx, dbpg.UpdateBookingPricingParams{
+			ID:                    current.ID,
+			Subtotal:              current.Subtotal + change.price,
+			TotalAmount:           current.TotalAmount + change.price,
+			EstimatedDurationMins: duration,
+			ResourceID:            resourceID,
+		})
+		if err != nil {
+			if errors.Is(err, ErrConflict) {
+				return problems.New(problems.Exist, "the booking no longer fits its time slot")
+			}
+			return problems.New(problems.Database, "failed to update booking", err)
+		}
+
+		// A balance reopens a booking that was paid in full; paying it off
+		// by taking services away closes it again
+		updated, err = syncPayments(ctx, tx, current.ID, actorID, fmt.Sprintf("%s, balance %s", change.reason, formatCents(balanceDue(updated))))
+		if err != nil {
+			return err
+		}
+		booking = updated
+		return nil
+	})
+	if err != nil {
+		return nil, txError(err, "failed to change booking services")
+	}
+	return &booking, nil
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveBookingServiceAfterCatalogueChange(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, params := newCheckout(0)
+	repo.services[11] = dbpg.Service{ID: 11, Name: "Wash", BasePrice: 5000, DurationMinutes: 45, ResourceTypes: []string{"bay"}}
+	repo.items = append(repo.items, dbpg.ListCartItemsRow{ID: 2, CartSessionID: 1, ServiceID: 11, Quantity: 1})
+
+	booking, err := svc.CreateBookingFromCart(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, int32(225), booking.EstimatedDurationMins)
+
+	// The wash now takes longer, but this booking was made at 45 minutes
+	wash := repo.services[11]
+	wash.DurationMinutes = 90
+	repo.services[11] = wash
+
+	booking, err = svc.RemoveBookingService(ctx, 2, booking.ID, repo.lines[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(180), booking.EstimatedDurationMins)
+	assert.Equal(t, int64(20000), booking.TotalAmount)
+	assert.Len(t, repo.lines, 1)
+}
+
+func TestBookingServiceUnits(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, params := newCheckout(0)
+	repo.services[11] = dbpg.Service{ID: 11, Name: "Wash", BasePrice: 5000, DurationMinutes: 45, ResourceTypes: []string{"bay"}}
+	repo.items = append(repo.items, dbpg.ListCartItemsRow{ID: 2, CartSessionID: 1, ServiceID: 11, Quantity: 2})
+
+	// Each wash is its own line
+	booking, err := svc.CreateBookingFromCart(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, int32(270), booking.EstimatedDurationMins)
+	assert.Equal(t, int64(30000), booking.TotalAmount)
+	require.Len(t, repo.lines, 3)
+
+	// Adding a service already on the booking adds another unit
+	booking, err = svc.AddBookingService(ctx, 2, booking.ID, 11, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(315), booking.EstimatedDurationMins)
+	assert.Equal(t, int64(35000), booking.TotalAmount)
+	require.Len(t, repo.lines, 4)
+
+	// and one unit can be taken off again
+	booking, err = svc.RemoveBookingService(ctx, 2, booking.ID, repo.lines[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(270), booking.EstimatedDurationMins)
+	assert.Equal(t, int64(30000), booking.TotalAmount)
+	assert.Len(t, repo.lines, 3)
+}
@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-chi/httplog"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/ics"
+	notification "github.com/richardbowden/degrees/internal/notifications"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/settings"
+)
+
+const (
+	defaultReminderHoursBefore = 24
+	defaultFollowUpHoursAfter  = 2
+)
+
+// reminderLead is how long before a booking starts the customer is reminded.
+func (s *BookingService) reminderLead(ctx context.Context) time.Duration {
+	hours := defaultReminderHoursBefore
+	if s.settings != nil {
+		if v, err := s.settings.GetInt(ctx, "booking", "reminder_hours_before", settings.SystemScope()); err == nil && v > 0 {
+			hours = v
+		}
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// followUpDelay is how long after a booking is completed the customer is
+// thanked and asked for a review.
+func (s *BookingService) followUpDelay(ctx context.Context) time.Duration {
+	hours := defaultFollowUpHoursAfter
+	if s.settings != nil {
+		if v, err := s.settings.GetInt(ctx, "booking", "follow_up_hours_after", settings.SystemScope()); err == nil && v >= 0 {
+			hours = v
+		}
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// reviewURL is the link the follow-up email asks customers to review at.
+func (s *BookingService) reviewURL(ctx context.Context) string {
+	if s.settings == nil {
+		return ""
+	}
+	v, err := s.settings.GetString(ctx, "booking", "review_url", settings.SystemScope())
+	if err != nil {
+		return ""
+	}
+	return v
+}
+
+// notifyBooked queues the confirmation for a new booking and schedules its
+// reminder. Failures are logged rather than failing the caller, as the
+// booking has already been made.
+func (s *BookingService) notifyBooked(ctx context.Context, bookingID int64) {
+	if s.Notifier == nil {
+		return
+	}
+	log := httplog.LogEntry(ctx)
+
+	row, err := s.repo.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		log.Error().Err(err).Int64("booking_id", bookingID).Msg("failed to get booking for notifications")
+		return
+	}
+	if !hasEmail(row.CustomerEmail) {
+		return
+	}
+
+	// The confirmation carries the booking as a calendar event; the email
+	// is still sent without it if the services cannot be read
+	var services []string
+	lines, err := s.repo.ListBookingServices(ctx, row.ID)
+	if err != nil {
+		log.Error().Err(err).Int64("booking_id", bookingID).Msg("failed to list booking services for calendar")
+	}
+	for _, l := range lines {
+		services = append(services, l.ServiceName)
+	}
+	event := bookingEvent(s.clock(ctx), eventHost(s.BaseURL), row, services)
+	calendar := ics.Calendar{Method: "PUBLISH", Events: []ics.Event{event}}.Bytes()
+
+	err = s.Notifier.QueueBookingConfirmation(ctx, row.ID, row.CustomerEmail,
+		formatDate(row.ScheduledDate), formatTime(row.ScheduledTime), row.DepositAmount, row.TotalAmount, calendar)
+	if err != nil {
+		log.Error().Err(err).Int64("booking_id", bookingID).Msg("failed to queue booking confirmation")
+	}
+	s.scheduleReminder(ctx, row.ID, row.ScheduledDate, row.ScheduledTime)
+}
+
+// scheduleReminder schedules the reminder for a booking starting at date and
+// time. No reminder is sent for a booking made too close to its start.
+func (s *BookingService) scheduleReminder(ctx context.Context, bookingID int64, date pgtype.Date, t pgtype.Time) {
+	if s.Notifier == nil {
+		return
+	}
+	clock := s.clock(ctx)
+	sendAt := clock.at(date.Time, minutesOf(t)).Add(-s.reminderLead(ctx))
+	if !sendAt.After(clock.now) {
+		return
+	}
+	if err := s.Notifier.ScheduleBookingReminder(ctx, bookingID, formatDate(date), formatTime(t), sendAt); err != nil {
+		log := httplog.LogEntry(ctx)
+		log.Error().Err(err).Int64("booking_id", bookingID).Msg("failed to schedule booking reminder")
+	}
+}
+
+// cancelReminders withdraws a booking's scheduled emails. A reminder that
+// escapes is still skipped when it runs, as the booking no longer matches it.
+func (s *BookingService) cancelReminders(ctx context.Context, bookingID int64) {
+	if s.Notifier == nil {
+		return
+	}
+	if err := s.Notifier.CancelBookingReminders(ctx, bookingID); err != nil {
+		log := httplog.LogEntry(ctx)
+		log.Error().Err(err).Int64("booking_id", bookingID).Msg("failed to cancel booking reminders")
+	}
+}
+
+// scheduleFollowUp schedules the thank-you for a completed booking.
+func (s *BookingService) scheduleFollowUp(ctx context.Context, bookingID int64) {
+	if s.Notifier == nil {
+		return
+	}
+	if err := s.Notifier.ScheduleBookingFollowUp(ctx, bookingID, s.now().Add(s.followUpDelay(ctx))); err != nil {
+		log := httplog.LogEntry(ctx)
+		log.Error().Err(err).Int64("booking_id", bookingID).Msg("failed to schedule booking follow-up")
+	}
+}
+
+// RemindBooking emails the customer a reminder of a booking due to start at
+// date and time. It is skipped if the booking has since been cancelled,
+// started or moved.
+func (s *BookingService) RemindBooking(ctx context.Context, bookingID int64, date, t string) (bool, error) {
+	row, ok, err := s.bookingForNotification(ctx, bookingID)
+	if err != nil || !ok {
+		return false, err
+	}
+	switch row.Status {
+	case dbpg.BookingStatusPendingPayment, dbpg.BookingStatusDepositPaid, dbpg.BookingStatusConfirmed:
+	default:
+		return false, nil
+	}
+	if formatDate(row.ScheduledDate) != date || formatTime(row.ScheduledTime) != t {
+		return false, nil
+	}
+
+	err = s.Notifier.SendBookingReminder(ctx, row.CustomerEmail, notification.BookingReminderData{
+		CustomerName: row.CustomerName,
+		BookingDate:  date,
+		BookingTime:  t,
+		Vehicle:      joinText(" ", row.VehicleMake, row.VehicleModel),
+		Address:      joinText(", ", row.ServiceAddress, row.ServiceSuburb),
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// FollowUpBooking thanks the customer for a completed booking and asks for a
+// review.
+func (s *BookingService) FollowUpBooking(ctx context.Context, bookingID int64) (bool, error) {
+	row, ok, err := s.bookingForNotification(ctx, bookingID)
+	if err != nil || !ok {
+		return false, err
+	}
+	if row.Status != dbpg.BookingStatusCompleted {
+		return false, nil
+	}
+
+	err = s.Notifier.SendBookingFollowUp(ctx, row.CustomerEmail, notification.BookingFollowUpData{
+		CustomerName: row.CustomerName,
+		BookingDate:  formatDate(row.ScheduledDate),
+		ReviewLink:   s.reviewURL(ctx),
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// bookingForNotification loads a booking a scheduled email is for, reporting
+// false if it no longer exists or the customer has no email address.
+func (s *BookingService) bookingForNotification(ctx context.Context, bookingID int64) (dbpg.GetBookingByIDRow, bool, error) {
+	if s.Notifier == nil {
+		return dbpg.GetBookingByIDRow{}, false, problems.New(problems.Internal, "booking notifier not configured")
+	}
+	row, err := s.repo.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return dbpg.GetBookingByIDRow{}, false, nil
+		}
+		return dbpg.GetBookingByIDRow{}, false, problems.New(problems.Database, "failed to get booking", err)
+	}
+	if !hasEmail(row.CustomerEmail) {
+		return dbpg.GetBookingByIDRow{}, false, nil
+	}
+	return row, true, nil
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/richardbowden/degrees/internal/dbpg"
+	notification "github.com/richardbowden/degrees/internal/notifications"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type notifyRepo struct {
+	BookingRepository
+	booking dbpg.GetBookingByIDRow
+}
+
+func (r *notifyRepo) GetBookingByID(ctx context.Context, id int64) (dbpg.GetBookingByIDRow, error) {
+	return r.booking, nil
+}
+
+type fakeNotifier struct {
+	BookingNotifier
+	reminders []time.Time
+	followUps []int64
+	sent      []notification.BookingReminderData
+}
+
+func (n *fakeNotifier) ScheduleBookingFollowUp(ctx context.Context, bookingID int64, sendAt time.Time) error {
+	n.followUps = append(n.followUps, bookingID)
+	return nil
+}
+
+func (n *fakeNotifier) ScheduleBookingReminder(ctx context.Context, bookingID int64, bookingDate, bookingTime string, sendAt time.Time) error {
+	n.reminders = append(n.reminders, sendAt)
+	return nil
+}
+
+func (n *fakeNotifier) SendBookingReminder(ctx context.Context, to string, data notification.BookingReminderData) error {
+	n.sent = append(n.sent, data)
+	return nil
+}
+
+func TestBookingReminders(t *testing.T) {
+	perth := mustLoadLocation(t, DefaultTimezone)
+	repo := &notifyRepo{booking: dbpg.GetBookingByIDRow{
+		ID:            1,
+		ScheduledDate: pgDateOf("2030-01-10"),
+		ScheduledTime: pgTimeOf(9, 0),
+		Status:        dbpg.BookingStatusConfirmed,
+		CustomerName:  "Sam",
+		CustomerEmail: "sam@example.com",
+	}}
+	notifier := &fakeNotifier{}
+	svc := NewBookingService(repo, nil)
+	svc.Notifier = notifier
+	svc.now = func() time.Time { return time.Date(2030, 1, 6, 9, 0, 0, 0, perth) }
+
+	// Scheduled the default 24 hours ahead of the start
+	ctx := context.Background()
+	svc.scheduleReminder(ctx, 1, repo.booking.ScheduledDate, repo.booking.ScheduledTime)
+	require.Len(t, notifier.reminders, 1)
+	assert.Equal(t, time.Date(2030, 1, 9, 9, 0, 0, 0, perth), notifier.reminders[0])
+
+	// None for a booking starting sooner than that
+	svc.scheduleReminder(ctx, 1, pgDateOf("2030-01-07"), pgTimeOf(8, 0))
+	assert.Len(t, notifier.reminders, 1)
+
+	sent, err := svc.RemindBooking(ctx, 1, "2030-01-10", "09:00")
+	require.NoError(t, err)
+	assert.True(t, sent)
+
+	// A reminder for the time the booking was moved from is not sent
+	sent, err = svc.RemindBooking(ctx, 1, "2030-01-09", "09:00")
+	require.NoError(t, err)
+	assert.False(t, sent)
+
+	repo.booking.Status = dbpg.BookingStatusCancelled
+	sent, err = svc.RemindBooking(ctx, 1, "2030-01-10", "09:00")
+	require.NoError(t, err)
+	assert.False(t, sent)
+
+	assert.Len(t, notifier.sent, 1)
+}
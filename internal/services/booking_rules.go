@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/settings"
+)
+
+// BookingRules is stored in settings under booking:booking_rules. The rules
+// decide which start times are offered and which are accepted.
+type BookingRules struct {
+	// SlotStepMinutes is the gap between offered start times, counted from
+	// opening.
+	SlotStepMinutes int `json:"slot_step_minutes"`
+	// DefaultDurationMinutes is assumed when availability is asked for
+	// without services or a duration.
+	DefaultDurationMinutes int `json:"default_duration_minutes"`
+	// MinLeadMinutes is the least notice a booking can be made with.
+	MinLeadMinutes int `json:"min_lead_minutes"`
+	// MaxAdvanceDays is how many days ahead bookings are taken.
+	MaxAdvanceDays int `json:"max_advance_days"`
+	// SameDayCutoff (HH:MM) is when bookings for the same day close, on top
+	// of the lead time. Empty means no cut-off.
+	SameDayCutoff string `json:"same_day_cutoff"`
+
+	// Categories overrides rules for jobs including a service in the
+	// category, keyed by category ID.
+	Categories map[int64]BookingRulesOverride `json:"categories"`
+}
+
+// BookingRulesOverride replaces the rules that are set for one category.
+type BookingRulesOverride struct {
+	SlotStepMinutes *int    `json:"slot_step_minutes,omitempty"`
+	MinLeadMinutes  *int    `json:"min_lead_minutes,omitempty"`
+	MaxAdvanceDays  *int    `json:"max_advance_days,omitempty"`
+	SameDayCutoff   *string `json:"same_day_cutoff,omitempty"`
+}
+
+// DefaultBookingRules is used when no rules have been configured, and for any
+// rule left unset.
+var DefaultBookingRules = BookingRules{
+	SlotStepMinutes:        30,
+	DefaultDurationMinutes: 60,
+	MinLeadMinutes:         24 * 60,
+	MaxAdvanceDays:         90,
+}
+
+// loadBookingRules reads the configured rules, falling back to the defaults
+// for anything missing.
+func loadBookingRules(ctx context.Context, s *settings.Service) BookingRules {
+	if s == nil {
+		return DefaultBookingRules
+	}
+	rules, err := settings.GetTyped[BookingRules](ctx, s, "booking", "booking_rules", settings.SystemScope())
+	if err != nil {
+		return DefaultBookingRules
+	}
+	if rules.SlotStepMinutes <= 0 {
+		rules.SlotStepMinutes = DefaultBookingRules.SlotStepMinutes
+	}
+	if rules.DefaultDurationMinutes <= 0 {
+		rules.DefaultDurationMinutes = DefaultBookingRules.DefaultDurationMinutes
+	}
+	if rules.MinLeadMinutes < 0 {
+		rules.MinLeadMinutes = 0
+	}
+	if rules.MaxAdvanceDays <= 0 {
+		rules.MaxAdvanceDays = DefaultBookingRules.MaxAdvanceDays
+	}
+	return rules
+}
+
+// slotRules are the booking rules that apply to one job.
+type slotRules struct {
+	step           int32
+	lead           time.Duration
+	maxAdvanceDays int
+	sameDayCutoff  int32 // minutes after midnight, or -1 for none
+}
+
+// forJob returns the rules for a job including services in categoryIDs.
+// Where the categories' rules differ the strictest applies: the longest step
+// and lead time, the shortest horizon and the earliest cut-off.
+func (r BookingRules) forJob(categoryIDs []int64) slotRules {
+	base := slotRules{
+		step:           int32(r.SlotStepMinutes),
+		lead:           time.Duration(r.MinLeadMinutes) * time.Minute,
+		maxAdvanceDays: r.MaxAdvanceDays,
+		sameDayCutoff:  parseCutoff(r.SameDayCutoff),
+	}
+
+	var merged *slotRules
+	for _, id := range categoryIDs {
+		o, ok := r.Categories[id]
+		if !ok {
+			continue
+		}
+		c := base
+		if o.SlotStepMinutes != nil && *o.SlotStepMinutes > 0 {
+			c.step = int32(*o.SlotStepMinutes)
+		}
+		if o.MinLeadMinutes != nil && *o.MinLeadMinutes >= 0 {
+			c.lead = time.Duration(*o.MinLeadMinutes) * time.Minute
+		}
+		if o.MaxAdvanceDays != nil && *o.MaxAdvanceDays > 0 {
+			c.maxAdvanceDays = *o.MaxAdvanceDays
+		}
+		if o.SameDayCutoff != nil {
+			c.sameDayCutoff = parseCutoff(*o.SameDayCutoff)
+		}
+
+		if merged == nil {
+			merged = &c
+			continue
+		}
+		merged.step = max(merged.step, c.step)
+		merged.lead = max(merged.lead, c.lead)
+		merged.maxAdvanceDays = min(merged.maxAdvanceDays, c.maxAdvanceDays)
+		if merged.sameDayCutoff < 0 || (c.sameDayCutoff >= 0 && c.sameDayCutoff < merged.sameDayCutoff) {
+			merged.sameDayCutoff = c.sameDayCutoff
+		}
+	}
+	if merged == nil {
+		return base
+	}
+	return *merged
+}
+
+// parseCutoff converts an HH:MM cut-off to minutes after midnight, or -1 if
+// it is empty or invalid.
+func parseCutoff(s string) int32 {
+	if s == "" {
+		return -1
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return -1
+	}
+	return int32(t.Hour()*60 + t.Minute())
+}
+
+// checkStart verifies that a job starting at mins on date can be booked now.
+func (r slotRules) checkStart(c businessClock, date time.Time, mins int32) error {
+	today := c.today()
+	switch {
+	case c.at(date, mins).Before(c.now.Add(r.lead)):
+		return problems.New(problems.InvalidRequest, fmt.Sprintf("bookings require at least %s advance notice", describeLead(r.lead)))
+	case date.After(today.AddDate(0, 0, r.maxAdvanceDays)):
+		return problems.New(problems.InvalidRequest, fmt.Sprintf("bookings can be made at most %d days ahead", r.maxAdvanceDays))
+	case r.sameDayCutoff >= 0 && date.Equal(today) && int32(c.now.Hour()*60+c.now.Minute()) >= r.sameDayCutoff:
+		return problems.New(problems.InvalidRequest, fmt.Sprintf("same-day bookings close at %02d:%02d", r.sameDayCutoff/60, r.sameDayCutoff%60))
+	}
+	return nil
+}
+
+// dayBookable reports whether any start on date can still be booked.
+func (r slotRules) dayBookable(c businessClock, date time.Time) bool {
+	return r.checkStart(c, date, 24*60-1) == nil
+}
+
+// bookable drops the slots on date that cannot be booked now.
+func (r slotRules) bookable(c businessClock, date time.Time, fits []slotFit) []slotFit {
+	var out []slotFit
+	for _, f := range fits {
+		if r.checkStart(c, date, f.start) == nil {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// checkBookingRules verifies that a job with req can be booked to start at
+// mins on date, and restricts the job to the rules' start times.
+func (s *BookingService) checkBookingRules(ctx context.Context, req *jobRequirements, date time.Time, mins int32) error {
+	rules := loadBookingRules(ctx, s.settings).forJob(req.categoryIDs)
+	req.step = rules.step
+	return rules.checkStart(s.clock(ctx), date, mins)
+}
+
+// describeLead formats a lead time for messages, e.g. "24 hours".
+func describeLead(d time.Duration) string {
+	if d%time.Hour == 0 {
+		if d == time.Hour {
+			return "1 hour"
+		}
+		return fmt.Sprintf("%d hours", int(d.Hours()))
+	}
+	return fmt.Sprintf("%d minutes", int(d.Minutes()))
+}
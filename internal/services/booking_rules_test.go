@@ -0,0 +1,63 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookingRulesForJob(t *testing.T) {
+	hour, noon, week := 60, "12:00", 7
+	rules := DefaultBookingRules
+	rules.Categories = map[int64]BookingRulesOverride{
+		1: {MinLeadMinutes: &hour, SameDayCutoff: &noon},
+		2: {SlotStepMinutes: &hour, MaxAdvanceDays: &week},
+	}
+
+	assert.Equal(t, slotRules{step: 30, lead: 24 * time.Hour, maxAdvanceDays: 90, sameDayCutoff: -1}, rules.forJob(nil))
+	assert.Equal(t, slotRules{step: 30, lead: time.Hour, maxAdvanceDays: 90, sameDayCutoff: 720}, rules.forJob([]int64{1, 3}))
+
+	// Mixing categories takes the strictest of each
+	assert.Equal(t, slotRules{step: 60, lead: 24 * time.Hour, maxAdvanceDays: 7, sameDayCutoff: 720}, rules.forJob([]int64{1, 2}))
+}
+
+func TestSlotRulesCheckStart(t *testing.T) {
+	perth := mustLoadLocation(t, "Australia/Perth")
+	clock := newBusinessClock(perth, time.Date(2030, 1, 7, 10, 15, 0, 0, perth))
+	today := pgDateOf("2030-01-07").Time
+	rules := slotRules{step: 30, lead: time.Hour, maxAdvanceDays: 14, sameDayCutoff: 14 * 60}
+
+	assert.Error(t, rules.checkStart(clock, today, 11*60))
+	assert.NoError(t, rules.checkStart(clock, today, 11*60+30))
+	assert.NoError(t, rules.checkStart(clock, pgDateOf("2030-01-21").Time, 9*60))
+	assert.Error(t, rules.checkStart(clock, pgDateOf("2030-01-22").Time, 9*60))
+
+	// After the cut-off nothing more is taken for today
+	late := newBusinessClock(perth, time.Date(2030, 1, 7, 14, 0, 0, 0, perth))
+	assert.Error(t, rules.checkStart(late, today, 16*60))
+	assert.True(t, rules.dayBookable(late, pgDateOf("2030-01-08").Time))
+	assert.False(t, rules.dayBookable(late, today))
+
+	fits := []slotFit{{start: 10 * 60}, {start: 11 * 60}, {start: 11*60 + 30}}
+	assert.Equal(t, []slotFit{{start: 11*60 + 30}}, rules.bookable(clock, today, fits))
+}
+
+func TestDayScheduleSlotStep(t *testing.T) {
+	day := daySchedule{
+		open:      true,
+		resources: []resourceDay{{id: 1, kind: "bay", openMins: 480, closeMins: 720}},
+	}
+	req := jobRequirements{step: 90}
+
+	var starts []int32
+	for _, f := range day.freeSlots(60, req) {
+		starts = append(starts, f.start)
+	}
+	assert.Equal(t, []int32{480, 570, 660}, starts)
+
+	_, err := day.check(510, 60, req)
+	assert.Error(t, err)
+	_, err = day.check(570, 60, req)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,456 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/settings"
+)
+
+const (
+	SeriesStatusActive    = "active"
+	SeriesStatusCancelled = "cancelled"
+	SeriesStatusEnded     = "ended"
+)
+
+// Occurrence outcomes recorded in booking_series_occurrences. Blocked means
+// the business was closed or blacked out that day, or the booking rules did
+// not allow the booking; conflict means the slot or every detailer was
+// already taken.
+const (
+	OccurrenceBooked   = "booked"
+	OccurrenceSkipped  = "skipped"
+	OccurrenceBlocked  = "blocked"
+	OccurrenceConflict = "conflict"
+)
+
+const defaultSeriesHorizonDays = 28
+
+// BookingSeriesDetail is a series with the occurrences dealt with so far.
+type BookingSeriesDetail struct {
+	Series      dbpg.BookingSeries
+	Occurrences []dbpg.BookingSeriesOccurrence
+}
+
+// startSeries makes a newly created booking the template and first
+// occurrence of a series.
+func startSeries(ctx context.Context, tx BookingTx, booking dbpg.Booking, rule RecurrenceRule) (dbpg.BookingSeries, error) {
+	series, err := tx.CreateBookingSeries(ctx, dbpg.CreateBookingSeriesParams{
+		CustomerID:        booking.CustomerID,
+		TemplateBookingID: booking.ID,
+		Rrule:             rule.String(),
+		StartDate:         booking.ScheduledDate,
+		ScheduledTime:     booking.ScheduledTime,
+	})
+	if err != nil {
+		return dbpg.BookingSeries{}, problems.New(problems.Database, "failed to create booking series", err)
+	}
+	if err := tx.SetBookingSeriesID(ctx, booking.ID, series.ID); err != nil {
+		return dbpg.BookingSeries{}, problems.New(problems.Database, "failed to link booking to series", err)
+	}
+	if _, err := tx.UpsertSeriesOccurrence(ctx, dbpg.UpsertSeriesOccurrenceParams{
+		SeriesID:       series.ID,
+		OccurrenceDate: booking.ScheduledDate,
+		BookingID:      pgtype.Int8{Int64: booking.ID, Valid: true},
+		Status:         OccurrenceBooked,
+	}); err != nil {
+		return dbpg.BookingSeries{}, problems.New(problems.Database, "failed to record series occurrence", err)
+	}
+	return series, nil
+}
+
+// seriesHorizon returns the last date occurrences should be booked up to.
+func (s *BookingService) seriesHorizon(ctx context.Context) time.Time {
+	days := defaultSeriesHorizonDays
+	if s.settings != nil {
+		if v, err := s.settings.GetInt(ctx, "booking", "series_horizon_days", settings.SystemScope()); err == nil && v > 0 {
+			days = v
+		}
+	}
+	today := s.clock(ctx).today()
+	return today.AddDate(0, 0, days)
+}
+
+// MaterialiseSeries books every active series up to the configured horizon
+// and returns how many occurrences were booked. It is safe to run repeatedly:
+// dates already booked, skipped or flagged are left alone.
+func (s *BookingService) MaterialiseSeries(ctx context.Context) (int, error) {
+	all, err := s.repo.ListActiveBookingSeries(ctx)
+	if err != nil {
+		return 0, problems.New(problems.Database, "failed to list booking series", err)
+	}
+
+	through := s.seriesHorizon(ctx)
+	var booked int
+	var errs []error
+	for _, series := range all {
+		n, err := s.materialiseSeries(ctx, series, through)
+		booked += n
+		if err != nil {
+			errs = append(errs, fmt.Errorf("series %d: %w", series.ID, err))
+		}
+	}
+	return booked, errors.Join(errs...)
+}
+
+func (s *BookingService) materialiseSeriesByID(ctx context.Context, seriesID int64) (int, error) {
+	series, err := s.repo.GetBookingSeriesByID(ctx, seriesID)
+	if err != nil {
+		return 0, problems.New(problems.Database, "failed to get booking series", err)
+	}
+	return s.materialiseSeries(ctx, series, s.seriesHorizon(ctx))
+}
+
+func (s *BookingService) materialiseSeries(ctx context.Context, series dbpg.BookingSeries, through time.Time) (int, error) {
+	rule, err := ParseRecurrenceRule(series.Rrule)
+	if err != nil {
+		return 0, err
+	}
+
+	existing, err := s.repo.ListSeriesOccurrences(ctx, series.ID)
+	if err != nil {
+		return 0, problems.New(problems.Database, "failed to list series occurrences", err)
+	}
+	done := make(map[string]bool, len(existing))
+	for _, o := range existing {
+		done[formatDate(o.OccurrenceDate)] = true
+	}
+
+	// Occurrences are never booked for today or the past
+	today := s.clock(ctx).today()
+	var pending []time.Time
+	for _, d := range rule.Occurrences(series.StartDate.Time, through) {
+		if d.After(today) && !done[d.Format("2006-01-02")] {
+			pending = append(pending, d)
+		}
+	}
+
+	var booked int
+	var deferred bool
+	if len(pending) > 0 {
+		template, err := s.repo.GetBookingByID(ctx, series.TemplateBookingID)
+		if err != nil {
+			return 0, problems.New(problems.Database, "failed to get series template booking", err)
+		}
+		lines, err := s.templateLines(ctx, template.ID)
+		if err != nil {
+			return 0, err
+		}
+		req, err := s.bookingRequirements(ctx, template.ID, template.ServicePostcode)
+		if err != nil {
+			return 0, err
+		}
+
+		// Dates further ahead than bookings are taken are left for a later run
+		lastBookable := today.AddDate(0, 0, loadBookingRules(ctx, s.settings).forJob(req.categoryIDs).maxAdvanceDays)
+		for _, d := range pending {
+			if d.After(lastBookable) {
+				deferred = true
+				break
+			}
+			ok, err := s.bookOccurrence(ctx, series, template, lines, req, d)
+			if err != nil {
+				return booked, err
+			}
+			if ok {
+				booked++
+			}
+		}
+	}
+
+	if !deferred && rule.EndsBy(series.StartDate.Time, through) {
+		return booked, s.endSeries(ctx, series.ID)
+	}
+	return booked, nil
+}
+
+func (s *BookingService) endSeries(ctx context.Context, seriesID int64) error {
+	if _, err := s.repo.UpdateBookingSeriesStatus(ctx, seriesID, SeriesStatusEnded); err != nil {
+		return problems.New(problems.Database, "failed to end booking series", err)
+	}
+	return nil
+}
+
+// templateLines rebuilds the booking_services and options of a booking so
+// they can be copied onto a new occurrence at the same prices and times.
+func (s *BookingService) templateLines(ctx context.Context, bookingID int64) ([]bookingLine, error) {
+	svcs, err := s.repo.ListBookingServices(ctx, bookingID)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list booking services", err)
+	}
+	lines := make([]bookingLine, 0, len(svcs))
+	for _, bs := range svcs {
+		opts, err := s.repo.ListBookingServiceOptions(ctx, bs.ID)
+		if err != nil {
+			return nil, problems.New(problems.Database, "failed to list booking service options", err)
+		}
+		line := bookingLine{service: dbpg.CreateBookingServiceParams{
+			ServiceID:         bs.ServiceID,
+			PriceAtBooking:    bs.PriceAtBooking,
+			DurationAtBooking: bs.DurationAtBooking,
+		}}
+		for _, opt := range opts {
+			line.options = append(line.options, dbpg.CreateBookingServiceOptionParams{
+				ServiceOptionID:   opt.ServiceOptionID,
+				PriceAtBooking:    opt.PriceAtBooking,
+				DurationAtBooking: opt.DurationAtBooking,
+			})
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// bookOccurrence books one series date, or records why it could not be
+// booked. Like any other booking it must meet the booking rules, such as the
+// lead time and the start times offered for its services. It reports whether
+// a booking was made.
+func (s *BookingService) bookOccurrence(ctx context.Context, series dbpg.BookingSeries, template dbpg.GetBookingByIDRow, lines []bookingLine, req jobRequirements, date time.Time) (bool, error) {
+	pgDate := pgtype.Date{Time: date, Valid: true}
+	startMins := minutesOf(template.ScheduledTime)
+
+	var booked bool
+	var bookingID int64
+	err := s.repo.WithTx(ctx, func(tx BookingTx) error {
+		if err := tx.LockScheduleDate(ctx, pgDate); err != nil {
+			return problems.New(problems.Database, "failed to lock schedule", err)
+		}
+
+		occurrence := dbpg.UpsertSeriesOccurrenceParams{
+			SeriesID:       series.ID,
+			OccurrenceDate: pgDate,
+		}
+
+		var resourceID int64
+		err := s.checkBookingRules(ctx, &req, date, startMins)
+		if err == nil {
+			resourceID, err = checkSlotAvailable(ctx, tx, date, startMins, template.EstimatedDurationMins, req, 0)
+		}
+		if err != nil {
+			var p problems.Problem
+			if !errors.As(err, &p) {
+				return err
+			}
+			switch p.Kind {
+			case problems.InvalidRequest:
+				occurrence.Status = OccurrenceBlocked
+			case problems.Exist:
+				occurrence.Status = OccurrenceConflict
+			default:
+				return err
+			}
+			occurrence.Reason = dbpg.StringToPGString(p.Detail)
+			if _, err := tx.UpsertSeriesOccurrence(ctx, occurrence); err != nil {
+				return problems.New(problems.Database, "failed to record series occurrence", err)
+			}
+			return nil
+		}
+
+		created, err := tx.CreateBooking(ctx, dbpg.CreateBookingParams{
+			CustomerID:            template.CustomerID,
+			VehicleID:             template.VehicleID,
+			ScheduledDate:         pgDate,
+			ScheduledTime:         template.ScheduledTime,
+			EstimatedDurationMins: template.EstimatedDurationMins,
+			Status:                initialStatus(template.DepositAmount),
+			PaymentStatus:         dbpg.PaymentStatusPending,
+			Subtotal:              template.Subtotal,
+			DepositAmount:         template.DepositAmount,
+			TotalAmount:           template.TotalAmount,
+			Notes:                 template.Notes,
+			ResourceID:            resourceID,
+			ServiceAddress:        template.ServiceAddress,
+			ServiceSuburb:         template.ServiceSuburb,
+			ServicePostcode:       template.ServicePostcode,
+			TravelDistanceKm:      template.TravelDistanceKm,
+			TravelSurcharge:       template.TravelSurcharge,
+		})
+		if err != nil {
+			if errors.Is(err, ErrConflict) {
+				occurrence.Status = OccurrenceConflict
+				occurrence.Reason = dbpg.StringToPGString("the selected time slot is no longer available")
+				if _, err := tx.UpsertSeriesOccurrence(ctx, occurrence); err != nil {
+					return problems.New(problems.Database, "failed to record series occurrence", err)
+				}
+				return nil
+			}
+			return problems.New(problems.Database, "failed to create booking", err)
+		}
+
+		if err := tx.SetBookingSeriesID(ctx, created.ID, series.ID); err != nil {
+			return problems.New(problems.Database, "failed to link booking to series", err)
+		}
+		if err := recordInitialStatus(ctx, tx, created, 0, "booked from recurring series"); err != nil {
+			return err
+		}
+		if err := insertBookingLines(ctx, tx, created.ID, lines); err != nil {
+			return err
+		}
+
+		occurrence.Status = OccurrenceBooked
+		occurrence.BookingID = pgtype.Int8{Int64: created.ID, Valid: true}
+		if _, err := tx.UpsertSeriesOccurrence(ctx, occurrence); err != nil {
+			return problems.New(problems.Database, "failed to record series occurrence", err)
+		}
+		booked = true
+		bookingID = created.ID
+		return nil
+	})
+	if err != nil {
+		return false, txError(err, "failed to book series occurrence")
+	}
+	if booked {
+		s.notifyBooked(ctx, bookingID)
+	}
+	return booked, nil
+}
+
+// ListMyBookingSeries returns the caller's series, newest first.
+func (s *BookingService) ListMyBookingSeries(ctx context.Context, userID int64) ([]BookingSeriesDetail, error) {
+	customer, err := s.repo.GetCustomerProfileByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return []BookingSeriesDetail{}, nil
+		}
+		return nil, problems.New(problems.Database, "failed to get customer profile", err)
+	}
+
+	all, err := s.repo.ListBookingSeriesByCustomer(ctx, customer.ID)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list booking series", err)
+	}
+
+	details := make([]BookingSeriesDetail, 0, len(all))
+	for _, series := range all {
+		occurrences, err := s.repo.ListSeriesOccurrences(ctx, series.ID)
+		if err != nil {
+			return nil, problems.New(problems.Database, "failed to list series occurrences", err)
+		}
+		details = append(details, BookingSeriesDetail{Series: series, Occurrences: occurrences})
+	}
+	return details, nil
+}
+
+// myBookingSeries loads a series and checks it belongs to the caller.
+func (s *BookingService) myBookingSeries(ctx context.Context, userID, seriesID int64) (dbpg.BookingSeries, error) {
+	customer, err := s.repo.GetCustomerProfileByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return dbpg.BookingSeries{}, problems.New(problems.NotExist, "booking series not found")
+		}
+		return dbpg.BookingSeries{}, problems.New(problems.Database, "failed to get customer profile", err)
+	}
+
+	series, err := s.repo.GetBookingSeriesByID(ctx, seriesID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return dbpg.BookingSeries{}, problems.New(problems.NotExist, "booking series not found")
+		}
+		return dbpg.BookingSeries{}, problems.New(problems.Database, "failed to get booking series", err)
+	}
+	if series.CustomerID != customer.ID {
+		return dbpg.BookingSeries{}, problems.New(problems.NotExist, "booking series not found")
+	}
+	return series, nil
+}
+
+// SkipSeriesOccurrence skips one date of a series. If that date is already
+// booked the booking is cancelled under the normal cancellation policy;
+// otherwise the date is recorded so it is never booked.
+func (s *BookingService) SkipSeriesOccurrence(ctx context.Context, userID, seriesID int64, date string) (*dbpg.BookingSeriesOccurrence, string, error) {
+	series, err := s.myBookingSeries(ctx, userID, seriesID)
+	if err != nil {
+		return nil, "", err
+	}
+	if series.Status == SeriesStatusCancelled {
+		return nil, "", problems.New(problems.InvalidRequest, "booking series is cancelled")
+	}
+
+	occurrenceDate, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, "", problems.New(problems.InvalidRequest, "invalid date format, expected YYYY-MM-DD")
+	}
+	if !occurrenceDate.After(s.clock(ctx).today()) {
+		return nil, "", problems.New(problems.InvalidRequest, "only future occurrences can be skipped")
+	}
+
+	rule, err := ParseRecurrenceRule(series.Rrule)
+	if err != nil {
+		return nil, "", err
+	}
+	if !rule.IsOccurrence(series.StartDate.Time, occurrenceDate) {
+		return nil, "", problems.New(problems.InvalidRequest, fmt.Sprintf("%s is not a date in this series", date))
+	}
+
+	existing, err := s.repo.ListSeriesOccurrences(ctx, series.ID)
+	if err != nil {
+		return nil, "", problems.New(problems.Database, "failed to list series occurrences", err)
+	}
+
+	msg := "occurrence skipped"
+	params := dbpg.UpsertSeriesOccurrenceParams{
+		SeriesID:       series.ID,
+		OccurrenceDate: pgtype.Date{Time: occurrenceDate, Valid: true},
+		Status:         OccurrenceSkipped,
+		Reason:         dbpg.StringToPGString("skipped by customer"),
+	}
+	for _, o := range existing {
+		if formatDate(o.OccurrenceDate) != date {
+			continue
+		}
+		if o.Status == OccurrenceSkipped {
+			return nil, "", problems.New(problems.InvalidRequest, "occurrence is already skipped")
+		}
+		if o.Status == OccurrenceBooked && o.BookingID.Valid {
+			_, cancelMsg, err := s.CancelBooking(ctx, userID, o.BookingID.Int64)
+			if err != nil {
+				return nil, "", err
+			}
+			msg = "occurrence skipped, " + cancelMsg
+			params.BookingID = o.BookingID
+		}
+	}
+
+	occurrence, err := s.repo.UpsertSeriesOccurrence(ctx, params)
+	if err != nil {
+		return nil, "", problems.New(problems.Database, "failed to record series occurrence", err)
+	}
+	return &occurrence, msg, nil
+}
+
+// CancelBookingSeries stops a series and cancels its upcoming bookings under
+// the normal cancellation policy. It returns the number of bookings cancelled.
+func (s *BookingService) CancelBookingSeries(ctx context.Context, userID, seriesID int64) (*dbpg.BookingSeries, int, error) {
+	series, err := s.myBookingSeries(ctx, userID, seriesID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if series.Status == SeriesStatusCancelled {
+		return nil, 0, problems.New(problems.InvalidRequest, "booking series is already cancelled")
+	}
+
+	// Stop materialisation first so no new occurrences appear mid-cancel
+	updated, err := s.repo.UpdateBookingSeriesStatus(ctx, series.ID, SeriesStatusCancelled)
+	if err != nil {
+		return nil, 0, problems.New(problems.Database, "failed to cancel booking series", err)
+	}
+
+	upcoming, err := s.repo.ListUpcomingSeriesBookings(ctx, series.ID)
+	if err != nil {
+		return nil, 0, problems.New(problems.Database, "failed to list series bookings", err)
+	}
+
+	var cancelled int
+	for _, b := range upcoming {
+		if _, _, err := s.CancelBooking(ctx, userID, b.ID); err != nil {
+			return &updated, cancelled, err
+		}
+		cancelled++
+	}
+	return &updated, cancelled, nil
+}
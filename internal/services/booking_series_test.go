@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (r *checkoutRepo) ListSeriesOccurrences(ctx context.Context, seriesID int64) ([]dbpg.BookingSeriesOccurrence, error) {
+	return r.occurrences, nil
+}
+
+func (r *checkoutRepo) ListBookingServiceOptions(ctx context.Context, bookingServiceID int64) ([]dbpg.ListBookingServiceOptionsRow, error) {
+	return nil, nil
+}
+
+func (r *checkoutRepo) UpdateBookingSeriesStatus(ctx context.Context, id int64, status string) (dbpg.BookingSeries, error) {
+	return dbpg.BookingSeries{ID: id, Status: status}, nil
+}
+
+func (t *checkoutTx) SetBookingSeriesID(ctx context.Context, bookingID, seriesID int64) error {
+	return nil
+}
+
+func (t *checkoutTx) UpsertSeriesOccurrence(ctx context.Context, params dbpg.UpsertSeriesOccurrenceParams) (dbpg.BookingSeriesOccurrence, error) {
+	o := dbpg.BookingSeriesOccurrence{
+		SeriesID:       params.SeriesID,
+		OccurrenceDate: params.OccurrenceDate,
+		BookingID:      params.BookingID,
+		Status:         params.Status,
+		Reason:         params.Reason,
+	}
+	t.repo.occurrences = append(t.repo.occurrences, o)
+	return o, nil
+}
+
+func TestMaterialiseSeriesBookingRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		now   time.Time
+		start string // the series' start time, HH:MM
+		want  map[string]string
+	}{
+		{
+			name:  "books each week",
+			now:   time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+			start: "10:00",
+			want:  map[string]string{"2026-03-11": OccurrenceBooked, "2026-03-18": OccurrenceBooked},
+		},
+		{
+			name:  "inside the lead time",
+			now:   time.Date(2026, 3, 10, 11, 0, 0, 0, time.UTC),
+			start: "10:00",
+			want:  map[string]string{"2026-03-11": OccurrenceBlocked, "2026-03-18": OccurrenceBooked},
+		},
+		{
+			name:  "not an offered start time",
+			now:   time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+			start: "10:15",
+			want:  map[string]string{"2026-03-11": OccurrenceBlocked, "2026-03-18": OccurrenceBlocked},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc, repo, params := newCheckout(0)
+			template, err := svc.CreateBookingFromCart(ctx, params)
+			require.NoError(t, err)
+			start, err := time.Parse("15:04", tt.start)
+			require.NoError(t, err)
+			repo.bookings[0].ScheduledTime = pgtype.Time{Microseconds: int64(start.Hour()*60+start.Minute()) * 60000000, Valid: true}
+			svc.now = func() time.Time { return tt.now }
+
+			series := dbpg.BookingSeries{
+				ID:                1,
+				CustomerID:        template.CustomerID,
+				TemplateBookingID: template.ID,
+				Rrule:             "FREQ=WEEKLY;COUNT=3",
+				StartDate:         template.ScheduledDate,
+				ScheduledTime:     repo.bookings[0].ScheduledTime,
+			}
+			// The template is the first occurrence, as startSeries records
+			repo.occurrences = []dbpg.BookingSeriesOccurrence{{SeriesID: 1, OccurrenceDate: template.ScheduledDate, Status: OccurrenceBooked}}
+			booked, err := svc.materialiseSeries(ctx, series, pgDateOf("2026-04-30").Time)
+			require.NoError(t, err)
+
+			got := map[string]string{}
+			for _, o := range repo.occurrences[1:] {
+				got[formatDate(o.OccurrenceDate)] = o.Status
+			}
+			assert.Equal(t, tt.want, got)
+
+			var want int
+			for _, status := range tt.want {
+				if status == OccurrenceBooked {
+					want++
+				}
+			}
+			assert.Equal(t, want, booked)
+			assert.Len(t, repo.bookings, want+1)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+)
+
+// bookingTransitions lists the booking statuses each status may move to.
+// completed, cancelled and no_show are terminal.
+var bookingTransitions = map[dbpg.BookingStatus][]dbpg.BookingStatus{
+	dbpg.BookingStatusPendingPayment: {dbpg.BookingStatusDepositPaid, dbpg.BookingStatusConfirmed, dbpg.BookingStatusCancelled, dbpg.BookingStatusNoShow},
+	dbpg.BookingStatusDepositPaid:    {dbpg.BookingStatusConfirmed, dbpg.BookingStatusInProgress, dbpg.BookingStatusCompleted, dbpg.BookingStatusCancelled, dbpg.BookingStatusNoShow},
+	dbpg.BookingStatusConfirmed:      {dbpg.BookingStatusDepositPaid, dbpg.BookingStatusInProgress, dbpg.BookingStatusCompleted, dbpg.BookingStatusCancelled, dbpg.BookingStatusNoShow},
+	dbpg.BookingStatusInProgress:     {dbpg.BookingStatusCompleted},
+	dbpg.BookingStatusCompleted:      {},
+	dbpg.BookingStatusCancelled:      {},
+	dbpg.BookingStatusNoShow:         {},
+}
+
+// paymentTransitions lists the payment statuses each status may move to. It
+// allows every move the payment ledger can make (see derivePaymentStatus),
+// since the ledger has the final say on what was paid. A fully paid booking
+// goes back to deposit_paid when services added to it leave a balance, a
+// refunded booking paid again counts as paid, money taken for a booking
+// already cancelled can be refunded, and a forfeited payment can still be
+// refunded as a goodwill gesture.
+var paymentTransitions = map[dbpg.PaymentStatus][]dbpg.PaymentStatus{
+	dbpg.PaymentStatusPending:           {dbpg.PaymentStatusDepositPaid, dbpg.PaymentStatusFullyPaid, dbpg.PaymentStatusPartiallyRefunded, dbpg.PaymentStatusRefunded},
+	dbpg.PaymentStatusDepositPaid:       {dbpg.PaymentStatusPending, dbpg.PaymentStatusFullyPaid, dbpg.PaymentStatusPartiallyRefunded, dbpg.PaymentStatusRefunded, dbpg.PaymentStatusForfeited},
+	dbpg.PaymentStatusFullyPaid:         {dbpg.PaymentStatusPending, dbpg.PaymentStatusDepositPaid, dbpg.PaymentStatusPartiallyRefunded, dbpg.PaymentStatusRefunded, dbpg.PaymentStatusForfeited},
+	dbpg.PaymentStatusPartiallyRefunded: {dbpg.PaymentStatusRefunded},
+	dbpg.PaymentStatusForfeited:         {dbpg.PaymentStatusPartiallyRefunded, dbpg.PaymentStatusRefunded},
+	dbpg.PaymentStatusRefunded:          {dbpg.PaymentStatusPending, dbpg.PaymentStatusDepositPaid, dbpg.PaymentStatusFullyPaid, dbpg.PaymentStatusPartiallyRefunded},
+}
+
+// initialStatus is the status a new booking starts in. One with a deposit to
+// pay waits for it at checkout; one without is confirmed straight away.
+func initialStatus(depositAmount int64) dbpg.BookingStatus {
+	if depositAmount > 0 {
+		return dbpg.BookingStatusPendingPayment
+	}
+	return dbpg.BookingStatusConfirmed
+}
+
+const (
+	historyFieldStatus        = "status"
+	historyFieldPaymentStatus = "payment_status"
+)
+
+// ParseBookingStatus validates a booking status supplied by a caller.
+func ParseBookingStatus(s string) (dbpg.BookingStatus, error) {
+	status := dbpg.BookingStatus(s)
+	if _, ok := bookingTransitions[status]; !ok {
+		return "", problems.New(problems.InvalidRequest, fmt.Sprintf("unknown booking status %q", s))
+	}
+	return status, nil
+}
+
+// CanTransitionBooking reports whether a booking may move from one status to another.
+func CanTransitionBooking(from, to dbpg.BookingStatus) bool {
+	return slices.Contains(bookingTransitions[from], to)
+}
+
+// CanTransitionPayment reports whether a booking's payment may move from one status to another.
+func CanTransitionPayment(from, to dbpg.PaymentStatus) bool {
+	return slices.Contains(paymentTransitions[from], to)
+}
+
+// StatusChange is a requested change to a booking's status and/or payment
+// status. Empty fields are left unchanged; ChangedBy is 0 for system changes.
+type StatusChange struct {
+	Status        dbpg.BookingStatus
+	PaymentStatus dbpg.PaymentStatus
+	ChangedBy     int64
+	Reason        string
+}
+
+// applyStatusChange locks the booking, validates the change against the state
+// machine and writes it along with its history rows. Moving to the status a
+// booking already has is a no-op, so replayed events are harmless.
+func applyStatusChange(ctx context.Context, tx BookingTx, bookingID int64, change StatusChange) (dbpg.Booking, error) {
+	booking, err := tx.GetBookingForUpdate(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return dbpg.Booking{}, problems.New(problems.NotExist, "booking not found")
+		}
+		return dbpg.Booking{}, problems.New(problems.Database, "failed to get booking", err)
+	}
+
+	updateStatus := change.Status != "" && change.Status != booking.Status
+	updatePayment := change.PaymentStatus != "" && change.PaymentStatus != booking.PaymentStatus
+
+	if updateStatus && !CanTransitionBooking(booking.Status, change.Status) {
+		return dbpg.Booking{}, problems.New(problems.InvalidRequest,
+			fmt.Sprintf("cannot change booking status from %s to %s", booking.Status, change.Status))
+	}
+	if updatePayment && !CanTransitionPayment(booking.PaymentStatus, change.PaymentStatus) {
+		return dbpg.Booking{}, problems.New(problems.InvalidRequest,
+			fmt.Sprintf("cannot change payment status from %s to %s", booking.PaymentStatus, change.PaymentStatus))
+	}
+
+	if updateStatus {
+		from := booking.Status
+		booking, err = tx.UpdateBookingStatus(ctx, dbpg.UpdateBookingStatusParams{ID: bookingID, Status: change.Status})
+		if err != nil {
+			return dbpg.Booking{}, problems.New(problems.Database, "failed to update booking status", err)
+		}
+		if err := recordStatusHistory(ctx, tx, bookingID, historyFieldStatus, string(from), string(change.Status), change); err != nil {
+			return dbpg.Booking{}, err
+		}
+	}
+
+	if updatePayment {
+		from := booking.PaymentStatus
+		booking, err = tx.UpdateBookingPaymentStatus(ctx, dbpg.UpdateBookingPaymentStatusParams{ID: bookingID, PaymentStatus: change.PaymentStatus})
+		if err != nil {
+			return dbpg.Booking{}, problems.New(problems.Database, "failed to update payment status", err)
+		}
+		if err := recordStatusHistory(ctx, tx, bookingID, historyFieldPaymentStatus, string(from), string(change.PaymentStatus), change); err != nil {
+			return dbpg.Booking{}, err
+		}
+	}
+
+	return booking, nil
+}
+
+// recordDepositPaid records amount taken at checkout for a booking, or its
+// deposit when amount is 0, and secures the booking if it was waiting on it.
+// Customers required to prepay have paid the whole amount rather than a
+// deposit. providerRef is the payment provider's ID for the payment, if known;
+// a payment already recorded under it is not recorded again. Money that
+// arrives after the booking has moved on, such as once it is cancelled, is
+// still recorded, leaving the booking's status alone.
+func recordDepositPaid(ctx context.Context, tx BookingTx, bookingID, amount int64, providerRef string) (dbpg.Booking, error) {
+	current, err := tx.GetBookingForUpdate(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return dbpg.Booking{}, problems.New(problems.NotExist, "booking not found")
+		}
+		return dbpg.Booking{}, problems.New(problems.Database, "failed to get booking", err)
+	}
+	if amount == 0 {
+		amount = current.DepositAmount
+	}
+	reason := "deposit paid"
+	if current.TotalAmount > 0 && amount >= current.TotalAmount {
+		reason = "paid in full"
+	}
+
+	// Without the provider's ID a repeat cannot be told from a second
+	// payment, so it is taken only while nothing has been paid
+	booking := current
+	if providerRef != "" || current.PaymentStatus == dbpg.PaymentStatusPending {
+		booking, err = recordPayment(ctx, tx, bookingID, PaymentEntry{
+			Kind:        dbpg.PaymentKindCharge,
+			Amount:      amount,
+			Method:      dbpg.PaymentMethodCard,
+			ProviderRef: providerRef,
+		}, reason)
+		if err != nil {
+			return dbpg.Booking{}, err
+		}
+	}
+
+	if booking.Status != dbpg.BookingStatusPendingPayment || booking.AmountPaid < booking.DepositAmount {
+		return booking, nil
+	}
+	return applyStatusChange(ctx, tx, bookingID, StatusChange{
+		Status: dbpg.BookingStatusDepositPaid,
+		Reason: reason,
+	})
+}
+
+// recordBalancePaid records a card payment towards what is left owing on a
+// booking.
+func recordBalancePaid(ctx context.Context, tx BookingTx, bookingID, amount int64, providerRef string) (dbpg.Booking, error) {
+	return recordPayment(ctx, tx, bookingID, PaymentEntry{
+		Kind:        dbpg.PaymentKindCharge,
+		Amount:      amount,
+		Method:      dbpg.PaymentMethodCard,
+		ProviderRef: providerRef,
+	}, fmt.Sprintf("balance of %s paid", formatCents(amount)))
+}
+
+// recordInitialStatus writes the history rows for a newly created booking.
+func recordInitialStatus(ctx context.Context, tx BookingTx, booking dbpg.Booking, changedBy int64, reason string) error {
+	change := StatusChange{ChangedBy: changedBy, Reason: reason}
+	if err := recordStatusHistory(ctx, tx, booking.ID, historyFieldStatus, "", string(booking.Status), change); err != nil {
+		return err
+	}
+	return recordStatusHistory(ctx, tx, booking.ID, historyFieldPaymentStatus, "", string(booking.PaymentStatus), change)
+}
+
+func recordStatusHistory(ctx context.Context, tx BookingTx, bookingID int64, field, from, to string, change StatusChange) error {
+	params := dbpg.CreateBookingStatusHistoryParams{
+		BookingID: bookingID,
+		Field:     field,
+		FromValue: dbpg.StringToPGString(from),
+		ToValue:   to,
+		Reason:    dbpg.StringToPGString(change.Reason),
+	}
+	if change.ChangedBy > 0 {
+		params.ChangedBy = pgtype.Int8{Int64: change.ChangedBy, Valid: true}
+	}
+	if _, err := tx.CreateBookingStatusHistory(ctx, params); err != nil {
+		return problems.New(problems.Database, "failed to record status history", err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanTransitionBooking(t *testing.T) {
+	tests := []struct {
+		from, to dbpg.BookingStatus
+		want     bool
+	}{
+		{dbpg.BookingStatusPendingPayment, dbpg.BookingStatusDepositPaid, true},
+		{dbpg.BookingStatusConfirmed, dbpg.BookingStatusInProgress, true},
+		{dbpg.BookingStatusConfirmed, dbpg.BookingStatusCompleted, true},
+		{dbpg.BookingStatusInProgress, dbpg.BookingStatusCompleted, true},
+		{dbpg.BookingStatusInProgress, dbpg.BookingStatusCancelled, false},
+		{dbpg.BookingStatusCancelled, dbpg.BookingStatusInProgress, false},
+		{dbpg.BookingStatusCancelled, dbpg.BookingStatusConfirmed, false},
+		{dbpg.BookingStatusCompleted, dbpg.BookingStatusCancelled, false},
+		{dbpg.BookingStatusConfirmed, dbpg.BookingStatusNoShow, true},
+		{dbpg.BookingStatusInProgress, dbpg.BookingStatusNoShow, false},
+		{dbpg.BookingStatusNoShow, dbpg.BookingStatusCancelled, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, CanTransitionBooking(tt.from, tt.to), "%s -> %s", tt.from, tt.to)
+	}
+}
+
+func TestCanTransitionPayment(t *testing.T) {
+	tests := []struct {
+		from, to dbpg.PaymentStatus
+		want     bool
+	}{
+		{dbpg.PaymentStatusPending, dbpg.PaymentStatusDepositPaid, true},
+		{dbpg.PaymentStatusDepositPaid, dbpg.PaymentStatusFullyPaid, true},
+		{dbpg.PaymentStatusFullyPaid, dbpg.PaymentStatusPartiallyRefunded, true},
+		{dbpg.PaymentStatusPartiallyRefunded, dbpg.PaymentStatusRefunded, true},
+		{dbpg.PaymentStatusPending, dbpg.PaymentStatusRefunded, true},
+		{dbpg.PaymentStatusRefunded, dbpg.PaymentStatusFullyPaid, true},
+		{dbpg.PaymentStatusRefunded, dbpg.PaymentStatusForfeited, false},
+		{dbpg.PaymentStatusFullyPaid, dbpg.PaymentStatusPending, true},
+		{dbpg.PaymentStatusPartiallyRefunded, dbpg.PaymentStatusPending, false},
+		{dbpg.PaymentStatusDepositPaid, dbpg.PaymentStatusForfeited, true},
+		{dbpg.PaymentStatusPending, dbpg.PaymentStatusForfeited, false},
+		{dbpg.PaymentStatusForfeited, dbpg.PaymentStatusRefunded, true},
+		{dbpg.PaymentStatusFullyPaid, dbpg.PaymentStatusDepositPaid, true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, CanTransitionPayment(tt.from, tt.to), "%s -> %s", tt.from, tt.to)
+	}
+}
+
+func TestParseBookingStatus(t *testing.T) {
+	status, err := ParseBookingStatus("in_progress")
+	require.NoError(t, err)
+	assert.Equal(t, dbpg.BookingStatusInProgress, status)
+
+	_, err = ParseBookingStatus("teleported")
+	assert.Error(t, err)
+}
+
+func TestDepositFor(t *testing.T) {
+	svc := NewBookingService(nil, nil)
+
+	assert.Equal(t, int64(3000), svc.depositFor(context.Background(), dbpg.CustomerProfile{NoShowCount: 1}, 10000))
+	// At the default threshold the whole amount is taken up front
+	assert.Equal(t, int64(10000), svc.depositFor(context.Background(), dbpg.CustomerProfile{NoShowCount: 2}, 10000))
+}
+
+func TestDerivePaymentStatus(t *testing.T) {
+	confirmed := dbpg.Booking{Status: dbpg.BookingStatusConfirmed, PaymentStatus: dbpg.PaymentStatusFullyPaid, DepositAmount: 3000, TotalAmount: 10000}
+	cancelled := dbpg.Booking{Status: dbpg.BookingStatusCancelled, PaymentStatus: dbpg.PaymentStatusDepositPaid, TotalAmount: 10000}
+	tests := []struct {
+		name    string
+		booking dbpg.Booking
+		totals  dbpg.SumBookingPaymentsRow
+		want    dbpg.PaymentStatus
+	}{
+		{"nothing paid yet", confirmed, dbpg.SumBookingPaymentsRow{}, dbpg.PaymentStatusPending},
+		{"deposit paid", confirmed, dbpg.SumBookingPaymentsRow{Charged: 3000}, dbpg.PaymentStatusDepositPaid},
+		{"part of the deposit", confirmed, dbpg.SumBookingPaymentsRow{Charged: 1000}, dbpg.PaymentStatusPending},
+		{"overpayment given back below the deposit", confirmed, dbpg.SumBookingPaymentsRow{Charged: 3000, Refunded: 2500}, dbpg.PaymentStatusPending},
+		{"paid in full with a tip", confirmed, dbpg.SumBookingPaymentsRow{Charged: 12000, Tips: 2000}, dbpg.PaymentStatusFullyPaid},
+		{"tip does not count towards the balance", confirmed, dbpg.SumBookingPaymentsRow{Charged: 10000, Tips: 2000}, dbpg.PaymentStatusDepositPaid},
+		{"adjusted up to the total", confirmed, dbpg.SumBookingPaymentsRow{Charged: 3000, Adjusted: 7000}, dbpg.PaymentStatusFullyPaid},
+		{"overpayment given back", confirmed, dbpg.SumBookingPaymentsRow{Charged: 12000, Refunded: 2000}, dbpg.PaymentStatusFullyPaid},
+		{"cancelled, nothing returned yet", cancelled, dbpg.SumBookingPaymentsRow{Charged: 3000}, dbpg.PaymentStatusDepositPaid},
+		{"cancelled, part returned", cancelled, dbpg.SumBookingPaymentsRow{Charged: 3000, Refunded: 1500}, dbpg.PaymentStatusPartiallyRefunded},
+		{"cancelled, all returned", cancelled, dbpg.SumBookingPaymentsRow{Charged: 3000, Refunded: 3000}, dbpg.PaymentStatusRefunded},
+		{"goodwill refund once done", dbpg.Booking{Status: dbpg.BookingStatusCompleted, TotalAmount: 10000}, dbpg.SumBookingPaymentsRow{Charged: 10000, Refunded: 2000}, dbpg.PaymentStatusPartiallyRefunded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, derivePaymentStatus(tt.booking, tt.totals))
+		})
+	}
+}
@@ -0,0 +1,478 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tierRepo struct {
+	BookingRepository
+	services map[int64]dbpg.Service
+	tiers    map[[2]int64]dbpg.GetPriceTierRow
+}
+
+func (r *tierRepo) GetServiceByID(ctx context.Context, id int64) (dbpg.Service, error) {
+	svc, ok := r.services[id]
+	if !ok {
+		return dbpg.Service{}, ErrNoRecord
+	}
+	return svc, nil
+}
+
+func (r *tierRepo) GetPriceTier(ctx context.Context, serviceID, vehicleCategoryID int64) (dbpg.GetPriceTierRow, error) {
+	tier, ok := r.tiers[[2]int64{serviceID, vehicleCategoryID}]
+	if !ok {
+		return dbpg.GetPriceTierRow{}, ErrNoRecord
+	}
+	return tier, nil
+}
+
+func TestQuoteItemsTiers(t *testing.T) {
+	const sedan, fourWD, suv = 1, 2, 3
+	repo := &tierRepo{
+		services: map[int64]dbpg.Service{
+			10: {ID: 10, Name: "Full detail", BasePrice: 20000, DurationMinutes: 180},
+			11: {ID: 11, Name: "Wash", BasePrice: 5000, DurationMinutes: 45},
+		},
+		tiers: map[[2]int64]dbpg.GetPriceTierRow{
+			{10, fourWD}: {Price: 30000, DurationMinutes: pgtype.Int4{Int32: 300, Valid: true}},
+			{10, suv}:    {Price: 25000},
+		},
+	}
+	svc := NewBookingService(repo, nil)
+	items := []quoteItem{{serviceID: 10, quantity: 1}, {serviceID: 11, quantity: 1}}
+
+	tests := []struct {
+		name     string
+		category int64
+		subtotal int64
+		duration int32
+	}{
+		{"no tier", sedan, 25000, 225},
+		{"tier sets price and duration", fourWD, 35000, 345},
+		{"tier sets price only", suv, 30000, 225},
+		{"no vehicle", 0, 25000, 225},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quote, err := svc.quoteItems(context.Background(), items, tt.category)
+			require.NoError(t, err)
+			assert.Equal(t, tt.subtotal, quote.subtotal)
+			assert.Equal(t, tt.duration, quote.duration)
+		})
+	}
+}
+
+func TestQuoteItemsOptions(t *testing.T) {
+	repo := &tierRepo{
+		services: map[int64]dbpg.Service{
+			10: {ID: 10, Name: "Full detail", BasePrice: 20000, DurationMinutes: 180},
+		},
+	}
+	svc := NewBookingService(repo, nil)
+	clay := quoteOption{id: 1, serviceID: 10, name: "Clay bar", price: 4000, duration: 30, active: true}
+
+	tests := []struct {
+		name     string
+		quantity int32
+		option   quoteOption
+		subtotal int64
+		duration int32
+		lines    int
+		invalid  bool
+	}{
+		{name: "one unit", quantity: 1, option: clay, subtotal: 24000, duration: 210, lines: 1},
+		{name: "per unit of quantity", quantity: 2, option: clay, subtotal: 48000, duration: 420, lines: 2},
+		{name: "another service's option", quantity: 1, option: quoteOption{id: 2, serviceID: 11, name: "Tyre shine", price: 1000, duration: 10, active: true}, invalid: true},
+		{name: "inactive option", quantity: 1, option: quoteOption{id: 3, serviceID: 10, name: "Ceramic coat", price: 9000, duration: 60}, invalid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := []quoteItem{{serviceID: 10, quantity: tt.quantity, options: []quoteOption{tt.option}}}
+			quote, err := svc.quoteItems(context.Background(), items, 0)
+			if tt.invalid {
+				var p problems.Problem
+				require.ErrorAs(t, err, &p)
+				assert.Equal(t, problems.InvalidRequest, p.Kind)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.subtotal, quote.subtotal)
+			assert.Equal(t, tt.duration, quote.duration)
+			require.Len(t, quote.lines, tt.lines)
+			for _, line := range quote.lines {
+				require.Len(t, line.options, 1)
+				assert.Equal(t, tt.option.price, line.options[0].PriceAtBooking)
+				assert.Equal(t, tt.option.duration, line.options[0].DurationAtBooking)
+			}
+		})
+	}
+}
+
+// checkoutRepo serves one customer's cart for checkout and keeps their
+// bookings in memory. What a transaction writes is undone if it fails.
+type checkoutRepo struct {
+	BookingRepository
+	customer dbpg.CustomerProfile
+	services map[int64]dbpg.Service
+	items    []dbpg.ListCartItemsRow
+	conflict bool // the booking insert hits the overlap constraint
+	bookings []dbpg.Booking
+	lines    []dbpg.BookingService // one per unit of a service
+	lineSeq  int64                 // like an identity column, not rolled back
+	cleared  []int64
+
+	occurrences []dbpg.BookingSeriesOccurrence
+}
+
+func (r *checkoutRepo) GetCustomerProfileByUserID(ctx context.Context, userID int64) (dbpg.CustomerProfile, error) {
+	if userID != r.customer.UserID {
+		return dbpg.CustomerProfile{}, ErrNoRecord
+	}
+	return r.customer, nil
+}
+
+func (r *checkoutRepo) GetCartByUserID(ctx context.Context, userID int64) (dbpg.CartSession, error) {
+	return dbpg.CartSession{ID: 1, UserID: pgtype.Int8{Int64: userID, Valid: true}}, nil
+}
+
+func (r *checkoutRepo) ListCartItems(ctx context.Context, cartSessionID int64) ([]dbpg.ListCartItemsRow, error) {
+	return r.items, nil
+}
+
+func (r *checkoutRepo) ListCartItemOptionsBySession(ctx context.Context, cartSessionID int64) ([]dbpg.ListCartItemOptionsBySessionRow, error) {
+	return nil, nil
+}
+
+func (r *checkoutRepo) GetServiceByID(ctx context.Context, id int64) (dbpg.Service, error) {
+	svc, ok := r.services[id]
+	if !ok {
+		return dbpg.Service{}, ErrNoRecord
+	}
+	return svc, nil
+}
+
+func (r *checkoutRepo) GetBookingByID(ctx context.Context, id int64) (dbpg.GetBookingByIDRow, error) {
+	for _, b := range r.bookings {
+		if b.ID == id {
+			return dbpg.GetBookingByIDRow{
+				ID:                    b.ID,
+				CustomerID:            b.CustomerID,
+				ScheduledDate:         b.ScheduledDate,
+				ScheduledTime:         b.ScheduledTime,
+				EstimatedDurationMins: b.EstimatedDurationMins,
+				Status:                b.Status,
+				PaymentStatus:         b.PaymentStatus,
+				Subtotal:              b.Subtotal,
+				DepositAmount:         b.DepositAmount,
+				TotalAmount:           b.TotalAmount,
+				CustomerUserID:        r.customer.UserID,
+			}, nil
+		}
+	}
+	return dbpg.GetBookingByIDRow{}, ErrNoRecord
+}
+
+func (r *checkoutRepo) ListBookingServices(ctx context.Context, bookingID int64) ([]dbpg.ListBookingServicesRow, error) {
+	return (&checkoutTx{repo: r}).ListBookingServices(ctx, bookingID)
+}
+
+func (r *checkoutRepo) WithTx(ctx context.Context, fn func(tx BookingTx) error) error {
+	bookings, lines, cleared := slices.Clone(r.bookings), slices.Clone(r.lines), slices.Clone(r.cleared)
+	if err := fn(&checkoutTx{repo: r}); err != nil {
+		r.bookings, r.lines, r.cleared = bookings, lines, cleared
+		return err
+	}
+	return nil
+}
+
+// checkoutTx is a day open 08:00-17:00 with one bay.
+type checkoutTx struct {
+	BookingTx
+	repo *checkoutRepo
+}
+
+func (t *checkoutTx) LockScheduleDate(ctx context.Context, date pgtype.Date) error { return nil }
+
+func (t *checkoutTx) IsDateBlackedOut(ctx context.Context, params dbpg.IsDateBlackedOutParams) (bool, error) {
+	return false, nil
+}
+
+func (t *checkoutTx) GetScheduleConfigForDay(ctx context.Context, dayOfWeek int32) (dbpg.ScheduleConfig, error) {
+	return dbpg.ScheduleConfig{
+		DayOfWeek: dayOfWeek,
+		OpenTime:  pgtype.Time{Microseconds: 8 * 60 * 60000000, Valid: true},
+		CloseTime: pgtype.Time{Microseconds: 17 * 60 * 60000000, Valid: true},
+		IsOpen:    true,
+	}, nil
+}
+
+func (t *checkoutTx) ListScheduleOverridesForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ScheduleOverride, error) {
+	return nil, nil
+}
+
+func (t *checkoutTx) ListScheduleWindowsForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ScheduleWindow, error) {
+	return nil, nil
+}
+
+func (t *checkoutTx) ListActiveResources(ctx context.Context) ([]dbpg.Resource, error) {
+	return []dbpg.Resource{{ID: 1, Name: "Bay 1", ResourceType: "bay", IsActive: true}}, nil
+}
+
+func (t *checkoutTx) ListResourceHoursForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ResourceHour, error) {
+	return nil, nil
+}
+
+func (t *checkoutTx) ListBookingsForDate(ctx context.Context, params dbpg.ListBookingsForDateParams) ([]dbpg.Booking, error) {
+	var bookings []dbpg.Booking
+	for _, b := range t.repo.bookings {
+		if b.ScheduledDate == params.ScheduledDate && b.Status != dbpg.BookingStatusCancelled {
+			bookings = append(bookings, b)
+		}
+	}
+	return bookings, nil
+}
+
+func (t *checkoutTx) ListActiveSlotHoldsForDate(ctx context.Context, date pgtype.Date) ([]dbpg.SlotHold, error) {
+	return nil, nil
+}
+
+func (t *checkoutTx) HasActiveStaff(ctx context.Context) (bool, error) { return false, nil }
+
+func (t *checkoutTx) DeleteCheckoutHoldsForCustomer(ctx context.Context, customerID int64) ([]dbpg.SlotHold, error) {
+	return nil, nil
+}
+
+func (t *checkoutTx) CreateBooking(ctx context.Context, params dbpg.CreateBookingParams) (dbpg.Booking, error) {
+	if t.repo.conflict {
+		return dbpg.Booking{}, ErrConflict
+	}
+	b := dbpg.Booking{
+		ID:                    int64(len(t.repo.bookings) + 1),
+		CustomerID:            params.CustomerID,
+		ScheduledDate:         params.ScheduledDate,
+		ScheduledTime:         params.ScheduledTime,
+		EstimatedDurationMins: params.EstimatedDurationMins,
+		Status:                params.Status,
+		PaymentStatus:         params.PaymentStatus,
+		Subtotal:              params.Subtotal,
+		DepositAmount:         params.DepositAmount,
+		TotalAmount:           params.TotalAmount,
+		ResourceID:            params.ResourceID,
+	}
+	t.repo.bookings = append(t.repo.bookings, b)
+	return b, nil
+}
+
+func (t *checkoutTx) RescheduleBooking(ctx context.Context, params dbpg.RescheduleBookingParams) (dbpg.Booking, error) {
+	for i, b := range t.repo.bookings {
+		if b.ID == params.ID {
+			b.ScheduledDate = params.ScheduledDate
+			b.ScheduledTime = params.ScheduledTime
+			b.ResourceID = params.ResourceID
+			t.repo.bookings[i] = b
+			return b, nil
+		}
+	}
+	return dbpg.Booking{}, ErrNoRecord
+}
+
+func (t *checkoutTx) GetBookingForUpdate(ctx context.Context, id int64) (dbpg.Booking, error) {
+	for _, b := range t.repo.bookings {
+		if b.ID == id {
+			return b, nil
+		}
+	}
+	return dbpg.Booking{}, ErrNoRecord
+}
+
+func (t *checkoutTx) UpdateBookingPricing(ctx context.Context, params dbpg.UpdateBookingPricingParams) (dbpg.Booking, error) {
+	for i, b := range t.repo.bookings {
+		if b.ID == params.ID {
+			b.Subtotal = params.Subtotal
+			b.TotalAmount = params.TotalAmount
+			b.EstimatedDurationMins = params.EstimatedDurationMins
+			b.ResourceID = params.ResourceID
+			t.repo.bookings[i] = b
+			return b, nil
+		}
+	}
+	return dbpg.Booking{}, ErrNoRecord
+}
+
+func (t *checkoutTx) CreateBookingStatusHistory(ctx context.Context, params dbpg.CreateBookingStatusHistoryParams) (dbpg.BookingStatusHistory, error) {
+	return dbpg.BookingStatusHistory{}, nil
+}
+
+func (t *checkoutTx) CreateBookingService(ctx context.Context, params dbpg.CreateBookingServiceParams) (dbpg.BookingService, error) {
+	t.repo.lineSeq++
+	bs := dbpg.BookingService{
+		ID:                t.repo.lineSeq,
+		BookingID:         params.BookingID,
+		ServiceID:         params.ServiceID,
+		PriceAtBooking:    params.PriceAtBooking,
+		DurationAtBooking: params.DurationAtBooking,
+	}
+	t.repo.lines = append(t.repo.lines, bs)
+	return bs, nil
+}
+
+func (t *checkoutTx) ListBookingServices(ctx context.Context, bookingID int64) ([]dbpg.ListBookingServicesRow, error) {
+	var rows []dbpg.ListBookingServicesRow
+	for _, bs := range t.repo.lines {
+		if bs.BookingID == bookingID {
+			rows = append(rows, dbpg.ListBookingServicesRow{
+				ID:                bs.ID,
+				BookingID:         bs.BookingID,
+				ServiceID:         bs.ServiceID,
+				PriceAtBooking:    bs.PriceAtBooking,
+				DurationAtBooking: bs.DurationAtBooking,
+				ServiceName:       t.repo.services[bs.ServiceID].Name,
+			})
+		}
+	}
+	return rows, nil
+}
+
+func (t *checkoutTx) GetBookingService(ctx context.Context, params dbpg.GetBookingServiceParams) (dbpg.BookingService, error) {
+	for _, bs := range t.repo.lines {
+		if bs.ID == params.ID && bs.BookingID == params.BookingID {
+			return bs, nil
+		}
+	}
+	return dbpg.BookingService{}, ErrNoRecord
+}
+
+func (t *checkoutTx) ListBookingServiceOptions(ctx context.Context, bookingServiceID int64) ([]dbpg.ListBookingServiceOptionsRow, error) {
+	return nil, nil
+}
+
+func (t *checkoutTx) DeleteBookingService(ctx context.Context, id int64) error {
+	t.repo.lines = slices.DeleteFunc(t.repo.lines, func(bs dbpg.BookingService) bool { return bs.ID == id })
+	return nil
+}
+
+func (t *checkoutTx) SumBookingPayments(ctx context.Context, bookingID int64) (dbpg.SumBookingPaymentsRow, error) {
+	return dbpg.SumBookingPaymentsRow{}, nil
+}
+
+func (t *checkoutTx) ClearCart(ctx context.Context, cartSessionID int64) error {
+	t.repo.cleared = append(t.repo.cleared, cartSessionID)
+	return nil
+}
+
+// newCheckout is a customer with a full detail in their cart, checking out on
+// a Wednesday two days ahead.
+func newCheckout(noShows int32) (*BookingService, *checkoutRepo, CreateBookingFromCartParams) {
+	repo := &checkoutRepo{
+		customer: dbpg.CustomerProfile{ID: 3, UserID: 5, NoShowCount: noShows},
+		services: map[int64]dbpg.Service{
+			10: {ID: 10, Name: "Full detail", BasePrice: 20000, DurationMinutes: 180, ResourceTypes: []string{"bay"}},
+		},
+		items: []dbpg.ListCartItemsRow{{ID: 1, CartSessionID: 1, ServiceID: 10, Quantity: 1}},
+	}
+	svc := NewBookingService(repo, nil)
+	svc.now = func() time.Time { return time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC) }
+	return svc, repo, CreateBookingFromCartParams{UserID: 5, ScheduledDate: "2026-03-04", ScheduledTime: "10:00"}
+}
+
+func TestCreateBookingFromCart(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, params := newCheckout(0)
+
+	booking, err := svc.CreateBookingFromCart(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, dbpg.BookingStatusPendingPayment, booking.Status)
+	assert.Equal(t, int64(6000), booking.DepositAmount)
+	assert.Len(t, repo.lines, 1)
+	assert.Equal(t, []int64{1}, repo.cleared)
+}
+
+func TestCreateBookingFromCartPrepay(t *testing.T) {
+	svc, _, params := newCheckout(defaultNoShowPrepayThreshold)
+
+	// A customer who keeps missing bookings pays for the whole job up front
+	booking, err := svc.CreateBookingFromCart(context.Background(), params)
+	require.NoError(t, err)
+	assert.Equal(t, dbpg.BookingStatusPendingPayment, booking.Status)
+	assert.Equal(t, int64(20000), booking.DepositAmount)
+	assert.Equal(t, booking.TotalAmount, booking.DepositAmount)
+}
+
+func TestCreateBookingFromCartConflict(t *testing.T) {
+	svc, repo, params := newCheckout(0)
+	repo.conflict = true
+
+	_, err := svc.CreateBookingFromCart(context.Background(), params)
+	var p problems.Problem
+	require.ErrorAs(t, err, &p)
+	assert.Equal(t, problems.Exist, p.Kind)
+
+	// The cart is kept and nothing of the booking is left behind
+	assert.Empty(t, repo.bookings)
+	assert.Empty(t, repo.lines)
+	assert.Empty(t, repo.cleared)
+}
+
+func TestRescheduleBooking(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, params := newCheckout(0)
+	booking, err := svc.CreateBookingFromCart(ctx, params)
+	require.NoError(t, err)
+
+	// Another customer has the bay from 14:00
+	repo.bookings = append(repo.bookings, dbpg.Booking{
+		ID:                    2,
+		CustomerID:            4,
+		ScheduledDate:         booking.ScheduledDate,
+		ScheduledTime:         pgtype.Time{Microseconds: 14 * 60 * 60000000, Valid: true},
+		EstimatedDurationMins: 120,
+		Status:                dbpg.BookingStatusConfirmed,
+		ResourceID:            booking.ResourceID,
+	})
+
+	tests := []struct {
+		name   string
+		date   string
+		time   string
+		status dbpg.BookingStatus
+		kind   problems.Kind // zero if the move goes ahead
+	}{
+		{name: "free slot", date: "2026-03-05", time: "13:00", status: dbpg.BookingStatusPendingPayment},
+		{name: "taken slot", date: "2026-03-04", time: "12:00", status: dbpg.BookingStatusPendingPayment, kind: problems.Exist},
+		{name: "cancelled", date: "2026-03-05", time: "09:00", status: dbpg.BookingStatusCancelled, kind: problems.InvalidRequest},
+		{name: "completed", date: "2026-03-05", time: "09:00", status: dbpg.BookingStatusCompleted, kind: problems.InvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo.bookings[0].Status = tt.status
+			before := repo.bookings[0]
+
+			moved, err := svc.RescheduleBooking(ctx, RescheduleBookingParams{
+				UserID:        5,
+				BookingID:     booking.ID,
+				ScheduledDate: tt.date,
+				ScheduledTime: tt.time,
+			})
+			if tt.kind != 0 {
+				var p problems.Problem
+				require.ErrorAs(t, err, &p)
+				assert.Equal(t, tt.kind, p.Kind)
+				assert.Equal(t, before, repo.bookings[0])
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.date, formatDate(moved.ScheduledDate))
+			assert.Equal(t, tt.time, formatTime(moved.ScheduledTime))
+			assert.Equal(t, *moved, repo.bookings[0])
+		})
+	}
+}
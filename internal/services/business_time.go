@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/settings"
+)
+
+// DefaultTimezone is used when no business timezone has been configured.
+const DefaultTimezone = "Australia/Perth"
+
+// loadTimezone reads the business timezone, an IANA name stored under
+// booking:timezone, falling back to the default if it is missing or unknown.
+func loadTimezone(ctx context.Context, s *settings.Service) *time.Location {
+	name := DefaultTimezone
+	if s != nil {
+		if v, err := s.GetString(ctx, "booking", "timezone", settings.SystemScope()); err == nil && v != "" {
+			name = v
+		}
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		loc, err = time.LoadLocation(DefaultTimezone)
+		if err != nil {
+			return time.UTC
+		}
+	}
+	return loc
+}
+
+// businessClock relates the current instant to the business's calendar.
+// Booking dates and times are stored without a zone and are wall-clock
+// values in the business timezone; dates are carried as midnight UTC, as
+// they come out of the database.
+type businessClock struct {
+	loc *time.Location
+	now time.Time
+}
+
+func newBusinessClock(loc *time.Location, now time.Time) businessClock {
+	return businessClock{loc: loc, now: now.In(loc)}
+}
+
+// today is the business's current date.
+func (c businessClock) today() time.Time {
+	return dateOf(c.now)
+}
+
+// dateOf returns the calendar date of t in its own location.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// at returns the instant of a wall-clock time on date, mins after midnight.
+// Times skipped by a daylight saving change resolve to the same clock
+// reading after the change.
+func (c businessClock) at(date time.Time, mins int32) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), int(mins/60), int(mins%60), 0, 0, c.loc)
+}
+
+// bookingStart is the instant a booking is scheduled to start.
+func (c businessClock) bookingStart(b dbpg.Booking) time.Time {
+	return c.at(b.ScheduledDate.Time, minutesOf(b.ScheduledTime))
+}
+
+// clock returns the business clock as of now.
+func (s *BookingService) clock(ctx context.Context) businessClock {
+	return newBusinessClock(loadTimezone(ctx, s.settings), s.now())
+}
+
+// Today returns the business's current date.
+func (s *BookingService) Today(ctx context.Context) time.Time {
+	return s.clock(ctx).today()
+}
+
+// clock returns the business clock as of now.
+func (s *ScheduleService) clock(ctx context.Context) businessClock {
+	return newBusinessClock(loadTimezone(ctx, s.settings), s.now())
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func TestBusinessClockToday(t *testing.T) {
+	perth := mustLoadLocation(t, "Australia/Perth")
+
+	// 17:30 UTC is already the next morning in Perth
+	clock := newBusinessClock(perth, time.Date(2030, 3, 4, 17, 30, 0, 0, time.UTC))
+	assert.Equal(t, pgDateOf("2030-03-05").Time, clock.today())
+}
+
+func TestBusinessClockAcrossDST(t *testing.T) {
+	sydney := mustLoadLocation(t, "Australia/Sydney")
+	rules := DefaultBookingRules.forJob(nil)
+
+	// Daylight saving ends at 03:00 on Sunday 7 April 2030, a 25-hour day
+	clock := newBusinessClock(sydney, time.Date(2030, 4, 6, 9, 0, 0, 0, sydney))
+	sunday := pgDateOf("2030-04-07").Time
+	assert.Equal(t, 25*time.Hour, clock.at(sunday, 9*60).Sub(clock.now))
+
+	// 08:00 Sunday is exactly 24 hours away, 07:30 is not
+	assert.NoError(t, rules.checkStart(clock, sunday, 8*60))
+	assert.Error(t, rules.checkStart(clock, sunday, 7*60+30))
+
+	// Daylight saving starts at 02:00 on Sunday 6 October 2030, a 23-hour day
+	clock = newBusinessClock(sydney, time.Date(2030, 10, 5, 9, 0, 0, 0, sydney))
+	sunday = pgDateOf("2030-10-06").Time
+	assert.Error(t, rules.checkStart(clock, sunday, 9*60))
+	assert.NoError(t, rules.checkStart(clock, sunday, 10*60))
+
+	// 02:30 does not exist that morning and reads as 03:30
+	assert.Equal(t, 3, clock.at(sunday, 2*60+30).Hour())
+
+	b := dbpg.Booking{ScheduledDate: pgDateOf("2030-10-07"), ScheduledTime: pgTimeOf(9, 0)}
+	assert.Equal(t, 47*time.Hour, clock.bookingStart(b).Sub(clock.now))
+}
@@ -0,0 +1,277 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/ics"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/settings"
+)
+
+// Calendar feed scopes.
+const (
+	CalendarFeedCustomer = "customer" // the user's own bookings
+	CalendarFeedStaff    = "staff"    // every booking, for staff and admins
+)
+
+// A feed covers bookings from a month ago to a year ahead.
+const (
+	calendarFeedPastDays  = 30
+	calendarFeedAheadDays = 365
+)
+
+type CalendarFeedRepository interface {
+	GetCalendarFeedForUser(ctx context.Context, userID int64, scope string) (dbpg.CalendarFeed, error)
+	GetCalendarFeedByToken(ctx context.Context, token string) (dbpg.CalendarFeed, error)
+	SetCalendarFeedToken(ctx context.Context, params dbpg.SetCalendarFeedTokenParams) (dbpg.CalendarFeed, error)
+	ListCalendarBookings(ctx context.Context, params dbpg.ListCalendarBookingsParams) ([]dbpg.ListCalendarBookingsRow, error)
+	ListBookingServiceNames(ctx context.Context, bookingIDs []int64) ([]dbpg.ListBookingServiceNamesRow, error)
+	GetStaffByUserID(ctx context.Context, userID int64) (dbpg.Staff, error)
+	GetCustomerProfileByUserID(ctx context.Context, userID int64) (dbpg.CustomerProfile, error)
+}
+
+// CalendarFeedService issues and renders read-only iCalendar feeds of
+// bookings. A feed is fetched by calendar apps without a session, so its URL
+// carries a token that can be reset if it leaks.
+type CalendarFeedService struct {
+	repo     CalendarFeedRepository
+	authz    *AuthzSvc
+	settings *settings.Service
+	BaseURL  string // used to build feed URLs and event UIDs
+	now      func() time.Time
+}
+
+func NewCalendarFeedService(repo CalendarFeedRepository, authz *AuthzSvc, settingsService *settings.Service) *CalendarFeedService {
+	return &CalendarFeedService{repo: repo, authz: authz, settings: settingsService, now: time.Now}
+}
+
+// GetCalendarFeedURL returns the caller's feed URL for scope, issuing a token
+// the first time it is asked for.
+func (s *CalendarFeedService) GetCalendarFeedURL(ctx context.Context, userID int64, scope string) (string, error) {
+	if err := s.checkScope(ctx, userID, scope); err != nil {
+		return "", err
+	}
+	feed, err := s.repo.GetCalendarFeedForUser(ctx, userID, scope)
+	if err == nil {
+		return s.feedURL(feed.Token), nil
+	}
+	if !errors.Is(err, ErrNoRecord) {
+		return "", problems.New(problems.Database, "failed to get calendar feed", err)
+	}
+	return s.issueToken(ctx, userID, scope)
+}
+
+// ResetCalendarFeedURL replaces the caller's feed token, so the old URL stops
+// working.
+func (s *CalendarFeedService) ResetCalendarFeedURL(ctx context.Context, userID int64, scope string) (string, error) {
+	if err := s.checkScope(ctx, userID, scope); err != nil {
+		return "", err
+	}
+	return s.issueToken(ctx, userID, scope)
+}
+
+func (s *CalendarFeedService) issueToken(ctx context.Context, userID int64, scope string) (string, error) {
+	token, err := generateURLToken()
+	if err != nil {
+		return "", problems.New(problems.Internal, "failed to generate feed token", err)
+	}
+	feed, err := s.repo.SetCalendarFeedToken(ctx, dbpg.SetCalendarFeedTokenParams{
+		UserID: userID,
+		Scope:  scope,
+		Token:  token,
+	})
+	if err != nil {
+		return "", problems.New(problems.Database, "failed to save calendar feed", err)
+	}
+	return s.feedURL(feed.Token), nil
+}
+
+func (s *CalendarFeedService) feedURL(token string) string {
+	return strings.TrimRight(s.BaseURL, "/") + "/calendar/" + token + ".ics"
+}
+
+// checkScope verifies the user may have a feed of scope. Staff feeds are for
+// active staff and admins.
+func (s *CalendarFeedService) checkScope(ctx context.Context, userID int64, scope string) error {
+	switch scope {
+	case CalendarFeedCustomer:
+		return nil
+	case CalendarFeedStaff:
+	default:
+		return problems.New(problems.InvalidRequest, fmt.Sprintf("unknown calendar feed %q", scope))
+	}
+
+	st, err := s.repo.GetStaffByUserID(ctx, userID)
+	if err == nil && st.IsActive {
+		return nil
+	}
+	if err != nil && !errors.Is(err, ErrNoRecord) {
+		return problems.New(problems.Database, "failed to get staff member", err)
+	}
+	isAdmin, err := s.authz.IsSystemAdmin(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return problems.New(problems.Unauthorized, "only staff can subscribe to the staff calendar")
+	}
+	return nil
+}
+
+// CalendarFeed renders the feed a token was issued for. Access is checked
+// again on every fetch, so a staff member who leaves loses the feed.
+func (s *CalendarFeedService) CalendarFeed(ctx context.Context, token string) ([]byte, error) {
+	feed, err := s.repo.GetCalendarFeedByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.NotExist, "calendar feed not found")
+		}
+		return nil, problems.New(problems.Database, "failed to get calendar feed", err)
+	}
+	if err := s.checkScope(ctx, feed.UserID, feed.Scope); err != nil {
+		return nil, problems.New(problems.NotExist, "calendar feed not found")
+	}
+
+	clock := newBusinessClock(loadTimezone(ctx, s.settings), s.now())
+	today := clock.today()
+	params := dbpg.ListCalendarBookingsParams{
+		DateFrom: pgtype.Date{Time: today.AddDate(0, 0, -calendarFeedPastDays), Valid: true},
+		DateTo:   pgtype.Date{Time: today.AddDate(0, 0, calendarFeedAheadDays), Valid: true},
+	}
+	cal := ics.Calendar{Name: "40 Degrees Bookings"}
+
+	if feed.Scope == CalendarFeedCustomer {
+		customer, err := s.repo.GetCustomerProfileByUserID(ctx, feed.UserID)
+		if err != nil {
+			if errors.Is(err, ErrNoRecord) {
+				return cal.Bytes(), nil
+			}
+			return nil, problems.New(problems.Database, "failed to get customer profile", err)
+		}
+		params.CustomerID = pgtype.Int8{Int64: customer.ID, Valid: true}
+		params.Statuses = bookingStatusNames(
+			dbpg.BookingStatusPendingPayment, dbpg.BookingStatusDepositPaid, dbpg.BookingStatusConfirmed,
+			dbpg.BookingStatusInProgress, dbpg.BookingStatusCompleted, dbpg.BookingStatusCancelled,
+			dbpg.BookingStatusNoShow)
+		cal.Name = "My 40 Degrees Bookings"
+	} else {
+		// Unpaid bookings are not yet commitments, so stay off the staff
+		// calendar. Cancelled ones are listed so calendars drop them.
+		params.Statuses = bookingStatusNames(
+			dbpg.BookingStatusDepositPaid, dbpg.BookingStatusConfirmed, dbpg.BookingStatusInProgress,
+			dbpg.BookingStatusCompleted, dbpg.BookingStatusCancelled, dbpg.BookingStatusNoShow)
+	}
+
+	rows, err := s.repo.ListCalendarBookings(ctx, params)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list bookings", err)
+	}
+	ids := make([]int64, len(rows))
+	for i, r := range rows {
+		ids[i] = r.ID
+	}
+	names, err := s.repo.ListBookingServiceNames(ctx, ids)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list booking services", err)
+	}
+	servicesByBooking := make(map[int64][]string)
+	for _, n := range names {
+		servicesByBooking[n.BookingID] = append(servicesByBooking[n.BookingID], n.ServiceName)
+	}
+
+	host := eventHost(s.BaseURL)
+	for _, r := range rows {
+		cal.Events = append(cal.Events, bookingEvent(clock, host, dbpg.GetBookingByIDRow(r), servicesByBooking[r.ID]))
+	}
+	return cal.Bytes(), nil
+}
+
+func bookingStatusNames(statuses ...dbpg.BookingStatus) []string {
+	names := make([]string, len(statuses))
+	for i, st := range statuses {
+		names[i] = string(st)
+	}
+	return names
+}
+
+// eventHost is the domain event UIDs are qualified with, so they are unique
+// across calendars.
+func eventHost(baseURL string) string {
+	if u, err := url.Parse(baseURL); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+	return "40degrees"
+}
+
+// bookingEvent describes a booking as a calendar event. The UID is derived
+// from the booking ID and its sequence from the booking's, so an updated
+// event replaces the one a calendar already has.
+func bookingEvent(c businessClock, host string, b dbpg.GetBookingByIDRow, services []string) ics.Event {
+	start := c.at(b.ScheduledDate.Time, minutesOf(b.ScheduledTime))
+	vehicle := joinText(" ", b.VehicleMake, b.VehicleModel)
+
+	summary := "Car detailing"
+	if len(services) > 0 {
+		summary = strings.Join(services, ", ")
+	}
+	if vehicle != "" {
+		summary += " - " + vehicle
+	}
+
+	var desc []string
+	if len(services) > 0 {
+		desc = append(desc, "Services: "+strings.Join(services, ", "))
+	}
+	if vehicle != "" {
+		if b.VehicleRego.Valid && b.VehicleRego.String != "" {
+			vehicle += " (" + b.VehicleRego.String + ")"
+		}
+		desc = append(desc, "Vehicle: "+vehicle)
+	}
+	desc = append(desc, "Customer: "+b.CustomerName)
+	if b.CustomerPhone.Valid && b.CustomerPhone.String != "" {
+		desc = append(desc, "Phone: "+b.CustomerPhone.String)
+	}
+	desc = append(desc, "Email: "+b.CustomerEmail)
+	if b.Notes.Valid && b.Notes.String != "" {
+		desc = append(desc, "Notes: "+b.Notes.String)
+	}
+
+	status := ics.StatusConfirmed
+	switch b.Status {
+	case dbpg.BookingStatusPendingPayment:
+		status = ics.StatusTentative
+	case dbpg.BookingStatusCancelled:
+		status = ics.StatusCancelled
+	}
+
+	return ics.Event{
+		UID:         fmt.Sprintf("booking-%d@%s", b.ID, host),
+		Sequence:    b.Sequence,
+		Stamp:       b.UpdatedAt.Time,
+		Start:       start,
+		End:         start.Add(time.Duration(b.EstimatedDurationMins) * time.Minute),
+		Summary:     summary,
+		Description: strings.Join(desc, "\n"),
+		Location:    joinText(", ", b.ServiceAddress, b.ServiceSuburb, b.ServicePostcode),
+		Status:      status,
+	}
+}
+
+// joinText joins the non-empty values with sep.
+func joinText(sep string, values ...pgtype.Text) string {
+	var parts []string
+	for _, v := range values {
+		if v.Valid && v.String != "" {
+			parts = append(parts, v.String)
+		}
+	}
+	return strings.Join(parts, sep)
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/ics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookingEvent(t *testing.T) {
+	perth := mustLoadLocation(t, DefaultTimezone)
+	clock := newBusinessClock(perth, time.Date(2030, 1, 6, 9, 0, 0, 0, perth))
+	b := dbpg.GetBookingByIDRow{
+		ID:                    42,
+		ScheduledDate:         pgDateOf("2030-01-10"),
+		ScheduledTime:         pgTimeOf(9, 30),
+		EstimatedDurationMins: 90,
+		Status:                dbpg.BookingStatusConfirmed,
+		Sequence:              3,
+		ServiceAddress:        pgtype.Text{String: "1 Hay St", Valid: true},
+		ServiceSuburb:         pgtype.Text{String: "Perth", Valid: true},
+		CustomerName:          "Sam Lee",
+		CustomerEmail:         "sam@example.com",
+		CustomerPhone:         pgtype.Text{String: "0400 000 000", Valid: true},
+		VehicleMake:           pgtype.Text{String: "Mazda", Valid: true},
+		VehicleModel:          pgtype.Text{String: "CX-5", Valid: true},
+		VehicleRego:           pgtype.Text{String: "1ABC234", Valid: true},
+	}
+
+	e := bookingEvent(clock, eventHost("https://book.example.com/"), b, []string{"Full detail", "Ceramic coat"})
+	assert.Equal(t, "booking-42@book.example.com", e.UID)
+	assert.Equal(t, int32(3), e.Sequence)
+	assert.Equal(t, time.Date(2030, 1, 10, 1, 30, 0, 0, time.UTC), e.Start.UTC())
+	assert.Equal(t, 90*time.Minute, e.End.Sub(e.Start))
+	assert.Equal(t, "Full detail, Ceramic coat - Mazda CX-5", e.Summary)
+	assert.Equal(t, "Services: Full detail, Ceramic coat\nVehicle: Mazda CX-5 (1ABC234)\nCustomer: Sam Lee\nPhone: 0400 000 000\nEmail: sam@example.com", e.Description)
+	assert.Equal(t, "1 Hay St, Perth", e.Location)
+	assert.Equal(t, ics.StatusConfirmed, e.Status)
+
+	b.Status = dbpg.BookingStatusCancelled
+	assert.Equal(t, ics.StatusCancelled, bookingEvent(clock, "x", b, nil).Status)
+}
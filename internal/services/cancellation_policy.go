@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/settings"
+)
+
+// RefundTier refunds RefundPercent of the amount paid when a booking is
+// cancelled at least MinHoursBefore hours before its scheduled start.
+type RefundTier struct {
+	MinHoursBefore int `json:"min_hours_before"`
+	RefundPercent  int `json:"refund_percent"`
+}
+
+// CancellationPolicy is stored in settings under booking:cancellation_policy.
+type CancellationPolicy struct {
+	Tiers []RefundTier `json:"tiers"`
+}
+
+// DefaultCancellationPolicy is used when no policy has been configured.
+var DefaultCancellationPolicy = CancellationPolicy{
+	Tiers: []RefundTier{
+		{MinHoursBefore: 72, RefundPercent: 100},
+		{MinHoursBefore: 24, RefundPercent: 50},
+		{MinHoursBefore: 0, RefundPercent: 0},
+	},
+}
+
+// loadCancellationPolicy reads the configured policy, falling back to the
+// default if it is missing or has no tiers.
+func loadCancellationPolicy(ctx context.Context, s *settings.Service) CancellationPolicy {
+	if s == nil {
+		return DefaultCancellationPolicy
+	}
+	policy, err := settings.GetTyped[CancellationPolicy](ctx, s, "booking", "cancellation_policy", settings.SystemScope())
+	if err != nil || len(policy.Tiers) == 0 {
+		return DefaultCancellationPolicy
+	}
+	return policy
+}
+
+// SortedTiers returns the tiers ordered from the longest notice to the shortest.
+func (p CancellationPolicy) SortedTiers() []RefundTier {
+	tiers := append([]RefundTier(nil), p.Tiers...)
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i].MinHoursBefore > tiers[j].MinHoursBefore })
+	return tiers
+}
+
+// RefundPercent returns the refund percentage for cancelling with the given
+// notice. Notice shorter than every tier refunds nothing.
+func (p CancellationPolicy) RefundPercent(notice time.Duration) int {
+	for _, tier := range p.SortedTiers() {
+		if notice >= time.Duration(tier.MinHoursBefore)*time.Hour {
+			return min(max(tier.RefundPercent, 0), 100)
+		}
+	}
+	return 0
+}
+
+// Describe returns a customer-facing line for each tier, longest notice first.
+func (p CancellationPolicy) Describe() []string {
+	tiers := p.SortedTiers()
+	lines := make([]string, len(tiers))
+	for i, tier := range tiers {
+		var window string
+		switch {
+		case i == 0 && tier.MinHoursBefore == 0:
+			window = "Cancel at any time"
+		case i == 0:
+			window = fmt.Sprintf("Cancel %d or more hours before", tier.MinHoursBefore)
+		case tier.MinHoursBefore == 0:
+			window = fmt.Sprintf("Cancel less than %d hours before", tiers[i-1].MinHoursBefore)
+		default:
+			window = fmt.Sprintf("Cancel %d-%d hours before", tier.MinHoursBefore, tiers[i-1].MinHoursBefore)
+		}
+
+		switch tier.RefundPercent {
+		case 0:
+			lines[i] = window + ": no refund"
+		case 100:
+			lines[i] = window + ": full refund of the amount paid"
+		default:
+			lines[i] = fmt.Sprintf("%s: %d%% refund of the amount paid", window, tier.RefundPercent)
+		}
+	}
+	return lines
+}
+
+// amountPaid is what the customer has paid and not had refunded or
+// forfeited.
+func amountPaid(b dbpg.Booking) int64 {
+	switch b.PaymentStatus {
+	case dbpg.PaymentStatusDepositPaid, dbpg.PaymentStatusFullyPaid:
+		return b.AmountPaid
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,26 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancellationPolicyRefundPercent(t *testing.T) {
+	p := DefaultCancellationPolicy
+	assert.Equal(t, 100, p.RefundPercent(96*time.Hour))
+	assert.Equal(t, 100, p.RefundPercent(72*time.Hour))
+	assert.Equal(t, 50, p.RefundPercent(71*time.Hour))
+	assert.Equal(t, 50, p.RefundPercent(24*time.Hour))
+	assert.Equal(t, 0, p.RefundPercent(23*time.Hour))
+	assert.Equal(t, 0, p.RefundPercent(-2*time.Hour))
+}
+
+func TestCancellationPolicyDescribe(t *testing.T) {
+	assert.Equal(t, []string{
+		"Cancel 72 or more hours before: full refund of the amount paid",
+		"Cancel 24-72 hours before: 50% refund of the amount paid",
+		"Cancel less than 24 hours before: no refund",
+	}, DefaultCancellationPolicy.Describe())
+}
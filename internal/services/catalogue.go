@@ -141,6 +141,10 @@ func (s *CatalogueService) CreateService(ctx context.Context, userID int64, para
 		return dbpg.Service{}, problems.New(problems.Unauthorized, "admin access required")
 	}
 
+	if len(params.ResourceTypes) == 0 {
+		params.ResourceTypes = DefaultResourceTypes
+	}
+
 	svc, err := s.repo.CreateService(ctx, params)
 	if err != nil {
 		return dbpg.Service{}, problems.New(problems.Database, "failed to create service", err)
@@ -158,6 +162,18 @@ func (s *CatalogueService) UpdateService(ctx context.Context, userID int64, para
 		return dbpg.Service{}, problems.New(problems.Unauthorized, "admin access required")
 	}
 
+	// Older clients don't send resource types; keep what the service has.
+	if len(params.ResourceTypes) == 0 {
+		existing, err := s.repo.GetServiceByID(ctx, params.ID)
+		if err != nil {
+			if errors.Is(err, ErrNoRecord) {
+				return dbpg.Service{}, problems.New(problems.NotExist, "service not found")
+			}
+			return dbpg.Service{}, problems.New(problems.Database, "failed to get service", err)
+		}
+		params.ResourceTypes = existing.ResourceTypes
+	}
+
 	svc, err := s.repo.UpdateService(ctx, params)
 	if err != nil {
 		if errors.Is(err, ErrNoRecord) {
@@ -264,7 +280,8 @@ func (s *CatalogueService) DeleteVehicleCategory(ctx context.Context, userID int
 	return s.repo.DeleteVehicleCategory(ctx, id)
 }
 
-// SetServicePriceTiers replaces all price tiers for a service (admin only).
+// SetServicePriceTiers replaces all price tiers for a service, each of which
+// may also override how long the service takes (admin only).
 func (s *CatalogueService) SetServicePriceTiers(ctx context.Context, userID int64, serviceID int64, tiers []dbpg.UpsertPriceTierParams) ([]dbpg.ListPriceTiersByServiceRow, error) {
 	isAdmin, err := s.authz.IsSystemAdmin(ctx, userID)
 	if err != nil {
@@ -273,6 +290,11 @@ func (s *CatalogueService) SetServicePriceTiers(ctx context.Context, userID int6
 	if !isAdmin {
 		return nil, problems.New(problems.Unauthorized, "admin access required")
 	}
+	for _, t := range tiers {
+		if t.DurationMinutes.Valid && t.DurationMinutes.Int32 <= 0 {
+			return nil, problems.New(problems.InvalidRequest, "tier duration must be positive")
+		}
+	}
 
 	// Delete existing tiers then upsert new ones
 	if err := s.repo.DeletePriceTiersByService(ctx, serviceID); err != nil {
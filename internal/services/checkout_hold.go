@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/settings"
+)
+
+// Why a slot is held.
+const (
+	SlotHoldWaitlist = "waitlist" // offered to a waitlisted customer
+	SlotHoldCheckout = "checkout" // chosen by a customer who is paying
+)
+
+const defaultCheckoutHoldMinutes = 15
+
+type HoldCheckoutSlotParams struct {
+	UserID           int64
+	VehicleID        int64
+	ScheduledDate    string // YYYY-MM-DD
+	ScheduledTime    string // HH:MM
+	CartSessionToken string
+	Location         *ServiceLocation // set for a mobile job at the customer's address
+}
+
+// checkoutHoldWindow is how long a slot is held while the customer pays.
+func (s *BookingService) checkoutHoldWindow(ctx context.Context) time.Duration {
+	mins := defaultCheckoutHoldMinutes
+	if s.settings != nil {
+		if v, err := s.settings.GetInt(ctx, "booking", "checkout_hold_minutes", settings.SystemScope()); err == nil && v > 0 {
+			mins = v
+		}
+	}
+	return time.Duration(mins) * time.Minute
+}
+
+// HoldCheckoutSlot holds the slot a customer has chosen for their cart while
+// they pay, so no one else can take it. A customer has one checkout hold at a
+// time: choosing another slot releases the previous one. Creating the booking
+// from the cart turns the hold into the booking.
+func (s *BookingService) HoldCheckoutSlot(ctx context.Context, params HoldCheckoutSlotParams) (*dbpg.SlotHold, error) {
+	customer, err := s.repo.GetCustomerProfileByUserID(ctx, params.UserID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.NotExist, "customer profile not found, please create one first")
+		}
+		return nil, problems.New(problems.Database, "failed to get customer profile", err)
+	}
+
+	scheduledDate, err := time.Parse("2006-01-02", params.ScheduledDate)
+	if err != nil {
+		return nil, problems.New(problems.InvalidRequest, "invalid date format, expected YYYY-MM-DD")
+	}
+	scheduledTime, err := time.Parse("15:04", params.ScheduledTime)
+	if err != nil {
+		return nil, problems.New(problems.InvalidRequest, "invalid time format, expected HH:MM")
+	}
+	startMins := int32(scheduledTime.Hour()*60 + scheduledTime.Minute())
+
+	quote, travel, err := s.quoteCheckout(ctx, params.UserID, params.CartSessionToken, params.VehicleID, params.Location)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkBookingRules(ctx, &quote.requirements, scheduledDate, startMins); err != nil {
+		return nil, err
+	}
+
+	pgDate := pgtype.Date{Time: scheduledDate, Valid: true}
+	holdParams := dbpg.CreateSlotHoldParams{
+		CustomerID:    customer.ID,
+		ScheduledDate: pgDate,
+		ScheduledTime: pgtype.Time{Microseconds: int64(startMins) * 60000000, Valid: true},
+		DurationMins:  quote.duration,
+		ExpiresAt:     pgtype.Timestamptz{Time: s.now().Add(s.checkoutHoldWindow(ctx)), Valid: true},
+		Purpose:       SlotHoldCheckout,
+	}
+	if params.Location != nil {
+		holdParams.ServicePostcode = dbpg.StringToPGString(travel.Location.Postcode)
+	}
+
+	var hold dbpg.SlotHold
+	var released []dbpg.SlotHold
+	err = s.repo.WithTx(ctx, func(tx BookingTx) error {
+		if err := tx.LockScheduleDate(ctx, pgDate); err != nil {
+			return problems.New(problems.Database, "failed to lock schedule", err)
+		}
+
+		released, err = tx.DeleteCheckoutHoldsForCustomer(ctx, customer.ID)
+		if err != nil {
+			return problems.New(problems.Database, "failed to release checkout hold", err)
+		}
+
+		day, err := loadDaySchedule(ctx, tx, scheduledDate)
+		if err != nil {
+			return err
+		}
+		resourceID, err := day.withoutHoldsFor(customer.ID).check(startMins, quote.duration, quote.requirements)
+		if err != nil {
+			return err
+		}
+		holdParams.ResourceID = resourceID
+
+		hold, err = tx.CreateSlotHold(ctx, holdParams)
+		if err != nil {
+			return problems.New(problems.Database, "failed to hold slot", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, txError(err, "failed to hold slot")
+	}
+
+	for _, h := range released {
+		queueWaitlistCheck(ctx, s.Waitlist, h.ScheduledDate)
+	}
+	return &hold, nil
+}
+
+// ReleaseCheckoutHold gives up the caller's checkout hold, if they have one,
+// when they leave checkout without booking.
+func (s *BookingService) ReleaseCheckoutHold(ctx context.Context, userID int64) error {
+	customer, err := s.repo.GetCustomerProfileByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil
+		}
+		return problems.New(problems.Database, "failed to get customer profile", err)
+	}
+
+	var released []dbpg.SlotHold
+	err = s.repo.WithTx(ctx, func(tx BookingTx) error {
+		released, err = tx.DeleteCheckoutHoldsForCustomer(ctx, customer.ID)
+		if err != nil {
+			return problems.New(problems.Database, "failed to release checkout hold", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return txError(err, "failed to release checkout hold")
+	}
+
+	for _, h := range released {
+		queueWaitlistCheck(ctx, s.Waitlist, h.ScheduledDate)
+	}
+	return nil
+}
+
+// ReleaseExpiredCheckoutHolds deletes checkout holds that ran out before the
+// customer booked, and re-evaluates the waitlist for the slots they free. It
+// returns how many holds were released.
+func (s *BookingService) ReleaseExpiredCheckoutHolds(ctx context.Context) (int, error) {
+	released, err := s.repo.DeleteExpiredCheckoutHolds(ctx)
+	if err != nil {
+		return 0, problems.New(problems.Database, "failed to release expired checkout holds", err)
+	}
+
+	dates := make(map[pgtype.Date]bool)
+	for _, h := range released {
+		if !dates[h.ScheduledDate] {
+			dates[h.ScheduledDate] = true
+			queueWaitlistCheck(ctx, s.Waitlist, h.ScheduledDate)
+		}
+	}
+	return len(released), nil
+}
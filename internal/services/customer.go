@@ -9,15 +9,16 @@ import (
 )
 
 type CustomerProfile struct {
-	ID        int64
-	UserID    int64
-	Phone     string
-	Address   string
-	Suburb    string
-	Postcode  string
-	Notes     string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID          int64
+	UserID      int64
+	Phone       string
+	Address     string
+	Suburb      string
+	Postcode    string
+	Notes       string
+	NoShowCount int32
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 type Vehicle struct {
@@ -1,5 +1,24 @@
 package services
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/richardbowden/degrees/internal/problems"
+)
 
 var ErrNoRecord = errors.New("no record found")
+
+// ErrConflict is returned by repositories when a write is rejected because it
+// clashes with existing data (e.g. an overlapping booking).
+var ErrConflict = errors.New("conflicting record")
+
+// txError passes problems raised inside a transaction callback through
+// untouched and reports anything else (begin/commit failures) as a database
+// problem.
+func txError(err error, msg string) error {
+	var p problems.Problem
+	if errors.As(err, &p) {
+		return err
+	}
+	return problems.New(problems.Database, msg, err)
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/settings"
+)
+
+// defaultNoShowPrepayThreshold is how many no-shows a customer may have
+// before they must pay for new bookings in full at checkout.
+const defaultNoShowPrepayThreshold = 2
+
+// noShowPrepayThreshold reads the no-show count at which full prepayment is
+// required. Zero turns the rule off.
+func (s *BookingService) noShowPrepayThreshold(ctx context.Context) int {
+	if s.settings == nil {
+		return defaultNoShowPrepayThreshold
+	}
+	v, err := s.settings.GetInt(ctx, "booking", "no_show_prepay_threshold", settings.SystemScope())
+	if err != nil || v < 0 {
+		return defaultNoShowPrepayThreshold
+	}
+	return v
+}
+
+// depositFor is the deposit a customer is asked for at checkout on total.
+// Customers who have reached the no-show threshold pay the whole amount.
+func (s *BookingService) depositFor(ctx context.Context, customer dbpg.CustomerProfile, total int64) int64 {
+	if threshold := s.noShowPrepayThreshold(ctx); threshold > 0 && int(customer.NoShowCount) >= threshold {
+		return total
+	}
+	return total * DepositPercentage / 100
+}
+
+// MarkNoShow records that the customer did not turn up for a booking. Nothing
+// is refunded: whatever was paid is forfeited and kept on the booking, and
+// the no-show counts against the customer's future checkouts.
+func (s *BookingService) MarkNoShow(ctx context.Context, actorID, bookingID int64, reason string) (*dbpg.Booking, error) {
+	if reason == "" {
+		reason = "customer did not attend"
+	}
+	clock := s.clock(ctx)
+
+	var booking dbpg.Booking
+	err := s.repo.WithTx(ctx, func(tx BookingTx) error {
+		current, err := tx.GetBookingForUpdate(ctx, bookingID)
+		if err != nil {
+			if errors.Is(err, ErrNoRecord) {
+				return problems.New(problems.NotExist, "booking not found")
+			}
+			return problems.New(problems.Database, "failed to get booking", err)
+		}
+		if !CanTransitionBooking(current.Status, dbpg.BookingStatusNoShow) {
+			return problems.New(problems.InvalidRequest,
+				fmt.Sprintf("a %s booking cannot be marked as a no-show", current.Status))
+		}
+		if clock.now.Before(clock.bookingStart(current)) {
+			return problems.New(problems.InvalidRequest, "a booking cannot be marked as a no-show before it starts")
+		}
+
+		paid := amountPaid(current)
+		change := StatusChange{
+			Status:    dbpg.BookingStatusNoShow,
+			ChangedBy: actorID,
+			Reason:    reason,
+		}
+		if paid > 0 {
+			change.PaymentStatus = dbpg.PaymentStatusForfeited
+		}
+		if _, err := applyStatusChange(ctx, tx, bookingID, change); err != nil {
+			return err
+		}
+
+		updated, err := tx.SetBookingForfeitedAmount(ctx, dbpg.SetBookingForfeitedAmountParams{ID: bookingID, ForfeitedAmount: paid})
+		if err != nil {
+			return problems.New(problems.Database, "failed to record forfeited amount", err)
+		}
+		if _, err := tx.IncrementCustomerNoShowCount(ctx, current.CustomerID); err != nil {
+			return problems.New(problems.Database, "failed to update customer no-show count", err)
+		}
+		booking = updated
+		return nil
+	})
+	if err != nil {
+		return nil, txError(err, "failed to mark booking as a no-show")
+	}
+	s.cancelReminders(ctx, booking.ID)
+	return &booking, nil
+}
@@ -2,94 +2,437 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/go-chi/httplog"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/richardbowden/degrees/internal/dbpg"
 	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/stripe"
 )
 
 type PaymentBookingRepository interface {
 	GetBookingByID(ctx context.Context, id int64) (dbpg.GetBookingByIDRow, error)
-	UpdateBookingPaymentStatus(ctx context.Context, params dbpg.UpdateBookingPaymentStatusParams) (dbpg.Booking, error)
-	UpdateBookingStatus(ctx context.Context, params dbpg.UpdateBookingStatusParams) (dbpg.Booking, error)
+	ListBookingPayments(ctx context.Context, bookingID int64) ([]dbpg.ListBookingPaymentsRow, error)
+	GetChargeByProviderRef(ctx context.Context, providerRef string) (dbpg.Payment, error)
+	WithTx(ctx context.Context, fn func(tx BookingTx) error) error
 }
 
 type StripeClient interface {
-	CreateCheckoutSession(amountCents int64, currency string, bookingID int64, successURL string, cancelURL string) (clientSecret string, err error)
+	CreateCheckoutSession(ctx context.Context, params stripe.CheckoutSessionParams) (stripe.CheckoutSession, error)
+	CreateRefund(ctx context.Context, params stripe.RefundParams) (stripe.Refund, error)
 }
 
+// PaymentNotifier emails customers about money going back to them.
+type PaymentNotifier interface {
+	QueueBookingRefunded(ctx context.Context, bookingID int64, to, customerName, bookingDate string, amount int64) error
+}
+
+// What a Checkout payment is for, carried in its metadata so the webhook
+// knows what to record.
+const (
+	paymentPurposeDeposit = "deposit"
+	paymentPurposeBalance = "balance"
+)
+
 type PaymentService struct {
-	repo    PaymentBookingRepository
-	stripe  StripeClient
-	baseURL string
+	repo          PaymentBookingRepository
+	stripe        StripeClient
+	Notifier      PaymentNotifier
+	baseURL       string
+	webhookSecret string
+	now           func() time.Time
 }
 
-func NewPaymentService(repo PaymentBookingRepository, stripe StripeClient, baseURL string) *PaymentService {
+func NewPaymentService(repo PaymentBookingRepository, stripe StripeClient, baseURL, webhookSecret string) *PaymentService {
 	return &PaymentService{
-		repo:    repo,
-		stripe:  stripe,
-		baseURL: baseURL,
+		repo:          repo,
+		stripe:        stripe,
+		baseURL:       baseURL,
+		webhookSecret: webhookSecret,
+		now:           time.Now,
 	}
 }
 
 func (s *PaymentService) CreateDepositSession(ctx context.Context, userID int64, bookingID int64) (string, int64, error) {
+	booking, err := s.customerBooking(ctx, userID, bookingID)
+	if err != nil {
+		return "", 0, err
+	}
+	if booking.Status != dbpg.BookingStatusPendingPayment {
+		return "", 0, problems.New(problems.InvalidRequest, "booking is not in pending_payment status")
+	}
+
+	depositAmount := booking.DepositAmount
+	clientSecret, err := s.checkoutSession(ctx, bookingID, depositAmount, paymentPurposeDeposit,
+		fmt.Sprintf("Deposit for booking #%d", bookingID),
+		// Asking again for the same deposit returns the same session
+		fmt.Sprintf("booking-%d-deposit-%d", bookingID, depositAmount))
+	if err != nil {
+		return "", 0, err
+	}
+	return clientSecret, depositAmount, nil
+}
+
+// CreateBalanceSession starts a Checkout session for what is left to pay on
+// a booking once its deposit is paid, returning its client secret and the
+// amount.
+func (s *PaymentService) CreateBalanceSession(ctx context.Context, userID int64, bookingID int64) (string, int64, error) {
+	booking, err := s.customerBooking(ctx, userID, bookingID)
+	if err != nil {
+		return "", 0, err
+	}
+	switch booking.Status {
+	case dbpg.BookingStatusDepositPaid, dbpg.BookingStatusConfirmed, dbpg.BookingStatusInProgress, dbpg.BookingStatusCompleted:
+	case dbpg.BookingStatusPendingPayment:
+		return "", 0, problems.New(problems.InvalidRequest, "the deposit must be paid first")
+	default:
+		return "", 0, problems.New(problems.InvalidRequest, fmt.Sprintf("cannot pay for a %s booking", booking.Status))
+	}
+
+	balance := booking.TotalAmount - booking.AmountPaid
+	if balance <= 0 {
+		return "", 0, problems.New(problems.InvalidRequest, "nothing is left to pay")
+	}
+	clientSecret, err := s.checkoutSession(ctx, bookingID, balance, paymentPurposeBalance,
+		fmt.Sprintf("Balance for booking #%d", bookingID),
+		// A new session once anything more is paid or the total changes
+		fmt.Sprintf("booking-%d-balance-%d-%d", bookingID, booking.AmountPaid, balance))
+	if err != nil {
+		return "", 0, err
+	}
+	return clientSecret, balance, nil
+}
+
+// customerBooking loads one of the customer's own bookings.
+func (s *PaymentService) customerBooking(ctx context.Context, userID, bookingID int64) (dbpg.GetBookingByIDRow, error) {
 	booking, err := s.repo.GetBookingByID(ctx, bookingID)
 	if err != nil {
 		if errors.Is(err, ErrNoRecord) {
-			return "", 0, problems.New(problems.NotExist, "booking not found")
+			return dbpg.GetBookingByIDRow{}, problems.New(problems.NotExist, "booking not found")
 		}
-		return "", 0, problems.New(problems.Database, "failed to get booking", err)
+		return dbpg.GetBookingByIDRow{}, problems.New(problems.Database, "failed to get booking", err)
 	}
 
 	// Verify the booking belongs to this user
 	if booking.CustomerUserID != userID {
-		return "", 0, problems.New(problems.NotExist, "booking not found")
+		return dbpg.GetBookingByIDRow{}, problems.New(problems.NotExist, "booking not found")
 	}
+	return booking, nil
+}
 
-	if booking.Status != dbpg.BookingStatusPendingPayment {
-		return "", 0, problems.New(problems.InvalidRequest, "booking is not in pending_payment status")
+// checkoutSession starts a Checkout session taking amount for a booking. The
+// purpose tells the webhook what the payment was for.
+func (s *PaymentService) checkoutSession(ctx context.Context, bookingID, amount int64, purpose, description, idempotencyKey string) (string, error) {
+	if s.stripe == nil {
+		return "", problems.New(problems.Internal, "payment provider not configured")
 	}
 
-	depositAmount := booking.DepositAmount
+	session, err := s.stripe.CreateCheckoutSession(ctx, stripe.CheckoutSessionParams{
+		AmountCents: amount,
+		Currency:    "aud",
+		Description: description,
+		ReturnURL:   s.baseURL + "/bookings/" + formatInt64(bookingID) + "/success",
+		Metadata: map[string]string{
+			"booking_id": formatInt64(bookingID),
+			"purpose":    purpose,
+		},
+		IdempotencyKey: idempotencyKey,
+	})
+	if err != nil {
+		return "", problems.New(problems.Internal, "failed to create payment session", err)
+	}
+	return session.ClientSecret, nil
+}
 
-	if s.stripe == nil {
-		return "", 0, problems.New(problems.Internal, "payment provider not configured")
+// HandleStripeWebhook verifies and acts on a Stripe webhook. Each event is
+// acted on once however often it is delivered. An event that no longer
+// applies, such as payment for a booking since cancelled, is logged and
+// acknowledged so Stripe stops retrying it.
+func (s *PaymentService) HandleStripeWebhook(ctx context.Context, payload []byte, signature string) error {
+	if s.webhookSecret == "" {
+		return problems.New(problems.Internal, "payment webhooks not configured")
+	}
+	event, err := stripe.ParseEvent(payload, signature, s.webhookSecret, s.now(), stripe.DefaultTolerance)
+	if err != nil {
+		return problems.New(problems.InvalidRequest, "invalid webhook", err)
 	}
 
-	successURL := s.baseURL + "/bookings/" + formatInt64(bookingID) + "/success"
-	cancelURL := s.baseURL + "/bookings/" + formatInt64(bookingID) + "/cancel"
+	switch event.Type {
+	case stripe.EventCheckoutSessionCompleted:
+		err = s.checkoutCompleted(ctx, event)
+	case stripe.EventRefundCreated, stripe.EventRefundUpdated:
+		err = s.refundUpdated(ctx, event)
+	default:
+		return nil
+	}
 
-	clientSecret, err := s.stripe.CreateCheckoutSession(depositAmount, "aud", bookingID, successURL, cancelURL)
+	var p problems.Problem
+	if errors.As(err, &p) && (p.Kind == problems.NotExist || p.Kind == problems.InvalidRequest) {
+		log := httplog.LogEntry(ctx)
+		log.Warn().Err(err).Str("event_id", event.ID).Str("event_type", event.Type).Msg("ignoring stripe event")
+		return nil
+	}
+	return err
+}
+
+// checkoutCompleted records the payment a Checkout session took.
+func (s *PaymentService) checkoutCompleted(ctx context.Context, event stripe.Event) error {
+	var session stripe.CheckoutSessionObject
+	if err := json.Unmarshal(event.Data.Object, &session); err != nil {
+		return problems.New(problems.InvalidRequest, "invalid checkout session", err)
+	}
+	// Delayed payment methods complete the session before the money arrives
+	if session.PaymentStatus != "paid" {
+		return nil
+	}
+	bookingID, err := strconv.ParseInt(session.Metadata["booking_id"], 10, 64)
 	if err != nil {
-		return "", 0, problems.New(problems.Internal, "failed to create payment session", err)
+		return problems.New(problems.InvalidRequest, fmt.Sprintf("checkout session %s is not for a booking", session.ID))
 	}
 
-	return clientSecret, depositAmount, nil
-}
+	err = s.repo.WithTx(ctx, func(tx BookingTx) error {
+		first, err := tx.RecordStripeEvent(ctx, dbpg.RecordStripeEventParams{
+			ID:        event.ID,
+			Type:      event.Type,
+			BookingID: pgtype.Int8{Int64: bookingID, Valid: true},
+		})
+		if err != nil {
+			return problems.New(problems.Database, "failed to record stripe event", err)
+		}
+		if !first {
+			return nil
+		}
 
-func (s *PaymentService) HandleDepositPaid(ctx context.Context, bookingID int64) (*dbpg.Booking, error) {
-	// Update payment status
-	booking, err := s.repo.UpdateBookingPaymentStatus(ctx, dbpg.UpdateBookingPaymentStatusParams{
-		ID:            bookingID,
-		PaymentStatus: dbpg.PaymentStatusDepositPaid,
+		switch session.Metadata["purpose"] {
+		case paymentPurposeDeposit:
+			_, err = recordDepositPaid(ctx, tx, bookingID, session.AmountTotal, session.PaymentIntent)
+		case paymentPurposeBalance:
+			_, err = recordBalancePaid(ctx, tx, bookingID, session.AmountTotal, session.PaymentIntent)
+		default:
+			err = problems.New(problems.InvalidRequest, fmt.Sprintf("checkout session %s has no known purpose", session.ID))
+		}
+		return err
 	})
+	if err != nil {
+		return txError(err, "failed to record payment")
+	}
+	return nil
+}
+
+// BookingRefund is the outcome of refunding a booking. Refunds the provider
+// has not yet settled are recorded when its webhook reports them. Refunds it
+// turned down are not recorded; the money is still the customer's to refund.
+type BookingRefund struct {
+	Booking  dbpg.GetBookingByIDRow
+	Refunded int64
+	Pending  int64
+	Failed   int64
+}
+
+// RefundBooking refunds amount of what a booking's customer paid by card
+// (admin). When amount is 0 a cancelled booking is refunded what the
+// cancellation policy gave back, and any other booking everything paid by
+// card. The refund comes off the latest card payments first, one provider
+// refund per payment. Each refund stands on its own, so when one cannot be
+// made the refunds already made are returned along with the error.
+func (s *PaymentService) RefundBooking(ctx context.Context, actorID, bookingID, amount int64, reason string) (*BookingRefund, error) {
+	if s.stripe == nil {
+		return nil, problems.New(problems.Internal, "payment provider not configured")
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, problems.New(problems.InvalidRequest, "a reason is required")
+	}
+	if amount < 0 {
+		return nil, problems.New(problems.InvalidRequest, "amount must not be negative")
+	}
+
+	booking, err := s.repo.GetBookingByID(ctx, bookingID)
 	if err != nil {
 		if errors.Is(err, ErrNoRecord) {
 			return nil, problems.New(problems.NotExist, "booking not found")
 		}
-		return nil, problems.New(problems.Database, "failed to update payment status", err)
+		return nil, problems.New(problems.Database, "failed to get booking", err)
+	}
+	if amount == 0 && booking.Status == dbpg.BookingStatusCancelled {
+		// Refunds made once the booking is cancelled move its payment
+		// status, so one already made is not made again
+		switch {
+		case booking.PaymentStatus == dbpg.PaymentStatusPartiallyRefunded || booking.PaymentStatus == dbpg.PaymentStatusRefunded:
+			return nil, problems.New(problems.InvalidRequest, "the refund due on cancellation has already been made, give an amount to refund more")
+		case booking.RefundAmount == 0:
+			return nil, problems.New(problems.InvalidRequest, "no refund is due under the cancellation policy, give an amount to refund anyway")
+		}
+		amount = booking.RefundAmount
+	}
+	payments, err := s.repo.ListBookingPayments(ctx, bookingID)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list booking payments", err)
+	}
+	charges := refundableCharges(payments)
+	var available int64
+	for _, c := range charges {
+		available += c.remaining
+	}
+	if available == 0 {
+		return nil, problems.New(problems.InvalidRequest, "nothing paid by card is left to refund"+manualRefundNote(payments))
+	}
+	if amount == 0 {
+		amount = available
+	}
+	if amount > available {
+		return nil, problems.New(problems.InvalidRequest, fmt.Sprintf("only %s paid by card is left to refund%s", formatCents(available), manualRefundNote(payments)))
+	}
+
+	result := &BookingRefund{}
+	for _, c := range charges {
+		if amount == 0 {
+			break
+		}
+		part := min(amount, c.remaining)
+		refund, err := s.stripe.CreateRefund(ctx, stripe.RefundParams{
+			PaymentIntent: c.ProviderRef.String,
+			AmountCents:   part,
+			Metadata: map[string]string{
+				"booking_id": formatInt64(bookingID),
+				"payment_id": formatInt64(c.ID),
+				"actor_id":   formatInt64(actorID),
+				"reason":     reason,
+			},
+			// Asking again before the first refund is recorded returns it
+			// rather than refunding twice
+			IdempotencyKey: fmt.Sprintf("payment-%d-refund-%d-%d", c.ID, c.Amount-c.remaining, part),
+		})
+		if err != nil {
+			msg := fmt.Sprintf("failed to refund payment %d", c.ID)
+			if done := result.Refunded + result.Pending; done > 0 {
+				msg += fmt.Sprintf(" after refunding %s", formatCents(done))
+			}
+			return s.partialRefund(ctx, bookingID, result, problems.New(problems.Internal, msg, err))
+		}
+		amount -= part
+
+		switch refund.Status {
+		case stripe.RefundStatusSucceeded:
+			if err := s.recordRefund(ctx, bookingID, c.ID, refund, actorID, reason); err != nil {
+				// The money has gone back; the webhook records it
+				result.Pending += refund.Amount
+				return s.partialRefund(ctx, bookingID, result, err)
+			}
+			result.Refunded += refund.Amount
+		case stripe.RefundStatusFailed, stripe.RefundStatusCanceled:
+			log := httplog.LogEntry(ctx)
+			log.Warn().Str("refund_id", refund.ID).Int64("payment_id", c.ID).Str("status", refund.Status).Msg("stripe refund did not go through")
+			result.Failed += part
+		default:
+			result.Pending += refund.Amount
+		}
+	}
+
+	result.Booking, err = s.repo.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to get booking", err)
+	}
+	return result, nil
+}
+
+// partialRefund returns the refunds made so far along with the error that
+// stopped the rest.
+func (s *PaymentService) partialRefund(ctx context.Context, bookingID int64, result *BookingRefund, err error) (*BookingRefund, error) {
+	if row, getErr := s.repo.GetBookingByID(ctx, bookingID); getErr == nil {
+		result.Booking = row
+	}
+	return result, err
+}
+
+// refundUpdated records a refund once the provider has settled it, whether
+// made by RefundBooking or directly in Stripe. The payment it refunds finds
+// the booking.
+func (s *PaymentService) refundUpdated(ctx context.Context, event stripe.Event) error {
+	var refund stripe.Refund
+	if err := json.Unmarshal(event.Data.Object, &refund); err != nil {
+		return problems.New(problems.InvalidRequest, "invalid refund", err)
+	}
+	switch refund.Status {
+	case stripe.RefundStatusSucceeded:
+	case stripe.RefundStatusFailed, stripe.RefundStatusCanceled:
+		log := httplog.LogEntry(ctx)
+		log.Warn().Str("refund_id", refund.ID).Str("payment_intent", refund.PaymentIntent).Str("status", refund.Status).Msg("stripe refund did not go through")
+		return nil
+	default:
+		return nil
+	}
+
+	charge, err := s.repo.GetChargeByProviderRef(ctx, refund.PaymentIntent)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return problems.New(problems.NotExist, fmt.Sprintf("refund %s is not for a booking payment", refund.ID))
+		}
+		return problems.New(problems.Database, "failed to get refunded payment", err)
+	}
+	actorID, _ := strconv.ParseInt(refund.Metadata["actor_id"], 10, 64)
+	note := refund.Metadata["reason"]
+	if note == "" {
+		note = "refunded in Stripe"
 	}
+	return s.recordRefund(ctx, charge.BookingID, charge.ID, refund, actorID, note)
+}
 
-	// Update booking status
-	booking, err = s.repo.UpdateBookingStatus(ctx, dbpg.UpdateBookingStatusParams{
-		ID:     bookingID,
-		Status: dbpg.BookingStatusDepositPaid,
+// recordRefund adds a settled provider refund to a booking's ledger and lets
+// the customer know. A refund already recorded is left alone.
+func (s *PaymentService) recordRefund(ctx context.Context, bookingID, chargeID int64, refund stripe.Refund, actorID int64, note string) error {
+	var added bool
+	err := s.repo.WithTx(ctx, func(tx BookingTx) error {
+		var err error
+		added, err = addPayment(ctx, tx, bookingID, PaymentEntry{
+			Kind:        dbpg.PaymentKindRefund,
+			Amount:      refund.Amount,
+			Method:      dbpg.PaymentMethodCard,
+			ProviderRef: refund.ID,
+			Note:        note,
+			ActorID:     actorID,
+			RefundOf:    chargeID,
+		})
+		if err != nil || !added {
+			return err
+		}
+		_, err = syncPayments(ctx, tx, bookingID, actorID, fmt.Sprintf("refunded %s: %s", formatCents(refund.Amount), note))
+		return err
 	})
 	if err != nil {
-		return nil, problems.New(problems.Database, "failed to update booking status", err)
+		return txError(err, "failed to record refund")
+	}
+	if added {
+		s.notifyRefunded(ctx, bookingID, refund.Amount)
 	}
+	return nil
+}
 
-	return &booking, nil
+// notifyRefunded queues the customer's refund confirmation. Failures are
+// logged, as the refund has already been made.
+func (s *PaymentService) notifyRefunded(ctx context.Context, bookingID, amount int64) {
+	if s.Notifier == nil {
+		return
+	}
+	log := httplog.LogEntry(ctx)
+	row, err := s.repo.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		log.Error().Err(err).Int64("booking_id", bookingID).Msg("failed to get booking for refund confirmation")
+		return
+	}
+	if !hasEmail(row.CustomerEmail) {
+		return
+	}
+	err = s.Notifier.QueueBookingRefunded(ctx, row.ID, row.CustomerEmail, row.CustomerName, formatDate(row.ScheduledDate), amount)
+	if err != nil {
+		log.Error().Err(err).Int64("booking_id", bookingID).Msg("failed to queue refund confirmation")
+	}
 }
 
 func formatInt64(n int64) string {
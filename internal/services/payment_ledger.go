@@ -0,0 +1,280 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+)
+
+// PaymentEntry is money moving on a booking, to be added to its ledger.
+// Amount is positive for charges and refunds and signed for adjustments. Tip
+// is the part of a charge that is a tip rather than towards the booking.
+type PaymentEntry struct {
+	Kind        dbpg.PaymentKind
+	Amount      int64
+	Tip         int64
+	Method      dbpg.PaymentMethod
+	ProviderRef string // the payment provider's ID for it, if any
+	Note        string
+	ActorID     int64 // 0 for payments recorded by the system
+	RefundOf    int64 // the charge a provider refund was made against
+}
+
+// BookingPayments is a booking's ledger and what it adds up to.
+type BookingPayments struct {
+	Payments    []dbpg.ListBookingPaymentsRow
+	TotalAmount int64
+	AmountPaid  int64 // net of tips and refunds
+	BalanceDue  int64
+	Tips        int64
+	Refunded    int64
+}
+
+// ListBookingPayments returns a booking's payment ledger (admin).
+func (s *BookingService) ListBookingPayments(ctx context.Context, bookingID int64) (*BookingPayments, error) {
+	row, err := s.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	payments, err := s.repo.ListBookingPayments(ctx, bookingID)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list booking payments", err)
+	}
+
+	result := &BookingPayments{
+		Payments:    payments,
+		TotalAmount: row.TotalAmount,
+		AmountPaid:  row.AmountPaid,
+		BalanceDue:  row.TotalAmount - row.AmountPaid,
+	}
+	for _, p := range payments {
+		switch p.Kind {
+		case dbpg.PaymentKindCharge:
+			result.Tips += p.TipAmount
+		case dbpg.PaymentKindRefund:
+			result.Refunded += p.Amount
+		}
+	}
+	return result, nil
+}
+
+// InPersonPayment is money taken for a booking at the counter or on the job.
+type InPersonPayment struct {
+	Method dbpg.PaymentMethod
+	Amount int64 // towards the booking
+	Tip    int64
+	Note   string
+}
+
+// RecordInPersonPayment records a payment taken in person by cash, EFTPOS or
+// bank transfer (admin). Card payments are taken through checkout instead. A
+// booking still waiting on its deposit is secured once the deposit is covered.
+func (s *BookingService) RecordInPersonPayment(ctx context.Context, actorID, bookingID int64, payment InPersonPayment) (*dbpg.Booking, error) {
+	switch payment.Method {
+	case dbpg.PaymentMethodCash, dbpg.PaymentMethodEftpos, dbpg.PaymentMethodBankTransfer:
+	default:
+		return nil, problems.New(problems.InvalidRequest, fmt.Sprintf("cannot record a %q payment in person", payment.Method))
+	}
+	if payment.Amount < 0 || payment.Tip < 0 || payment.Amount+payment.Tip == 0 {
+		return nil, problems.New(problems.InvalidRequest, "amount and tip must not be negative and cannot both be zero")
+	}
+
+	var booking dbpg.Booking
+	err := s.repo.WithTx(ctx, func(tx BookingTx) error {
+		current, err := tx.GetBookingForUpdate(ctx, bookingID)
+		if err != nil {
+			if errors.Is(err, ErrNoRecord) {
+				return problems.New(problems.NotExist, "booking not found")
+			}
+			return problems.New(problems.Database, "failed to get booking", err)
+		}
+		switch current.Status {
+		case dbpg.BookingStatusCancelled, dbpg.BookingStatusNoShow:
+			return problems.New(problems.InvalidRequest, fmt.Sprintf("cannot take payment for a %s booking", current.Status))
+		}
+		if owing := balanceDue(current); payment.Amount > owing {
+			return problems.New(problems.InvalidRequest, fmt.Sprintf("only %s is owing", formatCents(max(owing, 0))))
+		}
+
+		reason := fmt.Sprintf("%s paid by %s", formatCents(payment.Amount), strings.ReplaceAll(string(payment.Method), "_", " "))
+		updated, err := recordPayment(ctx, tx, bookingID, PaymentEntry{
+			Kind:    dbpg.PaymentKindCharge,
+			Amount:  payment.Amount + payment.Tip,
+			Tip:     payment.Tip,
+			Method:  payment.Method,
+			Note:    payment.Note,
+			ActorID: actorID,
+		}, reason)
+		if err != nil {
+			return err
+		}
+		if updated.Status == dbpg.BookingStatusPendingPayment && updated.AmountPaid >= updated.DepositAmount {
+			updated, err = applyStatusChange(ctx, tx, bookingID, StatusChange{
+				Status:    dbpg.BookingStatusDepositPaid,
+				ChangedBy: actorID,
+				Reason:    reason,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		booking = updated
+		return nil
+	})
+	if err != nil {
+		return nil, txError(err, "failed to record payment")
+	}
+	return &booking, nil
+}
+
+// recordPayment adds a payment to a booking's ledger and brings the booking's
+// amount paid and payment status into line with it. A payment the provider
+// already reported is not recorded twice.
+func recordPayment(ctx context.Context, tx BookingTx, bookingID int64, entry PaymentEntry, reason string) (dbpg.Booking, error) {
+	added, err := addPayment(ctx, tx, bookingID, entry)
+	if err != nil {
+		return dbpg.Booking{}, err
+	}
+	if !added {
+		return tx.GetBookingForUpdate(ctx, bookingID)
+	}
+	return syncPayments(ctx, tx, bookingID, entry.ActorID, reason)
+}
+
+// addPayment adds a payment to a booking's ledger, reporting false if the
+// provider already reported it.
+func addPayment(ctx context.Context, tx BookingTx, bookingID int64, entry PaymentEntry) (bool, error) {
+	params := dbpg.CreatePaymentParams{
+		BookingID:   bookingID,
+		Kind:        entry.Kind,
+		Amount:      entry.Amount,
+		TipAmount:   entry.Tip,
+		Method:      entry.Method,
+		ProviderRef: dbpg.StringToPGString(entry.ProviderRef),
+		Note:        dbpg.StringToPGString(entry.Note),
+	}
+	if entry.ActorID > 0 {
+		params.CreatedBy = pgtype.Int8{Int64: entry.ActorID, Valid: true}
+	}
+	if entry.RefundOf > 0 {
+		params.RefundOf = pgtype.Int8{Int64: entry.RefundOf, Valid: true}
+	}
+	if _, err := tx.CreatePayment(ctx, params); err != nil {
+		if errors.Is(err, ErrConflict) {
+			return false, nil
+		}
+		return false, problems.New(problems.Database, "failed to record payment", err)
+	}
+	return true, nil
+}
+
+// refundableCharge is a card charge and how much of it is left to refund.
+type refundableCharge struct {
+	dbpg.ListBookingPaymentsRow
+	remaining int64
+}
+
+// refundableCharges lists a ledger's card charges that the provider can still
+// refund, newest first, so a partial refund comes off the latest payment.
+func refundableCharges(payments []dbpg.ListBookingPaymentsRow) []refundableCharge {
+	refunded := map[int64]int64{}
+	for _, p := range payments {
+		if p.Kind == dbpg.PaymentKindRefund && p.RefundOf.Valid {
+			refunded[p.RefundOf.Int64] += p.Amount
+		}
+	}
+	var charges []refundableCharge
+	for i := len(payments) - 1; i >= 0; i-- {
+		p := payments[i]
+		if p.Kind != dbpg.PaymentKindCharge || p.Method != dbpg.PaymentMethodCard || !p.ProviderRef.Valid {
+			continue
+		}
+		if remaining := p.Amount - refunded[p.ID]; remaining > 0 {
+			charges = append(charges, refundableCharge{ListBookingPaymentsRow: p, remaining: remaining})
+		}
+	}
+	return charges
+}
+
+// manualRefundNote points out card charges a ledger has no provider reference
+// for, such as those carried over from before the ledger, for an error about
+// what is left to refund. They can only be refunded in Stripe by hand.
+func manualRefundNote(payments []dbpg.ListBookingPaymentsRow) string {
+	var manual int64
+	for _, p := range payments {
+		if p.Kind == dbpg.PaymentKindCharge && p.Method == dbpg.PaymentMethodCard && !p.ProviderRef.Valid {
+			manual += p.Amount
+		}
+	}
+	if manual == 0 {
+		return ""
+	}
+	return fmt.Sprintf("; %s paid by card has no Stripe reference and must be refunded in Stripe by hand", formatCents(manual))
+}
+
+// syncPayments brings a booking's amount paid and payment status into line
+// with its ledger, after a payment or a change in what the booking costs.
+func syncPayments(ctx context.Context, tx BookingTx, bookingID, changedBy int64, reason string) (dbpg.Booking, error) {
+	booking, err := tx.GetBookingForUpdate(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return dbpg.Booking{}, problems.New(problems.NotExist, "booking not found")
+		}
+		return dbpg.Booking{}, problems.New(problems.Database, "failed to get booking", err)
+	}
+	totals, err := tx.SumBookingPayments(ctx, bookingID)
+	if err != nil {
+		return dbpg.Booking{}, problems.New(problems.Database, "failed to total booking payments", err)
+	}
+
+	if paid := netPaid(totals); paid != booking.AmountPaid {
+		booking, err = tx.SetBookingAmountPaid(ctx, dbpg.SetBookingAmountPaidParams{ID: bookingID, AmountPaid: paid})
+		if err != nil {
+			return dbpg.Booking{}, problems.New(problems.Database, "failed to record amount paid", err)
+		}
+	}
+	if status := derivePaymentStatus(booking, totals); status != booking.PaymentStatus {
+		return applyStatusChange(ctx, tx, bookingID, StatusChange{
+			PaymentStatus: status,
+			ChangedBy:     changedBy,
+			Reason:        reason,
+		})
+	}
+	return booking, nil
+}
+
+// netPaid is what a ledger has taken towards a booking and kept.
+func netPaid(t dbpg.SumBookingPaymentsRow) int64 {
+	return t.Charged - t.Tips + t.Adjusted - t.Refunded
+}
+
+// derivePaymentStatus is the payment status a booking's ledger shows. Money
+// returned on a booking that is going ahead is an overpayment given back, so
+// only a booking that is done, cancelled or a no-show is partially refunded.
+// Until money is returned on a cancellation or no-show, the status it was
+// left with is kept. paymentTransitions allows every move this makes.
+func derivePaymentStatus(b dbpg.Booking, t dbpg.SumBookingPaymentsRow) dbpg.PaymentStatus {
+	paid := netPaid(t)
+	ended := b.Status == dbpg.BookingStatusCancelled || b.Status == dbpg.BookingStatusNoShow
+	switch {
+	case t.Refunded > 0 && paid <= 0:
+		return dbpg.PaymentStatusRefunded
+	case t.Refunded > 0 && (ended || b.Status == dbpg.BookingStatusCompleted):
+		return dbpg.PaymentStatusPartiallyRefunded
+	case ended:
+		return b.PaymentStatus
+	case paid <= 0:
+		return dbpg.PaymentStatusPending
+	case paid >= b.TotalAmount:
+		return dbpg.PaymentStatusFullyPaid
+	case paid >= b.DepositAmount:
+		return dbpg.PaymentStatusDepositPaid
+	}
+	// Part of the deposit does not secure the booking
+	return dbpg.PaymentStatusPending
+}
@@ -0,0 +1,585 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/stripe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// paymentTx keeps one booking and its ledger in memory.
+type paymentTx struct {
+	BookingTx
+	booking  dbpg.Booking
+	events   map[string]bool
+	payments []dbpg.Payment
+	reasons  []string // of status history rows
+}
+
+func (t *paymentTx) GetBookingForUpdate(ctx context.Context, id int64) (dbpg.Booking, error) {
+	if id != t.booking.ID {
+		return dbpg.Booking{}, ErrNoRecord
+	}
+	return t.booking, nil
+}
+
+// row is the booking as read outside a transaction, belonging to user 5.
+func (t *paymentTx) row(id int64) (dbpg.GetBookingByIDRow, error) {
+	if id != t.booking.ID {
+		return dbpg.GetBookingByIDRow{}, ErrNoRecord
+	}
+	b := t.booking
+	return dbpg.GetBookingByIDRow{
+		ID:             b.ID,
+		ScheduledDate:  b.ScheduledDate,
+		ScheduledTime:  b.ScheduledTime,
+		Status:         b.Status,
+		PaymentStatus:  b.PaymentStatus,
+		DepositAmount:  b.DepositAmount,
+		TotalAmount:    b.TotalAmount,
+		AmountPaid:     b.AmountPaid,
+		RefundAmount:   b.RefundAmount,
+		CustomerUserID: 5,
+		CustomerEmail:  "jo@example.com",
+	}, nil
+}
+
+func (t *paymentTx) RecordStripeEvent(ctx context.Context, params dbpg.RecordStripeEventParams) (bool, error) {
+	if t.events[params.ID] {
+		return false, nil
+	}
+	t.events[params.ID] = true
+	return true, nil
+}
+
+func (t *paymentTx) CreatePayment(ctx context.Context, params dbpg.CreatePaymentParams) (dbpg.Payment, error) {
+	for _, p := range t.payments {
+		if params.ProviderRef.Valid && p.Kind == params.Kind && p.ProviderRef == params.ProviderRef {
+			return dbpg.Payment{}, ErrConflict
+		}
+	}
+	p := dbpg.Payment{
+		ID:          int64(len(t.payments) + 1),
+		BookingID:   params.BookingID,
+		Kind:        params.Kind,
+		Amount:      params.Amount,
+		TipAmount:   params.TipAmount,
+		Method:      params.Method,
+		ProviderRef: params.ProviderRef,
+		RefundOf:    params.RefundOf,
+	}
+	t.payments = append(t.payments, p)
+	return p, nil
+}
+
+func (t *paymentTx) SumBookingPayments(ctx context.Context, bookingID int64) (dbpg.SumBookingPaymentsRow, error) {
+	var sum dbpg.SumBookingPaymentsRow
+	for _, p := range t.payments {
+		switch p.Kind {
+		case dbpg.PaymentKindCharge:
+			sum.Charged += p.Amount
+			sum.Tips += p.TipAmount
+		case dbpg.PaymentKindRefund:
+			sum.Refunded += p.Amount
+		case dbpg.PaymentKindAdjustment:
+			sum.Adjusted += p.Amount
+		}
+	}
+	return sum, nil
+}
+
+func (t *paymentTx) UpdateBookingStatus(ctx context.Context, params dbpg.UpdateBookingStatusParams) (dbpg.Booking, error) {
+	t.booking.Status = params.Status
+	return t.booking, nil
+}
+
+func (t *paymentTx) UpdateBookingPaymentStatus(ctx context.Context, params dbpg.UpdateBookingPaymentStatusParams) (dbpg.Booking, error) {
+	t.booking.PaymentStatus = params.PaymentStatus
+	return t.booking, nil
+}
+
+func (t *paymentTx) SetBookingAmountPaid(ctx context.Context, params dbpg.SetBookingAmountPaidParams) (dbpg.Booking, error) {
+	t.booking.AmountPaid = params.AmountPaid
+	return t.booking, nil
+}
+
+func (t *paymentTx) SetBookingRefundAmount(ctx context.Context, params dbpg.SetBookingRefundAmountParams) (dbpg.Booking, error) {
+	t.booking.RefundAmount = params.RefundAmount
+	return t.booking, nil
+}
+
+func (t *paymentTx) CreateBookingStatusHistory(ctx context.Context, params dbpg.CreateBookingStatusHistoryParams) (dbpg.BookingStatusHistory, error) {
+	t.reasons = append(t.reasons, params.Reason.String)
+	return dbpg.BookingStatusHistory{}, nil
+}
+
+type paymentRepo struct {
+	PaymentBookingRepository
+	tx *paymentTx
+}
+
+func (r *paymentRepo) WithTx(ctx context.Context, fn func(tx BookingTx) error) error {
+	return fn(r.tx)
+}
+
+func (r *paymentRepo) GetBookingByID(ctx context.Context, id int64) (dbpg.GetBookingByIDRow, error) {
+	return r.tx.row(id)
+}
+
+func (r *paymentRepo) ListBookingPayments(ctx context.Context, bookingID int64) ([]dbpg.ListBookingPaymentsRow, error) {
+	rows := make([]dbpg.ListBookingPaymentsRow, len(r.tx.payments))
+	for i, p := range r.tx.payments {
+		rows[i] = dbpg.ListBookingPaymentsRow{ID: p.ID, BookingID: p.BookingID, Kind: p.Kind, Amount: p.Amount, Method: p.Method, ProviderRef: p.ProviderRef, RefundOf: p.RefundOf}
+	}
+	return rows, nil
+}
+
+func (r *paymentRepo) GetChargeByProviderRef(ctx context.Context, providerRef string) (dbpg.Payment, error) {
+	for _, p := range r.tx.payments {
+		if p.Kind == dbpg.PaymentKindCharge && p.ProviderRef.String == providerRef {
+			return p, nil
+		}
+	}
+	return dbpg.Payment{}, ErrNoRecord
+}
+
+// refundStripe refunds with the next status in statuses, failing once they
+// run out.
+type refundStripe struct {
+	StripeClient
+	statuses []string
+	refunds  []stripe.RefundParams
+}
+
+func (c *refundStripe) CreateRefund(ctx context.Context, params stripe.RefundParams) (stripe.Refund, error) {
+	if len(c.statuses) == 0 {
+		return stripe.Refund{}, fmt.Errorf("no refund for %s", params.PaymentIntent)
+	}
+	c.refunds = append(c.refunds, params)
+	status := c.statuses[0]
+	c.statuses = c.statuses[1:]
+	return stripe.Refund{
+		ID:            fmt.Sprintf("re_%d", len(c.refunds)),
+		Amount:        params.AmountCents,
+		Status:        status,
+		PaymentIntent: params.PaymentIntent,
+		Metadata:      params.Metadata,
+	}, nil
+}
+
+type refundNotifier struct {
+	amounts []int64
+}
+
+func (n *refundNotifier) QueueBookingRefunded(ctx context.Context, bookingID int64, to, customerName, bookingDate string, amount int64) error {
+	n.amounts = append(n.amounts, amount)
+	return nil
+}
+
+// checkoutEvent is a completed Checkout session; each event is for its own
+// payment intent.
+func checkoutEvent(id string, bookingID int64, purpose string, amount int64) []byte {
+	return fmt.Appendf(nil, `{"id":%q,"type":"checkout.session.completed","data":{"object":{"id":"cs_1","payment_status":"paid","amount_total":%d,"payment_intent":"pi_%s","metadata":{"booking_id":"%d","purpose":%q}}}}`,
+		id, amount, id, bookingID, purpose)
+}
+
+func TestHandleStripeWebhook(t *testing.T) {
+	const secret = "whsec_test"
+	now := time.Unix(1_900_000_000, 0)
+	tx := &paymentTx{
+		booking: dbpg.Booking{
+			ID:            7,
+			Status:        dbpg.BookingStatusPendingPayment,
+			PaymentStatus: dbpg.PaymentStatusPending,
+			DepositAmount: 3000,
+			TotalAmount:   10000,
+		},
+		events: map[string]bool{},
+	}
+	svc := NewPaymentService(&paymentRepo{tx: tx}, nil, "", secret)
+	svc.now = func() time.Time { return now }
+	ctx := context.Background()
+	send := func(payload []byte) error {
+		return svc.HandleStripeWebhook(ctx, payload, stripe.SignatureFor(payload, secret, now))
+	}
+
+	deposit := checkoutEvent("evt_1", 7, paymentPurposeDeposit, 3000)
+	require.NoError(t, send(deposit))
+	assert.Equal(t, dbpg.BookingStatusDepositPaid, tx.booking.Status)
+	assert.Equal(t, dbpg.PaymentStatusDepositPaid, tx.booking.PaymentStatus)
+	assert.Equal(t, int64(3000), tx.booking.AmountPaid)
+
+	// A redelivered event changes nothing
+	require.NoError(t, send(deposit))
+	assert.Equal(t, int64(3000), tx.booking.AmountPaid)
+
+	require.NoError(t, send(checkoutEvent("evt_2", 7, paymentPurposeBalance, 7000)))
+	assert.Equal(t, dbpg.PaymentStatusFullyPaid, tx.booking.PaymentStatus)
+	assert.Equal(t, int64(10000), tx.booking.AmountPaid)
+	assert.Len(t, tx.payments, 2)
+
+	// Payment for an unknown booking is acknowledged rather than retried
+	assert.NoError(t, send(checkoutEvent("evt_3", 99, paymentPurposeDeposit, 3000)))
+
+	err := svc.HandleStripeWebhook(ctx, deposit, stripe.SignatureFor(deposit, "whsec_other", now))
+	var p problems.Problem
+	require.ErrorAs(t, err, &p)
+	assert.Equal(t, problems.InvalidRequest, p.Kind)
+}
+
+func TestDepositPaidAfterBookingMovedOn(t *testing.T) {
+	const secret = "whsec_test"
+	now := time.Unix(1_900_000_000, 0)
+	ctx := context.Background()
+	newTx := func(status dbpg.BookingStatus, paymentStatus dbpg.PaymentStatus, paid int64) *paymentTx {
+		return &paymentTx{
+			booking: dbpg.Booking{
+				ID:            7,
+				Status:        status,
+				PaymentStatus: paymentStatus,
+				DepositAmount: 3000,
+				TotalAmount:   10000,
+				AmountPaid:    paid,
+			},
+			events: map[string]bool{},
+		}
+	}
+	send := func(tx *paymentTx, payload []byte) error {
+		svc := NewPaymentService(&paymentRepo{tx: tx}, nil, "", secret)
+		svc.now = func() time.Time { return now }
+		return svc.HandleStripeWebhook(ctx, payload, stripe.SignatureFor(payload, secret, now))
+	}
+
+	// The amount Stripe took is recorded, not the deposit asked for
+	tx := newTx(dbpg.BookingStatusPendingPayment, dbpg.PaymentStatusPending, 0)
+	require.NoError(t, send(tx, checkoutEvent("evt_1", 7, paymentPurposeDeposit, 3500)))
+	assert.Equal(t, dbpg.BookingStatusDepositPaid, tx.booking.Status)
+	assert.Equal(t, int64(3500), tx.booking.AmountPaid)
+	assert.Equal(t, "pi_evt_1", tx.payments[0].ProviderRef.String)
+
+	// Paid at checkout after staff took the deposit in person
+	tx = newTx(dbpg.BookingStatusDepositPaid, dbpg.PaymentStatusDepositPaid, 3000)
+	tx.payments = []dbpg.Payment{{ID: 1, BookingID: 7, Kind: dbpg.PaymentKindCharge, Amount: 3000, Method: dbpg.PaymentMethodCash}}
+	require.NoError(t, send(tx, checkoutEvent("evt_2", 7, paymentPurposeDeposit, 3000)))
+	assert.Equal(t, dbpg.BookingStatusDepositPaid, tx.booking.Status)
+	assert.Equal(t, int64(6000), tx.booking.AmountPaid)
+	assert.Len(t, tx.payments, 2)
+
+	// Paid after the booking was cancelled, to be refunded
+	tx = newTx(dbpg.BookingStatusCancelled, dbpg.PaymentStatusPending, 0)
+	require.NoError(t, send(tx, checkoutEvent("evt_3", 7, paymentPurposeDeposit, 3000)))
+	assert.Equal(t, dbpg.BookingStatusCancelled, tx.booking.Status)
+	assert.Equal(t, int64(3000), tx.booking.AmountPaid)
+	assert.Len(t, tx.payments, 1)
+}
+
+func TestRefundBooking(t *testing.T) {
+	const secret = "whsec_test"
+	now := time.Unix(1_900_000_000, 0)
+	tx := &paymentTx{
+		booking: dbpg.Booking{
+			ID:            7,
+			Status:        dbpg.BookingStatusCancelled,
+			PaymentStatus: dbpg.PaymentStatusDepositPaid,
+			DepositAmount: 3000,
+			TotalAmount:   10000,
+			AmountPaid:    3000,
+		},
+		events: map[string]bool{},
+		payments: []dbpg.Payment{{
+			ID: 1, BookingID: 7, Kind: dbpg.PaymentKindCharge, Amount: 3000, Method: dbpg.PaymentMethodCard,
+			ProviderRef: dbpg.StringToPGString("pi_1"),
+		}},
+	}
+	client := &refundStripe{statuses: []string{stripe.RefundStatusSucceeded, stripe.RefundStatusPending}}
+	notifier := &refundNotifier{}
+	svc := NewPaymentService(&paymentRepo{tx: tx}, client, "", secret)
+	svc.Notifier = notifier
+	svc.now = func() time.Time { return now }
+	ctx := context.Background()
+	refundEvent := func(id string, refund stripe.Refund) error {
+		object, err := json.Marshal(refund)
+		require.NoError(t, err)
+		payload := fmt.Appendf(nil, `{"id":%q,"type":"refund.updated","data":{"object":%s}}`, id, object)
+		return svc.HandleStripeWebhook(ctx, payload, stripe.SignatureFor(payload, secret, now))
+	}
+
+	result, err := svc.RefundBooking(ctx, 2, 7, 1000, "goodwill")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), result.Refunded)
+	assert.Equal(t, "pi_1", client.refunds[0].PaymentIntent)
+	assert.Equal(t, dbpg.PaymentStatusPartiallyRefunded, tx.booking.PaymentStatus)
+	assert.Equal(t, int64(2000), tx.booking.AmountPaid)
+
+	// The provider's webhook for a refund already recorded changes nothing
+	require.NoError(t, refundEvent("evt_1", stripe.Refund{ID: "re_1", Amount: 1000, Status: stripe.RefundStatusSucceeded, PaymentIntent: "pi_1"}))
+	assert.Len(t, tx.payments, 2)
+
+	// The rest is refunded, settling later
+	result, err = svc.RefundBooking(ctx, 2, 7, 2000, "cancelled in time")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), result.Pending)
+	assert.Equal(t, int64(2000), tx.booking.AmountPaid)
+
+	require.NoError(t, refundEvent("evt_2", stripe.Refund{ID: "re_2", Amount: 2000, Status: stripe.RefundStatusSucceeded, PaymentIntent: "pi_1"}))
+	assert.Equal(t, dbpg.PaymentStatusRefunded, tx.booking.PaymentStatus)
+	assert.Equal(t, int64(0), tx.booking.AmountPaid)
+	assert.Equal(t, int64(1), tx.payments[2].RefundOf.Int64)
+	assert.Equal(t, []int64{1000, 2000}, notifier.amounts)
+
+	_, err = svc.RefundBooking(ctx, 2, 7, 0, "again")
+	var p problems.Problem
+	require.ErrorAs(t, err, &p)
+	assert.Equal(t, problems.InvalidRequest, p.Kind)
+
+	// A card payment from before the ledger has to be refunded by hand
+	tx.payments = append(tx.payments, dbpg.Payment{ID: 4, BookingID: 7, Kind: dbpg.PaymentKindCharge, Amount: 5000, Method: dbpg.PaymentMethodCard})
+	_, err = svc.RefundBooking(ctx, 2, 7, 5000, "again")
+	require.ErrorAs(t, err, &p)
+	assert.Contains(t, p.Error(), "$50.00 paid by card has no Stripe reference")
+}
+
+func TestRefundBookingAfterCancellation(t *testing.T) {
+	tx := &paymentTx{
+		booking: dbpg.Booking{
+			ID:            7,
+			ScheduledDate: pgtype.Date{Time: time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC), Valid: true},
+			ScheduledTime: pgtype.Time{Microseconds: 10 * 60 * 60000000, Valid: true},
+			Status:        dbpg.BookingStatusDepositPaid,
+			PaymentStatus: dbpg.PaymentStatusDepositPaid,
+			DepositAmount: 3000,
+			TotalAmount:   10000,
+			AmountPaid:    3000,
+		},
+		payments: []dbpg.Payment{{
+			ID: 1, BookingID: 7, Kind: dbpg.PaymentKindCharge, Amount: 3000, Method: dbpg.PaymentMethodCard,
+			ProviderRef: dbpg.StringToPGString("pi_1"),
+		}},
+	}
+	ctx := context.Background()
+	bookings := NewBookingService(&ledgerRepo{tx: tx}, nil)
+	bookings.now = func() time.Time { return time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC) }
+	client := &refundStripe{statuses: []string{stripe.RefundStatusSucceeded}}
+	svc := NewPaymentService(&paymentRepo{tx: tx}, client, "", "")
+
+	// Two days' notice gets half the deposit back
+	_, _, err := bookings.CancelBooking(ctx, 5, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1500), tx.booking.RefundAmount)
+
+	result, err := svc.RefundBooking(ctx, 2, 7, 0, "cancelled by customer")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1500), result.Refunded)
+	assert.Equal(t, int64(1500), client.refunds[0].AmountCents)
+	assert.Equal(t, dbpg.PaymentStatusPartiallyRefunded, tx.booking.PaymentStatus)
+	assert.Equal(t, int64(1500), tx.booking.AmountPaid)
+
+	// The policy refund is not made twice
+	_, err = svc.RefundBooking(ctx, 2, 7, 0, "cancelled by customer")
+	var p problems.Problem
+	require.ErrorAs(t, err, &p)
+	assert.Equal(t, problems.InvalidRequest, p.Kind)
+	assert.Len(t, client.refunds, 1)
+}
+
+func TestRefundBookingPartialFailure(t *testing.T) {
+	tx := &paymentTx{
+		booking: dbpg.Booking{
+			ID:            7,
+			Status:        dbpg.BookingStatusCompleted,
+			PaymentStatus: dbpg.PaymentStatusFullyPaid,
+			TotalAmount:   10000,
+			AmountPaid:    10000,
+		},
+		payments: []dbpg.Payment{
+			{ID: 1, BookingID: 7, Kind: dbpg.PaymentKindCharge, Amount: 3000, Method: dbpg.PaymentMethodCard, ProviderRef: dbpg.StringToPGString("pi_1")},
+			{ID: 2, BookingID: 7, Kind: dbpg.PaymentKindCharge, Amount: 7000, Method: dbpg.PaymentMethodCard, ProviderRef: dbpg.StringToPGString("pi_2")},
+		},
+	}
+	ctx := context.Background()
+
+	// A refund the provider turns down is reported, not recorded
+	client := &refundStripe{statuses: []string{stripe.RefundStatusSucceeded, stripe.RefundStatusFailed}}
+	svc := NewPaymentService(&paymentRepo{tx: tx}, client, "", "")
+	result, err := svc.RefundBooking(ctx, 2, 7, 0, "job redone")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7000), result.Refunded)
+	assert.Equal(t, int64(3000), result.Failed)
+	assert.Equal(t, int64(3000), tx.booking.AmountPaid)
+
+	// A provider error stops the rest but keeps what was already refunded
+	client = &refundStripe{statuses: []string{stripe.RefundStatusSucceeded}}
+	svc = NewPaymentService(&paymentRepo{tx: tx}, client, "", "")
+	tx.payments = append(tx.payments, dbpg.Payment{ID: 4, BookingID: 7, Kind: dbpg.PaymentKindCharge, Amount: 1000, Method: dbpg.PaymentMethodCard, ProviderRef: dbpg.StringToPGString("pi_3")})
+	result, err = svc.RefundBooking(ctx, 2, 7, 0, "job redone")
+	require.Error(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, int64(1000), result.Refunded)
+	assert.Equal(t, int64(7), result.Booking.ID)
+}
+
+func TestPaymentStatusFollowsLedger(t *testing.T) {
+	tx := &paymentTx{
+		booking: dbpg.Booking{
+			ID:            7,
+			Status:        dbpg.BookingStatusConfirmed,
+			PaymentStatus: dbpg.PaymentStatusPending,
+			TotalAmount:   10000,
+		},
+	}
+	ctx := context.Background()
+	steps := []struct {
+		entry PaymentEntry
+		want  dbpg.PaymentStatus
+	}{
+		{PaymentEntry{Kind: dbpg.PaymentKindCharge, Amount: 3000, Method: dbpg.PaymentMethodCard, ProviderRef: "pi_1"}, dbpg.PaymentStatusDepositPaid},
+		{PaymentEntry{Kind: dbpg.PaymentKindRefund, Amount: 3000, Method: dbpg.PaymentMethodCard, ProviderRef: "re_1", RefundOf: 1}, dbpg.PaymentStatusRefunded},
+		{PaymentEntry{Kind: dbpg.PaymentKindCharge, Amount: 10000, Method: dbpg.PaymentMethodCash}, dbpg.PaymentStatusFullyPaid},
+	}
+	for _, step := range steps {
+		booking, err := recordPayment(ctx, tx, 7, step.entry, "")
+		require.NoError(t, err)
+		assert.Equal(t, step.want, booking.PaymentStatus)
+	}
+	assert.Equal(t, int64(10000), tx.booking.AmountPaid)
+}
+
+type ledgerRepo struct {
+	BookingRepository
+	tx *paymentTx
+}
+
+func (r *ledgerRepo) WithTx(ctx context.Context, fn func(tx BookingTx) error) error {
+	return fn(r.tx)
+}
+
+func (r *ledgerRepo) GetBookingByID(ctx context.Context, id int64) (dbpg.GetBookingByIDRow, error) {
+	return r.tx.row(id)
+}
+
+func TestInPersonPaymentAndCompletion(t *testing.T) {
+	newTx := func() *paymentTx {
+		return &paymentTx{
+			booking: dbpg.Booking{
+				ID:            7,
+				Status:        dbpg.BookingStatusInProgress,
+				PaymentStatus: dbpg.PaymentStatusDepositPaid,
+				DepositAmount: 3000,
+				TotalAmount:   10000,
+				AmountPaid:    3000,
+			},
+			payments: []dbpg.Payment{{ID: 1, BookingID: 7, Kind: dbpg.PaymentKindCharge, Amount: 3000, Method: dbpg.PaymentMethodCard}},
+		}
+	}
+	ctx := context.Background()
+	tx := newTx()
+	svc := NewBookingService(&ledgerRepo{tx: tx}, nil)
+	invalid := func(err error) {
+		t.Helper()
+		var p problems.Problem
+		require.ErrorAs(t, err, &p)
+		assert.Equal(t, problems.InvalidRequest, p.Kind)
+	}
+
+	_, err := svc.CompleteBooking(ctx, 2, 7, "", "")
+	invalid(err)
+
+	_, err = svc.RecordInPersonPayment(ctx, 2, 7, InPersonPayment{Method: dbpg.PaymentMethodCard, Amount: 7000})
+	invalid(err)
+	_, err = svc.RecordInPersonPayment(ctx, 2, 7, InPersonPayment{Method: dbpg.PaymentMethodCash, Amount: 8000})
+	invalid(err)
+
+	booking, err := svc.RecordInPersonPayment(ctx, 2, 7, InPersonPayment{Method: dbpg.PaymentMethodCash, Amount: 7000, Tip: 500})
+	require.NoError(t, err)
+	assert.Equal(t, dbpg.PaymentStatusFullyPaid, booking.PaymentStatus)
+	assert.Equal(t, int64(10000), booking.AmountPaid)
+	assert.Equal(t, int64(7500), tx.payments[1].Amount)
+
+	notifier := &fakeNotifier{}
+	svc.Notifier = notifier
+	booking, err = svc.CompleteBooking(ctx, 2, 7, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, dbpg.BookingStatusCompleted, booking.Status)
+
+	// Completing it again changes nothing and sends no second follow-up
+	_, err = svc.CompleteBooking(ctx, 2, 7, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{7}, notifier.followUps)
+
+	// An override completes a booking with money still owing
+	tx = newTx()
+	svc = NewBookingService(&ledgerRepo{tx: tx}, nil)
+	booking, err = svc.CompleteBooking(ctx, 2, 7, "", "customer paying by invoice")
+	require.NoError(t, err)
+	assert.Equal(t, dbpg.BookingStatusCompleted, booking.Status)
+	assert.Equal(t, []string{"completed with $70.00 unpaid: customer paying by invoice"}, tx.reasons)
+}
+
+func TestInPersonPaymentUnderDeposit(t *testing.T) {
+	tx := &paymentTx{
+		booking: dbpg.Booking{
+			ID:            7,
+			Status:        dbpg.BookingStatusPendingPayment,
+			PaymentStatus: dbpg.PaymentStatusPending,
+			DepositAmount: 3000,
+			TotalAmount:   10000,
+		},
+	}
+	ctx := context.Background()
+	svc := NewBookingService(&ledgerRepo{tx: tx}, nil)
+
+	// Part of the deposit is recorded but does not secure the booking
+	booking, err := svc.RecordInPersonPayment(ctx, 2, 7, InPersonPayment{Method: dbpg.PaymentMethodCash, Amount: 1000})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1000), booking.AmountPaid)
+	assert.Equal(t, dbpg.BookingStatusPendingPayment, booking.Status)
+	assert.Equal(t, dbpg.PaymentStatusPending, booking.PaymentStatus)
+
+	booking, err = svc.RecordInPersonPayment(ctx, 2, 7, InPersonPayment{Method: dbpg.PaymentMethodEftpos, Amount: 2000})
+	require.NoError(t, err)
+	assert.Equal(t, dbpg.BookingStatusDepositPaid, booking.Status)
+	assert.Equal(t, dbpg.PaymentStatusDepositPaid, booking.PaymentStatus)
+}
+
+// checkoutPayments takes payment for bookings made through a checkoutRepo.
+type checkoutPayments struct {
+	PaymentBookingRepository
+	bookings *checkoutRepo
+}
+
+func (r checkoutPayments) GetBookingByID(ctx context.Context, id int64) (dbpg.GetBookingByIDRow, error) {
+	return r.bookings.GetBookingByID(ctx, id)
+}
+
+type sessionStripe struct {
+	StripeClient
+	sessions []stripe.CheckoutSessionParams
+}
+
+func (c *sessionStripe) CreateCheckoutSession(ctx context.Context, params stripe.CheckoutSessionParams) (stripe.CheckoutSession, error) {
+	c.sessions = append(c.sessions, params)
+	return stripe.CheckoutSession{ID: "cs_1", ClientSecret: "cs_1_secret"}, nil
+}
+
+func TestDepositSessionAfterCheckout(t *testing.T) {
+	ctx := context.Background()
+	bookings, repo, params := newCheckout(0)
+	booking, err := bookings.CreateBookingFromCart(ctx, params)
+	require.NoError(t, err)
+
+	client := &sessionStripe{}
+	svc := NewPaymentService(checkoutPayments{bookings: repo}, client, "", "")
+	secret, amount, err := svc.CreateDepositSession(ctx, params.UserID, booking.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "cs_1_secret", secret)
+	assert.Equal(t, booking.DepositAmount, amount)
+	assert.Equal(t, paymentPurposeDeposit, client.sessions[0].Metadata["purpose"])
+}
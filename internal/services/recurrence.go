@@ -0,0 +1,146 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/richardbowden/degrees/internal/problems"
+)
+
+const (
+	FreqWeekly  = "WEEKLY"
+	FreqMonthly = "MONTHLY"
+)
+
+// RecurrenceRule is the subset of an RFC 5545 RRULE used for booking series,
+// e.g. "FREQ=WEEKLY;INTERVAL=2;COUNT=6" for fortnightly, six times, or
+// "FREQ=MONTHLY;UNTIL=20271231". A series must end, so COUNT or UNTIL is
+// required.
+type RecurrenceRule struct {
+	Freq     string
+	Interval int
+	Count    int       // total occurrences including the first, 0 if unset
+	Until    time.Time // last possible date, zero if unset
+}
+
+// maxSeriesOccurrences bounds COUNT so a series cannot run on indefinitely.
+const maxSeriesOccurrences = 104
+
+// ParseRecurrenceRule parses and validates a rule string.
+func ParseRecurrenceRule(rule string) (RecurrenceRule, error) {
+	r := RecurrenceRule{Interval: 1}
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:"), ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return RecurrenceRule{}, problems.New(problems.InvalidRequest, fmt.Sprintf("invalid recurrence rule part %q", part))
+		}
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Freq = strings.ToUpper(value)
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return RecurrenceRule{}, problems.New(problems.InvalidRequest, "recurrence INTERVAL must be a positive number")
+			}
+			r.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || n > maxSeriesOccurrences {
+				return RecurrenceRule{}, problems.New(problems.InvalidRequest,
+					fmt.Sprintf("recurrence COUNT must be between 1 and %d", maxSeriesOccurrences))
+			}
+			r.Count = n
+		case "UNTIL":
+			// Accept a date or a UTC date-time; only the date is used
+			until, err := time.Parse("20060102", value[:min(len(value), 8)])
+			if err != nil {
+				return RecurrenceRule{}, problems.New(problems.InvalidRequest, "recurrence UNTIL must be a date like 20271231")
+			}
+			r.Until = until
+		default:
+			return RecurrenceRule{}, problems.New(problems.InvalidRequest, fmt.Sprintf("unsupported recurrence rule part %s", key))
+		}
+	}
+
+	switch r.Freq {
+	case FreqWeekly, FreqMonthly:
+	case "":
+		return RecurrenceRule{}, problems.New(problems.InvalidRequest, "recurrence FREQ is required")
+	default:
+		return RecurrenceRule{}, problems.New(problems.InvalidRequest, "recurrence FREQ must be WEEKLY or MONTHLY")
+	}
+	if r.Count == 0 && r.Until.IsZero() {
+		return RecurrenceRule{}, problems.New(problems.InvalidRequest, "recurrence needs an end: COUNT or UNTIL")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return RecurrenceRule{}, problems.New(problems.InvalidRequest, "recurrence may have COUNT or UNTIL, not both")
+	}
+	return r, nil
+}
+
+// String formats the rule in RRULE form.
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + r.Freq}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.Format("20060102"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Occurrences returns the series dates from start up to and including
+// through, honouring COUNT and UNTIL. The first occurrence is start itself.
+// As in RFC 5545, a monthly series on the 29th-31st skips months without
+// that day rather than moving to the end of the month.
+func (r RecurrenceRule) Occurrences(start, through time.Time) []time.Time {
+	if !r.Until.IsZero() && r.Until.Before(through) {
+		through = r.Until
+	}
+
+	var dates []time.Time
+	for i := 0; r.Count == 0 || len(dates) < r.Count; i++ {
+		var d time.Time
+		switch r.Freq {
+		case FreqMonthly:
+			d = start.AddDate(0, i*r.Interval, 0)
+		default:
+			d = start.AddDate(0, 0, 7*i*r.Interval)
+		}
+		if d.After(through) {
+			break
+		}
+		if r.Freq == FreqMonthly && d.Day() != start.Day() {
+			continue
+		}
+		dates = append(dates, d)
+	}
+	return dates
+}
+
+// IsOccurrence reports whether date is one of the series dates.
+func (r RecurrenceRule) IsOccurrence(start, date time.Time) bool {
+	for _, d := range r.Occurrences(start, date) {
+		if d.Equal(date) {
+			return true
+		}
+	}
+	return false
+}
+
+// EndsBy reports whether the series has no occurrences after through.
+func (r RecurrenceRule) EndsBy(start, through time.Time) bool {
+	if !r.Until.IsZero() && !r.Until.After(through) {
+		return true
+	}
+	return r.Count > 0 && len(r.Occurrences(start, through)) >= r.Count
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestParseRecurrenceRule(t *testing.T) {
+	r, err := ParseRecurrenceRule("FREQ=WEEKLY;INTERVAL=2;COUNT=6")
+	require.NoError(t, err)
+	assert.Equal(t, RecurrenceRule{Freq: FreqWeekly, Interval: 2, Count: 6}, r)
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;COUNT=6", r.String())
+
+	r, err = ParseRecurrenceRule("RRULE:FREQ=MONTHLY;UNTIL=20271231T000000Z")
+	require.NoError(t, err)
+	assert.Equal(t, date("2027-12-31"), r.Until)
+
+	for _, bad := range []string{
+		"",
+		"FREQ=DAILY;COUNT=3",
+		"FREQ=WEEKLY",
+		"FREQ=WEEKLY;COUNT=2;UNTIL=20270101",
+		"FREQ=WEEKLY;INTERVAL=0;COUNT=2",
+		"FREQ=WEEKLY;BYDAY=MO;COUNT=2",
+	} {
+		_, err := ParseRecurrenceRule(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	fortnightly := RecurrenceRule{Freq: FreqWeekly, Interval: 2, Count: 3}
+	assert.Equal(t,
+		[]time.Time{date("2026-11-02"), date("2026-11-16"), date("2026-11-30")},
+		fortnightly.Occurrences(date("2026-11-02"), date("2027-06-01")))
+	assert.Equal(t,
+		[]time.Time{date("2026-11-02"), date("2026-11-16")},
+		fortnightly.Occurrences(date("2026-11-02"), date("2026-11-20")))
+	assert.False(t, fortnightly.EndsBy(date("2026-11-02"), date("2026-11-20")))
+	assert.True(t, fortnightly.EndsBy(date("2026-11-02"), date("2026-11-30")))
+
+	// Months without a 31st are skipped, and COUNT counts real occurrences
+	monthly := RecurrenceRule{Freq: FreqMonthly, Interval: 1, Count: 3}
+	assert.Equal(t,
+		[]time.Time{date("2027-01-31"), date("2027-03-31"), date("2027-05-31")},
+		monthly.Occurrences(date("2027-01-31"), date("2028-01-01")))
+
+	until := RecurrenceRule{Freq: FreqWeekly, Interval: 4, Until: date("2027-01-10")}
+	assert.Equal(t,
+		[]time.Time{date("2026-11-02"), date("2026-11-30"), date("2026-12-28")},
+		until.Occurrences(date("2026-11-02"), date("2027-06-01")))
+	assert.True(t, until.IsOccurrence(date("2026-11-02"), date("2026-11-30")))
+	assert.False(t, until.IsOccurrence(date("2026-11-02"), date("2026-11-23")))
+}
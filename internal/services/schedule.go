@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/geo"
 	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/settings"
 )
 
 type ScheduleRepository interface {
@@ -17,9 +20,36 @@ type ScheduleRepository interface {
 	UpdateScheduleConfig(ctx context.Context, params dbpg.UpdateScheduleConfigParams) (dbpg.ScheduleConfig, error)
 	IsDateBlackedOut(ctx context.Context, params dbpg.IsDateBlackedOutParams) (bool, error)
 	CreateBlackout(ctx context.Context, params dbpg.CreateBlackoutParams) (dbpg.ScheduleBlackout, error)
-	DeleteBlackout(ctx context.Context, id int64) error
+	DeleteBlackout(ctx context.Context, id int64) (dbpg.ScheduleBlackout, error)
 	ListBlackoutDates(ctx context.Context) ([]dbpg.ScheduleBlackout, error)
+	ListScheduleWindows(ctx context.Context) ([]dbpg.ScheduleWindow, error)
+	ListScheduleWindowsForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ScheduleWindow, error)
+	SetScheduleWindows(ctx context.Context, params dbpg.SetScheduleWindowsParams) error
+	ListUpcomingScheduleOverrides(ctx context.Context) ([]dbpg.ScheduleOverride, error)
+	ListScheduleOverridesForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ScheduleOverride, error)
+	ListScheduleOverridesInRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.ScheduleOverride, error)
+	SetScheduleOverride(ctx context.Context, params dbpg.SetScheduleOverrideParams) ([]dbpg.ScheduleOverride, error)
+	DeleteScheduleOverride(ctx context.Context, date pgtype.Date) (int64, error)
 	ListBookingsForDate(ctx context.Context, params dbpg.ListBookingsForDateParams) ([]dbpg.Booking, error)
+	ListServiceRequirements(ctx context.Context, serviceIDs []int64, vehicleCategoryID int64) ([]dbpg.ListServiceRequirementsRow, error)
+	ListResources(ctx context.Context) ([]dbpg.Resource, error)
+	ListActiveResources(ctx context.Context) ([]dbpg.Resource, error)
+	CreateResource(ctx context.Context, params dbpg.CreateResourceParams) (dbpg.Resource, error)
+	UpdateResource(ctx context.Context, params dbpg.UpdateResourceParams) (dbpg.Resource, error)
+	ListResourceHours(ctx context.Context) ([]dbpg.ResourceHour, error)
+	ListResourceHoursForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ResourceHour, error)
+	UpsertResourceHours(ctx context.Context, params dbpg.UpsertResourceHoursParams) (dbpg.ResourceHour, error)
+	DeleteResourceHours(ctx context.Context, resourceID int64, dayOfWeek int32) error
+	HasActiveStaff(ctx context.Context) (bool, error)
+	ListRosteredStaffForDate(ctx context.Context, params dbpg.ListRosteredStaffForDateParams) ([]dbpg.ListRosteredStaffForDateRow, error)
+	ListBookingStaffForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ListBookingStaffForDateRow, error)
+	ListActiveSlotHoldsForDate(ctx context.Context, date pgtype.Date) ([]dbpg.SlotHold, error)
+	GetCustomerProfileByUserID(ctx context.Context, userID int64) (dbpg.CustomerProfile, error)
+	ListBlackoutsInRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.ScheduleBlackout, error)
+	ListBookingsForRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.Booking, error)
+	ListActiveSlotHoldsForRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.SlotHold, error)
+	ListRosteredStaffForRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.ListRosteredStaffForRangeRow, error)
+	ListBookingStaffForRange(ctx context.Context, from, to pgtype.Date) ([]dbpg.ListBookingStaffForRangeRow, error)
 }
 
 type AvailableSlot struct {
@@ -29,42 +59,78 @@ type AvailableSlot struct {
 }
 
 type ScheduleService struct {
-	repo ScheduleRepository
+	repo     ScheduleRepository
+	settings *settings.Service
+	Waitlist WaitlistQueue
+	now      func() time.Time
 }
 
-func NewScheduleService(repo ScheduleRepository) *ScheduleService {
-	return &ScheduleService{repo: repo}
+func NewScheduleService(repo ScheduleRepository, settingsService *settings.Service) *ScheduleService {
+	return &ScheduleService{repo: repo, settings: settingsService, now: time.Now}
 }
 
-func (s *ScheduleService) GetScheduleConfig(ctx context.Context) ([]dbpg.ScheduleConfig, error) {
+// GetScheduleConfig returns the weekly hours and, for days with split hours,
+// their opening windows.
+func (s *ScheduleService) GetScheduleConfig(ctx context.Context) ([]dbpg.ScheduleConfig, []dbpg.ScheduleWindow, error) {
 	configs, err := s.repo.GetScheduleConfig(ctx)
 	if err != nil {
-		return nil, problems.New(problems.Database, "failed to get schedule config", err)
+		return nil, nil, problems.New(problems.Database, "failed to get schedule config", err)
 	}
-	return configs, nil
+	windows, err := s.repo.ListScheduleWindows(ctx)
+	if err != nil {
+		return nil, nil, problems.New(problems.Database, "failed to list schedule windows", err)
+	}
+	return configs, windows, nil
 }
 
-func (s *ScheduleService) UpdateScheduleConfig(ctx context.Context, params dbpg.UpdateScheduleConfigParams) (*dbpg.ScheduleConfig, error) {
+// UpdateScheduleConfig sets a weekday's hours. With more than one window the
+// day has split hours, and its open and close times become the first opening
+// and the last closing; otherwise any split hours are cleared.
+func (s *ScheduleService) UpdateScheduleConfig(ctx context.Context, params dbpg.UpdateScheduleConfigParams, windows []TimeWindow) (*dbpg.ScheduleConfig, []dbpg.ScheduleWindow, error) {
 	if params.DayOfWeek < 0 || params.DayOfWeek > 6 {
-		return nil, problems.New(problems.InvalidRequest, "day_of_week must be between 0 (Sunday) and 6 (Saturday)")
+		return nil, nil, problems.New(problems.InvalidRequest, "day_of_week must be between 0 (Sunday) and 6 (Saturday)")
+	}
+	if len(windows) > 0 {
+		sorted, err := sortedWindows(windows)
+		if err != nil {
+			return nil, nil, err
+		}
+		windows = sorted
+		params.OpenTime = windows[0].Open
+		params.CloseTime = windows[len(windows)-1].Close
 	}
 
 	cfg, err := s.repo.UpdateScheduleConfig(ctx, params)
 	if err != nil {
-		return nil, problems.New(problems.Database, "failed to update schedule config", err)
+		return nil, nil, problems.New(problems.Database, "failed to update schedule config", err)
+	}
+
+	split := dbpg.SetScheduleWindowsParams{DayOfWeek: params.DayOfWeek}
+	if len(windows) > 1 {
+		split.OpenTimes, split.CloseTimes = windowTimes(windows)
+	}
+	if err := s.repo.SetScheduleWindows(ctx, split); err != nil {
+		return nil, nil, problems.New(problems.Database, "failed to set schedule windows", err)
 	}
-	return &cfg, nil
+	stored, err := s.repo.ListScheduleWindowsForDay(ctx, params.DayOfWeek)
+	if err != nil {
+		return nil, nil, problems.New(problems.Database, "failed to list schedule windows", err)
+	}
+	return &cfg, stored, nil
 }
 
-func (s *ScheduleService) AddBlackout(ctx context.Context, dateStr string, reason string) (*dbpg.ScheduleBlackout, error) {
+// AddBlackout closes a whole date. A recurring blackout closes the same day
+// and month every year from then on.
+func (s *ScheduleService) AddBlackout(ctx context.Context, dateStr string, reason string, recursAnnually bool) (*dbpg.ScheduleBlackout, error) {
 	date, err := time.Parse("2006-01-02", dateStr)
 	if err != nil {
 		return nil, problems.New(problems.InvalidRequest, "invalid date format, expected YYYY-MM-DD")
 	}
 
 	blackout, err := s.repo.CreateBlackout(ctx, dbpg.CreateBlackoutParams{
-		Date:   pgtype.Date{Time: date, Valid: true},
-		Reason: dbpg.StringToPGString(reason),
+		Date:           pgtype.Date{Time: date, Valid: true},
+		Reason:         dbpg.StringToPGString(reason),
+		RecursAnnually: recursAnnually,
 	})
 	if err != nil {
 		return nil, problems.New(problems.Database, "failed to create blackout", err)
@@ -73,101 +139,757 @@ func (s *ScheduleService) AddBlackout(ctx context.Context, dateStr string, reaso
 }
 
 func (s *ScheduleService) RemoveBlackout(ctx context.Context, id int64) error {
-	err := s.repo.DeleteBlackout(ctx, id)
+	blackout, err := s.repo.DeleteBlackout(ctx, id)
 	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return problems.New(problems.NotExist, "blackout not found")
+		}
 		return problems.New(problems.Database, "failed to remove blackout", err)
 	}
+
+	// The reopened day may suit customers on the waitlist
+	queueWaitlistCheck(ctx, s.Waitlist, blackout.Date)
+	return nil
+}
+
+func (s *ScheduleService) ListResources(ctx context.Context) ([]dbpg.Resource, []dbpg.ResourceHour, error) {
+	resources, err := s.repo.ListResources(ctx)
+	if err != nil {
+		return nil, nil, problems.New(problems.Database, "failed to list resources", err)
+	}
+	hours, err := s.repo.ListResourceHours(ctx)
+	if err != nil {
+		return nil, nil, problems.New(problems.Database, "failed to list resource hours", err)
+	}
+	return resources, hours, nil
+}
+
+func (s *ScheduleService) CreateResource(ctx context.Context, params dbpg.CreateResourceParams) (*dbpg.Resource, error) {
+	if params.Name == "" || params.ResourceType == "" {
+		return nil, problems.New(problems.InvalidRequest, "name and resource_type are required")
+	}
+	res, err := s.repo.CreateResource(ctx, params)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to create resource", err)
+	}
+	return &res, nil
+}
+
+func (s *ScheduleService) UpdateResource(ctx context.Context, params dbpg.UpdateResourceParams) (*dbpg.Resource, error) {
+	if params.Name == "" || params.ResourceType == "" {
+		return nil, problems.New(problems.InvalidRequest, "name and resource_type are required")
+	}
+	res, err := s.repo.UpdateResource(ctx, params)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.NotExist, "resource not found")
+		}
+		return nil, problems.New(problems.Database, "failed to update resource", err)
+	}
+	return &res, nil
+}
+
+// SetResourceHours sets a resource's hours for one weekday.
+func (s *ScheduleService) SetResourceHours(ctx context.Context, params dbpg.UpsertResourceHoursParams) (*dbpg.ResourceHour, error) {
+	if params.DayOfWeek < 0 || params.DayOfWeek > 6 {
+		return nil, problems.New(problems.InvalidRequest, "day_of_week must be between 0 (Sunday) and 6 (Saturday)")
+	}
+	if params.IsOpen && params.CloseTime.Microseconds <= params.OpenTime.Microseconds {
+		return nil, problems.New(problems.InvalidRequest, "close_time must be after open_time")
+	}
+	h, err := s.repo.UpsertResourceHours(ctx, params)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to set resource hours", err)
+	}
+	return &h, nil
+}
+
+// ClearResourceHours removes a resource's own hours for a weekday so it
+// follows the business hours again.
+func (s *ScheduleService) ClearResourceHours(ctx context.Context, resourceID int64, dayOfWeek int32) error {
+	if err := s.repo.DeleteResourceHours(ctx, resourceID, dayOfWeek); err != nil {
+		return problems.New(problems.Database, "failed to clear resource hours", err)
+	}
 	return nil
 }
 
-func (s *ScheduleService) GetAvailableSlots(ctx context.Context, dateStr string, durationMinutes int32) ([]AvailableSlot, error) {
+// GetAvailableSlots lists the start times on a date that can take a job of
+// the given services. Unless durationMinutes is set, the job takes as long as
+// the services do on a vehicle of the given category. A postcode asks for a
+// mobile job there, which must be in the service area and leaves time to
+// drive between neighbouring jobs. Slots held for other customers are not
+// available; a signed-in user's own holds are ignored, so the slot they are
+// checking out stays listed.
+func (s *ScheduleService) GetAvailableSlots(ctx context.Context, dateStr string, durationMinutes int32, serviceIDs []int64, vehicleCategoryID int64, postcode string, userID int64) ([]AvailableSlot, error) {
 	date, err := time.Parse("2006-01-02", dateStr)
 	if err != nil {
 		return nil, problems.New(problems.InvalidRequest, "invalid date format, expected YYYY-MM-DD")
 	}
 
+	req, servicesDuration, err := s.requirementsForServices(ctx, serviceIDs, vehicleCategoryID, postcode)
+	if err != nil {
+		return nil, err
+	}
+	rules := loadBookingRules(ctx, s.settings)
+	if durationMinutes <= 0 {
+		durationMinutes = servicesDuration
+	}
 	if durationMinutes <= 0 {
-		durationMinutes = 60 // default to 60 minutes
+		durationMinutes = int32(rules.DefaultDurationMinutes)
 	}
+	jobRules := rules.forJob(req.categoryIDs)
+	req.step = jobRules.step
 
-	// Check if date is blacked out
-	isBlackedOut, err := s.repo.IsDateBlackedOut(ctx, dbpg.IsDateBlackedOutParams{
-		Date: pgtype.Date{Time: date, Valid: true},
-	})
+	holder, err := s.holderFor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	day, err := loadDaySchedule(ctx, s.repo, date)
+	if err != nil {
+		return nil, err
+	}
+	day = day.withoutHoldsFor(holder)
+	slots := []AvailableSlot{}
+	for _, f := range jobRules.bookable(s.clock(ctx), date, day.freeSlots(durationMinutes, req)) {
+		slots = append(slots, AvailableSlot{
+			Date:                  dateStr,
+			Time:                  fmt.Sprintf("%02d:%02d", f.start/60, f.start%60),
+			AvailableDurationMins: f.resource.openUntil(f.start) - f.start,
+		})
+	}
+
+	return slots, nil
+}
+
+// holderFor returns the customer ID whose slot holds a user's availability
+// search ignores, or 0 for a guest or a user with no customer profile.
+func (s *ScheduleService) holderFor(ctx context.Context, userID int64) (int64, error) {
+	if userID == 0 {
+		return 0, nil
+	}
+	customer, err := s.repo.GetCustomerProfileByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return 0, nil
+		}
+		return 0, problems.New(problems.Database, "failed to get customer profile", err)
+	}
+	return customer.ID, nil
+}
+
+// requirementsForServices returns what a job made up of the given services
+// needs from the schedule, and the services' combined duration for a vehicle
+// of the given category. No services means no restriction. A postcode makes
+// it a mobile job there, which must be in the service area.
+func (s *ScheduleService) requirementsForServices(ctx context.Context, serviceIDs []int64, vehicleCategoryID int64, postcode string) (jobRequirements, int32, error) {
+	var req jobRequirements
+	var duration int32
+	if len(serviceIDs) > 0 {
+		rows, err := s.repo.ListServiceRequirements(ctx, serviceIDs, vehicleCategoryID)
+		if err != nil {
+			return jobRequirements{}, 0, problems.New(problems.Database, "failed to get service requirements", err)
+		}
+		var types [][]string
+		var categoryIDs []int64
+		for _, row := range rows {
+			types = append(types, row.ResourceTypes)
+			categoryIDs = append(categoryIDs, row.CategoryID)
+			duration += row.DurationMinutes
+		}
+		req, err = newJobRequirements(types, categoryIDs)
+		if err != nil {
+			return jobRequirements{}, 0, err
+		}
+	}
+	if postcode != "" {
+		area := loadServiceArea(ctx, s.settings)
+		travel, err := area.Quote(postcode)
+		if err != nil {
+			return jobRequirements{}, 0, err
+		}
+		req.site = area.site(travel.Location)
+	}
+	return req, duration, nil
+}
+
+// jobRequirements is what a booking needs from the schedule: a resource of
+// one of resourceTypes (any if empty) and a detailer skilled in every one of
+// categoryIDs. site is set for mobile jobs, which need a mobile resource and
+// time to travel from the previous job; jobs at the workshop leave it nil.
+type jobRequirements struct {
+	resourceTypes []string
+	categoryIDs   []int64
+	site          *jobSite
+	step          int32 // minutes between start times from opening; 0 offers every 30 minutes and accepts any
+}
+
+// canUse reports whether a resource of kind may take the job.
+func (r jobRequirements) canUse(kind string) bool {
+	if (r.site != nil) != (kind == MobileResourceType) {
+		return false
+	}
+	return canUse(kind, r.resourceTypes)
+}
+
+// newJobRequirements combines the resource types and categories of the
+// services in a booking.
+func newJobRequirements(resourceTypes [][]string, categoryIDs []int64) (jobRequirements, error) {
+	types, err := commonResourceTypes(resourceTypes)
+	if err != nil {
+		return jobRequirements{}, err
+	}
+	categories := slices.Clone(categoryIDs)
+	slices.Sort(categories)
+	return jobRequirements{
+		resourceTypes: types,
+		categoryIDs:   slices.Compact(categories),
+	}, nil
+}
+
+// checkLocation reports services that cannot be done where the job is: a
+// mobile job whose services need a bay, or a workshop job whose services are
+// only offered mobile.
+func (r jobRequirements) checkLocation() error {
+	if len(r.resourceTypes) == 0 {
+		return nil
+	}
+	mobileOK := slices.Contains(r.resourceTypes, MobileResourceType)
+	if r.site != nil && !mobileOK {
+		return problems.New(problems.InvalidRequest, "the selected services can only be done at our workshop")
+	}
+	if r.site == nil && mobileOK && len(r.resourceTypes) == 1 {
+		return problems.New(problems.InvalidRequest, "the selected services are only offered as a mobile booking, please provide a service address")
+	}
+	return nil
+}
+
+// DefaultResourceTypes is used for services created without resource types.
+var DefaultResourceTypes = []string{"bay"}
+
+// commonResourceTypes intersects the resource types of several services. A
+// service with no types places no restriction. An empty intersection means
+// the services cannot share a single resource.
+func commonResourceTypes(lists [][]string) ([]string, error) {
+	var common []string
+	seen := false
+	for _, types := range lists {
+		if len(types) == 0 {
+			continue
+		}
+		if !seen {
+			common = slices.Clone(types)
+			seen = true
+			continue
+		}
+		common = slices.DeleteFunc(common, func(t string) bool { return !slices.Contains(types, t) })
+	}
+	if seen && len(common) == 0 {
+		return nil, problems.New(problems.InvalidRequest, "the selected services cannot be performed together in one booking")
+	}
+	return common, nil
+}
+
+// scheduleReader is the read side of the schedule needed to decide whether a
+// slot is free. Both ScheduleRepository and BookingTx satisfy it, so the same
+// check runs for slot listing and inside the booking transaction.
+type scheduleReader interface {
+	GetScheduleConfigForDay(ctx context.Context, dayOfWeek int32) (dbpg.ScheduleConfig, error)
+	ListScheduleWindowsForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ScheduleWindow, error)
+	ListScheduleOverridesForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ScheduleOverride, error)
+	IsDateBlackedOut(ctx context.Context, params dbpg.IsDateBlackedOutParams) (bool, error)
+	ListBookingsForDate(ctx context.Context, params dbpg.ListBookingsForDateParams) ([]dbpg.Booking, error)
+	ListActiveResources(ctx context.Context) ([]dbpg.Resource, error)
+	ListResourceHoursForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ResourceHour, error)
+	HasActiveStaff(ctx context.Context) (bool, error)
+	ListRosteredStaffForDate(ctx context.Context, params dbpg.ListRosteredStaffForDateParams) ([]dbpg.ListRosteredStaffForDateRow, error)
+	ListBookingStaffForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ListBookingStaffForDateRow, error)
+	ListActiveSlotHoldsForDate(ctx context.Context, date pgtype.Date) ([]dbpg.SlotHold, error)
+}
+
+// interval is a half-open [start, end) range in minutes since midnight.
+// bookingID or holdID is set when the interval is occupied by a booking or a
+// slot hold, holder is the customer a hold is for, and site is set when the
+// booking or hold is a mobile job.
+type interval struct {
+	start     int32
+	end       int32
+	bookingID int64
+	holdID    int64
+	holder    int64
+	site      *geo.Point
+}
+
+// resourceDay is a single resource's hours and bookings for a date. breaks
+// are closed periods between openMins and closeMins.
+type resourceDay struct {
+	id        int64
+	kind      string
+	openMins  int32
+	closeMins int32
+	breaks    []interval
+	occupied  []interval
+}
+
+// staffShift is a detailer's rostered hours on a date and the service
+// categories they are skilled in.
+type staffShift struct {
+	id          int64
+	startMins   int32
+	endMins     int32
+	categoryIDs []int64
+}
+
+// daySchedule holds, for a date, every resource that is open and the time
+// already taken on each by existing bookings, plus the detailers rostered on.
+type daySchedule struct {
+	open       bool
+	bufferMins int32
+	resources  []resourceDay
+
+	// staffed is false until any active staff exist; availability is then
+	// limited by resources alone.
+	staffed  bool
+	staff    []staffShift
+	bookings []interval        // every booking and hold on the day, across resources
+	assigned map[int64][]int64 // booking ID -> assigned staff IDs
+}
+
+// loadDaySchedule resolves opening hours, blackouts, resources and existing
+// bookings for a single date. A closed or blacked-out day is returned with
+// open == false.
+func loadDaySchedule(ctx context.Context, r scheduleReader, date time.Time) (daySchedule, error) {
+	pgDate := pgtype.Date{Time: date, Valid: true}
+
+	isBlackedOut, err := r.IsDateBlackedOut(ctx, dbpg.IsDateBlackedOutParams{Date: pgDate})
 	if err != nil {
-		return nil, problems.New(problems.Database, "failed to check blackout", err)
+		return daySchedule{}, problems.New(problems.Database, "failed to check blackout", err)
 	}
 	if isBlackedOut {
-		return []AvailableSlot{}, nil
+		return daySchedule{}, nil
 	}
 
 	// Get schedule config for this day of week (Go: Sunday=0, same as our DB)
 	dayOfWeek := int32(date.Weekday())
-	config, err := s.repo.GetScheduleConfigForDay(ctx, dayOfWeek)
+	config, err := r.GetScheduleConfigForDay(ctx, dayOfWeek)
+	if err != nil && !errors.Is(err, ErrNoRecord) {
+		return daySchedule{}, problems.New(problems.Database, "failed to get schedule config", err)
+	}
+
+	// Hours set for the date itself replace the weekly hours, and may open a
+	// day that is normally closed
+	overrides, err := r.ListScheduleOverridesForDate(ctx, pgDate)
 	if err != nil {
-		if errors.Is(err, ErrNoRecord) {
-			return []AvailableSlot{}, nil
+		return daySchedule{}, problems.New(problems.Database, "failed to list schedule overrides", err)
+	}
+	in := dayInputs{config: config}
+	if len(overrides) > 0 {
+		in.windows = overrideIntervals(overrides)
+		in.override = true
+	} else {
+		if !config.IsOpen {
+			return daySchedule{}, nil
 		}
-		return nil, problems.New(problems.Database, "failed to get schedule config", err)
+		windows, err := r.ListScheduleWindowsForDay(ctx, dayOfWeek)
+		if err != nil {
+			return daySchedule{}, problems.New(problems.Database, "failed to list schedule windows", err)
+		}
+		in.windows = weeklyIntervals(config, windows)
 	}
 
-	if !config.IsOpen {
-		return []AvailableSlot{}, nil
+	in.resources, err = r.ListActiveResources(ctx)
+	if err != nil {
+		return daySchedule{}, problems.New(problems.Database, "failed to list resources", err)
+	}
+	in.hours, err = r.ListResourceHoursForDay(ctx, dayOfWeek)
+	if err != nil {
+		return daySchedule{}, problems.New(problems.Database, "failed to list resource hours", err)
+	}
+	in.bookings, err = r.ListBookingsForDate(ctx, dbpg.ListBookingsForDateParams{ScheduledDate: pgDate})
+	if err != nil {
+		return daySchedule{}, problems.New(problems.Database, "failed to list bookings for date", err)
+	}
+	in.holds, err = r.ListActiveSlotHoldsForDate(ctx, pgDate)
+	if err != nil {
+		return daySchedule{}, problems.New(problems.Database, "failed to list slot holds", err)
 	}
 
-	// Get existing bookings for this date
-	bookings, err := s.repo.ListBookingsForDate(ctx, dbpg.ListBookingsForDateParams{
-		ScheduledDate: pgtype.Date{Time: date, Valid: true},
-	})
+	in.staffed, err = r.HasActiveStaff(ctx)
 	if err != nil {
-		return nil, problems.New(problems.Database, "failed to list bookings for date", err)
+		return daySchedule{}, problems.New(problems.Database, "failed to check staff", err)
 	}
+	if in.staffed {
+		rostered, err := r.ListRosteredStaffForDate(ctx, dbpg.ListRosteredStaffForDateParams{
+			DayOfWeek:     dayOfWeek,
+			ScheduledDate: pgDate,
+		})
+		if err != nil {
+			return daySchedule{}, problems.New(problems.Database, "failed to list rostered staff", err)
+		}
+		for _, rs := range rostered {
+			in.staff = append(in.staff, staffShift{
+				id:          rs.StaffID,
+				startMins:   minutesOf(rs.StartTime),
+				endMins:     minutesOf(rs.EndTime),
+				categoryIDs: rs.CategoryIds,
+			})
+		}
 
-	// Convert open/close times from microseconds to minutes since midnight
-	openMins := int32(config.OpenTime.Microseconds / 60000000)
-	closeMins := int32(config.CloseTime.Microseconds / 60000000)
-	bufferMins := config.BufferMinutes
+		assignments, err := r.ListBookingStaffForDate(ctx, pgDate)
+		if err != nil {
+			return daySchedule{}, problems.New(problems.Database, "failed to list staff assignments", err)
+		}
+		in.assigned = make(map[int64][]int64, len(assignments))
+		for _, a := range assignments {
+			in.assigned[a.BookingID] = append(in.assigned[a.BookingID], a.StaffID)
+		}
+	}
 
-	// Build occupied intervals (start, end) in minutes since midnight
-	type interval struct {
-		start int32
-		end   int32
+	return newDaySchedule(in), nil
+}
+
+// dayInputs is everything that decides an open date's availability, read
+// either for one date by loadDaySchedule or for many at once by
+// loadScheduleRange. windows are the business hours for the date, in order;
+// override is set when they come from a date override rather than the week.
+type dayInputs struct {
+	config    dbpg.ScheduleConfig
+	windows   []interval
+	override  bool
+	resources []dbpg.Resource
+	hours     []dbpg.ResourceHour // resource hours for the date's weekday
+	bookings  []dbpg.Booking
+	holds     []dbpg.SlotHold
+	staffed   bool
+	staff     []staffShift
+	assigned  map[int64][]int64 // booking ID -> assigned staff IDs
+}
+
+// newDaySchedule builds the schedule for an open date.
+func newDaySchedule(in dayInputs) daySchedule {
+	hoursByResource := make(map[int64]dbpg.ResourceHour, len(in.hours))
+	for _, h := range in.hours {
+		hoursByResource[h.ResourceID] = h
+	}
+
+	day := daySchedule{
+		open:       true,
+		bufferMins: in.config.BufferMinutes,
+		staffed:    in.staffed,
+		staff:      in.staff,
+		assigned:   in.assigned,
+	}
+	// Gaps between the business windows, such as a lunch break, apply to
+	// every resource
+	openMins := in.windows[0].start
+	closeMins := in.windows[len(in.windows)-1].end
+	var breaks []interval
+	for i := 1; i < len(in.windows); i++ {
+		breaks = append(breaks, interval{start: in.windows[i-1].end, end: in.windows[i].start})
 	}
-	var occupied []interval
-	for _, b := range bookings {
+
+	index := make(map[int64]int, len(in.resources))
+	for _, res := range in.resources {
+		// Resources without their own hours follow the business hours, as
+		// do all resources on a date with overridden hours
+		rd := resourceDay{
+			id:        res.ID,
+			kind:      res.ResourceType,
+			openMins:  openMins,
+			closeMins: closeMins,
+			breaks:    breaks,
+		}
+		if h, ok := hoursByResource[res.ID]; ok {
+			if !h.IsOpen {
+				continue
+			}
+			if !in.override {
+				rd.openMins = minutesOf(h.OpenTime)
+				rd.closeMins = minutesOf(h.CloseTime)
+			}
+		}
+		index[res.ID] = len(day.resources)
+		day.resources = append(day.resources, rd)
+	}
+
+	for _, b := range in.bookings {
 		if !b.ScheduledTime.Valid {
 			continue
 		}
-		bStart := int32(b.ScheduledTime.Microseconds / 60000000)
-		bEnd := bStart + b.EstimatedDurationMins + bufferMins
-		occupied = append(occupied, interval{start: bStart, end: bEnd})
+		bStart := minutesOf(b.ScheduledTime)
+		occ := interval{
+			start:     bStart,
+			end:       bStart + b.EstimatedDurationMins + day.bufferMins,
+			bookingID: b.ID,
+			site:      postcodeSite(b.ServicePostcode),
+		}
+		day.bookings = append(day.bookings, occ)
+		if i, ok := index[b.ResourceID]; ok {
+			day.resources[i].occupied = append(day.resources[i].occupied, occ)
+		}
 	}
 
-	// Generate 30-minute slot start times
-	var slots []AvailableSlot
-	for slotStart := openMins; slotStart+durationMinutes <= closeMins; slotStart += 30 {
-		slotEnd := slotStart + durationMinutes
+	// Held slots are taken until they expire, and like an unassigned booking
+	// each one needs a detailer
+	for _, h := range in.holds {
+		hStart := minutesOf(h.ScheduledTime)
+		occ := interval{
+			start:  hStart,
+			end:    hStart + h.DurationMins + day.bufferMins,
+			holdID: h.ID,
+			holder: h.CustomerID,
+			site:   postcodeSite(h.ServicePostcode),
+		}
+		day.bookings = append(day.bookings, occ)
+		if i, ok := index[h.ResourceID]; ok {
+			day.resources[i].occupied = append(day.resources[i].occupied, occ)
+		}
+	}
+	return day
+}
 
-		// Check if this slot conflicts with any occupied interval
-		conflict := false
-		for _, occ := range occupied {
-			// Slots overlap if one starts before the other ends and vice versa
-			if slotStart < occ.end && slotEnd+bufferMins > occ.start {
-				conflict = true
-				break
-			}
+// postcodeSite locates a mobile job's postcode, or returns nil for a job at
+// the workshop or a postcode that cannot be located.
+func postcodeSite(postcode pgtype.Text) *geo.Point {
+	if !postcode.Valid {
+		return nil
+	}
+	if loc, ok := geo.Lookup(postcode.String); ok {
+		return &loc.Point
+	}
+	return nil
+}
+
+func minutesOf(t pgtype.Time) int32 {
+	return int32(t.Microseconds / 60000000)
+}
+
+// canUse reports whether a resource of kind may be used when the job is
+// restricted to types. No types means any resource.
+func canUse(kind string, types []string) bool {
+	return len(types) == 0 || slices.Contains(types, kind)
+}
+
+// fits reports whether a job of the given duration can start at start on
+// this resource without leaving its hours or overlapping an existing booking
+// (including buffer time). Between two mobile jobs the gap must also cover
+// the drive from one site to the other when that takes longer than the
+// buffer.
+func (rd resourceDay) fits(start, duration, bufferMins int32, site *jobSite) bool {
+	end := start + duration
+	if start < rd.openMins || end > rd.closeMins {
+		return false
+	}
+	for _, br := range rd.breaks {
+		if start < br.end && end > br.start {
+			return false
+		}
+	}
+	for _, occ := range rd.occupied {
+		gap := bufferMins
+		if site != nil && occ.site != nil {
+			gap = max(gap, site.travelMins(*occ.site))
+		}
+		// Slots overlap if one starts before the other ends and vice versa
+		if start < occ.end-bufferMins+gap && end+gap > occ.start {
+			return false
+		}
+	}
+	return true
+}
+
+// openUntil returns when the resource next closes after start, at a break or
+// at the end of the day.
+func (rd resourceDay) openUntil(start int32) int32 {
+	until := rd.closeMins
+	for _, br := range rd.breaks {
+		if br.start >= start && br.start < until {
+			until = br.start
 		}
+	}
+	return until
+}
 
-		if !conflict {
-			hours := slotStart / 60
-			mins := slotStart % 60
-			slots = append(slots, AvailableSlot{
-				Date:                  dateStr,
-				Time:                  fmt.Sprintf("%02d:%02d", hours, mins),
-				AvailableDurationMins: closeMins - slotStart,
-			})
+// findResource returns the first usable resource that can take a job of the
+// given duration starting at start.
+func (d daySchedule) findResource(start, duration int32, req jobRequirements) (resourceDay, bool) {
+	for _, rd := range d.resources {
+		if req.canUse(rd.kind) && rd.fits(start, duration, d.bufferMins, req.site) {
+			return rd, true
 		}
 	}
+	return resourceDay{}, false
+}
 
-	return slots, nil
+// hasStaffFor reports whether a detailer skilled in every one of categoryIDs
+// is rostered on for the whole job and not already needed elsewhere. Staff
+// assigned to an overlapping booking are busy, and each overlapping booking
+// with nobody assigned yet is assumed to take one of the free detailers.
+func (d daySchedule) hasStaffFor(start, duration int32, categoryIDs []int64) bool {
+	if !d.staffed {
+		return true
+	}
+	end := start + duration
+	busy := make(map[int64]bool)
+	unassigned := 0
+	for _, b := range d.bookings {
+		if start >= b.end || end+d.bufferMins <= b.start {
+			continue
+		}
+		var staff []int64
+		if b.bookingID != 0 {
+			staff = d.assigned[b.bookingID]
+		}
+		if len(staff) == 0 {
+			unassigned++
+		}
+		for _, id := range staff {
+			busy[id] = true
+		}
+	}
+
+	free := 0
+	for _, sh := range d.staff {
+		if busy[sh.id] || start < sh.startMins || end > sh.endMins {
+			continue
+		}
+		if !containsAll(sh.categoryIDs, categoryIDs) {
+			continue
+		}
+		free++
+	}
+	return free > unassigned
+}
+
+func containsAll(have, want []int64) bool {
+	for _, id := range want {
+		if !slices.Contains(have, id) {
+			return false
+		}
+	}
+	return true
+}
+
+// window returns the earliest opening and latest closing time across the
+// usable resources, or false if none are open.
+func (d daySchedule) window(req jobRequirements) (openMins, closeMins int32, ok bool) {
+	if !d.open {
+		return 0, 0, false
+	}
+	for _, rd := range d.resources {
+		if !req.canUse(rd.kind) {
+			continue
+		}
+		if !ok || rd.openMins < openMins {
+			openMins = rd.openMins
+		}
+		if !ok || rd.closeMins > closeMins {
+			closeMins = rd.closeMins
+		}
+		ok = true
+	}
+	return openMins, closeMins, ok
+}
+
+// slotFit is a start time at which a job fits, and the resource it fits on.
+type slotFit struct {
+	start    int32
+	resource resourceDay
+}
+
+// freeSlots returns every start time, a step apart from opening, at which a
+// suitable resource can take the whole job and a rostered detailer is free
+// for it.
+func (d daySchedule) freeSlots(duration int32, req jobRequirements) []slotFit {
+	openMins, closeMins, ok := d.window(req)
+	if !ok {
+		return nil
+	}
+	step := req.step
+	if step <= 0 {
+		step = 30
+	}
+	var fits []slotFit
+	for start := openMins; start+duration <= closeMins; start += step {
+		res, ok := d.findResource(start, duration, req)
+		if !ok || !d.hasStaffFor(start, duration, req.categoryIDs) {
+			continue
+		}
+		fits = append(fits, slotFit{start: start, resource: res})
+	}
+	return fits
+}
+
+// without returns a copy of the schedule that ignores the given booking, so a
+// booking being moved does not conflict with itself.
+func (d daySchedule) without(bookingID int64) daySchedule {
+	return d.excluding(func(occ interval) bool { return occ.bookingID == bookingID })
+}
+
+// withoutHold returns a copy of the schedule that ignores the given hold, so
+// the holder can book the slot it reserves.
+func (d daySchedule) withoutHold(holdID int64) daySchedule {
+	return d.excluding(func(occ interval) bool { return occ.holdID == holdID })
+}
+
+// withoutHoldsFor returns a copy of the schedule that ignores every hold
+// placed for a customer, so their own holds do not stand in their way.
+func (d daySchedule) withoutHoldsFor(customerID int64) daySchedule {
+	return d.excluding(func(occ interval) bool { return occ.holdID != 0 && occ.holder == customerID })
+}
+
+func (d daySchedule) excluding(drop func(interval) bool) daySchedule {
+	resources := make([]resourceDay, len(d.resources))
+	for i, rd := range d.resources {
+		rd.occupied = slices.DeleteFunc(slices.Clone(rd.occupied), drop)
+		resources[i] = rd
+	}
+	d.resources = resources
+	d.bookings = slices.DeleteFunc(slices.Clone(d.bookings), drop)
+	return d
+}
+
+// checkSlotAvailable verifies that a job can be booked on date at startMins
+// for duration minutes on a resource and with a detailer meeting req, and
+// returns the resource to assign. It is used at booking time to re-validate
+// the slot the customer picked. A non-zero excludeBookingID ignores that
+// booking's own time, for reschedules.
+func checkSlotAvailable(ctx context.Context, r scheduleReader, date time.Time, startMins, duration int32, req jobRequirements, excludeBookingID int64) (int64, error) {
+	day, err := loadDaySchedule(ctx, r, date)
+	if err != nil {
+		return 0, err
+	}
+	if excludeBookingID != 0 {
+		day = day.without(excludeBookingID)
+	}
+	return day.check(startMins, duration, req)
+}
+
+// check verifies a job fits at startMins on an open day and returns the
+// resource to assign.
+func (d daySchedule) check(startMins, duration int32, req jobRequirements) (int64, error) {
+	if !d.open {
+		return 0, problems.New(problems.InvalidRequest, "bookings are not available on the selected date")
+	}
+	if err := req.checkLocation(); err != nil {
+		return 0, err
+	}
+	openMins, closeMins, ok := d.window(req)
+	if !ok {
+		return 0, problems.New(problems.InvalidRequest, "no bay or van for the selected services is available on that date")
+	}
+	if startMins < openMins || startMins+duration > closeMins {
+		return 0, problems.New(problems.InvalidRequest, "the selected time is outside opening hours")
+	}
+	if req.step > 0 && (startMins-openMins)%req.step != 0 {
+		return 0, problems.New(problems.InvalidRequest, "the selected time is not one of the offered start times")
+	}
+	res, ok := d.findResource(startMins, duration, req)
+	if !ok {
+		return 0, problems.New(problems.Exist, "the selected time slot is no longer available")
+	}
+	if !d.hasStaffFor(startMins, duration, req.categoryIDs) {
+		return 0, problems.New(problems.Exist, "no detailer is available at the selected time")
+	}
+	return res.id, nil
 }
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+)
+
+// TimeWindow is one period of opening hours within a day.
+type TimeWindow struct {
+	Open  pgtype.Time
+	Close pgtype.Time
+}
+
+// sortedWindows orders windows by opening time and rejects empty or
+// overlapping ones.
+func sortedWindows(windows []TimeWindow) ([]TimeWindow, error) {
+	sorted := slices.Clone(windows)
+	slices.SortFunc(sorted, func(a, b TimeWindow) int {
+		return int(a.Open.Microseconds - b.Open.Microseconds)
+	})
+	for i, w := range sorted {
+		if w.Close.Microseconds <= w.Open.Microseconds {
+			return nil, problems.New(problems.InvalidRequest, "each window must close after it opens")
+		}
+		if i > 0 && w.Open.Microseconds < sorted[i-1].Close.Microseconds {
+			return nil, problems.New(problems.InvalidRequest, "windows must not overlap")
+		}
+	}
+	return sorted, nil
+}
+
+func windowTimes(windows []TimeWindow) (opens, closes []pgtype.Time) {
+	for _, w := range windows {
+		opens = append(opens, w.Open)
+		closes = append(closes, w.Close)
+	}
+	return opens, closes
+}
+
+// weeklyIntervals returns a weekday's business hours: its split windows if
+// it has any, otherwise its open and close times.
+func weeklyIntervals(config dbpg.ScheduleConfig, windows []dbpg.ScheduleWindow) []interval {
+	if len(windows) == 0 {
+		return []interval{{start: minutesOf(config.OpenTime), end: minutesOf(config.CloseTime)}}
+	}
+	intervals := make([]interval, len(windows))
+	for i, w := range windows {
+		intervals[i] = interval{start: minutesOf(w.OpenTime), end: minutesOf(w.CloseTime)}
+	}
+	return intervals
+}
+
+// overrideIntervals returns the business hours set for a single date.
+func overrideIntervals(overrides []dbpg.ScheduleOverride) []interval {
+	intervals := make([]interval, len(overrides))
+	for i, o := range overrides {
+		intervals[i] = interval{start: minutesOf(o.OpenTime), end: minutesOf(o.CloseTime)}
+	}
+	return intervals
+}
+
+// ListScheduleOverrides returns the hours set for today and later dates.
+func (s *ScheduleService) ListScheduleOverrides(ctx context.Context) ([]dbpg.ScheduleOverride, error) {
+	overrides, err := s.repo.ListUpcomingScheduleOverrides(ctx)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list schedule overrides", err)
+	}
+	return overrides, nil
+}
+
+// SetScheduleOverride replaces the hours for one date with the given
+// windows, for a partial-day closure or a late opening. Closing the whole
+// day is done with a blackout.
+func (s *ScheduleService) SetScheduleOverride(ctx context.Context, dateStr string, windows []TimeWindow, reason string) ([]dbpg.ScheduleOverride, error) {
+	date, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return nil, problems.New(problems.InvalidRequest, "invalid date format, expected YYYY-MM-DD")
+	}
+	if len(windows) == 0 {
+		return nil, problems.New(problems.InvalidRequest, "at least one window is required, add a blackout to close the whole day")
+	}
+	windows, err = sortedWindows(windows)
+	if err != nil {
+		return nil, err
+	}
+
+	pgDate := pgtype.Date{Time: date, Valid: true}
+	opens, closes := windowTimes(windows)
+	overrides, err := s.repo.SetScheduleOverride(ctx, dbpg.SetScheduleOverrideParams{
+		Date:       pgDate,
+		OpenTimes:  opens,
+		CloseTimes: closes,
+		Reason:     dbpg.StringToPGString(reason),
+	})
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to set schedule override", err)
+	}
+
+	// Longer hours may suit customers on the waitlist
+	queueWaitlistCheck(ctx, s.Waitlist, pgDate)
+	return overrides, nil
+}
+
+// RemoveScheduleOverride returns a date to its weekly hours.
+func (s *ScheduleService) RemoveScheduleOverride(ctx context.Context, dateStr string) error {
+	date, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return problems.New(problems.InvalidRequest, "invalid date format, expected YYYY-MM-DD")
+	}
+	pgDate := pgtype.Date{Time: date, Valid: true}
+	removed, err := s.repo.DeleteScheduleOverride(ctx, pgDate)
+	if err != nil {
+		return problems.New(problems.Database, "failed to remove schedule override", err)
+	}
+	if removed == 0 {
+		return problems.New(problems.NotExist, "no override for that date")
+	}
+
+	queueWaitlistCheck(ctx, s.Waitlist, pgDate)
+	return nil
+}
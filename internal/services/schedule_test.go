@@ -0,0 +1,499 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/geo"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommonResourceTypes(t *testing.T) {
+	types, err := commonResourceTypes([][]string{{"bay", "van"}, {"bay"}, nil})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bay"}, types)
+
+	types, err = commonResourceTypes(nil)
+	require.NoError(t, err)
+	assert.Empty(t, types)
+
+	_, err = commonResourceTypes([][]string{{"van"}, {"bay"}})
+	assert.Error(t, err)
+}
+
+func TestDayScheduleFindResource(t *testing.T) {
+	day := daySchedule{
+		open:       true,
+		bufferMins: 30,
+		resources: []resourceDay{
+			{id: 1, kind: "bay", openMins: 420, closeMins: 1020, occupied: []interval{{start: 540, end: 690, bookingID: 7}}},
+			{id: 2, kind: "bay", openMins: 600, closeMins: 1020},
+			{id: 3, kind: "van", openMins: 420, closeMins: 960},
+		},
+	}
+
+	bays := jobRequirements{resourceTypes: []string{"bay"}}
+	anywhere := jobRequirements{resourceTypes: []string{"bay", "van"}}
+	mobile := jobRequirements{resourceTypes: []string{"bay", "van"}, site: &jobSite{speedKmh: 40}}
+
+	// 09:00 is taken on bay 1 and bay 2 opens at 10:00
+	_, ok := day.findResource(540, 60, bays)
+	assert.False(t, ok)
+
+	// The van only takes mobile jobs
+	_, ok = day.findResource(540, 60, anywhere)
+	assert.False(t, ok)
+	res, ok := day.findResource(540, 60, mobile)
+	require.True(t, ok)
+	assert.Equal(t, int64(3), res.id)
+
+	res, ok = day.findResource(600, 60, bays)
+	require.True(t, ok)
+	assert.Equal(t, int64(2), res.id)
+
+	// The booking being moved does not block itself
+	res, ok = day.without(7).findResource(540, 60, bays)
+	require.True(t, ok)
+	assert.Equal(t, int64(1), res.id)
+	assert.Len(t, day.resources[0].occupied, 1)
+
+	openMins, closeMins, ok := day.window(mobile)
+	require.True(t, ok)
+	assert.Equal(t, int32(420), openMins)
+	assert.Equal(t, int32(960), closeMins)
+}
+
+func TestResourceDayFitsTravelTime(t *testing.T) {
+	joondalup, _ := geo.Lookup("6027")
+	fremantle, _ := geo.Lookup("6160")
+
+	// A van job in Joondalup 09:00-10:00 with a 15 minute buffer
+	van := resourceDay{
+		id: 3, kind: "van", openMins: 420, closeMins: 1020,
+		occupied: []interval{{start: 540, end: 615, bookingID: 7, site: &joondalup.Point}},
+	}
+	atFremantle := &jobSite{point: fremantle.Point, speedKmh: 40}
+	atJoondalup := &jobSite{point: joondalup.Point, speedKmh: 40}
+
+	// Next door only needs the buffer
+	assert.True(t, van.fits(615, 60, 15, atJoondalup))
+
+	// Fremantle is ~34km away, so 52 minutes at 40km/h
+	travel := atFremantle.travelMins(joondalup.Point)
+	assert.Equal(t, int32(52), travel)
+	assert.False(t, van.fits(615, 60, 15, atFremantle))
+	assert.False(t, van.fits(600+travel-1, 60, 15, atFremantle))
+	assert.True(t, van.fits(600+travel, 60, 15, atFremantle))
+
+	// Travel is needed before the existing job too
+	assert.False(t, van.fits(480, 60, 15, atFremantle))
+	assert.True(t, van.fits(540-travel-60, 60, 15, atFremantle))
+
+	// Jobs without a site fall back to the buffer
+	assert.True(t, van.fits(615, 60, 15, nil))
+}
+
+func TestDayScheduleHasStaffFor(t *testing.T) {
+	day := daySchedule{
+		open:       true,
+		bufferMins: 30,
+		staffed:    true,
+		staff: []staffShift{
+			{id: 1, startMins: 480, endMins: 1020, categoryIDs: []int64{10, 20}},
+			{id: 2, startMins: 480, endMins: 720, categoryIDs: []int64{10}},
+		},
+		bookings: []interval{{start: 540, end: 630, bookingID: 7}},
+		assigned: map[int64][]int64{7: {1}},
+	}
+
+	// Staff 1 is on booking 7, staff 2 is free but not skilled in category 20
+	assert.True(t, day.hasStaffFor(540, 60, []int64{10}))
+	assert.False(t, day.hasStaffFor(540, 60, []int64{10, 20}))
+
+	// Staff 2 finishes at 12:00, so cannot take a job running past it
+	assert.True(t, day.hasStaffFor(540, 120, []int64{10}))
+	assert.False(t, day.hasStaffFor(600, 180, []int64{10}))
+
+	// An unassigned booking takes one of the free detailers
+	day.assigned = map[int64][]int64{}
+	assert.True(t, day.hasStaffFor(540, 60, []int64{10}))
+	day.staff = day.staff[:1]
+	assert.False(t, day.hasStaffFor(540, 60, []int64{10}))
+
+	// Without any staff set up, only resources limit availability
+	day.staffed = false
+	assert.True(t, day.hasStaffFor(540, 60, []int64{30}))
+}
+
+func TestDayScheduleSlotHolds(t *testing.T) {
+	hold := interval{start: 540, end: 630, holdID: 3, holder: 7}
+	day := daySchedule{
+		open:      true,
+		resources: []resourceDay{{id: 1, kind: "bay", openMins: 480, closeMins: 720, occupied: []interval{hold}}},
+		bookings:  []interval{hold},
+	}
+
+	// 08:00-09:00 and 10:30-11:30 are free around the 09:00 hold
+	fits := day.freeSlots(60, jobRequirements{})
+	var starts []int32
+	for _, f := range fits {
+		starts = append(starts, f.start)
+	}
+	assert.Equal(t, []int32{480, 630, 660}, starts)
+
+	_, err := day.check(540, 60, jobRequirements{})
+	assert.Error(t, err)
+
+	// The holder can book the slot they hold
+	resourceID, err := day.withoutHold(3).check(540, 60, jobRequirements{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resourceID)
+	_, err = day.withoutHoldsFor(7).check(540, 60, jobRequirements{})
+	assert.NoError(t, err)
+
+	// Other customers' holds still stand
+	_, err = day.withoutHoldsFor(8).check(540, 60, jobRequirements{})
+	assert.Error(t, err)
+}
+
+// queuedDates records the dates queued for a waitlist check.
+type queuedDates []string
+
+func (q *queuedDates) QueueWaitlistCheck(ctx context.Context, date string) error {
+	*q = append(*q, date)
+	return nil
+}
+
+// heldScheduleRepo reads a day's schedule and holds from a checkout.
+type heldScheduleRepo struct {
+	ScheduleRepository
+	tx *waitlistTx
+}
+
+func (r heldScheduleRepo) GetCustomerProfileByUserID(ctx context.Context, userID int64) (dbpg.CustomerProfile, error) {
+	return r.tx.repo.GetCustomerProfileByUserID(ctx, userID)
+}
+
+func (r heldScheduleRepo) GetScheduleConfigForDay(ctx context.Context, dayOfWeek int32) (dbpg.ScheduleConfig, error) {
+	return r.tx.GetScheduleConfigForDay(ctx, dayOfWeek)
+}
+
+func (r heldScheduleRepo) ListScheduleWindowsForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ScheduleWindow, error) {
+	return r.tx.ListScheduleWindowsForDay(ctx, dayOfWeek)
+}
+
+func (r heldScheduleRepo) ListScheduleOverridesForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ScheduleOverride, error) {
+	return r.tx.ListScheduleOverridesForDate(ctx, date)
+}
+
+func (r heldScheduleRepo) IsDateBlackedOut(ctx context.Context, params dbpg.IsDateBlackedOutParams) (bool, error) {
+	return r.tx.IsDateBlackedOut(ctx, params)
+}
+
+func (r heldScheduleRepo) ListBookingsForDate(ctx context.Context, params dbpg.ListBookingsForDateParams) ([]dbpg.Booking, error) {
+	return r.tx.ListBookingsForDate(ctx, params)
+}
+
+func (r heldScheduleRepo) ListActiveResources(ctx context.Context) ([]dbpg.Resource, error) {
+	return r.tx.ListActiveResources(ctx)
+}
+
+func (r heldScheduleRepo) ListResourceHoursForDay(ctx context.Context, dayOfWeek int32) ([]dbpg.ResourceHour, error) {
+	return r.tx.ListResourceHoursForDay(ctx, dayOfWeek)
+}
+
+func (r heldScheduleRepo) HasActiveStaff(ctx context.Context) (bool, error) {
+	return r.tx.HasActiveStaff(ctx)
+}
+
+func (r heldScheduleRepo) ListActiveSlotHoldsForDate(ctx context.Context, date pgtype.Date) ([]dbpg.SlotHold, error) {
+	return r.tx.ListActiveSlotHoldsForDate(ctx, date)
+}
+
+// newHolds is the checkout day with customers 3 (user 5) and 4 (user 6),
+// and no holds yet.
+func newHolds() (*BookingService, *waitlistRepo, *queuedDates, CreateBookingFromCartParams) {
+	_, checkout, params := newCheckout(0)
+	repo := &waitlistRepo{
+		checkoutRepo: checkout,
+		now:          time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+		customers: map[int64]dbpg.CustomerProfile{
+			5: {ID: 3, UserID: 5},
+			6: {ID: 4, UserID: 6},
+		},
+	}
+	queue := &queuedDates{}
+	svc := NewBookingService(repo, nil)
+	svc.now = func() time.Time { return repo.now }
+	svc.Waitlist = queue
+	return svc, repo, queue, params
+}
+
+// slotHold is a three hour hold on bay 1 for customerID, ending its claim
+// or checkout window ttl from the checkout clock.
+func slotHold(id, customerID int64, date string, hour int, purpose string, ttl time.Duration) dbpg.SlotHold {
+	return dbpg.SlotHold{
+		ID:            id,
+		CustomerID:    customerID,
+		ResourceID:    1,
+		ScheduledDate: pgDateOf(date),
+		ScheduledTime: pgTimeOf(hour, 0),
+		DurationMins:  180,
+		ExpiresAt:     pgtype.Timestamptz{Time: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC).Add(ttl), Valid: true},
+		Purpose:       purpose,
+	}
+}
+
+func TestGetAvailableSlotsHolds(t *testing.T) {
+	tests := []struct {
+		name   string
+		userID int64
+		hold   dbpg.SlotHold
+		starts []string
+	}{
+		{name: "guest", userID: 0, hold: slotHold(1, 4, "2026-03-04", 10, SlotHoldCheckout, time.Hour), starts: []string{"13:00", "13:30", "14:00"}},
+		{name: "another customer", userID: 5, hold: slotHold(1, 4, "2026-03-04", 10, SlotHoldCheckout, time.Hour), starts: []string{"13:00", "13:30", "14:00"}},
+		{name: "no customer profile", userID: 7, hold: slotHold(1, 4, "2026-03-04", 10, SlotHoldCheckout, time.Hour), starts: []string{"13:00", "13:30", "14:00"}},
+		{name: "waitlist offer", userID: 5, hold: slotHold(1, 4, "2026-03-04", 10, SlotHoldWaitlist, time.Hour), starts: []string{"13:00", "13:30", "14:00"}},
+		{name: "the holder", userID: 6, hold: slotHold(1, 4, "2026-03-04", 10, SlotHoldCheckout, time.Hour)},
+		{name: "expired hold", userID: 5, hold: slotHold(1, 4, "2026-03-04", 10, SlotHoldCheckout, 0)},
+		{name: "hold on another day", userID: 5, hold: slotHold(1, 4, "2026-03-05", 10, SlotHoldCheckout, time.Hour)},
+	}
+	// Every half hour a three hour job fits in 08:00-17:00
+	var open []string
+	for m := 8 * 60; m <= 14*60; m += 30 {
+		open = append(open, formatTime(pgtype.Time{Microseconds: int64(m) * 60000000, Valid: true}))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, repo, _, _ := newHolds()
+			repo.holds = []dbpg.SlotHold{tt.hold}
+			svc := NewScheduleService(heldScheduleRepo{tx: &waitlistTx{checkoutTx: &checkoutTx{repo: repo.checkoutRepo}, repo: repo}}, nil)
+			svc.now = func() time.Time { return repo.now }
+
+			slots, err := svc.GetAvailableSlots(context.Background(), "2026-03-04", 180, nil, 0, "", tt.userID)
+			require.NoError(t, err)
+			var starts []string
+			for _, slot := range slots {
+				starts = append(starts, slot.Time)
+			}
+			want := tt.starts
+			if want == nil {
+				want = open
+			}
+			assert.Equal(t, want, starts)
+		})
+	}
+}
+
+func TestHoldCheckoutSlot(t *testing.T) {
+	const mine, theirs = 3, 4
+	tests := []struct {
+		name   string
+		userID int64
+		date   string
+		time   string
+		holds  []dbpg.SlotHold
+		booked bool // another customer has 10:00-13:00
+		kind   problems.Kind
+	}{
+		{name: "free slot", userID: 5, date: "2026-03-04", time: "10:00"},
+		{
+			name: "choosing another slot", userID: 5, date: "2026-03-04", time: "10:00",
+			holds: []dbpg.SlotHold{slotHold(1, mine, "2026-03-05", 13, SlotHoldCheckout, time.Hour)},
+		},
+		{
+			name: "the slot already held for them", userID: 5, date: "2026-03-04", time: "10:00",
+			holds: []dbpg.SlotHold{slotHold(1, mine, "2026-03-04", 10, SlotHoldCheckout, time.Hour)},
+		},
+		{
+			name: "another customer's hold has run out", userID: 5, date: "2026-03-04", time: "10:00",
+			holds: []dbpg.SlotHold{slotHold(1, theirs, "2026-03-04", 10, SlotHoldCheckout, 0)},
+		},
+		{
+			name: "held for another customer", userID: 5, date: "2026-03-04", time: "10:00",
+			holds: []dbpg.SlotHold{
+				slotHold(1, mine, "2026-03-05", 13, SlotHoldCheckout, time.Hour),
+				slotHold(2, theirs, "2026-03-04", 11, SlotHoldCheckout, time.Hour),
+			},
+			kind: problems.Exist,
+		},
+		{
+			name: "offered from the waitlist", userID: 5, date: "2026-03-04", time: "10:00",
+			holds: []dbpg.SlotHold{slotHold(1, theirs, "2026-03-04", 12, SlotHoldWaitlist, time.Hour)},
+			kind:  problems.Exist,
+		},
+		{name: "already booked", userID: 5, date: "2026-03-04", time: "11:00", booked: true, kind: problems.Exist},
+		{name: "inside the lead time", userID: 5, date: "2026-03-03", time: "10:00", kind: problems.InvalidRequest},
+		{name: "bad date", userID: 5, date: "4 March", time: "10:00", kind: problems.InvalidRequest},
+		{name: "bad time", userID: 5, date: "2026-03-04", time: "10am", kind: problems.InvalidRequest},
+		{name: "no customer profile", userID: 7, date: "2026-03-04", time: "10:00", kind: problems.NotExist},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, queue, _ := newHolds()
+			repo.holds = slices.Clone(tt.holds)
+			repo.holdSeq = int64(len(tt.holds))
+			if tt.booked {
+				repo.bookings = append(repo.bookings, dbpg.Booking{
+					ID: 1, CustomerID: theirs, ResourceID: 1, ScheduledDate: pgDateOf("2026-03-04"),
+					ScheduledTime: pgTimeOf(10, 0), EstimatedDurationMins: 180, Status: dbpg.BookingStatusConfirmed,
+				})
+			}
+
+			hold, err := svc.HoldCheckoutSlot(context.Background(), HoldCheckoutSlotParams{
+				UserID:        tt.userID,
+				ScheduledDate: tt.date,
+				ScheduledTime: tt.time,
+			})
+			if tt.kind != 0 {
+				var p problems.Problem
+				require.ErrorAs(t, err, &p)
+				assert.Equal(t, tt.kind, p.Kind)
+				// Their previous hold is kept
+				assert.Equal(t, tt.holds, repo.holds)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(mine), hold.CustomerID)
+			assert.Equal(t, int64(1), hold.ResourceID)
+			assert.Equal(t, tt.date, formatDate(hold.ScheduledDate))
+			assert.Equal(t, tt.time, formatTime(hold.ScheduledTime))
+			assert.Equal(t, int32(180), hold.DurationMins)
+			assert.Equal(t, SlotHoldCheckout, hold.Purpose)
+			assert.WithinDuration(t, repo.now.Add(defaultCheckoutHoldMinutes*time.Minute), hold.ExpiresAt.Time, 0)
+
+			// One checkout hold each; the slot given up goes to the waitlist
+			var kept []dbpg.SlotHold
+			for _, h := range tt.holds {
+				if h.CustomerID != mine {
+					kept = append(kept, h)
+				}
+			}
+			assert.Equal(t, append(kept, *hold), repo.holds)
+			for _, h := range tt.holds {
+				if h.CustomerID == mine {
+					assert.Equal(t, []string{formatDate(h.ScheduledDate)}, []string(*queue))
+				}
+			}
+		})
+	}
+}
+
+func TestCreateBookingFromCartHold(t *testing.T) {
+	const mine, theirs = 3, 4
+	tests := []struct {
+		name  string
+		holds []dbpg.SlotHold
+		kept  []dbpg.SlotHold // holds left afterwards
+		kind  problems.Kind
+	}{
+		{name: "no hold"},
+		{
+			name:  "their hold becomes the booking",
+			holds: []dbpg.SlotHold{slotHold(1, mine, "2026-03-04", 10, SlotHoldCheckout, time.Hour)},
+		},
+		{
+			name:  "their hold on another slot is released",
+			holds: []dbpg.SlotHold{slotHold(1, mine, "2026-03-05", 13, SlotHoldCheckout, time.Hour)},
+		},
+		{
+			name:  "another customer's hold runs out",
+			holds: []dbpg.SlotHold{slotHold(1, theirs, "2026-03-04", 10, SlotHoldCheckout, 0)},
+			kept:  []dbpg.SlotHold{slotHold(1, theirs, "2026-03-04", 10, SlotHoldCheckout, 0)},
+		},
+		{
+			name:  "held for another customer",
+			holds: []dbpg.SlotHold{slotHold(1, mine, "2026-03-04", 10, SlotHoldCheckout, time.Hour), slotHold(2, theirs, "2026-03-04", 12, SlotHoldCheckout, time.Hour)},
+			kind:  problems.Exist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, _, params := newHolds()
+			repo.holds = slices.Clone(tt.holds)
+
+			booking, err := svc.CreateBookingFromCart(context.Background(), params)
+			if tt.kind != 0 {
+				var p problems.Problem
+				require.ErrorAs(t, err, &p)
+				assert.Equal(t, tt.kind, p.Kind)
+				assert.Empty(t, repo.bookings)
+				assert.Equal(t, tt.holds, repo.holds)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "10:00", formatTime(booking.ScheduledTime))
+			assert.ElementsMatch(t, tt.kept, repo.holds)
+		})
+	}
+}
+
+func TestReleaseCheckoutHolds(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, queue, _ := newHolds()
+	repo.holds = []dbpg.SlotHold{
+		slotHold(1, 3, "2026-03-04", 8, SlotHoldCheckout, 0),
+		slotHold(2, 4, "2026-03-05", 8, SlotHoldCheckout, -time.Minute),
+		slotHold(3, 5, "2026-03-04", 11, SlotHoldCheckout, -time.Hour),
+		slotHold(4, 4, "2026-03-04", 14, SlotHoldCheckout, time.Minute),
+		slotHold(5, 6, "2026-03-06", 8, SlotHoldWaitlist, -time.Hour),
+	}
+
+	// Run out checkout holds go, once per date to the waitlist; waitlist
+	// offers are left for their own expiry
+	released, err := svc.ReleaseExpiredCheckoutHolds(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, released)
+	assert.ElementsMatch(t, []string{"2026-03-04", "2026-03-05"}, []string(*queue))
+	require.Len(t, repo.holds, 2)
+	assert.Equal(t, int64(4), repo.holds[0].ID)
+
+	// Leaving checkout gives up the live hold
+	*queue = nil
+	require.NoError(t, svc.ReleaseCheckoutHold(ctx, 6))
+	require.Len(t, repo.holds, 1)
+	assert.Equal(t, SlotHoldWaitlist, repo.holds[0].Purpose)
+	assert.Equal(t, []string{"2026-03-04"}, []string(*queue))
+
+	// Nothing to release for someone who has never checked out
+	require.NoError(t, svc.ReleaseCheckoutHold(ctx, 7))
+	require.NoError(t, svc.ReleaseCheckoutHold(ctx, 5))
+	assert.Len(t, repo.holds, 1)
+}
+
+func TestNewDayScheduleWindows(t *testing.T) {
+	in := dayInputs{
+		config:    dbpg.ScheduleConfig{BufferMinutes: 0},
+		windows:   []interval{{start: 480, end: 720}, {start: 780, end: 1020}},
+		resources: []dbpg.Resource{{ID: 1, ResourceType: "bay"}, {ID: 2, ResourceType: "bay"}},
+		hours: []dbpg.ResourceHour{
+			{ResourceID: 2, IsOpen: true, OpenTime: pgTimeOf(10, 0), CloseTime: pgTimeOf(14, 0)},
+		},
+	}
+	day := newDaySchedule(in)
+	require.Len(t, day.resources, 2)
+
+	// A two hour job cannot run through the 12:00-13:00 lunch break
+	_, err := day.check(660, 120, jobRequirements{})
+	assert.Error(t, err)
+	// Bay 2 closes at 14:00, so only bay 1 can take the afternoon job
+	res, ok := day.findResource(780, 120, jobRequirements{})
+	require.True(t, ok)
+	assert.Equal(t, int64(1), res.id)
+	assert.Equal(t, int32(720), day.resources[0].openUntil(600))
+
+	// On an overridden date every resource follows the override
+	in.override = true
+	in.windows = []interval{{start: 540, end: 600}}
+	day = newDaySchedule(in)
+	assert.Equal(t, int32(540), day.resources[1].openMins)
+	assert.Equal(t, int32(600), day.resources[1].closeMins)
+}
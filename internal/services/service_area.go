@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/richardbowden/degrees/internal/geo"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/settings"
+)
+
+// MobileResourceType is the resource type that travels to the customer.
+// Mobile jobs are only done on these, and jobs at the workshop never are.
+const MobileResourceType = "van"
+
+const (
+	ServiceAreaRadius    = "radius"
+	ServiceAreaPostcodes = "postcodes"
+)
+
+// ServiceArea is stored in settings under booking:service_area. It decides
+// where mobile jobs are accepted and what travel to them costs.
+type ServiceArea struct {
+	// Mode is "radius" (within RadiusKm of the base) or "postcodes" (only
+	// the listed postcodes).
+	Mode         string   `json:"mode"`
+	BasePostcode string   `json:"base_postcode"`
+	RadiusKm     float64  `json:"radius_km"`
+	Postcodes    []string `json:"postcodes"`
+
+	// Jobs further than FreeTravelKm from the base are charged
+	// SurchargePerKm cents for each extra kilometre or part of one.
+	FreeTravelKm   float64 `json:"free_travel_km"`
+	SurchargePerKm int64   `json:"surcharge_per_km"`
+
+	// TravelSpeedKmh is the average speed assumed when fitting travel time
+	// between consecutive mobile jobs.
+	TravelSpeedKmh float64 `json:"travel_speed_kmh"`
+}
+
+// DefaultServiceArea is used when no service area has been configured.
+var DefaultServiceArea = ServiceArea{
+	Mode:           ServiceAreaRadius,
+	BasePostcode:   "6027",
+	RadiusKm:       40,
+	FreeTravelKm:   15,
+	SurchargePerKm: 100,
+	TravelSpeedKmh: 40,
+}
+
+// loadServiceArea reads the configured service area, falling back to the
+// default if it is missing or has no base.
+func loadServiceArea(ctx context.Context, s *settings.Service) ServiceArea {
+	if s == nil {
+		return DefaultServiceArea
+	}
+	area, err := settings.GetTyped[ServiceArea](ctx, s, "booking", "service_area", settings.SystemScope())
+	if err != nil || area.BasePostcode == "" {
+		return DefaultServiceArea
+	}
+	return area
+}
+
+// ServiceLocation is the customer's address for a mobile job.
+type ServiceLocation struct {
+	Address  string
+	Suburb   string
+	Postcode string
+}
+
+// TravelQuote is what a mobile job at a postcode costs in travel.
+type TravelQuote struct {
+	Location   geo.Postcode
+	DistanceKm float64
+	Surcharge  int64
+}
+
+// Quote checks that postcode is inside the service area and prices travel to
+// it from the base.
+func (a ServiceArea) Quote(postcode string) (TravelQuote, error) {
+	postcode = strings.TrimSpace(postcode)
+	if postcode == "" {
+		return TravelQuote{}, problems.New(problems.InvalidRequest, "a postcode is required for mobile bookings")
+	}
+	loc, ok := geo.Lookup(postcode)
+	if !ok {
+		return TravelQuote{}, problems.New(problems.InvalidRequest, fmt.Sprintf("postcode %s is outside our service area", postcode))
+	}
+	base, ok := geo.Lookup(a.BasePostcode)
+	if !ok {
+		return TravelQuote{}, problems.New(problems.Internal, fmt.Sprintf("service area base postcode %s is unknown", a.BasePostcode))
+	}
+
+	distance := geo.DistanceKm(base.Point, loc.Point)
+	switch a.Mode {
+	case ServiceAreaPostcodes:
+		if !slices.Contains(a.Postcodes, postcode) {
+			return TravelQuote{}, problems.New(problems.InvalidRequest, fmt.Sprintf("postcode %s is outside our service area", postcode))
+		}
+	default:
+		if distance > a.RadiusKm {
+			return TravelQuote{}, problems.New(problems.InvalidRequest, fmt.Sprintf("postcode %s is outside our service area", postcode))
+		}
+	}
+
+	quote := TravelQuote{Location: loc, DistanceKm: math.Round(distance*10) / 10}
+	if extra := distance - a.FreeTravelKm; extra > 0 {
+		quote.Surcharge = int64(math.Ceil(extra)) * a.SurchargePerKm
+	}
+	return quote, nil
+}
+
+// site returns where a mobile job at loc is done, for travel time between jobs.
+func (a ServiceArea) site(loc geo.Postcode) *jobSite {
+	return &jobSite{point: loc.Point, speedKmh: a.TravelSpeedKmh}
+}
+
+// jobSite is where a mobile job is done and how fast a van gets there. A
+// zero speed means the site could not be located and travel is not estimated.
+type jobSite struct {
+	point    geo.Point
+	speedKmh float64
+}
+
+// travelMins is the time needed to drive to this site from another job at p.
+func (s *jobSite) travelMins(p geo.Point) int32 {
+	return geo.TravelMinutes(geo.DistanceKm(p, s.point), s.speedKmh)
+}
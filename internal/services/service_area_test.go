@@ -0,0 +1,37 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceAreaQuote(t *testing.T) {
+	area := DefaultServiceArea
+
+	// Joondalup is the base: no travel charge
+	q, err := area.Quote("6027")
+	require.NoError(t, err)
+	assert.Equal(t, "Joondalup", q.Location.Suburb)
+	assert.Zero(t, q.Surcharge)
+
+	// Subiaco is ~23km away, 8km past the free distance at $1/km
+	q, err = area.Quote("6008")
+	require.NoError(t, err)
+	assert.InDelta(t, 23, q.DistanceKm, 1.5)
+	assert.Equal(t, int64(900), q.Surcharge)
+
+	// Mandurah is well outside 40km, and unknown postcodes are not covered
+	_, err = area.Quote("6210")
+	assert.Error(t, err)
+	_, err = area.Quote("2000")
+	assert.Error(t, err)
+
+	area.Mode = ServiceAreaPostcodes
+	area.Postcodes = []string{"6210"}
+	_, err = area.Quote("6008")
+	assert.Error(t, err)
+	_, err = area.Quote("6210")
+	assert.NoError(t, err)
+}
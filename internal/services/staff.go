@@ -0,0 +1,327 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+)
+
+type StaffRepository interface {
+	ListStaff(ctx context.Context) ([]dbpg.ListStaffRow, error)
+	GetStaffByID(ctx context.Context, id int64) (dbpg.Staff, error)
+	GetStaffByUserID(ctx context.Context, userID int64) (dbpg.Staff, error)
+	CreateStaff(ctx context.Context, params dbpg.CreateStaffParams) (dbpg.Staff, error)
+	UpdateStaff(ctx context.Context, params dbpg.UpdateStaffParams) (dbpg.Staff, error)
+	ListAllStaffSkills(ctx context.Context) ([]dbpg.StaffSkill, error)
+	SetStaffSkills(ctx context.Context, staffID int64, categoryIDs []int64) error
+	ListStaffRoster(ctx context.Context) ([]dbpg.StaffRoster, error)
+	UpsertStaffRoster(ctx context.Context, params dbpg.UpsertStaffRosterParams) (dbpg.StaffRoster, error)
+	DeleteStaffRoster(ctx context.Context, staffID int64, dayOfWeek int32) error
+	ListUpcomingStaffLeave(ctx context.Context) ([]dbpg.StaffLeave, error)
+	CreateStaffLeave(ctx context.Context, params dbpg.CreateStaffLeaveParams) (dbpg.StaffLeave, error)
+	DeleteStaffLeave(ctx context.Context, id int64) error
+	GetBookingByID(ctx context.Context, id int64) (dbpg.GetBookingByIDRow, error)
+	ListBookingStaff(ctx context.Context, bookingID int64) ([]dbpg.ListBookingStaffRow, error)
+	SetBookingStaff(ctx context.Context, params dbpg.SetBookingStaffParams) error
+	ListStaffBookingsForDate(ctx context.Context, staffID int64, date pgtype.Date) ([]dbpg.Booking, error)
+	ListRosteredStaffForDate(ctx context.Context, params dbpg.ListRosteredStaffForDateParams) ([]dbpg.ListRosteredStaffForDateRow, error)
+}
+
+// StaffMember is a detailer with their skills, weekly roster and upcoming leave.
+type StaffMember struct {
+	Staff       dbpg.ListStaffRow
+	CategoryIDs []int64
+	Roster      []dbpg.StaffRoster
+	Leave       []dbpg.StaffLeave
+}
+
+type StaffService struct {
+	repo  StaffRepository
+	authz AdminChecker
+}
+
+func NewStaffService(repo StaffRepository, authz AdminChecker) *StaffService {
+	return &StaffService{
+		repo:  repo,
+		authz: authz,
+	}
+}
+
+func (s *StaffService) requireAdmin(ctx context.Context, userID int64) error {
+	isAdmin, err := s.authz.IsSystemAdmin(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return problems.New(problems.Unauthorized, "admin access required")
+	}
+	return nil
+}
+
+// ListStaff returns every staff member with their skills, roster and upcoming leave (admin only).
+func (s *StaffService) ListStaff(ctx context.Context, userID int64) ([]StaffMember, error) {
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	staff, err := s.repo.ListStaff(ctx)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list staff", err)
+	}
+	skills, err := s.repo.ListAllStaffSkills(ctx)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list staff skills", err)
+	}
+	roster, err := s.repo.ListStaffRoster(ctx)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list staff roster", err)
+	}
+	leave, err := s.repo.ListUpcomingStaffLeave(ctx)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list staff leave", err)
+	}
+
+	members := make([]StaffMember, len(staff))
+	index := make(map[int64]int, len(staff))
+	for i, st := range staff {
+		members[i] = StaffMember{Staff: st}
+		index[st.ID] = i
+	}
+	for _, sk := range skills {
+		if i, ok := index[sk.StaffID]; ok {
+			members[i].CategoryIDs = append(members[i].CategoryIDs, sk.CategoryID)
+		}
+	}
+	for _, r := range roster {
+		if i, ok := index[r.StaffID]; ok {
+			members[i].Roster = append(members[i].Roster, r)
+		}
+	}
+	for _, l := range leave {
+		if i, ok := index[l.StaffID]; ok {
+			members[i].Leave = append(members[i].Leave, l)
+		}
+	}
+	return members, nil
+}
+
+// CreateStaff links a user account to a new staff member (admin only).
+func (s *StaffService) CreateStaff(ctx context.Context, userID int64, params dbpg.CreateStaffParams, categoryIDs []int64) (*dbpg.Staff, error) {
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+	if params.UserID == 0 || params.DisplayName == "" {
+		return nil, problems.New(problems.InvalidRequest, "user_id and display_name are required")
+	}
+
+	if _, err := s.repo.GetStaffByUserID(ctx, params.UserID); err == nil {
+		return nil, problems.New(problems.Exist, "user is already a staff member")
+	}
+
+	st, err := s.repo.CreateStaff(ctx, params)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to create staff member", err)
+	}
+	if err := s.repo.SetStaffSkills(ctx, st.ID, categoryIDs); err != nil {
+		return nil, problems.New(problems.Database, "failed to set staff skills", err)
+	}
+	return &st, nil
+}
+
+// UpdateStaff updates a staff member and replaces their skills (admin only).
+func (s *StaffService) UpdateStaff(ctx context.Context, userID int64, params dbpg.UpdateStaffParams, categoryIDs []int64) (*dbpg.Staff, error) {
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+	if params.DisplayName == "" {
+		return nil, problems.New(problems.InvalidRequest, "display_name is required")
+	}
+
+	st, err := s.repo.UpdateStaff(ctx, params)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.NotExist, "staff member not found")
+		}
+		return nil, problems.New(problems.Database, "failed to update staff member", err)
+	}
+	if err := s.repo.SetStaffSkills(ctx, st.ID, categoryIDs); err != nil {
+		return nil, problems.New(problems.Database, "failed to set staff skills", err)
+	}
+	return &st, nil
+}
+
+// SetRoster sets a staff member's shift for a weekday (admin only).
+func (s *StaffService) SetRoster(ctx context.Context, userID int64, params dbpg.UpsertStaffRosterParams) (*dbpg.StaffRoster, error) {
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+	if params.DayOfWeek < 0 || params.DayOfWeek > 6 {
+		return nil, problems.New(problems.InvalidRequest, "day_of_week must be between 0 (Sunday) and 6 (Saturday)")
+	}
+	if params.EndTime.Microseconds <= params.StartTime.Microseconds {
+		return nil, problems.New(problems.InvalidRequest, "end_time must be after start_time")
+	}
+
+	r, err := s.repo.UpsertStaffRoster(ctx, params)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to set roster", err)
+	}
+	return &r, nil
+}
+
+// ClearRoster takes a staff member off the roster for a weekday (admin only).
+func (s *StaffService) ClearRoster(ctx context.Context, userID, staffID int64, dayOfWeek int32) error {
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteStaffRoster(ctx, staffID, dayOfWeek); err != nil {
+		return problems.New(problems.Database, "failed to clear roster", err)
+	}
+	return nil
+}
+
+// AddLeave records leave for a staff member, inclusive of both dates (admin only).
+func (s *StaffService) AddLeave(ctx context.Context, userID, staffID int64, startDate, endDate, reason string) (*dbpg.StaffLeave, error) {
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return nil, problems.New(problems.InvalidRequest, "invalid start_date format, expected YYYY-MM-DD")
+	}
+	end, err := time.Parse("2006-01-02", endDate)
+	if err != nil {
+		return nil, problems.New(problems.InvalidRequest, "invalid end_date format, expected YYYY-MM-DD")
+	}
+	if end.Before(start) {
+		return nil, problems.New(problems.InvalidRequest, "end_date must not be before start_date")
+	}
+
+	l, err := s.repo.CreateStaffLeave(ctx, dbpg.CreateStaffLeaveParams{
+		StaffID:   staffID,
+		StartDate: pgtype.Date{Time: start, Valid: true},
+		EndDate:   pgtype.Date{Time: end, Valid: true},
+		Reason:    dbpg.StringToPGString(reason),
+	})
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to add leave", err)
+	}
+	return &l, nil
+}
+
+// RemoveLeave deletes a leave entry (admin only).
+func (s *StaffService) RemoveLeave(ctx context.Context, userID, leaveID int64) error {
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteStaffLeave(ctx, leaveID); err != nil {
+		return problems.New(problems.Database, "failed to remove leave", err)
+	}
+	return nil
+}
+
+// AssignBookingStaff replaces the detailers assigned to a booking (admin
+// only). Each must be active, rostered on for the whole job and not on leave,
+// and not already assigned to another booking that overlaps this one.
+func (s *StaffService) AssignBookingStaff(ctx context.Context, userID, bookingID int64, staffIDs []int64) ([]dbpg.ListBookingStaffRow, error) {
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.repo.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.NotExist, "booking not found")
+		}
+		return nil, problems.New(problems.Database, "failed to get booking", err)
+	}
+	switch booking.Status {
+	case dbpg.BookingStatusCancelled, dbpg.BookingStatusCompleted, dbpg.BookingStatusNoShow:
+		return nil, problems.New(problems.InvalidRequest, fmt.Sprintf("cannot assign staff to a %s booking", booking.Status))
+	}
+
+	rostered, err := s.repo.ListRosteredStaffForDate(ctx, dbpg.ListRosteredStaffForDateParams{
+		DayOfWeek:     int32(booking.ScheduledDate.Time.Weekday()),
+		ScheduledDate: booking.ScheduledDate,
+	})
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list rostered staff", err)
+	}
+	shifts := make(map[int64]dbpg.ListRosteredStaffForDateRow, len(rostered))
+	for _, rs := range rostered {
+		shifts[rs.StaffID] = rs
+	}
+
+	start := minutesOf(booking.ScheduledTime)
+	end := start + booking.EstimatedDurationMins
+	for _, staffID := range staffIDs {
+		st, err := s.repo.GetStaffByID(ctx, staffID)
+		if err != nil {
+			if errors.Is(err, ErrNoRecord) {
+				return nil, problems.New(problems.NotExist, fmt.Sprintf("staff member %d not found", staffID))
+			}
+			return nil, problems.New(problems.Database, "failed to get staff member", err)
+		}
+		if !st.IsActive {
+			return nil, problems.New(problems.InvalidRequest, fmt.Sprintf("%s is not an active staff member", st.DisplayName))
+		}
+		shift, ok := shifts[staffID]
+		if !ok || start < minutesOf(shift.StartTime) || end > minutesOf(shift.EndTime) {
+			return nil, problems.New(problems.InvalidRequest, fmt.Sprintf("%s is not rostered on for this booking", st.DisplayName))
+		}
+
+		jobs, err := s.repo.ListStaffBookingsForDate(ctx, staffID, booking.ScheduledDate)
+		if err != nil {
+			return nil, problems.New(problems.Database, "failed to list staff bookings", err)
+		}
+		for _, job := range jobs {
+			if job.ID == bookingID {
+				continue
+			}
+			jobStart := minutesOf(job.ScheduledTime)
+			if start < jobStart+job.EstimatedDurationMins && end > jobStart {
+				return nil, problems.New(problems.Exist, fmt.Sprintf("%s is already assigned to booking %d at %s",
+					st.DisplayName, job.ID, formatTime(job.ScheduledTime)))
+			}
+		}
+	}
+
+	params := dbpg.SetBookingStaffParams{
+		BookingID:  bookingID,
+		StaffIds:   staffIDs,
+		AssignedBy: pgtype.Int8{Int64: userID, Valid: true},
+	}
+	if err := s.repo.SetBookingStaff(ctx, params); err != nil {
+		return nil, problems.New(problems.Database, "failed to assign staff", err)
+	}
+
+	assigned, err := s.repo.ListBookingStaff(ctx, bookingID)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list booking staff", err)
+	}
+	return assigned, nil
+}
+
+// ListMyJobs returns the bookings assigned to the calling detailer on date,
+// in start order.
+func (s *StaffService) ListMyJobs(ctx context.Context, userID int64, date time.Time) ([]dbpg.Booking, error) {
+	st, err := s.repo.GetStaffByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.Unauthorized, "only staff members have jobs")
+		}
+		return nil, problems.New(problems.Database, "failed to get staff member", err)
+	}
+
+	jobs, err := s.repo.ListStaffBookingsForDate(ctx, st.ID, pgtype.Date{Time: date, Valid: true})
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list jobs", err)
+	}
+	return jobs, nil
+}
@@ -0,0 +1,236 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// admins grants admin to the listed users.
+type admins map[int64]bool
+
+func (a admins) IsSystemAdmin(ctx context.Context, userID int64) (bool, error) {
+	return a[userID], nil
+}
+
+// staffRepo keeps staff, their rosters and leave, and who is assigned to
+// which booking in memory.
+type staffRepo struct {
+	StaffRepository
+	staff    map[int64]dbpg.Staff
+	roster   []dbpg.StaffRoster
+	leave    []dbpg.StaffLeave
+	bookings []dbpg.Booking
+	assigned map[int64][]int64 // staff by booking
+}
+
+func (r *staffRepo) GetStaffByID(ctx context.Context, id int64) (dbpg.Staff, error) {
+	st, ok := r.staff[id]
+	if !ok {
+		return dbpg.Staff{}, ErrNoRecord
+	}
+	return st, nil
+}
+
+func (r *staffRepo) GetStaffByUserID(ctx context.Context, userID int64) (dbpg.Staff, error) {
+	for _, st := range r.staff {
+		if st.UserID == userID {
+			return st, nil
+		}
+	}
+	return dbpg.Staff{}, ErrNoRecord
+}
+
+func (r *staffRepo) GetBookingByID(ctx context.Context, id int64) (dbpg.GetBookingByIDRow, error) {
+	for _, b := range r.bookings {
+		if b.ID == id {
+			return dbpg.GetBookingByIDRow{
+				ID:                    b.ID,
+				ScheduledDate:         b.ScheduledDate,
+				ScheduledTime:         b.ScheduledTime,
+				EstimatedDurationMins: b.EstimatedDurationMins,
+				Status:                b.Status,
+			}, nil
+		}
+	}
+	return dbpg.GetBookingByIDRow{}, ErrNoRecord
+}
+
+func (r *staffRepo) ListRosteredStaffForDate(ctx context.Context, params dbpg.ListRosteredStaffForDateParams) ([]dbpg.ListRosteredStaffForDateRow, error) {
+	var rows []dbpg.ListRosteredStaffForDateRow
+	for _, rs := range r.roster {
+		if !r.staff[rs.StaffID].IsActive || rs.DayOfWeek != params.DayOfWeek {
+			continue
+		}
+		onLeave := slices.ContainsFunc(r.leave, func(l dbpg.StaffLeave) bool {
+			return l.StaffID == rs.StaffID &&
+				!params.ScheduledDate.Time.Before(l.StartDate.Time) && !params.ScheduledDate.Time.After(l.EndDate.Time)
+		})
+		if !onLeave {
+			rows = append(rows, dbpg.ListRosteredStaffForDateRow{StaffID: rs.StaffID, StartTime: rs.StartTime, EndTime: rs.EndTime})
+		}
+	}
+	return rows, nil
+}
+
+func (r *staffRepo) ListStaffBookingsForDate(ctx context.Context, staffID int64, date pgtype.Date) ([]dbpg.Booking, error) {
+	var jobs []dbpg.Booking
+	for _, b := range r.bookings {
+		if b.ScheduledDate == date && b.Status != dbpg.BookingStatusCancelled && slices.Contains(r.assigned[b.ID], staffID) {
+			jobs = append(jobs, b)
+		}
+	}
+	slices.SortFunc(jobs, func(a, b dbpg.Booking) int {
+		return int(a.ScheduledTime.Microseconds - b.ScheduledTime.Microseconds)
+	})
+	return jobs, nil
+}
+
+func (r *staffRepo) SetBookingStaff(ctx context.Context, params dbpg.SetBookingStaffParams) error {
+	r.assigned[params.BookingID] = params.StaffIds
+	return nil
+}
+
+func (r *staffRepo) ListBookingStaff(ctx context.Context, bookingID int64) ([]dbpg.ListBookingStaffRow, error) {
+	var rows []dbpg.ListBookingStaffRow
+	for _, id := range r.assigned[bookingID] {
+		rows = append(rows, dbpg.ListBookingStaffRow{BookingID: bookingID, StaffID: id, DisplayName: r.staff[id].DisplayName})
+	}
+	return rows, nil
+}
+
+// newStaff is a Wednesday with a 10:00-13:00 job to staff. Alex already has
+// the 12:00 and 13:00 jobs after it, Max had a 14:00 job that was cancelled.
+// Sam's Wednesday shift ends at noon, Kim is on leave, Lee only works
+// Thursdays and Jo has left. User 1 is the admin.
+func newStaff() (*StaffService, *staffRepo) {
+	const alex, sam, jo, kim, lee, max = 1, 2, 3, 4, 5, 6
+	wednesday := pgtype.Date{Time: time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC), Valid: true}
+	at := func(hour int64) pgtype.Time { return pgtype.Time{Microseconds: hour * 60 * 60000000, Valid: true} }
+	shift := func(staffID int64, day time.Weekday, from, to int64) dbpg.StaffRoster {
+		return dbpg.StaffRoster{StaffID: staffID, DayOfWeek: int32(day), StartTime: at(from), EndTime: at(to)}
+	}
+	job := func(id, hour int64, mins int32, status dbpg.BookingStatus) dbpg.Booking {
+		return dbpg.Booking{ID: id, ScheduledDate: wednesday, ScheduledTime: at(hour), EstimatedDurationMins: mins, Status: status}
+	}
+
+	repo := &staffRepo{
+		staff: map[int64]dbpg.Staff{
+			alex: {ID: alex, UserID: 21, DisplayName: "Alex", IsActive: true},
+			sam:  {ID: sam, UserID: 22, DisplayName: "Sam", IsActive: true},
+			jo:   {ID: jo, UserID: 23, DisplayName: "Jo"},
+			kim:  {ID: kim, UserID: 24, DisplayName: "Kim", IsActive: true},
+			lee:  {ID: lee, UserID: 25, DisplayName: "Lee", IsActive: true},
+			max:  {ID: max, UserID: 26, DisplayName: "Max", IsActive: true},
+		},
+		roster: []dbpg.StaffRoster{
+			shift(alex, time.Wednesday, 8, 17),
+			shift(sam, time.Wednesday, 8, 12),
+			shift(jo, time.Wednesday, 8, 17),
+			shift(kim, time.Wednesday, 8, 17),
+			shift(lee, time.Thursday, 8, 17),
+			shift(max, time.Wednesday, 8, 17),
+		},
+		leave: []dbpg.StaffLeave{{
+			StaffID:   kim,
+			StartDate: pgtype.Date{Time: time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC), Valid: true},
+			EndDate:   pgtype.Date{Time: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC), Valid: true},
+		}},
+		bookings: []dbpg.Booking{
+			job(1, 10, 180, dbpg.BookingStatusConfirmed),
+			job(2, 13, 120, dbpg.BookingStatusConfirmed),
+			job(3, 12, 60, dbpg.BookingStatusConfirmed),
+			job(4, 14, 60, dbpg.BookingStatusCancelled),
+			job(5, 8, 60, dbpg.BookingStatusCompleted),
+		},
+		assigned: map[int64][]int64{2: {alex}, 3: {alex}, 4: {max}},
+	}
+	return NewStaffService(repo, admins{1: true}), repo
+}
+
+func TestAssignBookingStaff(t *testing.T) {
+	tests := []struct {
+		name      string
+		userID    int64
+		bookingID int64
+		staffIDs  []int64
+		kind      problems.Kind // zero if the staff are assigned
+	}{
+		{name: "free detailer", userID: 1, bookingID: 1, staffIDs: []int64{6}},
+		{name: "back to back jobs", userID: 1, bookingID: 2, staffIDs: []int64{1, 6}},
+		{name: "cancelled job does not count", userID: 1, bookingID: 2, staffIDs: []int64{6}},
+		{name: "unassign everyone", userID: 1, bookingID: 2, staffIDs: nil},
+		{name: "not an admin", userID: 21, bookingID: 1, staffIDs: []int64{6}, kind: problems.Unauthorized},
+		{name: "overlapping job", userID: 1, bookingID: 1, staffIDs: []int64{6, 1}, kind: problems.Exist},
+		{name: "shift ends before the job", userID: 1, bookingID: 1, staffIDs: []int64{2}, kind: problems.InvalidRequest},
+		{name: "on leave", userID: 1, bookingID: 1, staffIDs: []int64{4}, kind: problems.InvalidRequest},
+		{name: "rostered off that day", userID: 1, bookingID: 1, staffIDs: []int64{5}, kind: problems.InvalidRequest},
+		{name: "no longer active", userID: 1, bookingID: 1, staffIDs: []int64{3}, kind: problems.InvalidRequest},
+		{name: "unknown staff member", userID: 1, bookingID: 1, staffIDs: []int64{99}, kind: problems.NotExist},
+		{name: "unknown booking", userID: 1, bookingID: 99, staffIDs: []int64{6}, kind: problems.NotExist},
+		{name: "completed booking", userID: 1, bookingID: 5, staffIDs: []int64{6}, kind: problems.InvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo := newStaff()
+			before := slices.Clone(repo.assigned[tt.bookingID])
+
+			assigned, err := svc.AssignBookingStaff(context.Background(), tt.userID, tt.bookingID, tt.staffIDs)
+			if tt.kind != 0 {
+				var p problems.Problem
+				require.ErrorAs(t, err, &p)
+				assert.Equal(t, tt.kind, p.Kind)
+				assert.Equal(t, before, repo.assigned[tt.bookingID])
+				return
+			}
+			require.NoError(t, err)
+			var ids []int64
+			for _, a := range assigned {
+				ids = append(ids, a.StaffID)
+			}
+			assert.Equal(t, tt.staffIDs, ids)
+		})
+	}
+}
+
+func TestListMyJobs(t *testing.T) {
+	wednesday := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		userID int64
+		date   time.Time
+		jobs   []int64
+		kind   problems.Kind
+	}{
+		{name: "in start order", userID: 21, date: wednesday, jobs: []int64{3, 2}},
+		{name: "cancelled job dropped", userID: 26, date: wednesday},
+		{name: "another day", userID: 21, date: wednesday.AddDate(0, 0, 1)},
+		{name: "not a staff member", userID: 30, date: wednesday, kind: problems.Unauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := newStaff()
+
+			jobs, err := svc.ListMyJobs(context.Background(), tt.userID, tt.date)
+			if tt.kind != 0 {
+				var p problems.Problem
+				require.ErrorAs(t, err, &p)
+				assert.Equal(t, tt.kind, p.Kind)
+				return
+			}
+			require.NoError(t, err)
+			var ids []int64
+			for _, j := range jobs {
+				ids = append(ids, j.ID)
+			}
+			assert.Equal(t, tt.jobs, ids)
+		})
+	}
+}
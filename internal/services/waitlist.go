@@ -0,0 +1,559 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-chi/httplog"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/settings"
+)
+
+const (
+	WaitlistStatusWaiting   = "waiting"
+	WaitlistStatusOffered   = "offered"
+	WaitlistStatusClaimed   = "claimed"
+	WaitlistStatusExpired   = "expired"
+	WaitlistStatusCancelled = "cancelled"
+)
+
+const (
+	defaultWaitlistClaimMinutes = 120
+	maxWaitlistRangeDays        = 31
+)
+
+// queueWaitlistCheck asks for the waitlist to be re-evaluated for a date that
+// may have freed up. Failures are logged rather than failing the caller, as
+// the change that freed the slot has already been made.
+func queueWaitlistCheck(ctx context.Context, q WaitlistQueue, date pgtype.Date) {
+	if q == nil || !date.Valid {
+		return
+	}
+	if err := q.QueueWaitlistCheck(ctx, formatDate(date)); err != nil {
+		log := httplog.LogEntry(ctx)
+		log.Error().Err(err).Str("date", formatDate(date)).Msg("failed to queue waitlist check")
+	}
+}
+
+type JoinWaitlistParams struct {
+	UserID           int64
+	VehicleID        int64
+	DateFrom         string // YYYY-MM-DD
+	DateTo           string // YYYY-MM-DD, same as DateFrom for a single day
+	Notes            string
+	CartSessionToken string
+	Location         *ServiceLocation // set for a mobile job at the customer's address
+}
+
+// JoinWaitlist records the caller's interest in any slot within a date range
+// for the services in their cart. The cart and any travel to a mobile job are
+// priced now and snapshotted; the cart is left in place so the customer can
+// still book something else.
+func (s *BookingService) JoinWaitlist(ctx context.Context, params JoinWaitlistParams) (*dbpg.WaitlistEntry, error) {
+	customer, err := s.repo.GetCustomerProfileByUserID(ctx, params.UserID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.NotExist, "customer profile not found, please create one first")
+		}
+		return nil, problems.New(problems.Database, "failed to get customer profile", err)
+	}
+
+	dateFrom, err := time.Parse("2006-01-02", params.DateFrom)
+	if err != nil {
+		return nil, problems.New(problems.InvalidRequest, "invalid date_from format, expected YYYY-MM-DD")
+	}
+	dateTo := dateFrom
+	if params.DateTo != "" {
+		dateTo, err = time.Parse("2006-01-02", params.DateTo)
+		if err != nil {
+			return nil, problems.New(problems.InvalidRequest, "invalid date_to format, expected YYYY-MM-DD")
+		}
+	}
+	if dateTo.Before(dateFrom) {
+		return nil, problems.New(problems.InvalidRequest, "date_to must not be before date_from")
+	}
+	if dateTo.Sub(dateFrom) > maxWaitlistRangeDays*24*time.Hour {
+		return nil, problems.New(problems.InvalidRequest, fmt.Sprintf("the waitlist range can be at most %d days", maxWaitlistRangeDays))
+	}
+
+	quote, travel, err := s.quoteCheckout(ctx, params.UserID, params.CartSessionToken, params.VehicleID, params.Location)
+	if err != nil {
+		return nil, err
+	}
+	// Some of the range must still be bookable
+	if err := s.checkBookingRules(ctx, &quote.requirements, dateTo, 24*60-1); err != nil {
+		return nil, err
+	}
+
+	totalAmount := quote.subtotal + travel.Surcharge
+	entryParams := dbpg.CreateWaitlistEntryParams{
+		CustomerID:            customer.ID,
+		DateFrom:              pgtype.Date{Time: dateFrom, Valid: true},
+		DateTo:                pgtype.Date{Time: dateTo, Valid: true},
+		EstimatedDurationMins: quote.duration,
+		Subtotal:              quote.subtotal,
+		DepositAmount:         s.depositFor(ctx, customer, totalAmount),
+		TotalAmount:           totalAmount,
+		Notes:                 dbpg.StringToPGString(params.Notes),
+		TravelDistanceKm:      travel.DistanceKm,
+		TravelSurcharge:       travel.Surcharge,
+	}
+	if params.Location != nil {
+		suburb := params.Location.Suburb
+		if suburb == "" {
+			suburb = travel.Location.Suburb
+		}
+		entryParams.ServiceAddress = dbpg.StringToPGString(params.Location.Address)
+		entryParams.ServiceSuburb = dbpg.StringToPGString(suburb)
+		entryParams.ServicePostcode = dbpg.StringToPGString(travel.Location.Postcode)
+	}
+	if params.VehicleID > 0 {
+		entryParams.VehicleID = pgtype.Int8{Int64: params.VehicleID, Valid: true}
+	}
+
+	var entry dbpg.WaitlistEntry
+	err = s.repo.WithTx(ctx, func(tx BookingTx) error {
+		created, err := tx.CreateWaitlistEntry(ctx, entryParams)
+		if err != nil {
+			return problems.New(problems.Database, "failed to create waitlist entry", err)
+		}
+		entry = created
+
+		for _, line := range quote.lines {
+			ws, err := tx.CreateWaitlistEntryService(ctx, dbpg.CreateWaitlistEntryServiceParams{
+				WaitlistEntryID:   entry.ID,
+				ServiceID:         line.service.ServiceID,
+				PriceAtBooking:    line.service.PriceAtBooking,
+				DurationAtBooking: line.service.DurationAtBooking,
+			})
+			if err != nil {
+				return problems.New(problems.Database, "failed to create waitlist entry service", err)
+			}
+			for _, opt := range line.options {
+				if _, err := tx.CreateWaitlistEntryServiceOption(ctx, dbpg.CreateWaitlistEntryServiceOptionParams{
+					WaitlistEntryServiceID: ws.ID,
+					ServiceOptionID:        opt.ServiceOptionID,
+					PriceAtBooking:         opt.PriceAtBooking,
+					DurationAtBooking:      opt.DurationAtBooking,
+				}); err != nil {
+					return problems.New(problems.Database, "failed to create waitlist entry service option", err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, txError(err, "failed to join waitlist")
+	}
+
+	return &entry, nil
+}
+
+// ListMyWaitlist returns the caller's waitlist entries, newest first, with
+// the slot currently offered to them if any.
+func (s *BookingService) ListMyWaitlist(ctx context.Context, userID int64) ([]dbpg.ListWaitlistEntriesByCustomerRow, error) {
+	customer, err := s.repo.GetCustomerProfileByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return []dbpg.ListWaitlistEntriesByCustomerRow{}, nil
+		}
+		return nil, problems.New(problems.Database, "failed to get customer profile", err)
+	}
+
+	entries, err := s.repo.ListWaitlistEntriesByCustomer(ctx, customer.ID)
+	if err != nil {
+		return nil, problems.New(problems.Database, "failed to list waitlist entries", err)
+	}
+	return entries, nil
+}
+
+// LeaveWaitlist withdraws one of the caller's entries. A slot being held for
+// them is released and offered to the next customer.
+func (s *BookingService) LeaveWaitlist(ctx context.Context, userID, entryID int64) (*dbpg.WaitlistEntry, error) {
+	customer, err := s.repo.GetCustomerProfileByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.NotExist, "waitlist entry not found")
+		}
+		return nil, problems.New(problems.Database, "failed to get customer profile", err)
+	}
+
+	entry, err := s.repo.GetWaitlistEntryByID(ctx, entryID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.NotExist, "waitlist entry not found")
+		}
+		return nil, problems.New(problems.Database, "failed to get waitlist entry", err)
+	}
+	if entry.CustomerID != customer.ID {
+		return nil, problems.New(problems.NotExist, "waitlist entry not found")
+	}
+	if entry.Status != WaitlistStatusWaiting && entry.Status != WaitlistStatusOffered {
+		return nil, problems.New(problems.InvalidRequest, fmt.Sprintf("waitlist entry is already %s", entry.Status))
+	}
+
+	var hold dbpg.SlotHold
+	if entry.HoldID.Valid {
+		hold, err = s.repo.GetSlotHoldByID(ctx, entry.HoldID.Int64)
+		if err != nil && !errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.Database, "failed to get slot hold", err)
+		}
+	}
+
+	var updated dbpg.WaitlistEntry
+	err = s.repo.WithTx(ctx, func(tx BookingTx) error {
+		if hold.ID != 0 {
+			if err := tx.DeleteSlotHold(ctx, hold.ID); err != nil {
+				return problems.New(problems.Database, "failed to release slot hold", err)
+			}
+		}
+		u, err := tx.UpdateWaitlistEntryStatus(ctx, entry.ID, WaitlistStatusCancelled)
+		if err != nil {
+			return problems.New(problems.Database, "failed to update waitlist entry", err)
+		}
+		updated = u
+		return nil
+	})
+	if err != nil {
+		return nil, txError(err, "failed to leave waitlist")
+	}
+
+	if hold.ID != 0 {
+		queueWaitlistCheck(ctx, s.Waitlist, hold.ScheduledDate)
+	}
+	return &updated, nil
+}
+
+// waitlistClaimWindow is how long a customer has to claim an offered slot.
+func (s *BookingService) waitlistClaimWindow(ctx context.Context) time.Duration {
+	mins := defaultWaitlistClaimMinutes
+	if s.settings != nil {
+		if v, err := s.settings.GetInt(ctx, "booking", "waitlist_claim_minutes", settings.SystemScope()); err == nil && v > 0 {
+			mins = v
+		}
+	}
+	return time.Duration(mins) * time.Minute
+}
+
+// ProcessWaitlist offers free slots on a date to waiting customers in the
+// order they joined. Each offer holds the slot for the claim window and
+// emails the customer a claim link. It returns how many offers were made.
+func (s *BookingService) ProcessWaitlist(ctx context.Context, dateStr string) (int, error) {
+	date, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return 0, problems.New(problems.InvalidRequest, "invalid date format, expected YYYY-MM-DD")
+	}
+	// Too late to book this date at all
+	clock := s.clock(ctx)
+	if date.Before(clock.today()) {
+		return 0, nil
+	}
+	rules := loadBookingRules(ctx, s.settings)
+
+	pgDate := pgtype.Date{Time: date, Valid: true}
+	entries, err := s.repo.ListWaitingEntriesForDate(ctx, pgDate)
+	if err != nil {
+		return 0, problems.New(problems.Database, "failed to list waitlist entries", err)
+	}
+
+	window := s.waitlistClaimWindow(ctx)
+	var offered int
+	for _, entry := range entries {
+		svcs, err := s.repo.ListWaitlistEntryServices(ctx, entry.ID)
+		if err != nil {
+			return offered, problems.New(problems.Database, "failed to list waitlist entry services", err)
+		}
+		serviceIDs := make([]int64, len(svcs))
+		for i, ws := range svcs {
+			serviceIDs[i] = ws.ServiceID
+		}
+		req, err := s.requirementsAt(ctx, serviceIDs, entry.ServicePostcode)
+		if err != nil {
+			return offered, err
+		}
+		jobRules := rules.forJob(req.categoryIDs)
+		if !jobRules.dayBookable(clock, date) {
+			continue
+		}
+		req.step = jobRules.step
+
+		token, err := generateURLToken()
+		if err != nil {
+			return offered, problems.New(problems.Internal, "failed to generate claim token", err)
+		}
+
+		var hold dbpg.SlotHold
+		err = s.repo.WithTx(ctx, func(tx BookingTx) error {
+			if err := tx.LockScheduleDate(ctx, pgDate); err != nil {
+				return problems.New(problems.Database, "failed to lock schedule", err)
+			}
+			day, err := loadDaySchedule(ctx, tx, date)
+			if err != nil {
+				return err
+			}
+			fits := jobRules.bookable(clock, date, day.freeSlots(entry.EstimatedDurationMins, req))
+			if len(fits) == 0 {
+				return nil
+			}
+
+			created, err := tx.CreateSlotHold(ctx, dbpg.CreateSlotHoldParams{
+				CustomerID:      entry.CustomerID,
+				ResourceID:      fits[0].resource.id,
+				ScheduledDate:   pgDate,
+				ScheduledTime:   pgtype.Time{Microseconds: int64(fits[0].start) * 60000000, Valid: true},
+				DurationMins:    entry.EstimatedDurationMins,
+				ExpiresAt:       pgtype.Timestamptz{Time: clock.now.Add(window), Valid: true},
+				Purpose:         SlotHoldWaitlist,
+				ServicePostcode: entry.ServicePostcode,
+			})
+			if err != nil {
+				return problems.New(problems.Database, "failed to hold slot", err)
+			}
+
+			// Another run may have offered this entry a different date meanwhile
+			if _, err := tx.OfferWaitlistEntry(ctx, dbpg.OfferWaitlistEntryParams{
+				ID:         entry.ID,
+				HoldID:     pgtype.Int8{Int64: created.ID, Valid: true},
+				ClaimToken: dbpg.StringToPGString(token),
+			}); err != nil {
+				if errors.Is(err, ErrNoRecord) {
+					return errWaitlistEntryTaken
+				}
+				return problems.New(problems.Database, "failed to offer waitlist slot", err)
+			}
+			hold = created
+			return nil
+		})
+		if errors.Is(err, errWaitlistEntryTaken) {
+			continue
+		}
+		if err != nil {
+			return offered, txError(err, "failed to offer waitlist slot")
+		}
+		if hold.ID == 0 {
+			continue
+		}
+		offered++
+
+		if s.Notifier != nil {
+			link := fmt.Sprintf("%s/waitlist/claim?token=%s", s.BaseURL, token)
+			err := s.Notifier.SendWaitlistOffer(ctx, entry.CustomerEmail, entry.CustomerName,
+				dateStr, formatTime(hold.ScheduledTime), hold.ExpiresAt.Time.In(clock.loc).Format("2 Jan 15:04"), link)
+			if err != nil {
+				log := httplog.LogEntry(ctx)
+				log.Error().Err(err).Int64("waitlist_entry_id", entry.ID).Msg("failed to send waitlist offer")
+			}
+		}
+	}
+
+	return offered, nil
+}
+
+// errWaitlistEntryTaken rolls back an offer for an entry that stopped waiting
+// while the slot was being held.
+var errWaitlistEntryTaken = errors.New("waitlist entry is no longer waiting")
+
+// ExpireWaitlistOffers releases slots whose claim window has passed, offers
+// them to the next customers, and retires entries whose dates have gone by.
+// It returns how many offers expired.
+func (s *BookingService) ExpireWaitlistOffers(ctx context.Context) (int, error) {
+	expired, err := s.repo.ListExpiredWaitlistOffers(ctx)
+	if err != nil {
+		return 0, problems.New(problems.Database, "failed to list expired waitlist offers", err)
+	}
+
+	dates := make(map[string]bool)
+	for _, offer := range expired {
+		err := s.repo.WithTx(ctx, func(tx BookingTx) error {
+			if err := tx.ExpireWaitlistOffer(ctx, offer.ID); err != nil {
+				return problems.New(problems.Database, "failed to expire waitlist offer", err)
+			}
+			if err := tx.DeleteSlotHold(ctx, offer.HoldID.Int64); err != nil {
+				return problems.New(problems.Database, "failed to release slot hold", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, txError(err, "failed to expire waitlist offer")
+		}
+		dates[formatDate(offer.ScheduledDate)] = true
+	}
+
+	if _, err := s.repo.ExpirePastWaitlistEntries(ctx); err != nil {
+		return len(expired), problems.New(problems.Database, "failed to expire past waitlist entries", err)
+	}
+
+	var errs []error
+	for date := range dates {
+		if _, err := s.ProcessWaitlist(ctx, date); err != nil {
+			errs = append(errs, fmt.Errorf("waitlist %s: %w", date, err))
+		}
+	}
+	return len(expired), errors.Join(errs...)
+}
+
+// ClaimWaitlistOffer books the slot held for the caller by a waitlist offer.
+// The booking is made from the services and prices snapshotted when they
+// joined the waitlist.
+func (s *BookingService) ClaimWaitlistOffer(ctx context.Context, userID int64, token string) (*dbpg.Booking, error) {
+	customer, err := s.repo.GetCustomerProfileByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.NotExist, "customer profile not found, please create one first")
+		}
+		return nil, problems.New(problems.Database, "failed to get customer profile", err)
+	}
+
+	entry, err := s.repo.GetWaitlistEntryByClaimToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.NotExist, "this claim link is invalid or has already been used")
+		}
+		return nil, problems.New(problems.Database, "failed to get waitlist entry", err)
+	}
+	if entry.CustomerID != customer.ID {
+		return nil, problems.New(problems.NotExist, "this claim link is invalid or has already been used")
+	}
+	if entry.Status != WaitlistStatusOffered || !entry.HoldID.Valid {
+		return nil, problems.New(problems.InvalidRequest, "this offer is no longer available")
+	}
+
+	hold, err := s.repo.GetSlotHoldByID(ctx, entry.HoldID.Int64)
+	if err != nil {
+		if errors.Is(err, ErrNoRecord) {
+			return nil, problems.New(problems.InvalidRequest, "this offer is no longer available")
+		}
+		return nil, problems.New(problems.Database, "failed to get slot hold", err)
+	}
+	if !hold.ExpiresAt.Time.After(s.now()) {
+		return nil, problems.New(problems.InvalidRequest, "this offer has expired")
+	}
+
+	lines, serviceIDs, err := s.waitlistLines(ctx, entry.ID)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.requirementsAt(ctx, serviceIDs, entry.ServicePostcode)
+	if err != nil {
+		return nil, err
+	}
+
+	var booking dbpg.Booking
+	err = s.repo.WithTx(ctx, func(tx BookingTx) error {
+		if err := tx.LockScheduleDate(ctx, hold.ScheduledDate); err != nil {
+			return problems.New(problems.Database, "failed to lock schedule", err)
+		}
+
+		// The hold keeps everyone else off the slot; ignore it for the holder
+		day, err := loadDaySchedule(ctx, tx, hold.ScheduledDate.Time)
+		if err != nil {
+			return err
+		}
+		resourceID, err := day.withoutHold(hold.ID).check(minutesOf(hold.ScheduledTime), entry.EstimatedDurationMins, req)
+		if err != nil {
+			return err
+		}
+
+		created, err := tx.CreateBooking(ctx, dbpg.CreateBookingParams{
+			CustomerID:            entry.CustomerID,
+			VehicleID:             entry.VehicleID,
+			ScheduledDate:         hold.ScheduledDate,
+			ScheduledTime:         hold.ScheduledTime,
+			EstimatedDurationMins: entry.EstimatedDurationMins,
+			Status:                initialStatus(entry.DepositAmount),
+			PaymentStatus:         dbpg.PaymentStatusPending,
+			Subtotal:              entry.Subtotal,
+			DepositAmount:         entry.DepositAmount,
+			TotalAmount:           entry.TotalAmount,
+			Notes:                 entry.Notes,
+			ResourceID:            resourceID,
+			ServiceAddress:        entry.ServiceAddress,
+			ServiceSuburb:         entry.ServiceSuburb,
+			ServicePostcode:       entry.ServicePostcode,
+			TravelDistanceKm:      entry.TravelDistanceKm,
+			TravelSurcharge:       entry.TravelSurcharge,
+		})
+		if err != nil {
+			if errors.Is(err, ErrConflict) {
+				return problems.New(problems.Exist, "the selected time slot is no longer available")
+			}
+			return problems.New(problems.Database, "failed to create booking", err)
+		}
+		booking = created
+
+		if err := recordInitialStatus(ctx, tx, booking, userID, "booked from waitlist"); err != nil {
+			return err
+		}
+		if err := insertBookingLines(ctx, tx, booking.ID, lines); err != nil {
+			return err
+		}
+
+		if _, err := tx.ClaimWaitlistEntry(ctx, entry.ID, booking.ID); err != nil {
+			if errors.Is(err, ErrNoRecord) {
+				return problems.New(problems.InvalidRequest, "this offer is no longer available")
+			}
+			return problems.New(problems.Database, "failed to claim waitlist entry", err)
+		}
+		if err := tx.DeleteSlotHold(ctx, hold.ID); err != nil {
+			return problems.New(problems.Database, "failed to release slot hold", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, txError(err, "failed to claim waitlist offer")
+	}
+
+	s.notifyBooked(ctx, booking.ID)
+	return &booking, nil
+}
+
+// waitlistLines rebuilds the booking lines snapshotted on a waitlist entry,
+// and the services they are for.
+func (s *BookingService) waitlistLines(ctx context.Context, entryID int64) ([]bookingLine, []int64, error) {
+	svcs, err := s.repo.ListWaitlistEntryServices(ctx, entryID)
+	if err != nil {
+		return nil, nil, problems.New(problems.Database, "failed to list waitlist entry services", err)
+	}
+	opts, err := s.repo.ListWaitlistEntryServiceOptions(ctx, entryID)
+	if err != nil {
+		return nil, nil, problems.New(problems.Database, "failed to list waitlist entry service options", err)
+	}
+	optionsByService := make(map[int64][]dbpg.CreateBookingServiceOptionParams)
+	for _, opt := range opts {
+		optionsByService[opt.WaitlistEntryServiceID] = append(optionsByService[opt.WaitlistEntryServiceID], dbpg.CreateBookingServiceOptionParams{
+			ServiceOptionID:   opt.ServiceOptionID,
+			PriceAtBooking:    opt.PriceAtBooking,
+			DurationAtBooking: opt.DurationAtBooking,
+		})
+	}
+
+	lines := make([]bookingLine, len(svcs))
+	serviceIDs := make([]int64, len(svcs))
+	for i, ws := range svcs {
+		lines[i] = bookingLine{
+			service: dbpg.CreateBookingServiceParams{
+				ServiceID:         ws.ServiceID,
+				PriceAtBooking:    ws.PriceAtBooking,
+				DurationAtBooking: ws.DurationAtBooking,
+			},
+			options: optionsByService[ws.ID],
+		}
+		serviceIDs[i] = ws.ServiceID
+	}
+	return lines, serviceIDs, nil
+}
+
+// generateURLToken creates a random, URL-safe token for a claim or feed link.
+func generateURLToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(b), nil
+}
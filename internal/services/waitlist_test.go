@@ -0,0 +1,491 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/richardbowden/degrees/internal/dbpg"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitlistRepo adds the waitlist and slot holds to a checkout, for several
+// customers with the same cart.
+type waitlistRepo struct {
+	*checkoutRepo
+	now       time.Time
+	customers map[int64]dbpg.CustomerProfile // by user
+	entries   []dbpg.WaitlistEntry
+	entrySvcs []dbpg.WaitlistEntryService
+	holds     []dbpg.SlotHold
+	holdSeq   int64
+}
+
+func (r *waitlistRepo) GetCustomerProfileByUserID(ctx context.Context, userID int64) (dbpg.CustomerProfile, error) {
+	customer, ok := r.customers[userID]
+	if !ok {
+		return dbpg.CustomerProfile{}, ErrNoRecord
+	}
+	return customer, nil
+}
+
+func (r *waitlistRepo) ListWaitingEntriesForDate(ctx context.Context, date pgtype.Date) ([]dbpg.ListWaitingEntriesForDateRow, error) {
+	var rows []dbpg.ListWaitingEntriesForDateRow
+	for _, e := range r.entries {
+		if e.Status != WaitlistStatusWaiting || date.Time.Before(e.DateFrom.Time) || date.Time.After(e.DateTo.Time) {
+			continue
+		}
+		rows = append(rows, dbpg.ListWaitingEntriesForDateRow{
+			ID:                    e.ID,
+			CustomerID:            e.CustomerID,
+			DateFrom:              e.DateFrom,
+			DateTo:                e.DateTo,
+			EstimatedDurationMins: e.EstimatedDurationMins,
+			Subtotal:              e.Subtotal,
+			DepositAmount:         e.DepositAmount,
+			TotalAmount:           e.TotalAmount,
+			Status:                e.Status,
+			ServicePostcode:       e.ServicePostcode,
+		})
+	}
+	return rows, nil
+}
+
+func (r *waitlistRepo) ListWaitlistEntryServices(ctx context.Context, entryID int64) ([]dbpg.WaitlistEntryService, error) {
+	var svcs []dbpg.WaitlistEntryService
+	for _, ws := range r.entrySvcs {
+		if ws.WaitlistEntryID == entryID {
+			svcs = append(svcs, ws)
+		}
+	}
+	return svcs, nil
+}
+
+func (r *waitlistRepo) ListWaitlistEntryServiceOptions(ctx context.Context, entryID int64) ([]dbpg.WaitlistEntryServiceOption, error) {
+	return nil, nil
+}
+
+func (r *waitlistRepo) GetWaitlistEntryByClaimToken(ctx context.Context, token string) (dbpg.WaitlistEntry, error) {
+	for _, e := range r.entries {
+		if e.ClaimToken.Valid && e.ClaimToken.String == token {
+			return e, nil
+		}
+	}
+	return dbpg.WaitlistEntry{}, ErrNoRecord
+}
+
+func (r *waitlistRepo) GetSlotHoldByID(ctx context.Context, id int64) (dbpg.SlotHold, error) {
+	for _, h := range r.holds {
+		if h.ID == id {
+			return h, nil
+		}
+	}
+	return dbpg.SlotHold{}, ErrNoRecord
+}
+
+func (r *waitlistRepo) ListExpiredWaitlistOffers(ctx context.Context) ([]dbpg.ListExpiredWaitlistOffersRow, error) {
+	var rows []dbpg.ListExpiredWaitlistOffersRow
+	for _, e := range r.entries {
+		if e.Status != WaitlistStatusOffered {
+			continue
+		}
+		hold, err := r.GetSlotHoldByID(ctx, e.HoldID.Int64)
+		if err == nil && !hold.ExpiresAt.Time.After(r.now) {
+			rows = append(rows, dbpg.ListExpiredWaitlistOffersRow{ID: e.ID, HoldID: e.HoldID, ScheduledDate: hold.ScheduledDate})
+		}
+	}
+	return rows, nil
+}
+
+func (r *waitlistRepo) ExpirePastWaitlistEntries(ctx context.Context) (int64, error) {
+	today := r.now.Truncate(24 * time.Hour)
+	var n int64
+	for i, e := range r.entries {
+		if e.Status == WaitlistStatusWaiting && e.DateTo.Time.Before(today) {
+			r.entries[i].Status = WaitlistStatusExpired
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *waitlistRepo) DeleteExpiredCheckoutHolds(ctx context.Context) ([]dbpg.SlotHold, error) {
+	return r.deleteHolds(func(h dbpg.SlotHold) bool {
+		return h.Purpose == SlotHoldCheckout && !h.ExpiresAt.Time.After(r.now)
+	}), nil
+}
+
+func (r *waitlistRepo) deleteHolds(match func(h dbpg.SlotHold) bool) []dbpg.SlotHold {
+	var deleted []dbpg.SlotHold
+	r.holds = slices.DeleteFunc(r.holds, func(h dbpg.SlotHold) bool {
+		if match(h) {
+			deleted = append(deleted, h)
+			return true
+		}
+		return false
+	})
+	return deleted
+}
+
+func (r *waitlistRepo) WithTx(ctx context.Context, fn func(tx BookingTx) error) error {
+	entries, entrySvcs, holds := slices.Clone(r.entries), slices.Clone(r.entrySvcs), slices.Clone(r.holds)
+	err := r.checkoutRepo.WithTx(ctx, func(tx BookingTx) error {
+		return fn(&waitlistTx{checkoutTx: tx.(*checkoutTx), repo: r})
+	})
+	if err != nil {
+		r.entries, r.entrySvcs, r.holds = entries, entrySvcs, holds
+	}
+	return err
+}
+
+type waitlistTx struct {
+	*checkoutTx
+	repo *waitlistRepo
+}
+
+func (t *waitlistTx) ListActiveSlotHoldsForDate(ctx context.Context, date pgtype.Date) ([]dbpg.SlotHold, error) {
+	var holds []dbpg.SlotHold
+	for _, h := range t.repo.holds {
+		if h.ScheduledDate == date && h.ExpiresAt.Time.After(t.repo.now) {
+			holds = append(holds, h)
+		}
+	}
+	return holds, nil
+}
+
+func (t *waitlistTx) CreateSlotHold(ctx context.Context, params dbpg.CreateSlotHoldParams) (dbpg.SlotHold, error) {
+	t.repo.holdSeq++
+	h := dbpg.SlotHold{
+		ID:              t.repo.holdSeq,
+		CustomerID:      params.CustomerID,
+		ResourceID:      params.ResourceID,
+		ScheduledDate:   params.ScheduledDate,
+		ScheduledTime:   params.ScheduledTime,
+		DurationMins:    params.DurationMins,
+		ExpiresAt:       params.ExpiresAt,
+		Purpose:         params.Purpose,
+		ServicePostcode: params.ServicePostcode,
+	}
+	t.repo.holds = append(t.repo.holds, h)
+	return h, nil
+}
+
+func (t *waitlistTx) DeleteSlotHold(ctx context.Context, id int64) error {
+	t.repo.holds = slices.DeleteFunc(t.repo.holds, func(h dbpg.SlotHold) bool { return h.ID == id })
+	return nil
+}
+
+func (t *waitlistTx) DeleteCheckoutHoldsForCustomer(ctx context.Context, customerID int64) ([]dbpg.SlotHold, error) {
+	return t.repo.deleteHolds(func(h dbpg.SlotHold) bool {
+		return h.Purpose == SlotHoldCheckout && h.CustomerID == customerID
+	}), nil
+}
+
+func (t *waitlistTx) CreateWaitlistEntry(ctx context.Context, params dbpg.CreateWaitlistEntryParams) (dbpg.WaitlistEntry, error) {
+	e := dbpg.WaitlistEntry{
+		ID:                    int64(len(t.repo.entries) + 1),
+		CustomerID:            params.CustomerID,
+		VehicleID:             params.VehicleID,
+		DateFrom:              params.DateFrom,
+		DateTo:                params.DateTo,
+		EstimatedDurationMins: params.EstimatedDurationMins,
+		Subtotal:              params.Subtotal,
+		DepositAmount:         params.DepositAmount,
+		TotalAmount:           params.TotalAmount,
+		Notes:                 params.Notes,
+		Status:                WaitlistStatusWaiting,
+		ServiceAddress:        params.ServiceAddress,
+		ServiceSuburb:         params.ServiceSuburb,
+		ServicePostcode:       params.ServicePostcode,
+		TravelDistanceKm:      params.TravelDistanceKm,
+		TravelSurcharge:       params.TravelSurcharge,
+	}
+	t.repo.entries = append(t.repo.entries, e)
+	return e, nil
+}
+
+func (t *waitlistTx) CreateWaitlistEntryService(ctx context.Context, params dbpg.CreateWaitlistEntryServiceParams) (dbpg.WaitlistEntryService, error) {
+	ws := dbpg.WaitlistEntryService{
+		ID:                int64(len(t.repo.entrySvcs) + 1),
+		WaitlistEntryID:   params.WaitlistEntryID,
+		ServiceID:         params.ServiceID,
+		PriceAtBooking:    params.PriceAtBooking,
+		DurationAtBooking: params.DurationAtBooking,
+	}
+	t.repo.entrySvcs = append(t.repo.entrySvcs, ws)
+	return ws, nil
+}
+
+// update changes an entry in the given status, as the status guards on the
+// waitlist queries do.
+func (t *waitlistTx) update(id int64, status string, fn func(e *dbpg.WaitlistEntry)) (dbpg.WaitlistEntry, error) {
+	for i := range t.repo.entries {
+		if e := &t.repo.entries[i]; e.ID == id && (status == "" || e.Status == status) {
+			fn(e)
+			return *e, nil
+		}
+	}
+	return dbpg.WaitlistEntry{}, ErrNoRecord
+}
+
+func (t *waitlistTx) OfferWaitlistEntry(ctx context.Context, params dbpg.OfferWaitlistEntryParams) (dbpg.WaitlistEntry, error) {
+	return t.update(params.ID, WaitlistStatusWaiting, func(e *dbpg.WaitlistEntry) {
+		e.Status = WaitlistStatusOffered
+		e.HoldID = params.HoldID
+		e.ClaimToken = params.ClaimToken
+	})
+}
+
+func (t *waitlistTx) ClaimWaitlistEntry(ctx context.Context, id, bookingID int64) (dbpg.WaitlistEntry, error) {
+	return t.update(id, WaitlistStatusOffered, func(e *dbpg.WaitlistEntry) {
+		e.Status = WaitlistStatusClaimed
+		e.HoldID = pgtype.Int8{}
+		e.ClaimToken = pgtype.Text{}
+		e.BookingID = pgtype.Int8{Int64: bookingID, Valid: true}
+	})
+}
+
+func (t *waitlistTx) ExpireWaitlistOffer(ctx context.Context, id int64) error {
+	_, err := t.update(id, "", func(e *dbpg.WaitlistEntry) {
+		e.Status = WaitlistStatusExpired
+		e.HoldID = pgtype.Int8{}
+		e.ClaimToken = pgtype.Text{}
+	})
+	return err
+}
+
+// newWaitlist is a Wednesday booked out by another customer, with customers
+// 3 (user 5) and then 4 (user 6) waiting for it. The 08:00-11:00 booking has
+// just been cancelled, freeing room for one full detail.
+func newWaitlist(t *testing.T) (*BookingService, *waitlistRepo) {
+	_, checkout, _ := newCheckout(0)
+	repo := &waitlistRepo{
+		checkoutRepo: checkout,
+		now:          time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+		customers: map[int64]dbpg.CustomerProfile{
+			5: {ID: 3, UserID: 5},
+			6: {ID: 4, UserID: 6},
+		},
+	}
+	svc := NewBookingService(repo, nil)
+	svc.now = func() time.Time { return repo.now }
+
+	date := pgtype.Date{Time: time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC), Valid: true}
+	for i, start := range []int64{8, 11} {
+		repo.bookings = append(repo.bookings, dbpg.Booking{
+			ID:                    int64(i + 1),
+			CustomerID:            9,
+			ScheduledDate:         date,
+			ScheduledTime:         pgtype.Time{Microseconds: start * 60 * 60000000, Valid: true},
+			EstimatedDurationMins: int32(17-start) * 60,
+			Status:                dbpg.BookingStatusConfirmed,
+			ResourceID:            1,
+		})
+	}
+	for _, userID := range []int64{5, 6} {
+		_, err := svc.JoinWaitlist(context.Background(), JoinWaitlistParams{UserID: userID, DateFrom: "2026-03-04"})
+		require.NoError(t, err)
+	}
+	repo.bookings[0].Status = dbpg.BookingStatusCancelled
+	return svc, repo
+}
+
+func TestJoinWaitlist(t *testing.T) {
+	tests := []struct {
+		name   string
+		params JoinWaitlistParams
+		kind   problems.Kind // zero if the customer joins
+	}{
+		{name: "one day", params: JoinWaitlistParams{UserID: 5, DateFrom: "2026-03-05"}},
+		{name: "date range", params: JoinWaitlistParams{UserID: 5, DateFrom: "2026-03-05", DateTo: "2026-03-12"}},
+		{name: "no customer profile", params: JoinWaitlistParams{UserID: 7, DateFrom: "2026-03-05"}, kind: problems.NotExist},
+		{name: "bad date", params: JoinWaitlistParams{UserID: 5, DateFrom: "5 March"}, kind: problems.InvalidRequest},
+		{name: "range backwards", params: JoinWaitlistParams{UserID: 5, DateFrom: "2026-03-05", DateTo: "2026-03-04"}, kind: problems.InvalidRequest},
+		{name: "range too long", params: JoinWaitlistParams{UserID: 5, DateFrom: "2026-03-05", DateTo: "2026-04-30"}, kind: problems.InvalidRequest},
+		{name: "inside the lead time", params: JoinWaitlistParams{UserID: 5, DateFrom: "2026-03-02"}, kind: problems.InvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo := newWaitlist(t)
+			before := len(repo.entries)
+
+			entry, err := svc.JoinWaitlist(context.Background(), tt.params)
+			if tt.kind != 0 {
+				var p problems.Problem
+				require.ErrorAs(t, err, &p)
+				assert.Equal(t, tt.kind, p.Kind)
+				assert.Len(t, repo.entries, before)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, WaitlistStatusWaiting, entry.Status)
+			assert.Equal(t, int64(20000), entry.TotalAmount)
+			assert.Equal(t, int64(6000), entry.DepositAmount)
+			assert.Equal(t, int32(180), entry.EstimatedDurationMins)
+
+			// The cart is priced now and left for the customer to book from
+			svcs, err := repo.ListWaitlistEntryServices(context.Background(), entry.ID)
+			require.NoError(t, err)
+			require.Len(t, svcs, 1)
+			assert.Equal(t, int64(20000), svcs[0].PriceAtBooking)
+			assert.Empty(t, repo.cleared)
+		})
+	}
+}
+
+func TestProcessWaitlist(t *testing.T) {
+	ctx := context.Background()
+	svc, repo := newWaitlist(t)
+
+	// The freed slot goes to whoever joined first
+	offered, err := svc.ProcessWaitlist(ctx, "2026-03-04")
+	require.NoError(t, err)
+	assert.Equal(t, 1, offered)
+	assert.Equal(t, WaitlistStatusOffered, repo.entries[0].Status)
+	assert.True(t, repo.entries[0].ClaimToken.Valid)
+	assert.Equal(t, WaitlistStatusWaiting, repo.entries[1].Status)
+
+	require.Len(t, repo.holds, 1)
+	hold := repo.holds[0]
+	assert.Equal(t, repo.entries[0].HoldID.Int64, hold.ID)
+	assert.Equal(t, int64(3), hold.CustomerID)
+	assert.Equal(t, "08:00", formatTime(hold.ScheduledTime))
+	assert.WithinDuration(t, repo.now.Add(defaultWaitlistClaimMinutes*time.Minute), hold.ExpiresAt.Time, 0)
+
+	// The hold keeps the slot from the next customer
+	offered, err = svc.ProcessWaitlist(ctx, "2026-03-04")
+	require.NoError(t, err)
+	assert.Zero(t, offered)
+	assert.Equal(t, WaitlistStatusWaiting, repo.entries[1].Status)
+	assert.Len(t, repo.holds, 1)
+
+	// A date already gone is left alone
+	offered, err = svc.ProcessWaitlist(ctx, "2026-03-01")
+	require.NoError(t, err)
+	assert.Zero(t, offered)
+}
+
+func TestClaimWaitlistOffer(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  int64
+		token   string // the offer's token if empty
+		prepare func(repo *waitlistRepo)
+		kind    problems.Kind // zero if the slot is booked
+	}{
+		{name: "claimed in time", userID: 5},
+		{name: "someone else's offer", userID: 6, kind: problems.NotExist},
+		{name: "unknown link", userID: 5, token: "not-a-token", kind: problems.NotExist},
+		{
+			name:    "expired offer",
+			userID:  5,
+			prepare: func(repo *waitlistRepo) { repo.now = repo.now.Add(3 * time.Hour) },
+			kind:    problems.InvalidRequest,
+		},
+		{
+			name:   "direct booking got there first",
+			userID: 5,
+			prepare: func(repo *waitlistRepo) {
+				b := repo.bookings[0]
+				b.ID, b.CustomerID, b.Status = 3, 9, dbpg.BookingStatusConfirmed
+				repo.bookings = append(repo.bookings, b)
+			},
+			kind: problems.Exist,
+		},
+		{
+			name:    "direct booking commits at the same time",
+			userID:  5,
+			prepare: func(repo *waitlistRepo) { repo.conflict = true },
+			kind:    problems.Exist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc, repo := newWaitlist(t)
+			_, err := svc.ProcessWaitlist(ctx, "2026-03-04")
+			require.NoError(t, err)
+			token := tt.token
+			if token == "" {
+				token = repo.entries[0].ClaimToken.String
+			}
+			if tt.prepare != nil {
+				tt.prepare(repo)
+			}
+			bookings, lines := len(repo.bookings), len(repo.lines)
+
+			booking, err := svc.ClaimWaitlistOffer(ctx, tt.userID, token)
+			if tt.kind != 0 {
+				var p problems.Problem
+				require.ErrorAs(t, err, &p)
+				assert.Equal(t, tt.kind, p.Kind)
+
+				// The offer stands and nothing of the booking is left behind
+				assert.Equal(t, WaitlistStatusOffered, repo.entries[0].Status)
+				assert.Len(t, repo.holds, 1)
+				assert.Len(t, repo.bookings, bookings)
+				assert.Len(t, repo.lines, lines)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(3), booking.CustomerID)
+			assert.Equal(t, "2026-03-04", formatDate(booking.ScheduledDate))
+			assert.Equal(t, "08:00", formatTime(booking.ScheduledTime))
+			assert.Equal(t, dbpg.BookingStatusPendingPayment, booking.Status)
+			assert.Equal(t, int64(6000), booking.DepositAmount)
+			assert.Len(t, repo.lines, lines+1)
+
+			assert.Equal(t, WaitlistStatusClaimed, repo.entries[0].Status)
+			assert.Equal(t, booking.ID, repo.entries[0].BookingID.Int64)
+			assert.Empty(t, repo.holds)
+
+			// The link only works once
+			_, err = svc.ClaimWaitlistOffer(ctx, tt.userID, token)
+			var p problems.Problem
+			require.ErrorAs(t, err, &p)
+			assert.Equal(t, problems.NotExist, p.Kind)
+		})
+	}
+}
+
+func TestExpireWaitlistOffers(t *testing.T) {
+	ctx := context.Background()
+	svc, repo := newWaitlist(t)
+	_, err := svc.ProcessWaitlist(ctx, "2026-03-04")
+	require.NoError(t, err)
+	first, token := repo.entries[0].HoldID.Int64, repo.entries[0].ClaimToken.String
+
+	// Nothing to do while the offer is open
+	expired, err := svc.ExpireWaitlistOffers(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, expired)
+	assert.Equal(t, WaitlistStatusOffered, repo.entries[0].Status)
+
+	// Once it runs out the slot goes to the next customer
+	repo.now = repo.now.Add(3 * time.Hour)
+	expired, err = svc.ExpireWaitlistOffers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+
+	assert.Equal(t, WaitlistStatusExpired, repo.entries[0].Status)
+	assert.False(t, repo.entries[0].ClaimToken.Valid)
+	assert.Equal(t, WaitlistStatusOffered, repo.entries[1].Status)
+
+	require.Len(t, repo.holds, 1)
+	hold := repo.holds[0]
+	assert.NotEqual(t, first, hold.ID)
+	assert.Equal(t, repo.entries[1].HoldID.Int64, hold.ID)
+	assert.Equal(t, int64(4), hold.CustomerID)
+	assert.Equal(t, "08:00", formatTime(hold.ScheduledTime))
+	assert.WithinDuration(t, repo.now.Add(defaultWaitlistClaimMinutes*time.Minute), hold.ExpiresAt.Time, 0)
+
+	// The first customer's old link is dead
+	_, err = svc.ClaimWaitlistOffer(ctx, 5, token)
+	var p problems.Problem
+	require.ErrorAs(t, err, &p)
+	assert.Equal(t, problems.NotExist, p.Kind)
+}
@@ -0,0 +1,107 @@
+package stripe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCheckoutSession(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key, _, _ := r.BasicAuth()
+		assert.Equal(t, "sk_test_123", key)
+		assert.Equal(t, "/v1/checkout/sessions", r.URL.Path)
+		assert.Equal(t, "booking-7-deposit", r.Header.Get("Idempotency-Key"))
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "embedded", r.PostForm.Get("ui_mode"))
+		assert.Equal(t, "3000", r.PostForm.Get("line_items[0][price_data][unit_amount]"))
+		assert.Equal(t, "aud", r.PostForm.Get("line_items[0][price_data][currency]"))
+		assert.Equal(t, "7", r.PostForm.Get("metadata[booking_id]"))
+		assert.Equal(t, "7", r.PostForm.Get("payment_intent_data[metadata][booking_id]"))
+		w.Write([]byte(`{"id":"cs_test_1","client_secret":"cs_test_1_secret"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("sk_test_123", srv.URL)
+	session, err := c.CreateCheckoutSession(context.Background(), CheckoutSessionParams{
+		AmountCents:    3000,
+		Currency:       "aud",
+		Description:    "Booking deposit",
+		ReturnURL:      "https://example.com/bookings/7/success",
+		Metadata:       map[string]string{"booking_id": "7"},
+		IdempotencyKey: "booking-7-deposit",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "cs_test_1_secret", session.ClientSecret)
+}
+
+func TestCreateCheckoutSessionError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"type":"invalid_request_error","code":"amount_too_small","message":"Amount must be at least $0.50 aud"}}`))
+	}))
+	defer srv.Close()
+
+	_, err := NewClient("sk_test_123", srv.URL).CreateCheckoutSession(context.Background(), CheckoutSessionParams{AmountCents: 10, Currency: "aud"})
+	var stripeErr *Error
+	require.True(t, errors.As(err, &stripeErr))
+	assert.Equal(t, http.StatusBadRequest, stripeErr.StatusCode)
+	assert.Equal(t, "amount_too_small", stripeErr.Code)
+}
+
+func TestCreateRefund(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/refunds", r.URL.Path)
+		assert.Equal(t, "payment-3-refund-0-1500", r.Header.Get("Idempotency-Key"))
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "pi_123", r.PostForm.Get("payment_intent"))
+		assert.Equal(t, "1500", r.PostForm.Get("amount"))
+		assert.Equal(t, "7", r.PostForm.Get("metadata[booking_id]"))
+		w.Write([]byte(`{"id":"re_1","amount":1500,"status":"succeeded","payment_intent":"pi_123"}`))
+	}))
+	defer srv.Close()
+
+	refund, err := NewClient("sk_test_123", srv.URL).CreateRefund(context.Background(), RefundParams{
+		PaymentIntent:  "pi_123",
+		AmountCents:    1500,
+		Metadata:       map[string]string{"booking_id": "7"},
+		IdempotencyKey: "payment-3-refund-0-1500",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "re_1", refund.ID)
+	assert.Equal(t, RefundStatusSucceeded, refund.Status)
+}
+
+func TestParseEvent(t *testing.T) {
+	const secret = "whsec_test"
+	payload := []byte(`{"id":"evt_1","type":"checkout.session.completed","data":{"object":{"id":"cs_1"}}}`)
+	now := time.Unix(1_900_000_000, 0)
+
+	event, err := ParseEvent(payload, SignatureFor(payload, secret, now), secret, now, DefaultTolerance)
+	require.NoError(t, err)
+	assert.Equal(t, "evt_1", event.ID)
+	assert.Equal(t, EventCheckoutSessionCompleted, event.Type)
+
+	// Any one of several signatures may match, as during a secret rollover
+	header := SignatureFor(payload, "whsec_old", now) + ",v1=" + SignatureFor(payload, secret, now)[len("t=1900000000,v1="):]
+	_, err = ParseEvent(payload, header, secret, now, DefaultTolerance)
+	assert.NoError(t, err)
+
+	_, err = ParseEvent(payload, SignatureFor(payload, "whsec_other", now), secret, now, DefaultTolerance)
+	assert.ErrorIs(t, err, ErrNoSignature)
+
+	_, err = ParseEvent([]byte(`{"id":"evt_2"}`), SignatureFor(payload, secret, now), secret, now, DefaultTolerance)
+	assert.ErrorIs(t, err, ErrNoSignature)
+
+	_, err = ParseEvent(payload, "", secret, now, DefaultTolerance)
+	assert.ErrorIs(t, err, ErrNoSignature)
+
+	_, err = ParseEvent(payload, SignatureFor(payload, secret, now.Add(-time.Hour)), secret, now, DefaultTolerance)
+	assert.ErrorIs(t, err, ErrSignatureExpired)
+}
@@ -0,0 +1,113 @@
+package stripe
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignatureHeader carries a webhook's signature.
+const SignatureHeader = "Stripe-Signature"
+
+// DefaultTolerance is how old a webhook may be before it is refused as a
+// possible replay.
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	ErrNoSignature      = errors.New("stripe: webhook has no valid signature")
+	ErrSignatureExpired = errors.New("stripe: webhook signature has expired")
+)
+
+// Event is a webhook event. Data.Object is decoded by the handler for the
+// event's type.
+type Event struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// Event types handled.
+const (
+	EventCheckoutSessionCompleted = "checkout.session.completed"
+	// The object of refund events is a Refund.
+	EventRefundCreated = "refund.created"
+	EventRefundUpdated = "refund.updated"
+)
+
+// CheckoutSessionObject is the object of a checkout.session event.
+type CheckoutSessionObject struct {
+	ID            string            `json:"id"`
+	PaymentStatus string            `json:"payment_status"` // paid once the money is taken
+	AmountTotal   int64             `json:"amount_total"`
+	Currency      string            `json:"currency"`
+	PaymentIntent string            `json:"payment_intent"`
+	Metadata      map[string]string `json:"metadata"`
+}
+
+// ParseEvent verifies a webhook was signed with secret within tolerance of
+// now and decodes it. The header is the value of SignatureHeader.
+func ParseEvent(payload []byte, header, secret string, now time.Time, tolerance time.Duration) (Event, error) {
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch k {
+		case "t":
+			timestamp = v
+		case "v1":
+			signatures = append(signatures, v)
+		}
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return Event{}, ErrNoSignature
+	}
+
+	expected := sign(secret, timestamp, payload)
+	valid := false
+	for _, sig := range signatures {
+		got, err := hex.DecodeString(sig)
+		if err == nil && hmac.Equal(got, expected) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return Event{}, ErrNoSignature
+	}
+	if now.Sub(time.Unix(ts, 0)) > tolerance {
+		return Event{}, ErrSignatureExpired
+	}
+
+	var event Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return Event{}, err
+	}
+	return event, nil
+}
+
+// SignatureFor is the SignatureHeader value Stripe would send with payload,
+// for sending test webhooks.
+func SignatureFor(payload []byte, secret string, at time.Time) string {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	return "t=" + timestamp + ",v1=" + hex.EncodeToString(sign(secret, timestamp, payload))
+}
+
+func sign(secret, timestamp string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"os/signal"
@@ -22,6 +23,8 @@ import (
 
 	"github.com/richardbowden/degrees/internal/config"
 	"github.com/richardbowden/degrees/internal/health"
+	"github.com/richardbowden/degrees/internal/problems"
+	"github.com/richardbowden/degrees/internal/stripe"
 )
 
 var (
@@ -43,10 +46,23 @@ type Server struct {
 	httpServer     *http.Server
 	startTime      time.Time
 	authMiddleware *AuthMiddleware
+	calendarFeeds  CalendarFeeds
+	paymentHooks   PaymentWebhooks
 
 	middleware map[string]Middleware
 }
 
+// CalendarFeeds renders the iCalendar feed a token was issued for.
+type CalendarFeeds interface {
+	CalendarFeed(ctx context.Context, token string) ([]byte, error)
+}
+
+// PaymentWebhooks acts on webhooks from the payment provider. signature is
+// the request's signature header, verified against the payload.
+type PaymentWebhooks interface {
+	HandleStripeWebhook(ctx context.Context, payload []byte, signature string) error
+}
+
 func NewServer(cfg *config.Config, healthSvc *health.Service, authMiddleware *AuthMiddleware) *Server {
 	return &Server{
 		config:         cfg,
@@ -69,6 +85,18 @@ func NewServerWithGateway(cfg *config.Config, healthSvc *health.Service, authMid
 	}
 }
 
+// SetCalendarFeeds enables the calendar feed endpoint. Must be called before
+// Serve.
+func (s *Server) SetCalendarFeeds(feeds CalendarFeeds) {
+	s.calendarFeeds = feeds
+}
+
+// SetPaymentWebhooks enables the payment webhook endpoint. Must be called
+// before Serve.
+func (s *Server) SetPaymentWebhooks(hooks PaymentWebhooks) {
+	s.paymentHooks = hooks
+}
+
 func (s *Server) RegisterMiddleware(name string, middleware http.Handler) {
 	s.middleware[name] = middleware
 }
@@ -105,6 +133,17 @@ func (s *Server) setupRoutes() chi.Router {
 		r.Get("/ready", s.readinessCheck)
 	})
 
+	// Calendar apps fetch feeds without a session; the token in the URL is
+	// the credential
+	if s.calendarFeeds != nil {
+		r.Get("/calendar/{token}", s.calendarFeed)
+	}
+
+	// Stripe authenticates webhooks by signing the body, not with a session
+	if s.paymentHooks != nil {
+		r.Post("/webhooks/stripe", s.stripeWebhook)
+	}
+
 	// Mount gRPC-Gateway with JSON content-type restriction
 	// All API endpoints (/api/v1/*) are handled by gRPC-Gateway (auto-generated from proto)
 	rlog.Info().Msg("mounting gRPC-Gateway at /api/v1")
@@ -175,6 +214,56 @@ func (s *Server) serveWithGracefulShutdown() error {
 	return nil
 }
 
+func (s *Server) calendarFeed(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimSuffix(chi.URLParam(r, "token"), ".ics")
+
+	body, err := s.calendarFeeds.CalendarFeed(r.Context(), token)
+	if err != nil {
+		var p problems.Problem
+		if errors.As(err, &p) && p.Kind == problems.NotExist {
+			http.Error(w, "calendar feed not found", http.StatusNotFound)
+			return
+		}
+		log := httplog.LogEntry(r.Context())
+		log.Error().Err(err).Msg("failed to render calendar feed")
+		http.Error(w, "failed to render calendar feed", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=UTF-8")
+	w.Header().Set("Content-Disposition", `inline; filename="bookings.ics"`)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// maxWebhookBytes bounds a webhook body; Stripe events are a few KB.
+const maxWebhookBytes = 64 << 10
+
+func (s *Server) stripeWebhook(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBytes))
+	if err != nil {
+		http.Error(w, "invalid webhook body", http.StatusBadRequest)
+		return
+	}
+
+	// Any failure other than a bad signature is answered with an error so
+	// Stripe delivers the event again later
+	err = s.paymentHooks.HandleStripeWebhook(r.Context(), payload, r.Header.Get(stripe.SignatureHeader))
+	if err != nil {
+		var p problems.Problem
+		if errors.As(err, &p) && p.Kind == problems.InvalidRequest {
+			http.Error(w, "invalid webhook", http.StatusBadRequest)
+			return
+		}
+		log := httplog.LogEntry(r.Context())
+		log.Error().Err(err).Msg("failed to handle stripe webhook")
+		http.Error(w, "failed to handle webhook", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
 func (s *Server) healthCheck(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
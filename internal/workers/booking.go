@@ -17,6 +17,7 @@ type BookingConfirmationArgs struct {
 	BookingTime   string `json:"booking_time"`
 	DepositAmount int64  `json:"deposit_amount"`
 	TotalAmount   int64  `json:"total_amount"`
+	Calendar      []byte `json:"calendar,omitempty"` // iCalendar file attached to the email
 }
 
 func (BookingConfirmationArgs) Kind() string { return "booking_confirmation" }
@@ -26,7 +27,7 @@ func (BookingConfirmationArgs) InsertOpts() river.InsertOpts {
 }
 
 type BookingNotifier interface {
-	SendBookingConfirmation(ctx context.Context, to string, bookingDate string, bookingTime string, depositAmount int64, totalAmount int64) error
+	SendBookingConfirmation(ctx context.Context, to string, bookingDate string, bookingTime string, depositAmount int64, totalAmount int64, calendar []byte) error
 }
 
 type BookingConfirmationWorker struct {
@@ -55,6 +56,7 @@ func (w *BookingConfirmationWorker) Work(ctx context.Context, job *river.Job[Boo
 		job.Args.BookingTime,
 		job.Args.DepositAmount,
 		job.Args.TotalAmount,
+		job.Args.Calendar,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to send booking confirmation: %w", err)
@@ -66,3 +68,113 @@ func (w *BookingConfirmationWorker) Work(ctx context.Context, job *river.Job[Boo
 
 	return nil
 }
+
+type BookingRescheduledArgs struct {
+	BookingID     int64  `json:"booking_id"`
+	CustomerEmail string `json:"customer_email"`
+	CustomerName  string `json:"customer_name"`
+	OldDate       string `json:"old_date"`
+	OldTime       string `json:"old_time"`
+	NewDate       string `json:"new_date"`
+	NewTime       string `json:"new_time"`
+}
+
+func (BookingRescheduledArgs) Kind() string { return "booking_rescheduled" }
+
+func (BookingRescheduledArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{Queue: QueueBooking}
+}
+
+type BookingRescheduleNotifier interface {
+	SendBookingRescheduled(ctx context.Context, to, customerName, oldDate, oldTime, newDate, newTime string) error
+}
+
+type BookingRescheduledWorker struct {
+	river.WorkerDefaults[BookingRescheduledArgs]
+	notifier BookingRescheduleNotifier
+}
+
+func NewBookingRescheduledWorker(notifier BookingRescheduleNotifier) *BookingRescheduledWorker {
+	return &BookingRescheduledWorker{notifier: notifier}
+}
+
+func (w *BookingRescheduledWorker) Work(ctx context.Context, job *river.Job[BookingRescheduledArgs]) error {
+	log.Info().
+		Int64("booking_id", job.Args.BookingID).
+		Str("email", job.Args.CustomerEmail).
+		Msg("sending booking rescheduled notification")
+
+	if w.notifier == nil {
+		return fmt.Errorf("booking notifier not configured - job will retry")
+	}
+
+	err := w.notifier.SendBookingRescheduled(
+		ctx,
+		job.Args.CustomerEmail,
+		job.Args.CustomerName,
+		job.Args.OldDate,
+		job.Args.OldTime,
+		job.Args.NewDate,
+		job.Args.NewTime,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send booking rescheduled notification: %w", err)
+	}
+
+	return nil
+}
+
+type BookingRefundedArgs struct {
+	BookingID     int64  `json:"booking_id"`
+	CustomerEmail string `json:"customer_email"`
+	CustomerName  string `json:"customer_name"`
+	BookingDate   string `json:"booking_date"`
+	Amount        int64  `json:"amount"`
+}
+
+func (BookingRefundedArgs) Kind() string { return "booking_refunded" }
+
+func (BookingRefundedArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{Queue: QueueBooking}
+}
+
+type BookingRefundNotifier interface {
+	SendBookingRefunded(ctx context.Context, to, customerName, bookingDate string, amount int64) error
+}
+
+type BookingRefundedWorker struct {
+	river.WorkerDefaults[BookingRefundedArgs]
+	notifier BookingRefundNotifier
+}
+
+func NewBookingRefundedWorker(notifier BookingRefundNotifier) *BookingRefundedWorker {
+	return &BookingRefundedWorker{notifier: notifier}
+}
+
+func (w *BookingRefundedWorker) Work(ctx context.Context, job *river.Job[BookingRefundedArgs]) error {
+	log.Info().
+		Int64("booking_id", job.Args.BookingID).
+		Str("email", job.Args.CustomerEmail).
+		Msg("sending booking refund confirmation")
+
+	if w.notifier == nil {
+		return fmt.Errorf("booking notifier not configured - job will retry")
+	}
+
+	err := w.notifier.SendBookingRefunded(
+		ctx,
+		job.Args.CustomerEmail,
+		job.Args.CustomerName,
+		job.Args.BookingDate,
+		job.Args.Amount,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to send booking refund confirmation: %w", err)
+	}
+
+	log.Info().
+		Int64("booking_id", job.Args.BookingID).
+		Msg("booking refund confirmation sent")
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+)
+
+// BookingReminderArgs reminds a customer of a booking shortly before it
+// starts. Date and Time are the start the reminder was scheduled for, so a
+// reminder left over from before a reschedule is not sent.
+type BookingReminderArgs struct {
+	BookingID int64  `json:"booking_id"`
+	Date      string `json:"date"`
+	Time      string `json:"time"`
+}
+
+func (BookingReminderArgs) Kind() string { return "booking_reminder" }
+
+func (BookingReminderArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{Queue: QueueBooking}
+}
+
+// BookingFollowUpArgs thanks a customer after their booking is completed and
+// asks for a review.
+type BookingFollowUpArgs struct {
+	BookingID int64 `json:"booking_id"`
+}
+
+func (BookingFollowUpArgs) Kind() string { return "booking_follow_up" }
+
+func (BookingFollowUpArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{Queue: QueueBooking}
+}
+
+// BookingReminderSender sends scheduled booking emails. Each reports whether
+// the email was sent; it is skipped if the booking has since been cancelled,
+// moved or otherwise no longer needs it.
+type BookingReminderSender interface {
+	RemindBooking(ctx context.Context, bookingID int64, date, time string) (bool, error)
+	FollowUpBooking(ctx context.Context, bookingID int64) (bool, error)
+}
+
+type BookingReminderWorker struct {
+	river.WorkerDefaults[BookingReminderArgs]
+	sender BookingReminderSender
+}
+
+func NewBookingReminderWorker(sender BookingReminderSender) *BookingReminderWorker {
+	return &BookingReminderWorker{sender: sender}
+}
+
+func (w *BookingReminderWorker) Work(ctx context.Context, job *river.Job[BookingReminderArgs]) error {
+	sent, err := w.sender.RemindBooking(ctx, job.Args.BookingID, job.Args.Date, job.Args.Time)
+	if err != nil {
+		return fmt.Errorf("failed to send booking reminder: %w", err)
+	}
+
+	log.Info().
+		Int64("booking_id", job.Args.BookingID).
+		Bool("sent", sent).
+		Msg("booking reminder processed")
+	return nil
+}
+
+type BookingFollowUpWorker struct {
+	river.WorkerDefaults[BookingFollowUpArgs]
+	sender BookingReminderSender
+}
+
+func NewBookingFollowUpWorker(sender BookingReminderSender) *BookingFollowUpWorker {
+	return &BookingFollowUpWorker{sender: sender}
+}
+
+func (w *BookingFollowUpWorker) Work(ctx context.Context, job *river.Job[BookingFollowUpArgs]) error {
+	sent, err := w.sender.FollowUpBooking(ctx, job.Args.BookingID)
+	if err != nil {
+		return fmt.Errorf("failed to send booking follow-up: %w", err)
+	}
+
+	log.Info().
+		Int64("booking_id", job.Args.BookingID).
+		Bool("sent", sent).
+		Msg("booking follow-up processed")
+	return nil
+}
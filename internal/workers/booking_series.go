@@ -0,0 +1,45 @@
+package workers
+
+import (
+	"context"
+
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+)
+
+type BookingSeriesArgs struct{}
+
+func (BookingSeriesArgs) Kind() string { return "booking_series_materialise" }
+
+func (BookingSeriesArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue: QueueMaintenance,
+	}
+}
+
+// SeriesMaterialiser books upcoming occurrences of recurring booking series.
+type SeriesMaterialiser interface {
+	MaterialiseSeries(ctx context.Context) (int, error)
+}
+
+type BookingSeriesWorker struct {
+	river.WorkerDefaults[BookingSeriesArgs]
+	series SeriesMaterialiser
+}
+
+func NewBookingSeriesWorker(series SeriesMaterialiser) *BookingSeriesWorker {
+	return &BookingSeriesWorker{series: series}
+}
+
+func (w *BookingSeriesWorker) Work(ctx context.Context, job *river.Job[BookingSeriesArgs]) error {
+	log.Info().Msg("starting booking series materialisation")
+
+	booked, err := w.series.MaterialiseSeries(ctx)
+	if err != nil {
+		log.Error().Err(err).Int("booked", booked).Msg("failed to materialise booking series")
+		return err
+	}
+
+	log.Info().Int("booked", booked).Msg("booking series materialisation completed")
+	return nil
+}
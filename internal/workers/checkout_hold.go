@@ -0,0 +1,46 @@
+package workers
+
+import (
+	"context"
+
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+)
+
+// CheckoutHoldExpiryArgs releases slots held for customers who did not finish
+// checking out in time.
+type CheckoutHoldExpiryArgs struct{}
+
+func (CheckoutHoldExpiryArgs) Kind() string { return "checkout_hold_expiry" }
+
+func (CheckoutHoldExpiryArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue: QueueMaintenance,
+	}
+}
+
+type CheckoutHoldReleaser interface {
+	ReleaseExpiredCheckoutHolds(ctx context.Context) (int, error)
+}
+
+type CheckoutHoldExpiryWorker struct {
+	river.WorkerDefaults[CheckoutHoldExpiryArgs]
+	holds CheckoutHoldReleaser
+}
+
+func NewCheckoutHoldExpiryWorker(holds CheckoutHoldReleaser) *CheckoutHoldExpiryWorker {
+	return &CheckoutHoldExpiryWorker{holds: holds}
+}
+
+func (w *CheckoutHoldExpiryWorker) Work(ctx context.Context, job *river.Job[CheckoutHoldExpiryArgs]) error {
+	released, err := w.holds.ReleaseExpiredCheckoutHolds(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to release expired checkout holds")
+		return err
+	}
+
+	if released > 0 {
+		log.Info().Int("released", released).Msg("released expired checkout holds")
+	}
+	return nil
+}
@@ -4,11 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	fastmail "github.com/richardbowden/degrees/internal/email/genericsmtp"
 	"github.com/riverqueue/river"
 )
 
 type EMailer interface {
-	Send(from string, rcpt []string, subject, body string) error
+	Send(from string, rcpt []string, subject, body string, attachments ...fastmail.Attachment) error
 	IsReady() bool
 }
 
@@ -20,6 +21,8 @@ type EmailArgs struct {
 	Subject string   `json:"subject"`
 	Content string   `json:"content"`
 
+	Attachments []fastmail.Attachment `json:"attachments,omitempty"`
+
 	// Optional callback info - what to notify on success
 	CallbackType string `json:"callback_type,omitempty"` // e.g. "signup", "password_reset"
 	CallbackID   string `json:"callback_id,omitempty"`   // e.g. signup ID, user ID
@@ -62,7 +65,7 @@ func (w *EmailWorker) Work(ctx context.Context, job *river.Job[EmailArgs]) error
 		return fmt.Errorf("no recipients")
 	}
 
-	err := w.mailer.Send(job.Args.From, job.Args.To, job.Args.Subject, job.Args.Content)
+	err := w.mailer.Send(job.Args.From, job.Args.To, job.Args.Subject, job.Args.Content, job.Args.Attachments...)
 	if err != nil {
 		return fmt.Errorf("failed to send email to %s: %w", job.Args.To[0], err)
 	}
@@ -0,0 +1,79 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/riverqueue/river"
+	"github.com/rs/zerolog/log"
+)
+
+// WaitlistCheckArgs re-evaluates the waitlist for a date after a booking on
+// it was cancelled or moved, or a blackout was lifted.
+type WaitlistCheckArgs struct {
+	Date string `json:"date"`
+}
+
+func (WaitlistCheckArgs) Kind() string { return "waitlist_check" }
+
+func (WaitlistCheckArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{Queue: QueueBooking}
+}
+
+// WaitlistProcessor offers freed slots to waitlisted customers and expires
+// offers that were not claimed in time.
+type WaitlistProcessor interface {
+	ProcessWaitlist(ctx context.Context, date string) (int, error)
+	ExpireWaitlistOffers(ctx context.Context) (int, error)
+}
+
+type WaitlistCheckWorker struct {
+	river.WorkerDefaults[WaitlistCheckArgs]
+	waitlist WaitlistProcessor
+}
+
+func NewWaitlistCheckWorker(waitlist WaitlistProcessor) *WaitlistCheckWorker {
+	return &WaitlistCheckWorker{waitlist: waitlist}
+}
+
+func (w *WaitlistCheckWorker) Work(ctx context.Context, job *river.Job[WaitlistCheckArgs]) error {
+	offered, err := w.waitlist.ProcessWaitlist(ctx, job.Args.Date)
+	if err != nil {
+		return fmt.Errorf("failed to process waitlist for %s: %w", job.Args.Date, err)
+	}
+
+	log.Info().Str("date", job.Args.Date).Int("offered", offered).Msg("waitlist processed")
+	return nil
+}
+
+type WaitlistExpiryArgs struct{}
+
+func (WaitlistExpiryArgs) Kind() string { return "waitlist_expiry" }
+
+func (WaitlistExpiryArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue: QueueMaintenance,
+	}
+}
+
+type WaitlistExpiryWorker struct {
+	river.WorkerDefaults[WaitlistExpiryArgs]
+	waitlist WaitlistProcessor
+}
+
+func NewWaitlistExpiryWorker(waitlist WaitlistProcessor) *WaitlistExpiryWorker {
+	return &WaitlistExpiryWorker{waitlist: waitlist}
+}
+
+func (w *WaitlistExpiryWorker) Work(ctx context.Context, job *river.Job[WaitlistExpiryArgs]) error {
+	expired, err := w.waitlist.ExpireWaitlistOffers(ctx)
+	if err != nil {
+		log.Error().Err(err).Int("expired", expired).Msg("failed to expire waitlist offers")
+		return err
+	}
+
+	if expired > 0 {
+		log.Info().Int("expired", expired).Msg("expired unclaimed waitlist offers")
+	}
+	return nil
+}
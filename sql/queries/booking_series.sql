@@ -0,0 +1,50 @@
+-- name: CreateBookingSeries :one
+INSERT INTO booking_series (customer_id, template_booking_id, rrule, start_date, scheduled_time)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetBookingSeriesByID :one
+SELECT * FROM booking_series
+WHERE id = $1;
+
+-- name: ListBookingSeriesByCustomer :many
+SELECT * FROM booking_series
+WHERE customer_id = $1
+ORDER BY created_at DESC;
+
+-- name: ListActiveBookingSeries :many
+SELECT * FROM booking_series
+WHERE status = 'active'
+ORDER BY id;
+
+-- name: UpdateBookingSeriesStatus :one
+UPDATE booking_series
+SET status = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: ListSeriesOccurrences :many
+SELECT * FROM booking_series_occurrences
+WHERE series_id = $1
+ORDER BY occurrence_date;
+
+-- name: UpsertSeriesOccurrence :one
+INSERT INTO booking_series_occurrences (series_id, occurrence_date, booking_id, status, reason)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (series_id, occurrence_date)
+DO UPDATE SET booking_id = EXCLUDED.booking_id,
+              status = EXCLUDED.status,
+              reason = EXCLUDED.reason
+RETURNING *;
+
+-- name: SetBookingSeriesID :exec
+UPDATE bookings
+SET series_id = $2
+WHERE id = $1;
+
+-- name: ListUpcomingSeriesBookings :many
+SELECT * FROM bookings
+WHERE series_id = $1
+  AND scheduled_date >= CURRENT_DATE
+  AND status NOT IN ('cancelled', 'completed')
+ORDER BY scheduled_date;
@@ -3,19 +3,24 @@ INSERT INTO bookings (
     customer_id, vehicle_id, scheduled_date, scheduled_time,
     estimated_duration_mins, status, payment_status,
     subtotal, deposit_amount, total_amount,
-    stripe_payment_intent_id, stripe_deposit_intent_id, notes
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+    notes, resource_id,
+    service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge,
+    created_by
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 RETURNING *;
 
 -- name: GetBookingByID :one
 SELECT b.*,
        cp.user_id AS customer_user_id,
        cp.phone AS customer_phone,
+       u.login_email AS customer_email,
+       trim(u.first_name || ' ' || COALESCE(u.surname, '')) AS customer_name,
        v.make AS vehicle_make,
        v.model AS vehicle_model,
        v.rego AS vehicle_rego
 FROM bookings b
 JOIN customer_profiles cp ON cp.id = b.customer_id
+JOIN users u ON u.id = cp.user_id
 LEFT JOIN vehicles v ON v.id = b.vehicle_id
 WHERE b.id = $1;
 
@@ -49,12 +54,59 @@ WHERE scheduled_date = $1
   AND status NOT IN ('cancelled')
 ORDER BY scheduled_time;
 
+-- name: ListBookingsForRange :many
+SELECT * FROM bookings
+WHERE scheduled_date BETWEEN sqlc.arg(date_from)::date AND sqlc.arg(date_to)::date
+  AND status NOT IN ('cancelled')
+ORDER BY scheduled_date, scheduled_time;
+
 -- name: UpdateBookingStatus :one
 UPDATE bookings
 SET status = $2
 WHERE id = $1
 RETURNING *;
 
+-- name: GetBookingForUpdate :one
+SELECT * FROM bookings
+WHERE id = $1
+FOR UPDATE;
+
+-- name: RescheduleBooking :one
+UPDATE bookings
+SET scheduled_date = $2, scheduled_time = $3, resource_id = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: SetBookingRefundAmount :one
+UPDATE bookings
+SET refund_amount = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateBookingPricing :one
+UPDATE bookings
+SET subtotal = $2, total_amount = $3, estimated_duration_mins = $4, resource_id = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: SetBookingAmountPaid :one
+UPDATE bookings
+SET amount_paid = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: SetBookingForfeitedAmount :one
+UPDATE bookings
+SET forfeited_amount = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: IncrementCustomerNoShowCount :one
+UPDATE customer_profiles
+SET no_show_count = no_show_count + 1
+WHERE id = $1
+RETURNING *;
+
 -- name: UpdateBookingPaymentStatus :one
 UPDATE bookings
 SET payment_status = $2
@@ -62,26 +114,52 @@ WHERE id = $1
 RETURNING *;
 
 -- name: CreateBookingService :one
-INSERT INTO booking_services (booking_id, service_id, price_at_booking)
-VALUES ($1, $2, $3)
+INSERT INTO booking_services (booking_id, service_id, price_at_booking, duration_at_booking)
+VALUES ($1, $2, $3, $4)
 RETURNING *;
 
 -- name: CreateBookingServiceOption :one
-INSERT INTO booking_service_options (booking_service_id, service_option_id, price_at_booking)
-VALUES ($1, $2, $3)
+INSERT INTO booking_service_options (booking_service_id, service_option_id, price_at_booking, duration_at_booking)
+VALUES ($1, $2, $3, $4)
 RETURNING *;
 
 -- name: ListBookingServices :many
-SELECT bs.id, bs.booking_id, bs.service_id, bs.price_at_booking,
+SELECT bs.id, bs.booking_id, bs.service_id, bs.price_at_booking, bs.duration_at_booking,
        s.name AS service_name, s.slug AS service_slug
 FROM booking_services bs
 JOIN services s ON s.id = bs.service_id
 WHERE bs.booking_id = $1;
 
+-- name: GetBookingService :one
+SELECT * FROM booking_services
+WHERE id = $1 AND booking_id = $2;
+
+-- name: DeleteBookingService :exec
+DELETE FROM booking_services
+WHERE id = $1;
+
+-- name: GetBookingServiceOption :one
+SELECT * FROM booking_service_options
+WHERE id = $1 AND booking_service_id = $2;
+
+-- name: DeleteBookingServiceOption :exec
+DELETE FROM booking_service_options
+WHERE id = $1;
+
 -- name: ListBookingServiceOptions :many
 SELECT bso.id, bso.booking_service_id, bso.service_option_id,
-       bso.price_at_booking,
+       bso.price_at_booking, bso.duration_at_booking,
        so.name AS option_name
 FROM booking_service_options bso
 JOIN service_options so ON so.id = bso.service_option_id
 WHERE bso.booking_service_id = $1;
+
+-- name: CreateBookingStatusHistory :one
+INSERT INTO booking_status_history (booking_id, field, from_value, to_value, changed_by, reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListBookingStatusHistory :many
+SELECT * FROM booking_status_history
+WHERE booking_id = $1
+ORDER BY created_at, id;
@@ -0,0 +1,40 @@
+-- name: GetCalendarFeedForUser :one
+SELECT * FROM calendar_feeds
+WHERE user_id = $1 AND scope = $2;
+
+-- name: GetCalendarFeedByToken :one
+SELECT * FROM calendar_feeds
+WHERE token = $1;
+
+-- name: SetCalendarFeedToken :one
+INSERT INTO calendar_feeds (user_id, scope, token)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, scope) DO UPDATE
+SET token = EXCLUDED.token, created_at = NOW()
+RETURNING *;
+
+-- name: ListCalendarBookings :many
+-- Columns match GetBookingByID so each row can be handled as one.
+SELECT b.*,
+       cp.user_id AS customer_user_id,
+       cp.phone AS customer_phone,
+       u.login_email AS customer_email,
+       trim(u.first_name || ' ' || COALESCE(u.surname, '')) AS customer_name,
+       v.make AS vehicle_make,
+       v.model AS vehicle_model,
+       v.rego AS vehicle_rego
+FROM bookings b
+JOIN customer_profiles cp ON cp.id = b.customer_id
+JOIN users u ON u.id = cp.user_id
+LEFT JOIN vehicles v ON v.id = b.vehicle_id
+WHERE b.scheduled_date >= sqlc.arg(date_from) AND b.scheduled_date <= sqlc.arg(date_to)
+  AND b.status::text = ANY(sqlc.arg(statuses)::text[])
+  AND (sqlc.narg(customer_id)::bigint IS NULL OR b.customer_id = sqlc.narg(customer_id))
+ORDER BY b.scheduled_date, b.scheduled_time;
+
+-- name: ListBookingServiceNames :many
+SELECT bs.booking_id, s.name AS service_name
+FROM booking_services bs
+JOIN services s ON s.id = bs.service_id
+WHERE bs.booking_id = ANY(sqlc.arg(booking_ids)::bigint[])
+ORDER BY bs.booking_id, bs.id;
@@ -54,3 +54,12 @@ RETURNING *;
 -- name: RemoveCartItemOption :exec
 DELETE FROM cart_item_options
 WHERE cart_item_id = $1 AND service_option_id = $2;
+
+-- name: ListCartItemOptionsBySession :many
+SELECT cio.id, cio.cart_item_id, cio.service_option_id,
+       so.service_id, so.name AS option_name, so.price, so.duration_minutes, so.is_active
+FROM cart_item_options cio
+JOIN cart_items ci ON ci.id = cio.cart_item_id
+JOIN service_options so ON so.id = cio.service_option_id
+WHERE ci.cart_session_id = $1
+ORDER BY cio.cart_item_id, so.sort_order, so.name;
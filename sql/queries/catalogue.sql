@@ -37,6 +37,14 @@ FROM services s
 JOIN service_categories sc ON sc.id = s.category_id
 WHERE s.slug = $1;
 
+-- name: ListServiceRequirements :many
+-- Durations are those for the vehicle category where a tier sets one.
+SELECT s.id, s.category_id, s.resource_types,
+       COALESCE(spt.duration_minutes, s.duration_minutes)::int AS duration_minutes
+FROM services s
+LEFT JOIN service_price_tiers spt ON spt.service_id = s.id AND spt.vehicle_category_id = sqlc.arg(vehicle_category_id)
+WHERE s.id = ANY(sqlc.arg(ids)::bigint[]);
+
 -- name: GetServiceByID :one
 SELECT * FROM services
 WHERE id = $1;
@@ -44,15 +52,15 @@ WHERE id = $1;
 -- name: CreateService :one
 INSERT INTO services (
     category_id, name, slug, description, short_desc,
-    base_price, duration_minutes, is_active, sort_order
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    base_price, duration_minutes, is_active, sort_order, resource_types
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 RETURNING *;
 
 -- name: UpdateService :one
 UPDATE services
 SET category_id = $2, name = $3, slug = $4, description = $5,
     short_desc = $6, base_price = $7, duration_minutes = $8,
-    is_active = $9, sort_order = $10
+    is_active = $9, sort_order = $10, resource_types = $11
 WHERE id = $1
 RETURNING *;
 
@@ -72,14 +80,18 @@ SELECT * FROM service_options
 WHERE service_id = $1
 ORDER BY sort_order, name;
 
+-- name: GetServiceOptionByID :one
+SELECT * FROM service_options
+WHERE id = $1;
+
 -- name: CreateServiceOption :one
-INSERT INTO service_options (service_id, name, description, price, is_active, sort_order)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO service_options (service_id, name, description, price, is_active, sort_order, duration_minutes)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING *;
 
 -- name: UpdateServiceOption :one
 UPDATE service_options
-SET name = $2, description = $3, price = $4, is_active = $5, sort_order = $6
+SET name = $2, description = $3, price = $4, is_active = $5, sort_order = $6, duration_minutes = $7
 WHERE id = $1
 RETURNING *;
 
@@ -121,7 +133,7 @@ WHERE id = $1;
 -- ========================================
 
 -- name: ListPriceTiersByService :many
-SELECT spt.id, spt.service_id, spt.vehicle_category_id, spt.price, spt.created_at,
+SELECT spt.id, spt.service_id, spt.vehicle_category_id, spt.price, spt.duration_minutes, spt.created_at,
        vc.name AS category_name, vc.slug AS category_slug
 FROM service_price_tiers spt
 JOIN vehicle_categories vc ON vc.id = spt.vehicle_category_id
@@ -129,10 +141,10 @@ WHERE spt.service_id = $1
 ORDER BY vc.sort_order, vc.name;
 
 -- name: UpsertPriceTier :one
-INSERT INTO service_price_tiers (service_id, vehicle_category_id, price)
-VALUES ($1, $2, $3)
+INSERT INTO service_price_tiers (service_id, vehicle_category_id, price, duration_minutes)
+VALUES ($1, $2, $3, $4)
 ON CONFLICT (service_id, vehicle_category_id)
-DO UPDATE SET price = EXCLUDED.price
+DO UPDATE SET price = EXCLUDED.price, duration_minutes = EXCLUDED.duration_minutes
 RETURNING *;
 
 -- name: DeletePriceTier :exec
@@ -140,7 +152,7 @@ DELETE FROM service_price_tiers
 WHERE service_id = $1 AND vehicle_category_id = $2;
 
 -- name: GetPriceTier :one
-SELECT spt.id, spt.service_id, spt.vehicle_category_id, spt.price, spt.created_at,
+SELECT spt.id, spt.service_id, spt.vehicle_category_id, spt.price, spt.duration_minutes, spt.created_at,
        vc.name AS category_name, vc.slug AS category_slug
 FROM service_price_tiers spt
 JOIN vehicle_categories vc ON vc.id = spt.vehicle_category_id
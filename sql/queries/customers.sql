@@ -2,6 +2,10 @@
 SELECT * FROM customer_profiles
 WHERE user_id = $1;
 
+-- name: GetCustomerProfileByID :one
+SELECT * FROM customer_profiles
+WHERE id = $1;
+
 -- name: CreateCustomerProfile :one
 INSERT INTO customer_profiles (user_id, phone, address, suburb, postcode, notes)
 VALUES ($1, $2, $3, $4, $5, $6)
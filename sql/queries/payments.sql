@@ -0,0 +1,32 @@
+-- name: RecordStripeEvent :execrows
+-- Affects no rows when the event was already recorded.
+INSERT INTO stripe_events (id, type, booking_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: CreatePayment :one
+-- Returns no row when the provider payment was already recorded.
+INSERT INTO payments (booking_id, kind, amount, tip_amount, method, provider_ref, note, created_by, refund_of)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (kind, provider_ref) WHERE provider_ref IS NOT NULL DO NOTHING
+RETURNING *;
+
+-- name: ListBookingPayments :many
+SELECT p.*,
+       COALESCE(trim(u.first_name || ' ' || COALESCE(u.surname, '')), '')::text AS created_by_name
+FROM payments p
+LEFT JOIN users u ON u.id = p.created_by
+WHERE p.booking_id = $1
+ORDER BY p.created_at, p.id;
+
+-- name: GetChargeByProviderRef :one
+SELECT * FROM payments
+WHERE kind = 'charge' AND provider_ref = $1;
+
+-- name: SumBookingPayments :one
+SELECT COALESCE(SUM(amount) FILTER (WHERE kind = 'charge'), 0)::bigint AS charged,
+       COALESCE(SUM(tip_amount) FILTER (WHERE kind = 'charge'), 0)::bigint AS tips,
+       COALESCE(SUM(amount) FILTER (WHERE kind = 'refund'), 0)::bigint AS refunded,
+       COALESCE(SUM(amount) FILTER (WHERE kind = 'adjustment'), 0)::bigint AS adjusted
+FROM payments
+WHERE booking_id = $1;
@@ -21,15 +21,118 @@ SELECT * FROM schedule_blackouts
 ORDER BY date;
 
 -- name: CreateBlackout :one
-INSERT INTO schedule_blackouts (date, reason)
-VALUES ($1, $2)
+INSERT INTO schedule_blackouts (date, reason, recurs_annually)
+VALUES ($1, $2, $3)
 RETURNING *;
 
--- name: DeleteBlackout :exec
+-- name: DeleteBlackout :one
 DELETE FROM schedule_blackouts
-WHERE id = $1;
+WHERE id = $1
+RETURNING *;
 
 -- name: IsDateBlackedOut :one
 SELECT EXISTS(
-    SELECT 1 FROM schedule_blackouts WHERE date = $1
+    SELECT 1 FROM schedule_blackouts
+    WHERE date = sqlc.arg(date)::date
+       OR (recurs_annually
+           AND date <= sqlc.arg(date)::date
+           AND EXTRACT(MONTH FROM date) = EXTRACT(MONTH FROM sqlc.arg(date)::date)
+           AND EXTRACT(DAY FROM date) = EXTRACT(DAY FROM sqlc.arg(date)::date))
 ) AS is_blacked_out;
+
+-- name: ListBlackoutsInRange :many
+-- Blackouts in the range plus every recurring blackout that may fall in it.
+SELECT * FROM schedule_blackouts
+WHERE date BETWEEN sqlc.arg(date_from)::date AND sqlc.arg(date_to)::date
+   OR (recurs_annually AND date <= sqlc.arg(date_to)::date)
+ORDER BY date;
+
+-- name: LockScheduleDate :exec
+-- Serialises booking writes for a single day for the rest of the transaction.
+SELECT pg_advisory_xact_lock(hashtext('schedule:' || sqlc.arg(scheduled_date)::date::text));
+
+-- name: ListResources :many
+SELECT * FROM resources
+ORDER BY sort_order, id;
+
+-- name: ListActiveResources :many
+SELECT * FROM resources
+WHERE is_active = true
+ORDER BY sort_order, id;
+
+-- name: CreateResource :one
+INSERT INTO resources (name, resource_type, is_active, sort_order)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: UpdateResource :one
+UPDATE resources
+SET name = $2, resource_type = $3, is_active = $4, sort_order = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: ListResourceHours :many
+SELECT * FROM resource_hours
+ORDER BY resource_id, day_of_week;
+
+-- name: ListResourceHoursForDay :many
+SELECT * FROM resource_hours
+WHERE day_of_week = $1;
+
+-- name: UpsertResourceHours :one
+INSERT INTO resource_hours (resource_id, day_of_week, open_time, close_time, is_open)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (resource_id, day_of_week)
+DO UPDATE SET open_time = EXCLUDED.open_time,
+              close_time = EXCLUDED.close_time,
+              is_open = EXCLUDED.is_open
+RETURNING *;
+
+-- name: DeleteResourceHours :exec
+DELETE FROM resource_hours
+WHERE resource_id = $1 AND day_of_week = $2;
+
+-- name: ListScheduleWindows :many
+SELECT * FROM schedule_windows
+ORDER BY day_of_week, open_time;
+
+-- name: ListScheduleWindowsForDay :many
+SELECT * FROM schedule_windows
+WHERE day_of_week = $1
+ORDER BY open_time;
+
+-- name: SetScheduleWindows :exec
+-- Replaces a weekday's windows. Empty arrays clear them.
+WITH removed AS (
+    DELETE FROM schedule_windows WHERE day_of_week = sqlc.arg(day_of_week)
+)
+INSERT INTO schedule_windows (day_of_week, open_time, close_time)
+SELECT sqlc.arg(day_of_week), unnest(sqlc.arg(open_times)::time[]), unnest(sqlc.arg(close_times)::time[]);
+
+-- name: ListUpcomingScheduleOverrides :many
+SELECT * FROM schedule_overrides
+WHERE date >= CURRENT_DATE
+ORDER BY date, open_time;
+
+-- name: ListScheduleOverridesForDate :many
+SELECT * FROM schedule_overrides
+WHERE date = $1
+ORDER BY open_time;
+
+-- name: ListScheduleOverridesInRange :many
+SELECT * FROM schedule_overrides
+WHERE date BETWEEN sqlc.arg(date_from)::date AND sqlc.arg(date_to)::date
+ORDER BY date, open_time;
+
+-- name: SetScheduleOverride :many
+-- Replaces the hours for a date with the given windows.
+WITH removed AS (
+    DELETE FROM schedule_overrides WHERE date = sqlc.arg(date)
+)
+INSERT INTO schedule_overrides (date, open_time, close_time, reason)
+SELECT sqlc.arg(date), unnest(sqlc.arg(open_times)::time[]), unnest(sqlc.arg(close_times)::time[]), sqlc.narg(reason)
+RETURNING *;
+
+-- name: DeleteScheduleOverride :execrows
+DELETE FROM schedule_overrides
+WHERE date = $1;
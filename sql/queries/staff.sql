@@ -0,0 +1,140 @@
+-- name: ListStaff :many
+SELECT s.*, u.login_email AS email
+FROM staff s
+JOIN users u ON u.id = s.user_id
+ORDER BY s.display_name;
+
+-- name: GetStaffByID :one
+SELECT * FROM staff
+WHERE id = $1;
+
+-- name: GetStaffByUserID :one
+SELECT * FROM staff
+WHERE user_id = $1;
+
+-- name: CreateStaff :one
+INSERT INTO staff (user_id, display_name, is_active)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: UpdateStaff :one
+UPDATE staff
+SET display_name = $2, is_active = $3
+WHERE id = $1
+RETURNING *;
+
+-- name: ListAllStaffSkills :many
+SELECT * FROM staff_skills
+ORDER BY staff_id, category_id;
+
+-- name: SetStaffSkills :exec
+-- Replaces a staff member's skills with the given categories.
+WITH removed AS (
+    DELETE FROM staff_skills
+    WHERE staff_id = sqlc.arg(staff_id) AND category_id <> ALL(sqlc.arg(category_ids)::bigint[])
+)
+INSERT INTO staff_skills (staff_id, category_id)
+SELECT sqlc.arg(staff_id), unnest(sqlc.arg(category_ids)::bigint[])
+ON CONFLICT DO NOTHING;
+
+-- name: ListStaffRoster :many
+SELECT * FROM staff_roster
+ORDER BY staff_id, day_of_week;
+
+-- name: UpsertStaffRoster :one
+INSERT INTO staff_roster (staff_id, day_of_week, start_time, end_time)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (staff_id, day_of_week)
+DO UPDATE SET start_time = EXCLUDED.start_time,
+              end_time = EXCLUDED.end_time
+RETURNING *;
+
+-- name: DeleteStaffRoster :exec
+DELETE FROM staff_roster
+WHERE staff_id = $1 AND day_of_week = $2;
+
+-- name: ListUpcomingStaffLeave :many
+SELECT * FROM staff_leave
+WHERE end_date >= CURRENT_DATE
+ORDER BY start_date;
+
+-- name: CreateStaffLeave :one
+INSERT INTO staff_leave (staff_id, start_date, end_date, reason)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: DeleteStaffLeave :exec
+DELETE FROM staff_leave
+WHERE id = $1;
+
+-- name: HasActiveStaff :one
+SELECT EXISTS(SELECT 1 FROM staff WHERE is_active = true);
+
+-- name: ListRosteredStaffForDate :many
+-- Active staff working on a date: rostered for its weekday and not on leave.
+SELECT s.id AS staff_id, r.start_time, r.end_time,
+       ARRAY(SELECT sk.category_id FROM staff_skills sk WHERE sk.staff_id = s.id)::bigint[] AS category_ids
+FROM staff s
+JOIN staff_roster r ON r.staff_id = s.id
+WHERE s.is_active = true
+  AND r.day_of_week = sqlc.arg(day_of_week)
+  AND NOT EXISTS (
+      SELECT 1 FROM staff_leave l
+      WHERE l.staff_id = s.id
+        AND sqlc.arg(scheduled_date)::date BETWEEN l.start_date AND l.end_date
+  );
+
+-- name: ListBookingStaffForDate :many
+SELECT bs.booking_id, bs.staff_id
+FROM booking_staff bs
+JOIN bookings b ON b.id = bs.booking_id
+WHERE b.scheduled_date = $1
+  AND b.status NOT IN ('cancelled');
+
+-- name: ListRosteredStaffForRange :many
+-- ListRosteredStaffForDate for every date in a range at once.
+SELECT d.day::date AS scheduled_date, s.id AS staff_id, r.start_time, r.end_time,
+       ARRAY(SELECT sk.category_id FROM staff_skills sk WHERE sk.staff_id = s.id)::bigint[] AS category_ids
+FROM generate_series(sqlc.arg(date_from)::date, sqlc.arg(date_to)::date, INTERVAL '1 day') AS d(day)
+JOIN staff_roster r ON r.day_of_week = EXTRACT(DOW FROM d.day)::int
+JOIN staff s ON s.id = r.staff_id
+WHERE s.is_active = true
+  AND NOT EXISTS (
+      SELECT 1 FROM staff_leave l
+      WHERE l.staff_id = s.id
+        AND d.day::date BETWEEN l.start_date AND l.end_date
+  )
+ORDER BY d.day, s.id;
+
+-- name: ListBookingStaffForRange :many
+SELECT b.scheduled_date, bs.booking_id, bs.staff_id
+FROM booking_staff bs
+JOIN bookings b ON b.id = bs.booking_id
+WHERE b.scheduled_date BETWEEN sqlc.arg(date_from)::date AND sqlc.arg(date_to)::date
+  AND b.status NOT IN ('cancelled');
+
+-- name: ListBookingStaff :many
+SELECT bs.booking_id, bs.staff_id, s.display_name
+FROM booking_staff bs
+JOIN staff s ON s.id = bs.staff_id
+WHERE bs.booking_id = $1
+ORDER BY s.display_name;
+
+-- name: SetBookingStaff :exec
+-- Replaces the detailers assigned to a booking with the given staff.
+WITH removed AS (
+    DELETE FROM booking_staff
+    WHERE booking_id = sqlc.arg(booking_id) AND staff_id <> ALL(sqlc.arg(staff_ids)::bigint[])
+)
+INSERT INTO booking_staff (booking_id, staff_id, assigned_by)
+SELECT sqlc.arg(booking_id), unnest(sqlc.arg(staff_ids)::bigint[]), sqlc.narg(assigned_by)
+ON CONFLICT DO NOTHING;
+
+-- name: ListStaffBookingsForDate :many
+SELECT b.*
+FROM bookings b
+JOIN booking_staff bs ON bs.booking_id = b.id
+WHERE bs.staff_id = $1
+  AND b.scheduled_date = $2
+  AND b.status NOT IN ('cancelled')
+ORDER BY b.scheduled_time;
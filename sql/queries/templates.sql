@@ -24,7 +24,7 @@ select * from template where id = $1;
 -- name: ListSystemNotificationTemplates :many
 select t.id, t.name, t.content,t.version, nt.name system_name
     from template t
-         join notification_template nt on t.id = nt.id;
+         join notification_template nt on t.id = nt.template_id;
 
 -- name: SaveTemplate :exec
 insert into template (name, ref, content, scope_type, version)
@@ -0,0 +1,152 @@
+-- name: CreateSlotHold :one
+INSERT INTO slot_holds (customer_id, resource_id, scheduled_date, scheduled_time, duration_mins, expires_at, purpose, service_postcode)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetSlotHoldByID :one
+SELECT * FROM slot_holds
+WHERE id = $1;
+
+-- name: DeleteSlotHold :exec
+DELETE FROM slot_holds
+WHERE id = $1;
+
+-- The customer's checkout hold, if it has not run out.
+-- name: GetCheckoutHoldForCustomer :one
+SELECT * FROM slot_holds
+WHERE customer_id = $1
+  AND purpose = 'checkout'
+  AND expires_at > NOW()
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: DeleteCheckoutHoldsForCustomer :many
+DELETE FROM slot_holds
+WHERE customer_id = $1
+  AND purpose = 'checkout'
+RETURNING *;
+
+-- name: DeleteExpiredCheckoutHolds :many
+DELETE FROM slot_holds
+WHERE purpose = 'checkout'
+  AND expires_at <= NOW()
+RETURNING *;
+
+-- name: ListActiveSlotHoldsForDate :many
+SELECT * FROM slot_holds
+WHERE scheduled_date = $1
+  AND expires_at > NOW()
+ORDER BY scheduled_time;
+
+-- name: ListActiveSlotHoldsForRange :many
+SELECT * FROM slot_holds
+WHERE scheduled_date BETWEEN sqlc.arg(date_from)::date AND sqlc.arg(date_to)::date
+  AND expires_at > NOW()
+ORDER BY scheduled_date, scheduled_time;
+
+-- name: CreateWaitlistEntry :one
+INSERT INTO waitlist_entries (
+    customer_id, vehicle_id, date_from, date_to, estimated_duration_mins,
+    subtotal, deposit_amount, total_amount, notes,
+    service_address, service_suburb, service_postcode, travel_distance_km, travel_surcharge
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING *;
+
+-- name: CreateWaitlistEntryService :one
+INSERT INTO waitlist_entry_services (waitlist_entry_id, service_id, price_at_booking, duration_at_booking)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: CreateWaitlistEntryServiceOption :one
+INSERT INTO waitlist_entry_service_options (waitlist_entry_service_id, service_option_id, price_at_booking, duration_at_booking)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetWaitlistEntryByID :one
+SELECT * FROM waitlist_entries
+WHERE id = $1;
+
+-- name: GetWaitlistEntryByClaimToken :one
+SELECT * FROM waitlist_entries
+WHERE claim_token = $1;
+
+-- name: ListWaitlistEntriesByCustomer :many
+SELECT w.*,
+       h.scheduled_date AS offered_date,
+       h.scheduled_time AS offered_time,
+       h.expires_at AS offer_expires_at
+FROM waitlist_entries w
+LEFT JOIN slot_holds h ON h.id = w.hold_id
+WHERE w.customer_id = $1
+ORDER BY w.created_at DESC;
+
+-- Waiting customers whose range covers the date, first come first served.
+-- name: ListWaitingEntriesForDate :many
+SELECT w.*,
+       u.login_email AS customer_email,
+       trim(u.first_name || ' ' || COALESCE(u.surname, '')) AS customer_name
+FROM waitlist_entries w
+JOIN customer_profiles cp ON cp.id = w.customer_id
+JOIN users u ON u.id = cp.user_id
+WHERE w.status = 'waiting'
+  AND @date::date BETWEEN w.date_from AND w.date_to
+ORDER BY w.created_at, w.id;
+
+-- name: ListWaitlistEntryServices :many
+SELECT * FROM waitlist_entry_services
+WHERE waitlist_entry_id = $1
+ORDER BY id;
+
+-- name: ListWaitlistEntryServiceOptions :many
+SELECT o.* FROM waitlist_entry_service_options o
+JOIN waitlist_entry_services s ON s.id = o.waitlist_entry_service_id
+WHERE s.waitlist_entry_id = $1
+ORDER BY o.id;
+
+-- name: OfferWaitlistEntry :one
+UPDATE waitlist_entries
+SET status = 'offered',
+    hold_id = $2,
+    claim_token = $3,
+    offered_at = NOW()
+WHERE id = $1
+  AND status = 'waiting'
+RETURNING *;
+
+-- name: ClaimWaitlistEntry :one
+UPDATE waitlist_entries
+SET status = 'claimed',
+    hold_id = NULL,
+    claim_token = NULL,
+    booking_id = $2
+WHERE id = $1
+  AND status = 'offered'
+RETURNING *;
+
+-- name: UpdateWaitlistEntryStatus :one
+UPDATE waitlist_entries
+SET status = $2
+WHERE id = $1
+RETURNING *;
+
+-- Offers whose hold has run out, with the date the hold was on.
+-- name: ListExpiredWaitlistOffers :many
+SELECT w.id, w.hold_id, h.scheduled_date
+FROM waitlist_entries w
+JOIN slot_holds h ON h.id = w.hold_id
+WHERE w.status = 'offered'
+  AND h.expires_at <= NOW();
+
+-- name: ExpireWaitlistOffer :exec
+UPDATE waitlist_entries
+SET status = 'expired',
+    hold_id = NULL,
+    claim_token = NULL
+WHERE id = $1;
+
+-- name: ExpirePastWaitlistEntries :execrows
+UPDATE waitlist_entries
+SET status = 'expired'
+WHERE status = 'waiting'
+  AND date_to < CURRENT_DATE;
@@ -0,0 +1,2 @@
+-- Only drops the guard; bookings are untouched, so this always succeeds.
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_no_overlap;
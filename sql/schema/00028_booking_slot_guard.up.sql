@@ -0,0 +1,41 @@
+-- Prevent two active bookings from occupying overlapping time ranges.
+-- Cancelled bookings release their slot, so they are excluded from the check.
+
+-- Bookings made before this guard may already overlap, which would stop the
+-- constraint being added. Rather than pick which customer loses their slot,
+-- stop and list the clashing pairs so they can be moved or cancelled first.
+DO $$
+DECLARE
+    clashes TEXT;
+BEGIN
+    SELECT string_agg(format('%s and %s', a.id, b.id), ', ' ORDER BY a.id, b.id)
+    INTO clashes
+    FROM bookings a
+    JOIN bookings b ON b.id > a.id
+    WHERE a.status <> 'cancelled'
+      AND b.status <> 'cancelled'
+      AND tsrange(
+            a.scheduled_date + a.scheduled_time,
+            a.scheduled_date + a.scheduled_time + make_interval(mins => a.estimated_duration_mins),
+            '[)'
+          ) && tsrange(
+            b.scheduled_date + b.scheduled_time,
+            b.scheduled_date + b.scheduled_time + make_interval(mins => b.estimated_duration_mins),
+            '[)'
+          );
+
+    IF clashes IS NOT NULL THEN
+        RAISE EXCEPTION 'overlapping bookings must be moved or cancelled before adding bookings_no_overlap: %', clashes;
+    END IF;
+END
+$$;
+
+ALTER TABLE bookings ADD CONSTRAINT bookings_no_overlap
+    EXCLUDE USING gist (
+        tsrange(
+            scheduled_date + scheduled_time,
+            scheduled_date + scheduled_time + make_interval(mins => estimated_duration_mins),
+            '[)'
+        ) WITH &&
+    )
+    WHERE (status <> 'cancelled');
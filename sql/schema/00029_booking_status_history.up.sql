@@ -0,0 +1,14 @@
+-- Audit trail of booking and payment status changes.
+-- changed_by is NULL for system-driven changes such as payment webhooks.
+
+CREATE TABLE booking_status_history (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    booking_id BIGINT NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    field TEXT NOT NULL CHECK (field IN ('status', 'payment_status')),
+    from_value TEXT,
+    to_value TEXT NOT NULL,
+    changed_by BIGINT REFERENCES users(id),
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_booking_status_history_booking ON booking_status_history(booking_id);
@@ -0,0 +1,2 @@
+DELETE FROM notification_template WHERE name = 'booking-rescheduled';
+DELETE FROM template WHERE ref = 'booking-rescheduled';
@@ -0,0 +1,10 @@
+-- Email sent to a customer when their booking is moved to a new date/time.
+
+INSERT INTO template (name, ref, content, scope_type, version, created_by, updated_by)
+VALUES
+  ('Booking Rescheduled', 'booking-rescheduled', 'Hi {{.CustomerName}}, your booking has been moved from {{.OldDate}} at {{.OldTime}} to {{.NewDate}} at {{.NewTime}}. Any deposit you have paid stays with the booking.', 'System', 1, NULL, NULL)
+ON CONFLICT (ref, version) DO NOTHING;
+
+INSERT INTO notification_template (name, template_id)
+SELECT 'booking-rescheduled', id FROM template WHERE ref = 'booking-rescheduled' AND version = 1
+ON CONFLICT (name) DO NOTHING;
@@ -0,0 +1,2 @@
+DELETE FROM settings WHERE scope = 'system' AND subsystem = 'booking' AND key = 'cancellation_policy';
+ALTER TABLE bookings DROP COLUMN IF EXISTS refund_amount;
@@ -0,0 +1,11 @@
+-- Refund owed to the customer when a booking is cancelled, computed from the
+-- cancellation policy at the time of cancellation.
+ALTER TABLE bookings ADD COLUMN refund_amount BIGINT NOT NULL DEFAULT 0;
+
+-- Default cancellation policy. Tiers are matched on hours of notice before the
+-- scheduled start; refund_percent applies to the amount the customer has paid.
+INSERT INTO settings (scope, subsystem, key, value, description)
+VALUES
+    ('system', 'booking', 'cancellation_policy',
+     '{"tiers": [{"min_hours_before": 72, "refund_percent": 100}, {"min_hours_before": 24, "refund_percent": 50}, {"min_hours_before": 0, "refund_percent": 0}]}',
+     'Refund tiers applied when a customer cancels a booking');
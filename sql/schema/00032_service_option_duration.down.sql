@@ -0,0 +1 @@
+ALTER TABLE service_options DROP COLUMN IF EXISTS duration_minutes;
@@ -0,0 +1,2 @@
+-- Extra time an option adds to its service, e.g. clay bar treatment.
+ALTER TABLE service_options ADD COLUMN duration_minutes INT NOT NULL DEFAULT 0;
@@ -0,0 +1,15 @@
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_no_overlap;
+ALTER TABLE bookings ADD CONSTRAINT bookings_no_overlap
+    EXCLUDE USING gist (
+        tsrange(
+            scheduled_date + scheduled_time,
+            scheduled_date + scheduled_time + make_interval(mins => estimated_duration_mins),
+            '[)'
+        ) WITH &&
+    )
+    WHERE (status <> 'cancelled');
+
+ALTER TABLE bookings DROP COLUMN IF EXISTS resource_id;
+ALTER TABLE services DROP COLUMN IF EXISTS resource_types;
+DROP TABLE IF EXISTS resource_hours;
+DROP TABLE IF EXISTS resources;
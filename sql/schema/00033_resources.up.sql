@@ -0,0 +1,52 @@
+-- Bookable resources (wash bays, mobile vans). Each resource is a single unit
+-- that takes one job at a time; capacity for a type of work comes from the
+-- number of active resources of that type.
+
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE resources (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    name TEXT NOT NULL,
+    resource_type TEXT NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    sort_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+SELECT add_updated_at_trigger('resources');
+
+-- Per-resource weekly hours. A resource with no row for a day follows the
+-- business hours in schedule_config.
+CREATE TABLE resource_hours (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    resource_id BIGINT NOT NULL REFERENCES resources(id) ON DELETE CASCADE,
+    day_of_week INT NOT NULL CHECK (day_of_week BETWEEN 0 AND 6),
+    open_time TIME NOT NULL,
+    close_time TIME NOT NULL,
+    is_open BOOLEAN NOT NULL DEFAULT true,
+    UNIQUE(resource_id, day_of_week)
+);
+
+-- Resource types a service can be performed on.
+ALTER TABLE services ADD COLUMN resource_types TEXT[] NOT NULL DEFAULT '{bay}';
+
+INSERT INTO resources (name, resource_type, sort_order) VALUES ('Bay 1', 'bay', 1);
+
+-- Existing bookings all ran in the single workshop, now Bay 1.
+ALTER TABLE bookings ADD COLUMN resource_id BIGINT REFERENCES resources(id);
+UPDATE bookings SET resource_id = (SELECT id FROM resources WHERE name = 'Bay 1');
+ALTER TABLE bookings ALTER COLUMN resource_id SET NOT NULL;
+CREATE INDEX idx_bookings_resource_id ON bookings(resource_id);
+
+-- Overlap guard is now per resource.
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_no_overlap;
+ALTER TABLE bookings ADD CONSTRAINT bookings_no_overlap
+    EXCLUDE USING gist (
+        resource_id WITH =,
+        tsrange(
+            scheduled_date + scheduled_time,
+            scheduled_date + scheduled_time + make_interval(mins => estimated_duration_mins),
+            '[)'
+        ) WITH &&
+    )
+    WHERE (status <> 'cancelled');
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS booking_staff;
+DROP TABLE IF EXISTS staff_skills;
+DROP TABLE IF EXISTS staff_leave;
+DROP TABLE IF EXISTS staff_roster;
+DROP TABLE IF EXISTS staff;
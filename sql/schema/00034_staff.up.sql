@@ -0,0 +1,49 @@
+-- Detailers. Each staff member is a user account so they can sign in and
+-- see their own jobs.
+CREATE TABLE staff (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id BIGINT NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    display_name TEXT NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+SELECT add_updated_at_trigger('staff');
+
+-- Weekly roster. A staff member with no row for a day is not working.
+CREATE TABLE staff_roster (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    staff_id BIGINT NOT NULL REFERENCES staff(id) ON DELETE CASCADE,
+    day_of_week INT NOT NULL CHECK (day_of_week BETWEEN 0 AND 6),
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL CHECK (end_time > start_time),
+    UNIQUE(staff_id, day_of_week)
+);
+
+-- Leave, inclusive of both dates.
+CREATE TABLE staff_leave (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    staff_id BIGINT NOT NULL REFERENCES staff(id) ON DELETE CASCADE,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL CHECK (end_date >= start_date),
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_staff_leave_staff_id ON staff_leave(staff_id);
+
+-- Service categories a staff member is trained for.
+CREATE TABLE staff_skills (
+    staff_id BIGINT NOT NULL REFERENCES staff(id) ON DELETE CASCADE,
+    category_id BIGINT NOT NULL REFERENCES service_categories(id) ON DELETE CASCADE,
+    PRIMARY KEY (staff_id, category_id)
+);
+
+-- Detailers assigned to a booking.
+CREATE TABLE booking_staff (
+    booking_id BIGINT NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    staff_id BIGINT NOT NULL REFERENCES staff(id) ON DELETE CASCADE,
+    assigned_by BIGINT REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (booking_id, staff_id)
+);
+CREATE INDEX idx_booking_staff_staff_id ON booking_staff(staff_id);
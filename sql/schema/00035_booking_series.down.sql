@@ -0,0 +1,4 @@
+DELETE FROM settings WHERE scope = 'system' AND subsystem = 'booking' AND key = 'series_horizon_days';
+ALTER TABLE bookings DROP COLUMN IF EXISTS series_id;
+DROP TABLE IF EXISTS booking_series_occurrences;
+DROP TABLE IF EXISTS booking_series;
@@ -0,0 +1,36 @@
+-- Recurring booking series. The first booking of a series is its template:
+-- later occurrences copy its services, options, prices, vehicle and time.
+CREATE TABLE booking_series (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    customer_id BIGINT NOT NULL REFERENCES customer_profiles(id) ON DELETE CASCADE,
+    template_booking_id BIGINT NOT NULL REFERENCES bookings(id),
+    rrule TEXT NOT NULL,
+    start_date DATE NOT NULL,
+    scheduled_time TIME NOT NULL,
+    status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'cancelled', 'ended')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+SELECT add_updated_at_trigger('booking_series');
+CREATE INDEX idx_booking_series_customer_id ON booking_series(customer_id);
+
+-- One row per occurrence date that has been dealt with: booked, skipped by
+-- the customer, or flagged because the day was closed or the slot was taken.
+CREATE TABLE booking_series_occurrences (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    series_id BIGINT NOT NULL REFERENCES booking_series(id) ON DELETE CASCADE,
+    occurrence_date DATE NOT NULL,
+    booking_id BIGINT REFERENCES bookings(id) ON DELETE SET NULL,
+    status TEXT NOT NULL CHECK (status IN ('booked', 'skipped', 'blocked', 'conflict')),
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(series_id, occurrence_date)
+);
+
+ALTER TABLE bookings ADD COLUMN series_id BIGINT REFERENCES booking_series(id) ON DELETE SET NULL;
+CREATE INDEX idx_bookings_series_id ON bookings(series_id);
+
+INSERT INTO settings (scope, subsystem, key, value, description)
+VALUES
+    ('system', 'booking', 'series_horizon_days', '28',
+     'How many days ahead recurring booking occurrences are booked');
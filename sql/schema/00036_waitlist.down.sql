@@ -0,0 +1,9 @@
+DELETE FROM notification_template WHERE name = 'waitlist-slot-available';
+DELETE FROM template WHERE ref = 'waitlist-slot-available';
+
+DELETE FROM settings WHERE scope = 'system' AND subsystem = 'booking' AND key = 'waitlist_claim_minutes';
+
+DROP TABLE IF EXISTS waitlist_entry_service_options;
+DROP TABLE IF EXISTS waitlist_entry_services;
+DROP TABLE IF EXISTS waitlist_entries;
+DROP TABLE IF EXISTS slot_holds;
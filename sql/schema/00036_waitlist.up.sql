@@ -0,0 +1,69 @@
+-- Short-lived reservations of a slot on a resource. A held slot is treated as
+-- taken by everyone except the holder until it expires or becomes a booking.
+CREATE TABLE slot_holds (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    customer_id BIGINT NOT NULL REFERENCES customer_profiles(id) ON DELETE CASCADE,
+    resource_id BIGINT NOT NULL REFERENCES resources(id),
+    scheduled_date DATE NOT NULL,
+    scheduled_time TIME NOT NULL,
+    duration_mins INT NOT NULL CHECK (duration_mins > 0),
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_slot_holds_scheduled_date ON slot_holds(scheduled_date);
+
+-- Customers waiting for a slot on a fully booked date or range of dates. The
+-- cart is snapshotted the same way checkout snapshots it into a booking.
+CREATE TABLE waitlist_entries (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    customer_id BIGINT NOT NULL REFERENCES customer_profiles(id) ON DELETE CASCADE,
+    vehicle_id BIGINT REFERENCES vehicles(id) ON DELETE SET NULL,
+    date_from DATE NOT NULL,
+    date_to DATE NOT NULL,
+    estimated_duration_mins INT NOT NULL,
+    subtotal BIGINT NOT NULL,
+    deposit_amount BIGINT NOT NULL,
+    total_amount BIGINT NOT NULL,
+    notes TEXT,
+    status TEXT NOT NULL DEFAULT 'waiting'
+        CHECK (status IN ('waiting', 'offered', 'claimed', 'expired', 'cancelled')),
+    hold_id BIGINT REFERENCES slot_holds(id) ON DELETE SET NULL,
+    claim_token TEXT UNIQUE,
+    offered_at TIMESTAMPTZ,
+    booking_id BIGINT REFERENCES bookings(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (date_to >= date_from)
+);
+SELECT add_updated_at_trigger('waitlist_entries');
+CREATE INDEX idx_waitlist_entries_customer_id ON waitlist_entries(customer_id);
+CREATE INDEX idx_waitlist_entries_waiting ON waitlist_entries(date_from, date_to) WHERE status = 'waiting';
+
+CREATE TABLE waitlist_entry_services (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    waitlist_entry_id BIGINT NOT NULL REFERENCES waitlist_entries(id) ON DELETE CASCADE,
+    service_id BIGINT NOT NULL REFERENCES services(id),
+    price_at_booking BIGINT NOT NULL
+);
+CREATE INDEX idx_waitlist_entry_services_entry_id ON waitlist_entry_services(waitlist_entry_id);
+
+CREATE TABLE waitlist_entry_service_options (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    waitlist_entry_service_id BIGINT NOT NULL REFERENCES waitlist_entry_services(id) ON DELETE CASCADE,
+    service_option_id BIGINT NOT NULL REFERENCES service_options(id),
+    price_at_booking BIGINT NOT NULL
+);
+
+INSERT INTO settings (scope, subsystem, key, value, description)
+VALUES
+    ('system', 'booking', 'waitlist_claim_minutes', '120',
+     'How long a waitlisted customer has to claim a freed slot before it is offered to the next');
+
+INSERT INTO template (name, ref, content, scope_type, version, created_by, updated_by)
+VALUES
+  ('Waitlist Slot Available', 'waitlist-slot-available', 'Hi {{.CustomerName}}, a slot has opened up on {{.Date}} at {{.Time}} and we are holding it for you until {{.ExpiresAt}}. Claim it here: {{.ClaimLink}}', 'System', 1, NULL, NULL)
+ON CONFLICT (ref, version) DO NOTHING;
+
+INSERT INTO notification_template (name, template_id)
+SELECT 'waitlist-slot-available', id FROM template WHERE ref = 'waitlist-slot-available' AND version = 1
+ON CONFLICT (name) DO NOTHING;
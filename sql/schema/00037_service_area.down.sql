@@ -0,0 +1,7 @@
+DELETE FROM settings WHERE scope = 'system' AND subsystem = 'booking' AND key = 'service_area';
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS travel_surcharge,
+    DROP COLUMN IF EXISTS travel_distance_km,
+    DROP COLUMN IF EXISTS service_postcode,
+    DROP COLUMN IF EXISTS service_suburb,
+    DROP COLUMN IF EXISTS service_address;
@@ -0,0 +1,19 @@
+-- Where a mobile job is done. Bookings at the workshop leave these NULL;
+-- travel_distance_km is measured from the base to the service postcode.
+ALTER TABLE bookings
+    ADD COLUMN service_address TEXT,
+    ADD COLUMN service_suburb TEXT,
+    ADD COLUMN service_postcode TEXT,
+    ADD COLUMN travel_distance_km DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN travel_surcharge BIGINT NOT NULL DEFAULT 0;
+
+-- Default service area: anywhere within 40km of the Joondalup base. mode
+-- "postcodes" limits mobile jobs to the listed postcodes instead. Jobs further
+-- than free_travel_km from the base are charged surcharge_per_km cents for
+-- each extra kilometre, and vans are assumed to average travel_speed_kmh
+-- between jobs.
+INSERT INTO settings (scope, subsystem, key, value, description)
+VALUES
+    ('system', 'booking', 'service_area',
+     '{"mode": "radius", "base_postcode": "6027", "radius_km": 40, "postcodes": [], "free_travel_km": 15, "surcharge_per_km": 100, "travel_speed_kmh": 40}',
+     'Where mobile bookings are accepted, travel surcharges and travel speed between jobs');
@@ -0,0 +1,3 @@
+ALTER TABLE schedule_blackouts DROP COLUMN IF EXISTS recurs_annually;
+DROP TABLE IF EXISTS schedule_overrides;
+DROP TABLE IF EXISTS schedule_windows;
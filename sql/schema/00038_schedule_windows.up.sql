@@ -0,0 +1,28 @@
+-- Opening windows for a weekday, e.g. 08:00-12:00 and 13:00-17:00 around a
+-- lunch break. A day with no windows is open from schedule_config.open_time
+-- to close_time; with windows, those two hold the first opening and the
+-- last closing.
+CREATE TABLE schedule_windows (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    day_of_week INT NOT NULL CHECK (day_of_week BETWEEN 0 AND 6),
+    open_time TIME NOT NULL,
+    close_time TIME NOT NULL CHECK (close_time > open_time)
+);
+CREATE INDEX idx_schedule_windows_day ON schedule_windows(day_of_week);
+
+-- Hours for a specific date that replace the weekly hours, for partial-day
+-- closures and late openings. Several rows for a date are several windows.
+-- Whole days are closed with schedule_blackouts instead.
+CREATE TABLE schedule_overrides (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    date DATE NOT NULL,
+    open_time TIME NOT NULL,
+    close_time TIME NOT NULL CHECK (close_time > open_time),
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_schedule_overrides_date ON schedule_overrides(date);
+
+-- A recurring blackout closes the same day and month every year from date on,
+-- e.g. Christmas Day.
+ALTER TABLE schedule_blackouts ADD COLUMN recurs_annually BOOLEAN NOT NULL DEFAULT false;
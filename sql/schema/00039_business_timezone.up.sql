@@ -0,0 +1,7 @@
+-- Booking dates and times are stored without a zone and are wall-clock
+-- values in this IANA timezone, which notice periods and slot times are
+-- worked out in.
+INSERT INTO settings (scope, subsystem, key, value, description)
+VALUES
+    ('system', 'booking', 'timezone', '"Australia/Perth"',
+     'IANA timezone that booking dates and times are in');
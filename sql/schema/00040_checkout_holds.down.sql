@@ -0,0 +1,6 @@
+DELETE FROM settings WHERE scope = 'system' AND subsystem = 'booking' AND key = 'checkout_hold_minutes';
+DELETE FROM slot_holds WHERE purpose = 'checkout';
+DROP INDEX IF EXISTS idx_slot_holds_checkout;
+ALTER TABLE slot_holds
+    DROP COLUMN IF EXISTS service_postcode,
+    DROP COLUMN IF EXISTS purpose;
@@ -0,0 +1,13 @@
+-- Slot holds are placed either for a waitlist offer or while a customer is
+-- checking out. Checkout holds of mobile jobs record where the job is, so
+-- travel time to and from it is kept free as it would be for the booking.
+ALTER TABLE slot_holds
+    ADD COLUMN purpose TEXT NOT NULL DEFAULT 'waitlist'
+        CHECK (purpose IN ('waitlist', 'checkout')),
+    ADD COLUMN service_postcode TEXT;
+CREATE INDEX idx_slot_holds_checkout ON slot_holds(customer_id) WHERE purpose = 'checkout';
+
+INSERT INTO settings (scope, subsystem, key, value, description)
+VALUES
+    ('system', 'booking', 'checkout_hold_minutes', '15',
+     'How long a slot is held for a customer between choosing it and paying');
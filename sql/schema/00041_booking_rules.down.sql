@@ -0,0 +1 @@
+DELETE FROM settings WHERE scope = 'system' AND subsystem = 'booking' AND key = 'booking_rules';
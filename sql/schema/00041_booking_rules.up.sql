@@ -0,0 +1,11 @@
+-- Rules for which start times are offered and accepted. Start times are
+-- slot_step_minutes apart from opening; bookings need min_lead_minutes of
+-- notice, can be at most max_advance_days ahead and, if same_day_cutoff
+-- (HH:MM) is set, are not taken for the same day after it. categories
+-- overrides any of these for a service category, keyed by category ID, e.g.
+-- {"3": {"min_lead_minutes": 120}}.
+INSERT INTO settings (scope, subsystem, key, value, description)
+VALUES
+    ('system', 'booking', 'booking_rules',
+     '{"slot_step_minutes": 30, "default_duration_minutes": 60, "min_lead_minutes": 1440, "max_advance_days": 90, "same_day_cutoff": "", "categories": {}}',
+     'Slot step, lead time, booking horizon and same-day cut-off, with per-category overrides');
@@ -0,0 +1,5 @@
+DELETE FROM settings WHERE scope = 'system' AND subsystem = 'booking'
+    AND key IN ('reminder_hours_before', 'follow_up_hours_after', 'review_url');
+
+DELETE FROM notification_template WHERE name IN ('booking-confirmation', 'booking-reminder', 'booking-follow-up');
+DELETE FROM template WHERE ref IN ('booking-confirmation', 'booking-reminder', 'booking-follow-up');
@@ -0,0 +1,23 @@
+-- Emails scheduled around a booking: the confirmation sent when it is made,
+-- a reminder before it starts and a thank-you once it is completed.
+
+INSERT INTO template (name, ref, content, scope_type, version, created_by, updated_by)
+VALUES
+  ('Booking Confirmation', 'booking-confirmation', 'Thanks for your booking on {{.BookingDate}} at {{.BookingTime}}. The total is {{.Total}}, with a deposit of {{.Deposit}}.', 'System', 1, NULL, NULL),
+  ('Booking Reminder', 'booking-reminder', 'Hi {{.CustomerName}}, this is a reminder that your {{if .Vehicle}}{{.Vehicle}} is{{else}}vehicle is{{end}} booked in on {{.BookingDate}} at {{.BookingTime}}{{if .Address}} at {{.Address}}{{end}}. If you need to change or cancel, please do so from your bookings page.', 'System', 1, NULL, NULL),
+  ('Booking Follow Up', 'booking-follow-up', 'Hi {{.CustomerName}}, thanks for choosing us on {{.BookingDate}}. We hope you are happy with the result.{{if .ReviewLink}} We would love to hear how we went: {{.ReviewLink}}{{end}}', 'System', 1, NULL, NULL)
+ON CONFLICT (ref, version) DO NOTHING;
+
+INSERT INTO notification_template (name, template_id)
+SELECT ref, id FROM template
+WHERE ref IN ('booking-confirmation', 'booking-reminder', 'booking-follow-up') AND version = 1
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO settings (scope, subsystem, key, value, description)
+VALUES
+    ('system', 'booking', 'reminder_hours_before', '24',
+     'How many hours before a booking starts the customer is sent a reminder'),
+    ('system', 'booking', 'follow_up_hours_after', '2',
+     'How many hours after a booking is completed the customer is thanked and asked for a review'),
+    ('system', 'booking', 'review_url', '""',
+     'Link included in the follow-up email asking for a review; left out if empty');
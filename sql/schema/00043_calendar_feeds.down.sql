@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS calendar_feeds;
+
+DROP TRIGGER IF EXISTS bump_booking_sequence ON bookings;
+DROP FUNCTION IF EXISTS bump_booking_sequence();
+ALTER TABLE bookings DROP COLUMN IF EXISTS sequence;
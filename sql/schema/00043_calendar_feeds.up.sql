@@ -0,0 +1,34 @@
+-- Calendar clients use a VEVENT's SEQUENCE to tell a changed event from a
+-- stale copy, so it goes up whenever anything shown in the event changes.
+ALTER TABLE bookings ADD COLUMN sequence INT NOT NULL DEFAULT 0;
+
+CREATE OR REPLACE FUNCTION bump_booking_sequence()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF (NEW.scheduled_date, NEW.scheduled_time, NEW.estimated_duration_mins, NEW.status,
+        NEW.vehicle_id, NEW.notes, NEW.service_address, NEW.service_suburb, NEW.service_postcode)
+       IS DISTINCT FROM
+       (OLD.scheduled_date, OLD.scheduled_time, OLD.estimated_duration_mins, OLD.status,
+        OLD.vehicle_id, OLD.notes, OLD.service_address, OLD.service_suburb, OLD.service_postcode) THEN
+        NEW.sequence = OLD.sequence + 1;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER bump_booking_sequence
+BEFORE UPDATE ON bookings
+FOR EACH ROW
+EXECUTE FUNCTION bump_booking_sequence();
+
+-- Read-only iCalendar feeds, reached by an unguessable token in the URL
+-- rather than a session. A customer feed lists the user's own bookings; a
+-- staff feed lists every booking and is only issued to staff and admins.
+CREATE TABLE calendar_feeds (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    scope TEXT NOT NULL CHECK (scope IN ('customer', 'staff')),
+    token TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, scope)
+);
@@ -0,0 +1,8 @@
+DELETE FROM settings WHERE scope = 'system' AND subsystem = 'booking' AND key = 'no_show_prepay_threshold';
+
+ALTER TABLE customer_profiles DROP COLUMN IF EXISTS no_show_count;
+ALTER TABLE bookings DROP COLUMN IF EXISTS forfeited_amount;
+
+-- Enum values cannot be dropped; fold no-shows back into cancellations.
+UPDATE bookings SET status = 'cancelled' WHERE status = 'no_show';
+UPDATE bookings SET payment_status = 'deposit_paid' WHERE payment_status = 'forfeited';
@@ -0,0 +1,14 @@
+-- A customer who does not turn up is marked no_show rather than cancelled,
+-- and whatever they had paid is forfeited rather than refunded.
+ALTER TYPE booking_status ADD VALUE IF NOT EXISTS 'no_show';
+ALTER TYPE payment_status ADD VALUE IF NOT EXISTS 'forfeited';
+
+ALTER TABLE bookings ADD COLUMN forfeited_amount BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE customer_profiles ADD COLUMN no_show_count INT NOT NULL DEFAULT 0;
+
+-- Customers with at least this many no-shows pay the full amount at
+-- checkout instead of a deposit. 0 turns the rule off.
+INSERT INTO settings (scope, subsystem, key, value, description)
+VALUES
+    ('system', 'booking', 'no_show_prepay_threshold', '2',
+     'Number of no-shows after which a customer must prepay bookings in full (0 to disable)');
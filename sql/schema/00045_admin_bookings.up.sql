@@ -0,0 +1,4 @@
+-- Bookings taken over the phone or at the counter are entered by staff on
+-- the customer's behalf; created_by records who. NULL for self-service
+-- checkouts and bookings made by the system.
+ALTER TABLE bookings ADD COLUMN created_by BIGINT REFERENCES users(id);
@@ -0,0 +1,14 @@
+-- What the customer has paid towards a booking. Services added or removed
+-- after payment change the total but not this, leaving a balance to settle.
+ALTER TABLE bookings ADD COLUMN amount_paid BIGINT NOT NULL DEFAULT 0;
+
+-- Until now the amount paid was implied by the payment status. Refunded
+-- bookings only ever had a deposit taken unless more was refunded.
+UPDATE bookings SET amount_paid = CASE payment_status
+    WHEN 'deposit_paid' THEN deposit_amount
+    WHEN 'fully_paid' THEN total_amount
+    WHEN 'forfeited' THEN forfeited_amount
+    WHEN 'partially_refunded' THEN GREATEST(deposit_amount, refund_amount)
+    WHEN 'refunded' THEN GREATEST(deposit_amount, refund_amount)
+    ELSE 0
+END;
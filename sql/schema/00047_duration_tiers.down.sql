@@ -0,0 +1 @@
+ALTER TABLE service_price_tiers DROP COLUMN IF EXISTS duration_minutes;
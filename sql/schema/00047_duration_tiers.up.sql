@@ -0,0 +1,3 @@
+-- Bigger vehicles take longer as well as costing more. A tier may override
+-- how long the service takes for its category; NULL keeps the service's own.
+ALTER TABLE service_price_tiers ADD COLUMN duration_minutes INT CHECK (duration_minutes > 0);
@@ -0,0 +1,9 @@
+-- Stripe webhook events already acted on. Stripe delivers at least once, so
+-- an event is recorded in the same transaction as its effect and a repeat is
+-- ignored.
+CREATE TABLE stripe_events (
+    id TEXT PRIMARY KEY,
+    type TEXT NOT NULL,
+    booking_id BIGINT REFERENCES bookings(id) ON DELETE SET NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
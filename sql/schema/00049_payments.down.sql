@@ -0,0 +1,18 @@
+ALTER TABLE bookings ADD COLUMN stripe_payment_intent_id TEXT;
+ALTER TABLE bookings ADD COLUMN stripe_deposit_intent_id TEXT;
+
+-- The first card charge with a reference was the deposit, the last the rest
+UPDATE bookings b SET
+    stripe_deposit_intent_id = (SELECT p.provider_ref FROM payments p
+        WHERE p.booking_id = b.id AND p.kind = 'charge' AND p.method = 'card' AND p.provider_ref IS NOT NULL
+        ORDER BY p.created_at, p.id LIMIT 1),
+    stripe_payment_intent_id = (SELECT p.provider_ref FROM payments p
+        WHERE p.booking_id = b.id AND p.kind = 'charge' AND p.method = 'card' AND p.provider_ref IS NOT NULL
+        ORDER BY p.created_at DESC, p.id DESC LIMIT 1);
+
+UPDATE bookings SET amount_paid = amount_paid + refund_amount
+WHERE refund_amount > 0 AND payment_status IN ('refunded', 'partially_refunded');
+
+DROP TABLE IF EXISTS payments;
+DROP TYPE IF EXISTS payment_method;
+DROP TYPE IF EXISTS payment_kind;
@@ -0,0 +1,59 @@
+-- A ledger of money moving on a booking. Charges and refunds are positive
+-- amounts in their direction; adjustments are signed corrections, positive
+-- when they count as paid. tip_amount is the part of a charge that is a tip
+-- rather than towards the booking.
+CREATE TYPE payment_kind AS ENUM ('charge', 'refund', 'adjustment');
+CREATE TYPE payment_method AS ENUM ('card', 'cash', 'eftpos', 'bank_transfer', 'other');
+
+CREATE TABLE payments (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    booking_id BIGINT NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    kind payment_kind NOT NULL,
+    amount BIGINT NOT NULL,
+    tip_amount BIGINT NOT NULL DEFAULT 0,
+    method payment_method NOT NULL,
+    -- The payment provider's ID for it, e.g. a Stripe PaymentIntent or refund
+    provider_ref TEXT,
+    note TEXT,
+    created_by BIGINT REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (kind = 'adjustment' OR amount > 0),
+    CHECK (tip_amount >= 0 AND tip_amount <= amount)
+);
+CREATE INDEX idx_payments_booking ON payments(booking_id, created_at);
+-- A provider reports each payment once however often it tells us about it
+CREATE UNIQUE INDEX idx_payments_provider_ref ON payments(kind, provider_ref) WHERE provider_ref IS NOT NULL;
+
+-- Carry over what bookings recorded before the ledger: the deposit and the
+-- rest of what was paid, each under the Stripe PaymentIntent the booking kept
+-- for it. A charge with no PaymentIntent cannot be refunded through the app
+-- and is noted as one to refund in Stripe by hand. amount_paid becomes the
+-- ledger's net total, after refunds.
+WITH carried AS (
+    SELECT id, amount_paid, updated_at, stripe_deposit_intent_id,
+           NULLIF(stripe_payment_intent_id, stripe_deposit_intent_id) AS balance_intent_id,
+           CASE WHEN stripe_deposit_intent_id IS NOT NULL THEN LEAST(amount_paid, deposit_amount) ELSE 0 END AS deposit_paid
+    FROM bookings WHERE amount_paid > 0
+)
+INSERT INTO payments (booking_id, kind, amount, method, provider_ref, note, created_at)
+SELECT id, 'charge', deposit_paid, 'card', stripe_deposit_intent_id, 'recorded before the payment ledger', updated_at
+FROM carried WHERE deposit_paid > 0
+UNION ALL
+SELECT id, 'charge', amount_paid - deposit_paid, 'card', balance_intent_id,
+       CASE WHEN balance_intent_id IS NULL
+            THEN 'recorded before the payment ledger without a Stripe reference, refund in Stripe by hand'
+            ELSE 'recorded before the payment ledger' END,
+       updated_at
+FROM carried WHERE amount_paid > deposit_paid;
+
+INSERT INTO payments (booking_id, kind, amount, method, note, created_at)
+SELECT id, 'refund', refund_amount, 'card', 'recorded before the payment ledger', updated_at
+FROM bookings
+WHERE refund_amount > 0 AND payment_status IN ('refunded', 'partially_refunded');
+
+UPDATE bookings SET amount_paid = amount_paid - refund_amount
+WHERE refund_amount > 0 AND payment_status IN ('refunded', 'partially_refunded');
+
+-- Provider references now live on the ledger
+ALTER TABLE bookings DROP COLUMN stripe_payment_intent_id;
+ALTER TABLE bookings DROP COLUMN stripe_deposit_intent_id;
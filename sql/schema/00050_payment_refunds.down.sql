@@ -0,0 +1,5 @@
+DELETE FROM notification_template WHERE name = 'booking-refunded';
+DELETE FROM template WHERE ref = 'booking-refunded';
+
+DROP INDEX IF EXISTS idx_payments_refund_of;
+ALTER TABLE payments DROP COLUMN IF EXISTS refund_of;
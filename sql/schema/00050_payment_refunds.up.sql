@@ -0,0 +1,15 @@
+-- Refunds through the payment provider are made against one of its charges,
+-- so what is left to refund on each charge can be worked out.
+ALTER TABLE payments ADD COLUMN refund_of BIGINT REFERENCES payments(id);
+CREATE INDEX idx_payments_refund_of ON payments(refund_of) WHERE refund_of IS NOT NULL;
+
+-- Emailed to the customer once a refund has gone through.
+INSERT INTO template (name, ref, content, scope_type, version, created_by, updated_by)
+VALUES
+  ('Booking Refunded', 'booking-refunded', 'Hi {{.CustomerName}}, we have refunded {{.Amount}} for your booking on {{.BookingDate}}. It should reach your account within 5 to 10 business days.', 'System', 1, NULL, NULL)
+ON CONFLICT (ref, version) DO NOTHING;
+
+INSERT INTO notification_template (name, template_id)
+SELECT ref, id FROM template
+WHERE ref = 'booking-refunded' AND version = 1
+ON CONFLICT (name) DO NOTHING;
@@ -0,0 +1,4 @@
+ALTER TABLE waitlist_entry_service_options DROP COLUMN IF EXISTS duration_at_booking;
+ALTER TABLE waitlist_entry_services DROP COLUMN IF EXISTS duration_at_booking;
+ALTER TABLE booking_service_options DROP COLUMN IF EXISTS duration_at_booking;
+ALTER TABLE booking_services DROP COLUMN IF EXISTS duration_at_booking;
@@ -0,0 +1,39 @@
+-- The time each service and option took when it was booked, like its price,
+-- so taking one off a booking later gives back what it added even if the
+-- catalogue has changed since. Lines booked before this get what the
+-- catalogue says now.
+ALTER TABLE booking_services ADD COLUMN duration_at_booking INT NOT NULL DEFAULT 0;
+ALTER TABLE booking_service_options ADD COLUMN duration_at_booking INT NOT NULL DEFAULT 0;
+ALTER TABLE waitlist_entry_services ADD COLUMN duration_at_booking INT NOT NULL DEFAULT 0;
+ALTER TABLE waitlist_entry_service_options ADD COLUMN duration_at_booking INT NOT NULL DEFAULT 0;
+
+UPDATE booking_services bs
+SET duration_at_booking = COALESCE(
+    (SELECT spt.duration_minutes FROM bookings b
+     JOIN vehicles v ON v.id = b.vehicle_id
+     JOIN service_price_tiers spt ON spt.vehicle_category_id = v.vehicle_category_id
+     WHERE b.id = bs.booking_id AND spt.service_id = bs.service_id),
+    (SELECT s.duration_minutes FROM services s WHERE s.id = bs.service_id));
+
+UPDATE booking_service_options bso
+SET duration_at_booking = so.duration_minutes
+FROM service_options so
+WHERE so.id = bso.service_option_id;
+
+UPDATE waitlist_entry_services ws
+SET duration_at_booking = COALESCE(
+    (SELECT spt.duration_minutes FROM waitlist_entries we
+     JOIN vehicles v ON v.id = we.vehicle_id
+     JOIN service_price_tiers spt ON spt.vehicle_category_id = v.vehicle_category_id
+     WHERE we.id = ws.waitlist_entry_id AND spt.service_id = ws.service_id),
+    (SELECT s.duration_minutes FROM services s WHERE s.id = ws.service_id));
+
+UPDATE waitlist_entry_service_options wso
+SET duration_at_booking = so.duration_minutes
+FROM service_options so
+WHERE so.id = wso.service_option_id;
+
+ALTER TABLE booking_services ALTER COLUMN duration_at_booking DROP DEFAULT;
+ALTER TABLE booking_service_options ALTER COLUMN duration_at_booking DROP DEFAULT;
+ALTER TABLE waitlist_entry_services ALTER COLUMN duration_at_booking DROP DEFAULT;
+ALTER TABLE waitlist_entry_service_options ALTER COLUMN duration_at_booking DROP DEFAULT;
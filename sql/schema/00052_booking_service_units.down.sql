@@ -0,0 +1,3 @@
+-- Fails while a booking holds a service more than once; those units have to
+-- be merged or removed by hand first.
+ALTER TABLE booking_services ADD CONSTRAINT booking_services_booking_id_service_id_key UNIQUE (booking_id, service_id);
@@ -0,0 +1,4 @@
+-- Each unit of a service is its own booking_services row, so it can carry its
+-- own options and be taken off on its own. A booking can therefore hold the
+-- same service more than once, e.g. two vehicles' worth of a wash.
+ALTER TABLE booking_services DROP CONSTRAINT IF EXISTS booking_services_booking_id_service_id_key;
@@ -0,0 +1,6 @@
+ALTER TABLE waitlist_entries
+    DROP COLUMN IF EXISTS travel_surcharge,
+    DROP COLUMN IF EXISTS travel_distance_km,
+    DROP COLUMN IF EXISTS service_postcode,
+    DROP COLUMN IF EXISTS service_suburb,
+    DROP COLUMN IF EXISTS service_address;
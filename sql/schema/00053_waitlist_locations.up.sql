@@ -0,0 +1,9 @@
+-- Where a mobile job on the waitlist is to be done, and the travel it was
+-- priced with, so an offer checks travel time to the site and the booking
+-- claimed from it keeps the location and surcharge.
+ALTER TABLE waitlist_entries
+    ADD COLUMN service_address TEXT,
+    ADD COLUMN service_suburb TEXT,
+    ADD COLUMN service_postcode TEXT,
+    ADD COLUMN travel_distance_km DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN travel_surcharge BIGINT NOT NULL DEFAULT 0;